@@ -164,7 +164,7 @@ func TestParseConfigUnsupportedCallType(t *testing.T) {
 
 	_, err := parseConfig([]byte(str), common.MainNet)
 	require.Error(t, err)
-	assert.Equal(t, `unsupported call type for user "Test User", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "solAccount" or "solPDA"`, err.Error())
+	assert.Equal(t, `unsupported call type for user "Test User", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "solAccount", "solPDA", "suiObject", "suiMoveView", "aptosView", "aptosResource", "ethGetLogs" or "ethGetStorageAt"`, err.Error())
 }
 
 func TestParseConfigInvalidContractAddress(t *testing.T) {
@@ -355,6 +355,317 @@ func TestParseConfigSuccess(t *testing.T) {
 	assert.True(t, exists)
 }
 
+func TestParseConfigSuiSuccess(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "suiObject": {
+            "note:": "Sui clock object",
+            "chain": 21,
+            "objectId": "0x6"
+          }
+        },
+        {
+          "suiMoveView": {
+            "chain": 21,
+            "package": "0x2",
+            "module": "clock",
+            "function": "timestamp_ms"
+          }
+        },
+        {
+          "suiMoveView": {
+            "chain": 21,
+            "package": "0x5306f64e312b581766351c07af79c72fcb1cd25147157fdc2f8ad76de9a3fb6a",
+            "module": "state",
+            "function": "*"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	perms, err := parseConfig([]byte(str), common.MainNet)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(perms))
+
+	perm, exists := perms["my_secret_key"]
+	require.True(t, exists)
+
+	assert.Equal(t, 3, len(perm.allowedCalls))
+
+	_, exists = perm.allowedCalls["suiObject:21:0000000000000000000000000000000000000000000000000000000000000006"]
+	assert.True(t, exists)
+
+	_, exists = perm.allowedCalls["suiMoveView:21:0000000000000000000000000000000000000000000000000000000000000002::clock::timestamp_ms"]
+	assert.True(t, exists)
+
+	_, exists = perm.allowedCalls["suiMoveView:21:5306f64e312b581766351c07af79c72fcb1cd25147157fdc2f8ad76de9a3fb6a::state::*"]
+	assert.True(t, exists)
+}
+
+func TestParseConfigSuiInvalidObjectID(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "suiObject": {
+            "chain": 21,
+            "objectId": "HelloWorld"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	_, err := parseConfig([]byte(str), common.MainNet)
+	require.ErrorContains(t, err, `invalid sui object id "HelloWorld" for user "Test User"`)
+}
+
+func TestParseConfigSuiMoveViewMissingModule(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "suiMoveView": {
+            "chain": 21,
+            "package": "0x2",
+            "function": "timestamp_ms"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	_, err := parseConfig([]byte(str), common.MainNet)
+	require.Error(t, err)
+	assert.Equal(t, `invalid sui module "" for user "Test User"`, err.Error())
+}
+
+func TestParseConfigAptosSuccess(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "aptosView": {
+            "note:": "Aptos coin balance",
+            "chain": 22,
+            "address": "0x1",
+            "module": "coin",
+            "function": "balance"
+          }
+        },
+        {
+          "aptosView": {
+            "chain": 22,
+            "address": "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625",
+            "module": "state",
+            "function": "*"
+          }
+        },
+        {
+          "aptosResource": {
+            "chain": 22,
+            "account": "0x1",
+            "resourceType": "0x1::block::BlockResource"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	perms, err := parseConfig([]byte(str), common.MainNet)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(perms))
+
+	perm, exists := perms["my_secret_key"]
+	require.True(t, exists)
+
+	assert.Equal(t, 3, len(perm.allowedCalls))
+
+	_, exists = perm.allowedCalls["aptosView:22:0000000000000000000000000000000000000000000000000000000000000001::coin::balance"]
+	assert.True(t, exists)
+
+	_, exists = perm.allowedCalls["aptosView:22:5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625::state::*"]
+	assert.True(t, exists)
+
+	_, exists = perm.allowedCalls["aptosResource:22:0000000000000000000000000000000000000000000000000000000000000001:0x1::block::BlockResource"]
+	assert.True(t, exists)
+}
+
+func TestParseConfigAptosViewInvalidFunction(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "aptosView": {
+            "chain": 22,
+            "address": "0x1",
+            "module": "coin",
+            "function": "coin::balance"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	_, err := parseConfig([]byte(str), common.MainNet)
+	require.Error(t, err)
+	assert.Equal(t, `invalid aptos function "coin::balance" for user "Test User"`, err.Error())
+}
+
+func TestParseConfigAptosResourceMissingType(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "aptosResource": {
+            "chain": 22,
+            "account": "0x1"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	_, err := parseConfig([]byte(str), common.MainNet)
+	require.Error(t, err)
+	assert.Equal(t, `invalid aptos resource type "" for user "Test User"`, err.Error())
+}
+
+func TestParseConfigEthGetLogsAndStorageAtSuccess(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "ethGetLogs": {
+            "note:": "USDC Transfer events on Polygon",
+            "chain": 5,
+            "contractAddress": "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174",
+            "topic0": "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+          }
+        },
+        {
+          "ethGetLogs": {
+            "chain": 5,
+            "contractAddress": "0x7A4B5a56256163F07b2C80A7cA55aBE66c4ec4d7",
+            "topic0": "*"
+          }
+        },
+        {
+          "ethGetStorageAt": {
+            "chain": 5,
+            "contractAddress": "0x7A4B5a56256163F07b2C80A7cA55aBE66c4ec4d7"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	perms, err := parseConfig([]byte(str), common.MainNet)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(perms))
+
+	perm, exists := perms["my_secret_key"]
+	require.True(t, exists)
+
+	assert.Equal(t, 3, len(perm.allowedCalls))
+
+	_, exists = perm.allowedCalls["ethGetLogs:5:0000000000000000000000002791bca1f2de4661ed88a30c99a7a9449aa84174:ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"]
+	assert.True(t, exists)
+
+	_, exists = perm.allowedCalls["ethGetLogs:5:0000000000000000000000007a4b5a56256163f07b2c80a7ca55abe66c4ec4d7:*"]
+	assert.True(t, exists)
+
+	_, exists = perm.allowedCalls["ethGetStorageAt:5:0000000000000000000000007a4b5a56256163f07b2c80a7ca55abe66c4ec4d7"]
+	assert.True(t, exists)
+}
+
+func TestParseConfigEthGetLogsInvalidTopic(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "ethGetLogs": {
+            "chain": 5,
+            "contractAddress": "0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174",
+            "topic0": "0x06fdde03"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	_, err := parseConfig([]byte(str), common.MainNet)
+	require.Error(t, err)
+	assert.Equal(t, `topic0 "0x06fdde03" for user "Test User" has an invalid length, must be 32 bytes`, err.Error())
+}
+
+func TestParseConfigEthGetStorageAtInvalidContractAddress(t *testing.T) {
+	str := `
+	{
+  "permissions": [
+    {
+      "userName": "Test User",
+      "apiKey": "my_secret_key",
+      "allowedCalls": [
+        {
+          "ethGetStorageAt": {
+            "chain": 5,
+            "contractAddress": "HelloWorld"
+          }
+        }
+      ]
+    }
+  ]
+}`
+
+	_, err := parseConfig([]byte(str), common.MainNet)
+	require.Error(t, err)
+	assert.Equal(t, `invalid contract address "HelloWorld" for user "Test User"`, err.Error())
+}
+
 func TestParseConfigAllowAnythingWhenNotSpecified(t *testing.T) {
 	str := `
 	{
@@ -46,6 +46,12 @@ type (
 		EthCallWithFinality *EthCallWithFinality `json:"ethCallWithFinality"`
 		SolanaAccount       *SolanaAccount       `json:"solAccount"`
 		SolanaPda           *SolanaPda           `json:"solPDA"`
+		SuiObject           *SuiObject           `json:"suiObject"`
+		SuiMoveView         *SuiMoveView         `json:"suiMoveView"`
+		AptosView           *AptosView           `json:"aptosView"`
+		AptosResource       *AptosResource       `json:"aptosResource"`
+		EthGetLogs          *EthGetLogs          `json:"ethGetLogs"`
+		EthGetStorageAt     *EthGetStorageAt     `json:"ethGetStorageAt"`
 	}
 
 	EthCall struct {
@@ -77,6 +83,46 @@ type (
 		// As a future enhancement, we may want to specify the allowed seeds.
 	}
 
+	SuiObject struct {
+		Chain    int    `json:"chain"`
+		ObjectID string `json:"objectId"`
+	}
+
+	SuiMoveView struct {
+		Chain   int    `json:"chain"`
+		Package string `json:"package"`
+		Module  string `json:"module"`
+		// Function may be "*" to allow any function in the module.
+		Function string `json:"function"`
+	}
+
+	AptosView struct {
+		Chain   int    `json:"chain"`
+		Address string `json:"address"`
+		Module  string `json:"module"`
+		// Function may be "*" to allow any function in the module.
+		Function string `json:"function"`
+	}
+
+	AptosResource struct {
+		Chain   int    `json:"chain"`
+		Account string `json:"account"`
+		// ResourceType must match the type in the query exactly, such as "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>".
+		ResourceType string `json:"resourceType"`
+	}
+
+	EthGetLogs struct {
+		Chain           int    `json:"chain"`
+		ContractAddress string `json:"contractAddress"`
+		// Topic0 is the event signature hash. It may be "*" to allow any event emitted by the contract.
+		Topic0 string `json:"topic0"`
+	}
+
+	EthGetStorageAt struct {
+		Chain           int    `json:"chain"`
+		ContractAddress string `json:"contractAddress"`
+	}
+
 	PermissionsMap map[string]*permissionEntry
 
 	permissionEntry struct {
@@ -189,6 +235,8 @@ func (perms *Permissions) GetUserEntry(apiKey string) (*permissionEntry, bool) {
 
 const EthCallSigLength = 4
 
+const EthTopicLength = 32
+
 // parseConfigFile parses the permissions config file into a map keyed by API key.
 func parseConfigFile(fileName string, env common.Environment) (PermissionsMap, error) {
 	jsonFile, err := os.Open(fileName)
@@ -326,8 +374,71 @@ func parseConfig(byteValue []byte, env common.Environment) (PermissionsMap, erro
 					}
 				}
 				callKey = fmt.Sprintf("solPDA:%d:%s", ac.SolanaPda.Chain, pa)
+			} else if ac.SuiObject != nil {
+				objectID, err := parseMoveAddress(ac.SuiObject.ObjectID)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid sui object id "%s" for user "%s": %w`, ac.SuiObject.ObjectID, user.UserName, err)
+				}
+				callKey = fmt.Sprintf("suiObject:%d:%s", ac.SuiObject.Chain, objectID.String())
+			} else if ac.SuiMoveView != nil {
+				pkg, err := parseMoveAddress(ac.SuiMoveView.Package)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid sui package "%s" for user "%s": %w`, ac.SuiMoveView.Package, user.UserName, err)
+				}
+				if ac.SuiMoveView.Module == "" || len(ac.SuiMoveView.Module) > query.SuiMaxIdentifierLength {
+					return nil, fmt.Errorf(`invalid sui module "%s" for user "%s"`, ac.SuiMoveView.Module, user.UserName)
+				}
+				if ac.SuiMoveView.Function == "" || len(ac.SuiMoveView.Function) > query.SuiMaxIdentifierLength {
+					return nil, fmt.Errorf(`invalid sui function "%s" for user "%s"`, ac.SuiMoveView.Function, user.UserName)
+				}
+				callKey = fmt.Sprintf("suiMoveView:%d:%s::%s::%s", ac.SuiMoveView.Chain, pkg.String(), ac.SuiMoveView.Module, ac.SuiMoveView.Function)
+			} else if ac.AptosView != nil {
+				addr, err := parseMoveAddress(ac.AptosView.Address)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid aptos address "%s" for user "%s": %w`, ac.AptosView.Address, user.UserName, err)
+				}
+				if ac.AptosView.Module == "" || strings.Contains(ac.AptosView.Module, ":") {
+					return nil, fmt.Errorf(`invalid aptos module "%s" for user "%s"`, ac.AptosView.Module, user.UserName)
+				}
+				if ac.AptosView.Function == "" || strings.Contains(ac.AptosView.Function, ":") {
+					return nil, fmt.Errorf(`invalid aptos function "%s" for user "%s"`, ac.AptosView.Function, user.UserName)
+				}
+				callKey = fmt.Sprintf("aptosView:%d:%s::%s::%s", ac.AptosView.Chain, addr.String(), ac.AptosView.Module, ac.AptosView.Function)
+			} else if ac.AptosResource != nil {
+				account, err := parseMoveAddress(ac.AptosResource.Account)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid aptos account "%s" for user "%s": %w`, ac.AptosResource.Account, user.UserName, err)
+				}
+				if ac.AptosResource.ResourceType == "" || len(ac.AptosResource.ResourceType) > query.AptosMaxTypeLength {
+					return nil, fmt.Errorf(`invalid aptos resource type "%s" for user "%s"`, ac.AptosResource.ResourceType, user.UserName)
+				}
+				callKey = fmt.Sprintf("aptosResource:%d:%s:%s", ac.AptosResource.Chain, account.String(), ac.AptosResource.ResourceType)
+			} else if ac.EthGetLogs != nil {
+				contractAddr, err := vaa.StringToAddress(ac.EthGetLogs.ContractAddress)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid contract address "%s" for user "%s"`, ac.EthGetLogs.ContractAddress, user.UserName)
+				}
+				topic0 := ac.EthGetLogs.Topic0
+				if topic0 != "*" {
+					// The topic should be the 32 byte hash of the event signature. Parse it into a standard form without the "0x".
+					buf, err := hex.DecodeString(strings.TrimPrefix(topic0, "0x"))
+					if err != nil {
+						return nil, fmt.Errorf(`invalid topic0 "%s" for user "%s"`, topic0, user.UserName)
+					}
+					if len(buf) != EthTopicLength {
+						return nil, fmt.Errorf(`topic0 "%s" for user "%s" has an invalid length, must be %d bytes`, topic0, user.UserName, EthTopicLength)
+					}
+					topic0 = hex.EncodeToString(buf)
+				}
+				callKey = fmt.Sprintf("ethGetLogs:%d:%s:%s", ac.EthGetLogs.Chain, contractAddr.String(), topic0)
+			} else if ac.EthGetStorageAt != nil {
+				contractAddr, err := vaa.StringToAddress(ac.EthGetStorageAt.ContractAddress)
+				if err != nil {
+					return nil, fmt.Errorf(`invalid contract address "%s" for user "%s"`, ac.EthGetStorageAt.ContractAddress, user.UserName)
+				}
+				callKey = fmt.Sprintf("ethGetStorageAt:%d:%s", ac.EthGetStorageAt.Chain, contractAddr.String())
 			} else {
-				return nil, fmt.Errorf(`unsupported call type for user "%s", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "solAccount" or "solPDA"`, user.UserName)
+				return nil, fmt.Errorf(`unsupported call type for user "%s", must be "ethCall", "ethCallByTimestamp", "ethCallWithFinality", "solAccount", "solPDA", "suiObject", "suiMoveView", "aptosView", "aptosResource", "ethGetLogs" or "ethGetStorageAt"`, user.UserName)
 			}
 
 			if callKey == "" {
@@ -376,3 +487,13 @@ func parseConfig(byteValue []byte, env common.Environment) (PermissionsMap, erro
 
 	return ret, nil
 }
+
+// parseMoveAddress parses a Sui or Aptos address, such as a Sui object ID or an Aptos account. Both chains allow leading
+// zeros to be dropped (such as "0x2"), so the hex string is padded before it is converted to the standard form of 32 bytes.
+func parseMoveAddress(str string) (vaa.Address, error) {
+	hexStr := strings.TrimPrefix(str, "0x")
+	if len(hexStr)%2 != 0 {
+		hexStr = "0" + hexStr
+	}
+	return vaa.StringToAddress(hexStr)
+}
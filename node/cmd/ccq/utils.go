@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
@@ -115,6 +116,18 @@ func validateRequest(logger *zap.Logger, env common.Environment, perms *Permissi
 			status, err = validateSolanaAccountQuery(logger, permsForUser, "solAccount", pcq.ChainId, q)
 		case *query.SolanaPdaQueryRequest:
 			status, err = validateSolanaPdaQuery(logger, permsForUser, "solPDA", pcq.ChainId, q)
+		case *query.SuiObjectQueryRequest:
+			status, err = validateSuiObjectQuery(logger, permsForUser, "suiObject", pcq.ChainId, q)
+		case *query.SuiMoveViewQueryRequest:
+			status, err = validateSuiMoveViewQuery(logger, permsForUser, "suiMoveView", pcq.ChainId, q)
+		case *query.AptosViewQueryRequest:
+			status, err = validateAptosViewQuery(logger, permsForUser, "aptosView", pcq.ChainId, q)
+		case *query.AptosResourceQueryRequest:
+			status, err = validateAptosResourceQuery(logger, permsForUser, "aptosResource", pcq.ChainId, q)
+		case *query.EthGetLogsQueryRequest:
+			status, err = validateEthGetLogsQuery(logger, permsForUser, "ethGetLogs", pcq.ChainId, q)
+		case *query.EthGetStorageAtQueryRequest:
+			status, err = validateEthGetStorageAtQuery(logger, permsForUser, "ethGetStorageAt", pcq.ChainId, q)
 		default:
 			logger.Debug("unsupported query type", zap.String("userName", permsForUser.userName), zap.Any("type", pcq.Query))
 			invalidQueryRequestReceived.WithLabelValues("unsupported_query_type").Inc()
@@ -200,3 +213,173 @@ func validateSolanaPdaQuery(logger *zap.Logger, permsForUser *permissionEntry, c
 
 	return http.StatusOK, nil
 }
+
+// validateSuiObjectQuery performs verification on a Sui sui_object query.
+func validateSuiObjectQuery(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainID vaa.ChainID, q *query.SuiObjectQueryRequest) (int, error) {
+	if !permsForUser.allowAnything {
+		for _, objectID := range q.ObjectIDs {
+			callKey := fmt.Sprintf("%s:%d:%s", callTag, chainID, vaa.Address(objectID).String())
+			if _, exists := permsForUser.allowedCalls[callKey]; !exists {
+				logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", callKey))
+				invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+				return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, callKey)
+			}
+
+			totalRequestedCallsByChain.WithLabelValues(chainID.String()).Inc()
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// validateSuiMoveViewQuery performs verification on a Sui sui_move_view query.
+func validateSuiMoveViewQuery(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainID vaa.ChainID, q *query.SuiMoveViewQueryRequest) (int, error) {
+	if !permsForUser.allowAnything {
+		for _, cd := range q.CallData {
+			pkg := vaa.Address(cd.Package).String()
+			callKey := fmt.Sprintf("%s:%d:%s::%s::%s", callTag, chainID, pkg, cd.Module, cd.Function)
+			if _, exists := permsForUser.allowedCalls[callKey]; !exists {
+				// The function doesn't exist explicitly. See if the whole module is allowed.
+				wildCardCallKey := fmt.Sprintf("%s:%d:%s::%s::*", callTag, chainID, pkg, cd.Module)
+				if _, exists := permsForUser.allowedCalls[wildCardCallKey]; !exists {
+					logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", callKey))
+					invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+					return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, callKey)
+				}
+			}
+
+			totalRequestedCallsByChain.WithLabelValues(chainID.String()).Inc()
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// validateAptosViewQuery performs verification on an Aptos aptos_view query.
+func validateAptosViewQuery(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainID vaa.ChainID, q *query.AptosViewQueryRequest) (int, error) {
+	if !permsForUser.allowAnything {
+		for _, cd := range q.CallData {
+			// The function has already been validated to be of the form "<address>::<module>::<function>".
+			parts := strings.Split(cd.Function, "::")
+			if len(parts) != 3 {
+				invalidQueryRequestReceived.WithLabelValues("invalid_function").Inc()
+				return http.StatusBadRequest, fmt.Errorf(`invalid function "%s"`, cd.Function)
+			}
+			addr, err := parseMoveAddress(parts[0])
+			if err != nil {
+				invalidQueryRequestReceived.WithLabelValues("invalid_function").Inc()
+				return http.StatusBadRequest, fmt.Errorf(`invalid address in function "%s": %w`, cd.Function, err)
+			}
+			callKey := fmt.Sprintf("%s:%d:%s::%s::%s", callTag, chainID, addr.String(), parts[1], parts[2])
+			if _, exists := permsForUser.allowedCalls[callKey]; !exists {
+				// The function doesn't exist explicitly. See if the whole module is allowed.
+				wildCardCallKey := fmt.Sprintf("%s:%d:%s::%s::*", callTag, chainID, addr.String(), parts[1])
+				if _, exists := permsForUser.allowedCalls[wildCardCallKey]; !exists {
+					logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", callKey))
+					invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+					return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, callKey)
+				}
+			}
+
+			totalRequestedCallsByChain.WithLabelValues(chainID.String()).Inc()
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// validateAptosResourceQuery performs verification on an Aptos aptos_resource query.
+func validateAptosResourceQuery(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainID vaa.ChainID, q *query.AptosResourceQueryRequest) (int, error) {
+	if !permsForUser.allowAnything {
+		for _, resource := range q.Resources {
+			callKey := fmt.Sprintf("%s:%d:%s:%s", callTag, chainID, vaa.Address(resource.Account).String(), resource.ResourceType)
+			if _, exists := permsForUser.allowedCalls[callKey]; !exists {
+				logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", callKey))
+				invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+				return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, callKey)
+			}
+
+			totalRequestedCallsByChain.WithLabelValues(chainID.String()).Inc()
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// validateEthGetLogsQuery performs verification on an EVM eth_get_logs query. Every contract address in the query must be allowed
+// for every event signature that the query may match. If the query does not restrict the event signature, a wildcard is required.
+func validateEthGetLogsQuery(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainID vaa.ChainID, q *query.EthGetLogsQueryRequest) (int, error) {
+	if !permsForUser.allowAnything {
+		if len(q.Addresses) == 0 {
+			logger.Debug("eth_get_logs query does not specify a contract address", zap.String("userName", permsForUser.userName))
+			invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+			return http.StatusForbidden, errors.New("eth_get_logs query must specify at least one contract address")
+		}
+
+		var topics []string
+		if len(q.Topics) != 0 {
+			for _, topic := range q.Topics[0] {
+				topics = append(topics, hex.EncodeToString(topic.Bytes()))
+			}
+		}
+
+		for _, addr := range q.Addresses {
+			contractAddress, err := vaa.BytesToAddress(addr)
+			if err != nil {
+				logger.Debug("failed to parse contract address", zap.String("userName", permsForUser.userName), zap.String("contract", hex.EncodeToString(addr)), zap.Error(err))
+				invalidQueryRequestReceived.WithLabelValues("invalid_contract_address").Inc()
+				return http.StatusBadRequest, fmt.Errorf("failed to parse contract address: %w", err)
+			}
+
+			wildCardCallKey := fmt.Sprintf("%s:%d:%s:*", callTag, chainID, contractAddress)
+			if _, exists := permsForUser.allowedCalls[wildCardCallKey]; exists {
+				totalRequestedCallsByChain.WithLabelValues(chainID.String()).Inc()
+				continue
+			}
+
+			if len(topics) == 0 {
+				logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", wildCardCallKey))
+				invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+				return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, wildCardCallKey)
+			}
+
+			for _, topic := range topics {
+				callKey := fmt.Sprintf("%s:%d:%s:%s", callTag, chainID, contractAddress, topic)
+				if _, exists := permsForUser.allowedCalls[callKey]; !exists {
+					logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", callKey))
+					invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+					return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, callKey)
+				}
+			}
+
+			totalRequestedCallsByChain.WithLabelValues(chainID.String()).Inc()
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// validateEthGetStorageAtQuery performs verification on an EVM eth_get_storage_at query.
+func validateEthGetStorageAtQuery(logger *zap.Logger, permsForUser *permissionEntry, callTag string, chainID vaa.ChainID, q *query.EthGetStorageAtQueryRequest) (int, error) {
+	if !permsForUser.allowAnything {
+		for _, slot := range q.Slots {
+			contractAddress, err := vaa.BytesToAddress(slot.Address)
+			if err != nil {
+				logger.Debug("failed to parse contract address", zap.String("userName", permsForUser.userName), zap.String("contract", hex.EncodeToString(slot.Address)), zap.Error(err))
+				invalidQueryRequestReceived.WithLabelValues("invalid_contract_address").Inc()
+				return http.StatusBadRequest, fmt.Errorf("failed to parse contract address: %w", err)
+			}
+
+			callKey := fmt.Sprintf("%s:%d:%s", callTag, chainID, contractAddress)
+			if _, exists := permsForUser.allowedCalls[callKey]; !exists {
+				logger.Debug("requested call not authorized", zap.String("userName", permsForUser.userName), zap.String("callKey", callKey))
+				invalidQueryRequestReceived.WithLabelValues("call_not_authorized").Inc()
+				return http.StatusForbidden, fmt.Errorf(`call "%s" not authorized`, callKey)
+			}
+
+			totalRequestedCallsByChain.WithLabelValues(chainID.String()).Inc()
+		}
+	}
+
+	return http.StatusOK, nil
+}
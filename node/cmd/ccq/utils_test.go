@@ -0,0 +1,90 @@
+package ccq
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/query"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestValidateEthGetLogsQuery(t *testing.T) {
+	usdc := ethCommon.FromHex("0x2791bca1f2de4661ed88a30c99a7a9449aa84174")
+	other := ethCommon.FromHex("0x7a4b5a56256163f07b2c80a7ca55abe66c4ec4d7")
+	transfer := ethCommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approval := ethCommon.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	perms := &permissionEntry{
+		userName: "Test User",
+		allowedCalls: allowedCallsForUser{
+			"ethGetLogs:5:0000000000000000000000002791bca1f2de4661ed88a30c99a7a9449aa84174:ddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef": {},
+			"ethGetLogs:5:0000000000000000000000007a4b5a56256163f07b2c80a7ca55abe66c4ec4d7:*":                                                                {},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		chainID   vaa.ChainID
+		addresses [][]byte
+		topics    [][]ethCommon.Hash
+		status    int
+	}{
+		{name: "allowed topic", chainID: vaa.ChainIDPolygon, addresses: [][]byte{usdc}, topics: [][]ethCommon.Hash{{transfer}}, status: http.StatusOK},
+		{name: "allowed topic with other positions", chainID: vaa.ChainIDPolygon, addresses: [][]byte{usdc}, topics: [][]ethCommon.Hash{{transfer}, {}, {approval}}, status: http.StatusOK},
+		{name: "wildcard contract with any topic", chainID: vaa.ChainIDPolygon, addresses: [][]byte{other}, topics: [][]ethCommon.Hash{{approval}}, status: http.StatusOK},
+		{name: "wildcard contract with no topics", chainID: vaa.ChainIDPolygon, addresses: [][]byte{other}, status: http.StatusOK},
+		{name: "both contracts", chainID: vaa.ChainIDPolygon, addresses: [][]byte{usdc, other}, topics: [][]ethCommon.Hash{{transfer}}, status: http.StatusOK},
+		{name: "topic not allowed", chainID: vaa.ChainIDPolygon, addresses: [][]byte{usdc}, topics: [][]ethCommon.Hash{{approval}}, status: http.StatusForbidden},
+		{name: "one of several topics not allowed", chainID: vaa.ChainIDPolygon, addresses: [][]byte{usdc}, topics: [][]ethCommon.Hash{{transfer, approval}}, status: http.StatusForbidden},
+		{name: "no topic requires wildcard", chainID: vaa.ChainIDPolygon, addresses: [][]byte{usdc}, status: http.StatusForbidden},
+		{name: "empty topic0 requires wildcard", chainID: vaa.ChainIDPolygon, addresses: [][]byte{usdc}, topics: [][]ethCommon.Hash{{}, {transfer}}, status: http.StatusForbidden},
+		{name: "no contract address", chainID: vaa.ChainIDPolygon, topics: [][]ethCommon.Hash{{transfer}}, status: http.StatusForbidden},
+		{name: "wrong chain", chainID: vaa.ChainIDEthereum, addresses: [][]byte{usdc}, topics: [][]ethCommon.Hash{{transfer}}, status: http.StatusForbidden},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			q := &query.EthGetLogsQueryRequest{FromBlock: "0x1", ToBlock: "0x2", Addresses: tc.addresses, Topics: tc.topics}
+			status, err := validateEthGetLogsQuery(zap.NewNop(), perms, "ethGetLogs", tc.chainID, q)
+			assert.Equal(t, tc.status, status)
+			if tc.status == http.StatusOK {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	// A user that is allowed anything does not need explicit permissions.
+	q := &query.EthGetLogsQueryRequest{FromBlock: "0x1", ToBlock: "0x2"}
+	status, err := validateEthGetLogsQuery(zap.NewNop(), &permissionEntry{allowAnything: true}, "ethGetLogs", vaa.ChainIDPolygon, q)
+	assert.Equal(t, http.StatusOK, status)
+	assert.NoError(t, err)
+}
+
+func TestValidateEthGetStorageAtQuery(t *testing.T) {
+	perms := &permissionEntry{
+		userName: "Test User",
+		allowedCalls: allowedCallsForUser{
+			"ethGetStorageAt:5:0000000000000000000000007a4b5a56256163f07b2c80a7ca55abe66c4ec4d7": {},
+		},
+	}
+
+	q := &query.EthGetStorageAtQueryRequest{
+		BlockId: "0x28d9630",
+		Slots: []*query.EthStorageSlot{
+			{Address: ethCommon.FromHex("0x7a4b5a56256163f07b2c80a7ca55abe66c4ec4d7"), Slot: ethCommon.HexToHash("0x01")},
+		},
+	}
+	status, err := validateEthGetStorageAtQuery(zap.NewNop(), perms, "ethGetStorageAt", vaa.ChainIDPolygon, q)
+	assert.Equal(t, http.StatusOK, status)
+	assert.NoError(t, err)
+
+	q.Slots = append(q.Slots, &query.EthStorageSlot{Address: ethCommon.FromHex("0x2791bca1f2de4661ed88a30c99a7a9449aa84174"), Slot: ethCommon.HexToHash("0x01")})
+	status, err = validateEthGetStorageAtQuery(zap.NewNop(), perms, "ethGetStorageAt", vaa.ChainIDPolygon, q)
+	assert.Equal(t, http.StatusForbidden, status)
+	assert.EqualError(t, err, `call "ethGetStorageAt:5:0000000000000000000000002791bca1f2de4661ed88a30c99a7a9449aa84174" not authorized`)
+}
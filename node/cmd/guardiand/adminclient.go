@@ -27,6 +27,7 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/notary"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/wormhole-foundation/wormhole/sdk"
@@ -44,9 +45,10 @@ import (
 )
 
 var (
-	clientSocketPath *string
-	shouldBackfill   *bool
-	unsafeDevnetMode *bool
+	clientSocketPath      *string
+	shouldBackfill        *bool
+	unsafeDevnetMode      *bool
+	notaryOperatorKeyPath *string
 )
 
 func init() {
@@ -83,16 +85,30 @@ func init() {
 	NotaryGetBlackholedMessage.Flags().AddFlagSet(pf)
 	NotaryListDelayedMessages.Flags().AddFlagSet(pf)
 	NotaryListBlackholedMessages.Flags().AddFlagSet(pf)
+	NotaryAnnotateDelayedMessage.Flags().AddFlagSet(pf)
+	NotaryApproveDelayedMessage.Flags().AddFlagSet(pf)
+	NotaryListDelayedMessageReviews.Flags().AddFlagSet(pf)
 	PurgePythNetVaasCmd.Flags().AddFlagSet(pf)
 	SignExistingVaaCmd.Flags().AddFlagSet(pf)
 	SignExistingVaasFromCSVCmd.Flags().AddFlagSet(pf)
 	GetAndObserveMissingVAAs.Flags().AddFlagSet(pf)
 	BroadcastDelegateSignatures.Flags().AddFlagSet(pf)
+	ReloadWatchersCmd.Flags().AddFlagSet(pf)
 
 	adminClientSignWormchainAddressFlags := pflag.NewFlagSet("adminClientSignWormchainAddressFlags", pflag.ContinueOnError)
 	unsafeDevnetMode = adminClientSignWormchainAddressFlags.Bool("unsafeDevMode", false, "Run in unsafe devnet mode")
 	AdminClientSignWormchainAddress.Flags().AddFlagSet(adminClientSignWormchainAddressFlags)
 
+	// Flags identifying the operator reviewing a notary delayed message
+	notaryReviewFlags := pflag.NewFlagSet("notaryReviewFlags", pflag.ContinueOnError)
+	notaryOperatorKeyPath = notaryReviewFlags.String("operatorKey", "", "Path to the operator key file signing the review, created with guardiand keygen --block-type \""+notary.OperatorKeyArmoredBlock+"\"")
+	err = cobra.MarkFlagRequired(notaryReviewFlags, "operatorKey")
+	if err != nil {
+		panic(err)
+	}
+	NotaryAnnotateDelayedMessage.Flags().AddFlagSet(notaryReviewFlags)
+	NotaryApproveDelayedMessage.Flags().AddFlagSet(notaryReviewFlags)
+
 	AdminCmd.AddCommand(AdminClientInjectGuardianSetUpdateCmd)
 	AdminCmd.AddCommand(AdminClientFindMissingMessagesCmd)
 	AdminCmd.AddCommand(AdminClientGovernanceVAAVerifyCmd)
@@ -108,6 +124,8 @@ func init() {
 	AdminCmd.AddCommand(ClientChainGovernorDropPendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorReleasePendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorResetReleaseTimerCmd)
+	AdminCmd.AddCommand(ClientChainGovernorSetTokenLimitCmd)
+	AdminCmd.AddCommand(ClientChainGovernorSetCorridorLimitCmd)
 	// Notary commands
 	AdminCmd.AddCommand(NotaryBlackholeDelayedMessage)
 	AdminCmd.AddCommand(NotaryReleaseDelayedMessage)
@@ -119,6 +137,9 @@ func init() {
 	AdminCmd.AddCommand(NotaryGetBlackholedMessage)
 	AdminCmd.AddCommand(NotaryListDelayedMessages)
 	AdminCmd.AddCommand(NotaryListBlackholedMessages)
+	AdminCmd.AddCommand(NotaryAnnotateDelayedMessage)
+	AdminCmd.AddCommand(NotaryApproveDelayedMessage)
+	AdminCmd.AddCommand(NotaryListDelayedMessageReviews)
 	// Other commands
 	AdminCmd.AddCommand(PurgePythNetVaasCmd)
 	AdminCmd.AddCommand(SignExistingVaaCmd)
@@ -126,6 +147,7 @@ func init() {
 	AdminCmd.AddCommand(Keccak256Hash)
 	AdminCmd.AddCommand(GetAndObserveMissingVAAs)
 	AdminCmd.AddCommand(BroadcastDelegateSignatures)
+	AdminCmd.AddCommand(ReloadWatchersCmd)
 }
 
 var AdminCmd = &cobra.Command{
@@ -210,6 +232,20 @@ var ClientChainGovernorResetReleaseTimerCmd = &cobra.Command{
 	Args:  cobra.RangeArgs(1, 2),
 }
 
+var ClientChainGovernorSetTokenLimitCmd = &cobra.Command{
+	Use:   "governor-set-token-limit [EMITTER_CHAIN] [TOKEN_CHAIN] [TOKEN_ADDRESS] [DAILY_LIMIT|reset]",
+	Short: "Overrides the chain governor daily limit (in USD) of a token leaving the emitter chain, or restores the configured limit if \"reset\" is specified",
+	Run:   runChainGovernorSetTokenLimit,
+	Args:  cobra.ExactArgs(4),
+}
+
+var ClientChainGovernorSetCorridorLimitCmd = &cobra.Command{
+	Use:   "governor-set-corridor-limit [EMITTER_CHAIN] [TARGET_CHAIN] [DAILY_LIMIT|reset]",
+	Short: "Overrides the chain governor daily limit (in USD) of the transfers from the emitter chain to the target chain, or restores the configured limit if \"reset\" is specified",
+	Run:   runChainGovernorSetCorridorLimit,
+	Args:  cobra.ExactArgs(3),
+}
+
 var PurgePythNetVaasCmd = &cobra.Command{
 	Use:   "purge-pythnet-vaas [DAYS_OLD] <logonly>",
 	Short: "Deletes PythNet VAAs from the database that are more than [DAYS_OLD] days only (if logonly is specified, doesn't delete anything)",
@@ -252,6 +288,13 @@ var BroadcastDelegateSignatures = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 }
 
+var ReloadWatchersCmd = &cobra.Command{
+	Use:   "reload-watchers",
+	Short: "Reloads the watchers of the guardian config file, restarting the ones that changed",
+	Run:   runReloadWatchers,
+	Args:  cobra.ExactArgs(0),
+}
+
 var Keccak256Hash = &cobra.Command{
 	Use:   "keccak256",
 	Short: "Compute legacy keccak256 hash",
@@ -329,6 +372,27 @@ var (
 		Run:   runNotaryListBlackholedMessages,
 		Args:  cobra.NoArgs,
 	}
+
+	NotaryAnnotateDelayedMessage = &cobra.Command{
+		Use:   "notary-annotate-delayed-message [MESSAGE_ID] [NOTE]",
+		Short: "Adds a note from the operator to the review of a delayed message",
+		Run:   runNotaryAnnotateDelayedMessage,
+		Args:  cobra.ExactArgs(2),
+	}
+
+	NotaryApproveDelayedMessage = &cobra.Command{
+		Use:   "notary-approve-delayed-message [MESSAGE_ID]",
+		Short: "Approves the release of a delayed message, which is released once enough operators approved it",
+		Run:   runNotaryApproveDelayedMessage,
+		Args:  cobra.ExactArgs(1),
+	}
+
+	NotaryListDelayedMessageReviews = &cobra.Command{
+		Use:   "notary-list-delayed-message-reviews",
+		Short: "Lists all delayed messages together with their annotations and approvals",
+		Run:   runNotaryListDelayedMessageReviews,
+		Args:  cobra.NoArgs,
+	}
 )
 
 func getAdminClient(addr string) (*grpc.ClientConn, nodev1.NodePrivilegedServiceClient, error) {
@@ -989,6 +1053,26 @@ func runChainGovernorStatus(cmd *cobra.Command, args []string) {
 	fmt.Println(resp.Response)
 }
 
+func runReloadWatchers(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(*clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.ReloadWatchers(ctx, &nodev1.ReloadWatchersRequest{})
+	if err != nil {
+		log.Fatalf("failed to run ReloadWatchers RPC: %s", err)
+	}
+
+	fmt.Printf("started: %s\n", strings.Join(resp.Started, ", "))
+	fmt.Printf("stopped: %s\n", strings.Join(resp.Stopped, ", "))
+	fmt.Printf("updated: %s\n", strings.Join(resp.Updated, ", "))
+}
+
 func runChainGovernorReload(cmd *cobra.Command, args []string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -1084,6 +1168,92 @@ func runChainGovernorResetReleaseTimer(cmd *cobra.Command, args []string) {
 	fmt.Println(resp.Response)
 }
 
+// parseGovernorLimit parses the DAILY_LIMIT argument of the governor limit commands, which may be "reset" instead.
+func parseGovernorLimit(arg string) (dailyLimit uint64, reset bool) {
+	if arg == "reset" {
+		return 0, true
+	}
+
+	dailyLimit, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		log.Fatalf("invalid DAILY_LIMIT: %v", err)
+	}
+	return dailyLimit, false
+}
+
+func runChainGovernorSetTokenLimit(cmd *cobra.Command, args []string) {
+	emitterChain, err := vaa.StringToKnownChainID(args[0])
+	if err != nil {
+		log.Fatalf("invalid EMITTER_CHAIN: %v", err)
+	}
+
+	tokenChain, err := vaa.StringToKnownChainID(args[1])
+	if err != nil {
+		log.Fatalf("invalid TOKEN_CHAIN: %v", err)
+	}
+
+	dailyLimit, reset := parseGovernorLimit(args[3])
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(*clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.ChainGovernorSetTokenLimitRequest{
+		EmitterChainId: uint32(emitterChain),
+		TokenChainId:   uint32(tokenChain),
+		TokenAddress:   args[2],
+		DailyLimit:     dailyLimit,
+		RemoveOverride: reset,
+	}
+	resp, err := c.ChainGovernorSetTokenLimit(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run ChainGovernorSetTokenLimit RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runChainGovernorSetCorridorLimit(cmd *cobra.Command, args []string) {
+	emitterChain, err := vaa.StringToKnownChainID(args[0])
+	if err != nil {
+		log.Fatalf("invalid EMITTER_CHAIN: %v", err)
+	}
+
+	targetChain, err := vaa.StringToKnownChainID(args[1])
+	if err != nil {
+		log.Fatalf("invalid TARGET_CHAIN: %v", err)
+	}
+
+	dailyLimit, reset := parseGovernorLimit(args[2])
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(*clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.ChainGovernorSetCorridorLimitRequest{
+		EmitterChainId: uint32(emitterChain),
+		TargetChainId:  uint32(targetChain),
+		DailyLimit:     dailyLimit,
+		RemoveOverride: reset,
+	}
+	resp, err := c.ChainGovernorSetCorridorLimit(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run ChainGovernorSetCorridorLimit RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
 func runPurgePythNetVaas(cmd *cobra.Command, args []string) {
 	daysOld, err := strconv.ParseUint(args[0], 10, 64)
 	if err != nil {
@@ -1475,3 +1645,99 @@ func runNotaryListBlackholedMessages(cmd *cobra.Command, args []string) {
 		fmt.Println(vaaId)
 	}
 }
+
+func runNotaryAnnotateDelayedMessage(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+	conn, c, err := getAdminClient(*clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	operatorKey, err := common.LoadArmoredKey(*notaryOperatorKeyPath, notary.OperatorKeyArmoredBlock, false)
+	if err != nil {
+		log.Fatalf("failed to load operator key: %v", err)
+	}
+
+	timestamp := time.Unix(time.Now().Unix(), 0)
+	signature, err := crypto.Sign(notary.AnnotationDigest(args[0], args[1], timestamp).Bytes(), operatorKey)
+	if err != nil {
+		log.Fatalf("failed to sign note: %v", err)
+	}
+
+	msg := nodev1.NotaryAnnotateDelayedMessageRequest{
+		VaaId:     args[0],
+		Note:      args[1],
+		Timestamp: uint64(timestamp.Unix()), // #nosec G115 -- The current time is after the epoch
+		Signature: signature,
+	}
+	resp, err := c.NotaryAnnotateDelayedMessage(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run NotaryAnnotateDelayedMessage RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runNotaryApproveDelayedMessage(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+	conn, c, err := getAdminClient(*clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	operatorKey, err := common.LoadArmoredKey(*notaryOperatorKeyPath, notary.OperatorKeyArmoredBlock, false)
+	if err != nil {
+		log.Fatalf("failed to load operator key: %v", err)
+	}
+
+	timestamp := time.Unix(time.Now().Unix(), 0)
+	signature, err := crypto.Sign(notary.ApprovalDigest(args[0], timestamp).Bytes(), operatorKey)
+	if err != nil {
+		log.Fatalf("failed to sign approval: %v", err)
+	}
+
+	msg := nodev1.NotaryApproveDelayedMessageRequest{
+		VaaId:     args[0],
+		Timestamp: uint64(timestamp.Unix()), // #nosec G115 -- The current time is after the epoch
+		Signature: signature,
+	}
+	resp, err := c.NotaryApproveDelayedMessage(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run NotaryApproveDelayedMessage RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runNotaryListDelayedMessageReviews(cmd *cobra.Command, args []string) {
+	ctx := context.Background()
+	conn, c, err := getAdminClient(*clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.NotaryListDelayedMessageReviewsRequest{}
+	resp, err := c.NotaryListDelayedMessageReviews(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run NotaryListDelayedMessageReviews RPC: %s", err)
+	}
+
+	fmt.Printf("Delayed messages (%d), approvals required for release: %d\n", len(resp.Reviews), resp.RequiredApprovals)
+	for _, review := range resp.Reviews {
+		fmt.Printf("\n%s\n  Release Time: %s\n", review.VaaId, review.ReleaseTime)
+		if review.RuleId != "" {
+			fmt.Printf("  Policy Rule: %s\n", review.RuleId)
+		}
+		fmt.Printf("  Approvals (%d):\n", len(review.Approvals))
+		for _, a := range review.Approvals {
+			fmt.Printf("    %s by %s\n", a.Timestamp, a.Operator)
+		}
+		fmt.Printf("  Annotations (%d):\n", len(review.Annotations))
+		for _, a := range review.Annotations {
+			fmt.Printf("    %s by %s: %s\n", a.Timestamp, a.Operator, a.Note)
+		}
+	}
+}
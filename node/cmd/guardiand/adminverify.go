@@ -11,6 +11,7 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/adminrpc"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
 var AdminClientGovernanceVAAVerifyCmd = &cobra.Command{
@@ -55,5 +56,13 @@ func runGovernanceVAAVerify(cmd *cobra.Command, args []string) {
 			log.Fatal(err.Error())
 		}
 		log.Printf("VAA with digest %x: %+v\n", digest, debugStr)
+
+		// Bridge and accountant modules may be given any name, which the decoder does not know about.
+		body, err := vaa.DecodeGovernancePayload(v.Payload)
+		if err != nil {
+			log.Printf("Failed to decode governance payload: %v", err)
+			continue
+		}
+		log.Printf("Governance message %T: %+v\n", body, body)
 	}
 }
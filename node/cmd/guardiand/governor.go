@@ -0,0 +1,174 @@
+package guardiand
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	guardianDB "github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	simulateNetwork       *string
+	simulateProposal      *string
+	simulateVAAs          *string
+	simulateDataDir       *string
+	simulateFlowCancel    *bool
+	simulateCheckInterval *time.Duration
+	simulateJSON          *bool
+)
+
+func init() {
+	simulateNetwork = GovernorSimulateCmd.Flags().String("network", "mainnet", "Network whose governor configuration is simulated (mainnet, testnet or devnet)")
+	simulateProposal = GovernorSimulateCmd.Flags().String("proposal", "", "JSON file with the proposed chain limits. The current limits are simulated if unset")
+	simulateVAAs = GovernorSimulateCmd.Flags().String("vaas", "", "JSONL or CSV file of historical VAAs to replay")
+	simulateDataDir = GovernorSimulateCmd.Flags().String("dataDir", "", "Data directory of a stopped guardian, whose governor transfers are replayed")
+	simulateFlowCancel = GovernorSimulateCmd.Flags().Bool("flowCancel", true, "Simulate the governor with flow cancel enabled")
+	simulateCheckInterval = GovernorSimulateCmd.Flags().Duration("checkInterval", governor.DefaultSimulationCheckInterval, "How often enqueued transfers are checked for release")
+	simulateJSON = GovernorSimulateCmd.Flags().Bool("json", false, "Print the result as JSON")
+
+	GovernorCmd.AddCommand(GovernorSimulateCmd)
+}
+
+var GovernorCmd = &cobra.Command{
+	Use:   "governor",
+	Short: "Offline tools for the chain governor",
+}
+
+var GovernorSimulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Replay historical transfers through the governor with proposed chain limits",
+	Long: `Replay historical transfers through the governor with proposed chain limits, and report which transfers
+would have been enqueued, how long they would have waited and which ones would have been flow cancelled.
+
+The transfers are read from a JSONL or CSV file of VAAs (--vaas), or from the governor records of a guardian
+database (--dataDir). The database is modified when old records are migrated, so use a copy of the data
+directory or stop the guardian first. Note that a guardian only keeps the transfers of the last 24 hours.`,
+	Run:  runGovernorSimulate,
+	Args: cobra.NoArgs,
+}
+
+func runGovernorSimulate(cmd *cobra.Command, args []string) {
+	if (*simulateVAAs == "") == (*simulateDataDir == "") {
+		log.Fatal("exactly one of --vaas and --dataDir must be set")
+	}
+
+	env, err := common.ParseEnvironment(*simulateNetwork)
+	if err != nil {
+		log.Fatalf("invalid network: %v", err)
+	}
+
+	var cfg *governor.SimulationConfig
+	if *simulateProposal != "" {
+		b, err := os.ReadFile(*simulateProposal)
+		if err != nil {
+			log.Fatalf("failed to read proposal: %v", err)
+		}
+		cfg, err = governor.ParseSimulationConfig(b)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// The governor logs every transfer it processes, which would drown the report.
+	logger := zap.NewNop()
+	simulator, err := governor.NewSimulator(logger, env, *simulateFlowCancel, cfg, *simulateCheckInterval)
+	if err != nil {
+		log.Fatalf("failed to create simulator: %v", err)
+	}
+
+	var transfers []governor.SimulationTransfer
+	if *simulateVAAs != "" {
+		transfers, err = readSimulationVAAs(*simulateVAAs)
+		if err != nil {
+			log.Fatalf("failed to read VAAs: %v", err)
+		}
+	} else {
+		db := guardianDB.OpenDb(logger, simulateDataDir)
+		xfers, pending, err := db.GetChainGovernorData(logger)
+		db.Close()
+		if err != nil {
+			log.Fatalf("failed to read governor data: %v", err)
+		}
+		transfers, err = simulator.TransfersFromDB(xfers, pending)
+		if err != nil {
+			log.Fatalf("failed to convert governor data: %v", err)
+		}
+	}
+
+	result, err := simulator.Run(transfers)
+	if err != nil {
+		log.Fatalf("simulation failed: %v", err)
+	}
+
+	if *simulateJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	printSimulationResult(result)
+}
+
+// readSimulationVAAs reads a file of VAAs to replay, whose format is determined by its extension.
+func readSimulationVAAs(fileName string) ([]governor.SimulationTransfer, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(fileName), ".csv") {
+		return governor.ReadSimulationVAAsCSV(f)
+	}
+	return governor.ReadSimulationVAAsJSONL(f)
+}
+
+func printSimulationResult(result *governor.SimulationResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintln(w, "Enqueued transfers")
+	fmt.Fprintln(w, "Message ID\tValue (USD)\tBig\tObserved\tReleased\tWait\t")
+	for _, st := range result.Transfers {
+		if !st.Enqueued {
+			continue
+		}
+		released, wait := "never", "-"
+		if !st.ReleasedAt.IsZero() {
+			released = st.ReleasedAt.UTC().Format(time.RFC3339)
+			wait = st.ReleasedAt.Sub(st.ObservedAt).String()
+		}
+		fmt.Fprintf(w, "%s\t%d\t%t\t%s\t%s\t%s\t\n", st.MsgID, st.Value, st.BigTransaction, st.ObservedAt.UTC().Format(time.RFC3339), released, wait)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Chains")
+	fmt.Fprintln(w, "Chain\tDaily limit\tBig transaction size\tTransfers\tEnqueued\tMax wait\tPeak usage (USD)\t")
+	for _, c := range result.Chains {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%s\t%d\t\n", c.EmitterChain, c.DailyLimit, c.BigTransactionSize, c.Transfers, c.Enqueued, c.MaxWait, c.PeakUsage)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Flow cancelled transfers")
+	fmt.Fprintln(w, "Emitter chain\tTarget chain\tTransfers\tValue (USD)\t")
+	for _, c := range result.Corridors {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t\n", c.EmitterChain, c.TargetChain, c.Transfers, c.Value)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Ignored messages that are not governed transfers: %d\n", result.Ignored)
+
+	w.Flush()
+}
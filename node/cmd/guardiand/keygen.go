@@ -1,11 +1,14 @@
 package guardiand
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"log"
+	"strings"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
@@ -20,8 +23,8 @@ func init() {
 }
 
 var KeygenCmd = &cobra.Command{
-	Use:   "keygen [KEYFILE]",
-	Short: "Create guardian key at the specified path",
+	Use:   "keygen [KEYFILE|PKCS11_URI]",
+	Short: "Create guardian key at the specified path, or in the HSM of the specified pkcs11:// signer URI",
 	Run:   runKeygen,
 	Args:  cobra.ExactArgs(1),
 }
@@ -30,6 +33,11 @@ func runKeygen(cmd *cobra.Command, args []string) {
 	common.LockMemory()
 	common.SetRestrictiveUmask()
 
+	if strings.HasPrefix(args[0], "pkcs11://") {
+		runPkcs11Keygen(args[0])
+		return
+	}
+
 	log.Print("Creating new key at ", args[0])
 
 	gk, err := ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
@@ -42,3 +50,22 @@ func runKeygen(cmd *cobra.Command, args []string) {
 		log.Fatalf("failed to write key: %v", err)
 	}
 }
+
+// runPkcs11Keygen generates the guardian key in an HSM. The private key never leaves the HSM, so only the public key
+// and the guardian address are printed.
+func runPkcs11Keygen(signerUri string) {
+	signerType, keyConfig, err := guardiansigner.ParseSignerUri(signerUri)
+	if err != nil || signerType != guardiansigner.Pkcs11SignerType {
+		log.Fatalf("invalid PKCS#11 signer URI: %v", err)
+	}
+
+	log.Print("Creating new key in PKCS#11 token")
+
+	publicKey, err := guardiansigner.GeneratePkcs11Key(context.Background(), keyConfig)
+	if err != nil {
+		log.Fatalf("failed to generate key: %v", err)
+	}
+
+	log.Printf("Public key: %x", ethcrypto.FromECDSAPub(publicKey))
+	log.Printf("Guardian address: %s", ethcrypto.PubkeyToAddress(*publicKey).Hex())
+}
@@ -152,9 +152,11 @@ var (
 	suiRPC           *string
 	suiMoveEventType *string
 
-	solanaRPC          *string
-	solanaContract     *string
-	solanaShimContract *string
+	solanaRPC            *string
+	solanaContract       *string
+	solanaShimContract   *string
+	solanaGeyserEndpoint *string
+	solanaGeyserToken    *string
 
 	fogoRPC          *string
 	fogoContract     *string
@@ -275,8 +277,9 @@ var (
 	testnetMode   *bool
 	nodeName      *string
 
-	publicRPC *string
-	publicWeb *string
+	publicRPC   *string
+	publicWeb   *string
+	txHashIndex *bool
 
 	tlsHostname *string
 	tlsProdEnv  *bool
@@ -291,9 +294,15 @@ var (
 	// Prometheus remote write URL
 	promRemoteURL *string
 
+	// OpenTelemetry message tracing parameters
+	otlpTraceEndpoint *string
+	otlpTraceInsecure *bool
+	traceSampleRatio  *float64
+
 	chainGovernorEnabled      *bool
 	governorFlowCancelEnabled *bool
 	coinGeckoApiKey           *string
+	governorPriceOracleFile   *string
 
 	ccqEnabled           *bool
 	ccqAllowedRequesters *string
@@ -321,9 +330,16 @@ var (
 	// transferVerifierEnabledChainIDs.
 	txVerifierChains []vaa.ChainID
 
+	// EVM RPC quorum settings, specified as "<network>=<value>" entries. See evm.ApplyRpcQuorum.
+	evmRPCQuorum  []string
+	evmQuorumRPCs []string
+
 	// featureFlags are additional static flags that should be published in P2P heartbeats.
-	featureFlags  []string
-	notaryEnabled *bool
+	featureFlags            []string
+	notaryEnabled           *bool
+	notaryPolicyFile        *string
+	notaryOperators         *string
+	notaryRequiredApprovals *uint8
 
 	managerServiceEnabled     *bool
 	dogecoinManagerSignerUris []string
@@ -350,6 +366,7 @@ func init() {
 	guardianSignerUri = NodeCmd.Flags().String("guardianSignerUri", "", "Guardian signer URI")
 	solanaContract = NodeCmd.Flags().String("solanaContract", "", "Address of the Solana program (required if solanaRpc is specified)")
 	solanaShimContract = NodeCmd.Flags().String("solanaShimContract", "", "Address of the Solana shim program")
+	solanaGeyserToken = NodeCmd.Flags().String("solanaGeyserToken", "", "Access token of the Solana Geyser gRPC endpoint")
 	fogoContract = NodeCmd.Flags().String("fogoContract", "", "Address of the Fogo program (required if fogoRpc is specified)")
 	fogoShimContract = NodeCmd.Flags().String("fogoShimContract", "", "Address of the Fogo shim program")
 
@@ -430,6 +447,7 @@ func init() {
 	suiMoveEventType = NodeCmd.Flags().String("suiMoveEventType", "", "Sui move event type for publish_message")
 
 	solanaRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "solanaRPC", "Solana RPC URL (required)", "http://solana-devnet:8899", []string{"http", "https"})
+	solanaGeyserEndpoint = node.RegisterFlagWithValidationOrFail(NodeCmd, "solanaGeyserEndpoint", "Solana Yellowstone Geyser gRPC URL to stream transactions from, instead of polling every block", "https://solana-geyser:443", []string{"http", "https"})
 	fogoRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "fogoRPC", "Fogo RPC URL (required)", "http://solana-devnet:8899", []string{"http", "https"})
 
 	pythnetContract = NodeCmd.Flags().String("pythnetContract", "", "Address of the PythNet program (required)")
@@ -549,6 +567,7 @@ func init() {
 
 	publicRPC = NodeCmd.Flags().String("publicRPC", "", "Listen address for public gRPC interface")
 	publicWeb = NodeCmd.Flags().String("publicWeb", "", "Listen address for public REST and gRPC Web interface")
+	txHashIndex = NodeCmd.Flags().Bool("txHashIndex", false, "Index the stored VAAs by the hash of the source transaction, to look them up with the public RPC")
 
 	tlsHostname = NodeCmd.Flags().String("tlsHostname", "", "If set, serve publicWeb as TLS with this hostname using Let's Encrypt")
 	tlsProdEnv = NodeCmd.Flags().Bool("tlsProdEnv", false,
@@ -563,9 +582,14 @@ func init() {
 
 	promRemoteURL = NodeCmd.Flags().String("promRemoteURL", "", "Prometheus remote write URL (Grafana)")
 
+	otlpTraceEndpoint = NodeCmd.Flags().String("otlpTraceEndpoint", "", "OTLP/HTTP collector host:port to export message traces to (disabled if blank)")
+	otlpTraceInsecure = NodeCmd.Flags().Bool("otlpTraceInsecure", false, "Connect to the OTLP collector without TLS")
+	traceSampleRatio = NodeCmd.Flags().Float64("traceSampleRatio", 1.0, "Ratio of the messages that are traced, between 0 and 1")
+
 	chainGovernorEnabled = NodeCmd.Flags().Bool("chainGovernorEnabled", false, "Run the chain governor")
 	governorFlowCancelEnabled = NodeCmd.Flags().Bool("governorFlowCancelEnabled", false, "Enable flow cancel on the governor")
 	coinGeckoApiKey = NodeCmd.Flags().String("coinGeckoApiKey", "", "CoinGecko Pro API key. If no API key is provided, CoinGecko requests may be throttled or blocked.")
+	governorPriceOracleFile = NodeCmd.Flags().String("governorPriceOracleFile", "", "Path to a JSON file listing the price sources aggregated by the governor. Defaults to CoinGecko only.")
 
 	ccqEnabled = NodeCmd.Flags().Bool("ccqEnabled", false, "Enable cross chain query support")
 	ccqAllowedRequesters = NodeCmd.Flags().String("ccqAllowedRequesters", "", "Comma separated list of signers allowed to submit cross chain queries")
@@ -583,8 +607,13 @@ func init() {
 	subscribeToVAAs = NodeCmd.Flags().Bool("subscribeToVAAs", false, "Guardiand should subscribe to incoming signed VAAs, set to true if running a public RPC node")
 
 	transferVerifierEnabledChainIDs = NodeCmd.Flags().UintSlice("transferVerifierEnabledChainIDs", make([]uint, 0), "Transfer Verifier will be enabled for these chain IDs (comma-separated)")
+	NodeCmd.Flags().StringSliceVarP(&evmRPCQuorum, "evmRPCQuorum", "", []string{}, "Number of RPC endpoints that must agree for an EVM network, as <network>=<quorum> (e.g. eth=2)")
+	NodeCmd.Flags().StringSliceVarP(&evmQuorumRPCs, "evmQuorumRPCs", "", []string{}, "Additional RPC URLs for an EVM network that uses a quorum, as <network>=<url> (may be repeated)")
 
 	notaryEnabled = NodeCmd.Flags().Bool("notaryEnabled", false, "Run the notary")
+	notaryOperators = NodeCmd.Flags().String("notaryOperators", "", "Comma separated list of operator key addresses allowed to annotate and approve notary delayed messages")
+	notaryRequiredApprovals = NodeCmd.Flags().Uint8("notaryRequiredApprovals", 0, "Number of distinct operators that must approve the early release of a notary delayed message (0 lets a single admin command release it)")
+	notaryPolicyFile = NodeCmd.Flags().String("notaryPolicyFile", "", "JSON file containing the notary policy rules, reloaded when it changes (requires --notaryEnabled)")
 
 	managerServiceEnabled = NodeCmd.Flags().Bool("managerServiceEnabled", false, "Run the manager service")
 	NodeCmd.Flags().StringSliceVarP(&dogecoinManagerSignerUris, "dogecoinManagerSignerUris", "", []string{}, "Dogecoin manager signer URI(s)")
@@ -959,6 +988,14 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Fatal("--solanaShimContract may only be specified if --solanaContract is specified")
 	}
 
+	if *solanaGeyserEndpoint != "" && *solanaRPC == "" {
+		logger.Fatal("--solanaGeyserEndpoint may only be specified if --solanaRPC is specified")
+	}
+
+	if *solanaGeyserToken != "" && *solanaGeyserEndpoint == "" {
+		logger.Fatal("--solanaGeyserToken may only be specified if --solanaGeyserEndpoint is specified")
+	}
+
 	if !argsConsistent([]string{*fogoContract, *fogoRPC}) {
 		logger.Fatal("Both --fogoContract and --fogoRPC must be set or both unset")
 	}
@@ -1023,6 +1060,22 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Fatal("If coinGeckoApiKey is set, then chainGovernorEnabled must be set")
 	}
 
+	if !*chainGovernorEnabled && *governorPriceOracleFile != "" {
+		logger.Fatal("If governorPriceOracleFile is set, then chainGovernorEnabled must be set")
+	}
+
+	if !*notaryEnabled && *notaryPolicyFile != "" {
+		logger.Fatal("If notaryPolicyFile is set, then notaryEnabled must be set")
+	}
+
+	if !*notaryEnabled && *notaryRequiredApprovals != 0 {
+		logger.Fatal("If notaryRequiredApprovals is set, then notaryEnabled must be set")
+	}
+
+	if !*notaryEnabled && *notaryOperators != "" {
+		logger.Fatal("If notaryOperators is set, then notaryEnabled must be set")
+	}
+
 	// NOTE: If this flag isn't set, or the list is empty, Transfer Verifier should not be enabled.
 	if len(*transferVerifierEnabledChainIDs) != 0 {
 		var parseErr error
@@ -1172,6 +1225,9 @@ func runNode(cmd *cobra.Command, args []string) {
 	// Database
 	db := guardianDB.OpenDb(logger.With(zap.String("component", "badgerDb")), dataDir)
 	defer db.Close()
+	if *txHashIndex {
+		db.EnableTxHashIndex()
+	}
 
 	wormchainId := "wormchain"
 	if env == common.TestNet {
@@ -1789,11 +1845,12 @@ func runNode(cmd *cobra.Command, args []string) {
 
 	if shouldStart(aptosRPC) {
 		wc := &aptos.WatcherConfig{
-			NetworkID: "aptos",
-			ChainID:   vaa.ChainIDAptos,
-			Rpc:       *aptosRPC,
-			Account:   *aptosAccount,
-			Handle:    *aptosHandle,
+			NetworkID:         "aptos",
+			ChainID:           vaa.ChainIDAptos,
+			Rpc:               *aptosRPC,
+			Account:           *aptosAccount,
+			Handle:            *aptosHandle,
+			TxVerifierEnabled: slices.Contains(txVerifierChains, vaa.ChainIDAptos),
 		}
 		watcherConfigs = append(watcherConfigs, wc)
 	}
@@ -1834,28 +1891,34 @@ func runNode(cmd *cobra.Command, args []string) {
 	if shouldStart(solanaRPC) {
 		// confirmed watcher
 		wc := &solana.WatcherConfig{
-			NetworkID:     "solana-confirmed",
-			ChainID:       vaa.ChainIDSolana,
-			Rpc:           *solanaRPC,
-			Websocket:     "",
-			Contract:      *solanaContract,
-			ShimContract:  *solanaShimContract,
-			ReceiveObsReq: false,
-			Commitment:    rpc.CommitmentConfirmed,
+			NetworkID:         "solana-confirmed",
+			ChainID:           vaa.ChainIDSolana,
+			Rpc:               *solanaRPC,
+			Websocket:         "",
+			Contract:          *solanaContract,
+			ShimContract:      *solanaShimContract,
+			ReceiveObsReq:     false,
+			Commitment:        rpc.CommitmentConfirmed,
+			TxVerifierEnabled: slices.Contains(txVerifierChains, vaa.ChainIDSolana),
+			GeyserEndpoint:    *solanaGeyserEndpoint,
+			GeyserToken:       *solanaGeyserToken,
 		}
 
 		watcherConfigs = append(watcherConfigs, wc)
 
 		// finalized watcher
 		wc = &solana.WatcherConfig{
-			NetworkID:     "solana-finalized",
-			ChainID:       vaa.ChainIDSolana,
-			Rpc:           *solanaRPC,
-			Websocket:     "",
-			Contract:      *solanaContract,
-			ShimContract:  *solanaShimContract,
-			ReceiveObsReq: true,
-			Commitment:    rpc.CommitmentFinalized,
+			NetworkID:         "solana-finalized",
+			ChainID:           vaa.ChainIDSolana,
+			Rpc:               *solanaRPC,
+			Websocket:         "",
+			Contract:          *solanaContract,
+			ShimContract:      *solanaShimContract,
+			ReceiveObsReq:     true,
+			Commitment:        rpc.CommitmentFinalized,
+			TxVerifierEnabled: slices.Contains(txVerifierChains, vaa.ChainIDSolana),
+			GeyserEndpoint:    *solanaGeyserEndpoint,
+			GeyserToken:       *solanaGeyserToken,
 		}
 		watcherConfigs = append(watcherConfigs, wc)
 
@@ -2019,6 +2082,10 @@ func runNode(cmd *cobra.Command, args []string) {
 
 	}
 
+	if err := evm.ApplyRpcQuorum(watcherConfigs, evmRPCQuorum, evmQuorumRPCs); err != nil {
+		logger.Fatal("invalid EVM RPC quorum configuration", zap.Error(err))
+	}
+
 	var ibcWatcherConfig *node.IbcWatcherConfig = nil
 	if shouldStart(ibcWS) {
 		ibcWatcherConfig = &node.IbcWatcherConfig{
@@ -2091,17 +2158,50 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Info("initialized XRPL manager signer", zap.String("compressed_public_key", fmt.Sprintf("%x", xrplCompressedPubKey)), zap.String("xrpl_address", xrplAddress))
 	}
 
+	// Watchers can also be configured in the config file, in which case a SIGHUP reloads them.
+	var watcherFile *node.WatcherFile
+	if viper.ConfigFileUsed() != "" {
+		watcherFile, err = node.LoadWatcherFile(viper.ConfigFileUsed())
+		if err != nil {
+			logger.Fatal("failed to load the watchers of the config file", zap.Error(err))
+		}
+
+		sighup := make(chan os.Signal, 1)
+		signal.Notify(sighup, syscall.SIGHUP)
+		go func() {
+			for {
+				select {
+				case <-rootCtx.Done():
+					return
+				case <-sighup:
+					logger.Info("Received sighup. reloading the watchers of the config file.")
+					result, err := watcherFile.Reload()
+					if err != nil {
+						logger.Error("failed to reload the watchers of the config file", zap.Error(err))
+						continue
+					}
+					logger.Info("reloaded the watchers of the config file",
+						zap.Any("started", result.Started),
+						zap.Any("stopped", result.Stopped),
+						zap.Any("updated", result.Updated),
+					)
+				}
+			}
+		}()
+	}
+
 	guardianOptions := []*node.GuardianOption{
 		node.GuardianOptionDatabase(db),
-		node.GuardianOptionWatchers(watcherConfigs, ibcWatcherConfig),
+		node.GuardianOptionWatchers(watcherConfigs, ibcWatcherConfig, watcherFile),
 		node.GuardianOptionAccountant(*accountantWS, *accountantContract, *accountantCheckEnabled, accountantWormchainConn, *accountantNttContract, accountantNttWormchainConn, *accountantSubmitObservationBatchSize),
-		node.GuardianOptionGovernor(*chainGovernorEnabled, *governorFlowCancelEnabled, *coinGeckoApiKey),
-		node.GuardianOptionNotary(*notaryEnabled),
+		node.GuardianOptionGovernor(*chainGovernorEnabled, *governorFlowCancelEnabled, *coinGeckoApiKey, *governorPriceOracleFile),
+		node.GuardianOptionNotary(*notaryEnabled, *notaryPolicyFile, *notaryOperators, uint(*notaryRequiredApprovals)),
 		node.GuardianOptionManagerService(*managerServiceEnabled, managerSigners, *ethRPC),
 		node.GuardianOptionGatewayRelayer(*gatewayRelayerContract, gatewayRelayerWormchainConn),
 		node.GuardianOptionQueryHandler(*ccqEnabled, *ccqAllowedRequesters),
 		node.GuardianOptionAdminService(*adminSocketPath, ethRPC, ethContract, rpcMap),
 		node.GuardianOptionStatusServer(*statusAddr),
+		node.GuardianOptionTracing(*otlpTraceEndpoint, *otlpTraceInsecure, *traceSampleRatio, *nodeName),
 		node.GuardianOptionAlternatePublisher(guardianAddrAsBytes, *additionalPublishers),
 		node.GuardianOptionProcessor(*p2pNetworkID, *ethDelegatedGuardiansContract != ""),
 
@@ -0,0 +1,176 @@
+package guardiand
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	signerServerSignerUri       *string
+	signerServerListenAddr      *string
+	signerServerSocketPath      *string
+	signerServerTlsCert         *string
+	signerServerTlsKey          *string
+	signerServerTlsClientCA     *string
+	signerServerAllowedPurposes *[]string
+	signerServerRateLimit       *float64
+	signerServerRateBurst       *int
+	signerServerAuditLog        *string
+	signerServerLogLevel        *string
+)
+
+func init() {
+	signerServerSignerUri = SignerServerCmd.Flags().String("signerUri", "", "URI of the guardian signer to serve, see docs/guardian_signer.md (required)")
+	signerServerListenAddr = SignerServerCmd.Flags().String("listenAddr", "", "TCP listen address, which requires --tlsCert, --tlsKey and --tlsClientCA")
+	signerServerSocketPath = SignerServerCmd.Flags().String("socketPath", "", "UNIX domain socket path, as an alternative to --listenAddr")
+	signerServerTlsCert = SignerServerCmd.Flags().String("tlsCert", "", "Path to the PEM encoded server certificate")
+	signerServerTlsKey = SignerServerCmd.Flags().String("tlsKey", "", "Path to the PEM encoded server private key")
+	signerServerTlsClientCA = SignerServerCmd.Flags().String("tlsClientCA", "", "Path to the PEM encoded certificate authorities of the clients")
+	signerServerAllowedPurposes = SignerServerCmd.Flags().StringSlice("allowedPurposes", []string{"guardian"}, "Signing purposes that the server signs for")
+	signerServerRateLimit = SignerServerCmd.Flags().Float64("rateLimit", 100, "Maximum number of signatures per second (0 disables the limit)")
+	signerServerRateBurst = SignerServerCmd.Flags().Int("rateBurst", 500, "Maximum number of signatures in a burst above the rate limit")
+	signerServerAuditLog = SignerServerCmd.Flags().String("auditLog", "", "Path to the append-only audit log of the signed digests (required)")
+	signerServerLogLevel = SignerServerCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
+}
+
+var SignerServerCmd = &cobra.Command{
+	Use:   "signer-server",
+	Short: "Serve a guardian signer to guardian nodes using the grpc:// or grpc+unix:// signer",
+	Run:   runSignerServer,
+	Args:  cobra.NoArgs,
+}
+
+func runSignerServer(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	lvl, err := ipfslog.LevelFromString(*signerServerLogLevel)
+	if err != nil {
+		fmt.Println("Invalid log level")
+		os.Exit(1)
+	}
+
+	logger := ipfslog.Logger("wormhole-signer-server").Desugar()
+
+	ipfslog.SetAllLoggers(lvl)
+
+	if *signerServerSignerUri == "" {
+		logger.Fatal("Please specify --signerUri")
+	}
+	if *signerServerAuditLog == "" {
+		logger.Fatal("Please specify --auditLog")
+	}
+	if (*signerServerListenAddr == "") == (*signerServerSocketPath == "") {
+		logger.Fatal("Please specify exactly one of --listenAddr and --socketPath")
+	}
+	if len(*signerServerAllowedPurposes) == 0 {
+		logger.Fatal("Please specify at least one signing purpose in --allowedPurposes")
+	}
+
+	rootCtx, rootCtxCancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer rootCtxCancel()
+
+	signer, err := guardiansigner.NewGuardianSignerFromUriWithPurpose(rootCtx, *signerServerSignerUri, false, "signer-server")
+	if err != nil {
+		logger.Fatal("failed to create guardian signer", zap.Error(err))
+	}
+
+	auditLog, err := guardiansigner.OpenAuditLog(*signerServerAuditLog)
+	if err != nil {
+		logger.Fatal("failed to open audit log", zap.Error(err))
+	}
+	defer auditLog.Close()
+
+	var limiter *rate.Limiter
+	if *signerServerRateLimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(*signerServerRateLimit), *signerServerRateBurst)
+	}
+
+	var opts []grpc.ServerOption
+	if *signerServerTlsCert != "" || *signerServerTlsKey != "" || *signerServerTlsClientCA != "" {
+		creds, err := signerServerCredentials(*signerServerTlsCert, *signerServerTlsKey, *signerServerTlsClientCA)
+		if err != nil {
+			logger.Fatal("failed to load TLS configuration", zap.Error(err))
+		}
+		opts = append(opts, grpc.Creds(creds))
+	} else if *signerServerListenAddr != "" {
+		logger.Fatal("Mutual TLS is required with --listenAddr, please specify --tlsCert, --tlsKey and --tlsClientCA")
+	}
+
+	var l net.Listener
+	if *signerServerListenAddr != "" {
+		l, err = net.Listen("tcp", *signerServerListenAddr)
+	} else {
+		// Remove the socket of a previous run. The restrictive umask makes the new socket only accessible to the user.
+		if fi, statErr := os.Stat(*signerServerSocketPath); statErr == nil && fi.Mode()&os.ModeType == os.ModeSocket {
+			if err := os.Remove(*signerServerSocketPath); err != nil {
+				logger.Fatal("failed to remove existing socket", zap.Error(err))
+			}
+		}
+		l, err = net.Listen("unix", *signerServerSocketPath)
+	}
+	if err != nil {
+		logger.Fatal("failed to listen", zap.Error(err))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	signerv1.RegisterSignerServiceServer(grpcServer, guardiansigner.NewSignerServer(logger, signer, *signerServerAllowedPurposes, limiter, auditLog))
+
+	publicKey := signer.PublicKey(rootCtx)
+	logger.Info("signer server listening",
+		zap.String("addr", l.Addr().String()),
+		zap.String("signer", signer.TypeAsString()),
+		zap.String("address", ethcrypto.PubkeyToAddress(publicKey).Hex()),
+		zap.Strings("allowedPurposes", *signerServerAllowedPurposes),
+	)
+
+	go func() {
+		<-rootCtx.Done()
+		logger.Info("root context cancelled, exiting...")
+		grpcServer.GracefulStop()
+	}()
+
+	if err := grpcServer.Serve(l); err != nil {
+		logger.Fatal("signer server failed", zap.Error(err))
+	}
+}
+
+// signerServerCredentials returns the credentials of a signer server that requires mutual TLS.
+func signerServerCredentials(certFile string, keyFile string, clientCAFile string) (credentials.TransportCredentials, error) {
+	if certFile == "" || keyFile == "" || clientCAFile == "" {
+		return nil, fmt.Errorf("--tlsCert, --tlsKey and --tlsClientCA must all be set")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	clientCAs, err := guardiansigner.LoadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
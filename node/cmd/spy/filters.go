@@ -0,0 +1,153 @@
+package spy
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// filterEmitter matches VAAs emitted by an emitter.
+type filterEmitter struct {
+	chainId     vaa.ChainID
+	emitterAddr vaa.Address
+}
+
+func (f filterEmitter) matchesEmitter(chainId vaa.ChainID, emitterAddr vaa.Address) bool {
+	return f.chainId == chainId && f.emitterAddr == emitterAddr
+}
+
+func (f filterEmitter) matches(v *vaa.VAA) bool {
+	return f.matchesEmitter(v.EmitterChain, v.EmitterAddress)
+}
+
+// filterSequenceRange matches VAAs whose sequence falls within [minSequence, maxSequence], optionally restricted to
+// an emitter. A maxSequence of zero means the range is unbounded.
+type filterSequenceRange struct {
+	emitter     *filterEmitter
+	minSequence uint64
+	maxSequence uint64
+}
+
+func (f filterSequenceRange) matches(v *vaa.VAA) bool {
+	if f.emitter != nil && !f.emitter.matches(v) {
+		return false
+	}
+	return v.Sequence >= f.minSequence && (f.maxSequence == 0 || v.Sequence <= f.maxSequence)
+}
+
+// filterConsistencyLevel matches VAAs with the given consistency level.
+type filterConsistencyLevel struct {
+	consistencyLevel uint8
+}
+
+func (f filterConsistencyLevel) matches(v *vaa.VAA) bool {
+	return v.ConsistencyLevel == f.consistencyLevel
+}
+
+// filterGovernance matches governance VAAs.
+type filterGovernance struct{}
+
+func (filterGovernance) matches(v *vaa.VAA) bool {
+	return v.EmitterChain == vaa.GovernanceChain && v.EmitterAddress == vaa.GovernanceEmitter
+}
+
+// filterPayloadId matches VAAs whose payload starts with the given byte.
+type filterPayloadId struct {
+	payloadId uint8
+}
+
+func (f filterPayloadId) matches(v *vaa.VAA) bool {
+	return len(v.Payload) > 0 && v.Payload[0] == f.payloadId
+}
+
+// filterTokenTransfer matches token bridge transfers to the given target chain. If tokenBridges is set, the VAA must
+// also be emitted by the token bridge of its emitter chain.
+type filterTokenTransfer struct {
+	targetChain  vaa.ChainID
+	tokenBridges map[vaa.ChainID][]byte
+}
+
+func (f filterTokenTransfer) matches(v *vaa.VAA) bool {
+	if f.tokenBridges != nil {
+		tokenBridge, ok := f.tokenBridges[v.EmitterChain]
+		if !ok || !bytes.Equal(v.EmitterAddress.Bytes(), tokenBridge) {
+			return false
+		}
+	}
+
+	hdr, err := vaa.DecodeTransferPayloadHdr(v.Payload)
+	if err != nil {
+		return false
+	}
+	return hdr.TargetChain == f.targetChain
+}
+
+// parseFilterEntry validates a filter of a signed VAA subscription request.
+func (s *spyServer) parseFilterEntry(f *spyv1.FilterEntry) (filterSignedVaa, error) {
+	switch t := f.Filter.(type) {
+	case *spyv1.FilterEntry_EmitterFilter:
+		return parseEmitterFilter(t.EmitterFilter)
+	case *spyv1.FilterEntry_SequenceRangeFilter:
+		filter := filterSequenceRange{
+			minSequence: t.SequenceRangeFilter.GetMinSequence(),
+			maxSequence: t.SequenceRangeFilter.GetMaxSequence(),
+		}
+		if filter.maxSequence != 0 && filter.maxSequence < filter.minSequence {
+			return nil, status.Error(codes.InvalidArgument, "max sequence must not be lower than min sequence")
+		}
+		if t.SequenceRangeFilter.GetEmitter() != nil {
+			emitter, err := parseEmitterFilter(t.SequenceRangeFilter.GetEmitter())
+			if err != nil {
+				return nil, err
+			}
+			filter.emitter = &emitter
+		}
+		return filter, nil
+	case *spyv1.FilterEntry_ConsistencyLevelFilter:
+		if t.ConsistencyLevelFilter.GetConsistencyLevel() > math.MaxUint8 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("consistency level must be a valid 8 bit unsigned integer: %v", t.ConsistencyLevelFilter.GetConsistencyLevel()))
+		}
+		return filterConsistencyLevel{
+			consistencyLevel: uint8(t.ConsistencyLevelFilter.GetConsistencyLevel()), // #nosec G115 -- This is validated above
+		}, nil
+	case *spyv1.FilterEntry_GovernanceFilter:
+		return filterGovernance{}, nil
+	case *spyv1.FilterEntry_PayloadIdFilter:
+		if t.PayloadIdFilter.GetPayloadId() > math.MaxUint8 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("payload id must be a valid 8 bit unsigned integer: %v", t.PayloadIdFilter.GetPayloadId()))
+		}
+		return filterPayloadId{
+			payloadId: uint8(t.PayloadIdFilter.GetPayloadId()), // #nosec G115 -- This is validated above
+		}, nil
+	case *spyv1.FilterEntry_TokenTransferFilter:
+		if t.TokenTransferFilter.GetTargetChainId() > math.MaxUint16 {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("target chain id must be a valid 16 bit unsigned integer: %v", t.TokenTransferFilter.GetTargetChainId().Number()))
+		}
+		return filterTokenTransfer{
+			targetChain:  vaa.ChainID(t.TokenTransferFilter.GetTargetChainId()), // #nosec G115 -- This is validated above
+			tokenBridges: s.tokenBridges,
+		}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unsupported filter type")
+	}
+}
+
+// parseEmitterFilter validates an emitter filter of a subscription request.
+func parseEmitterFilter(f *spyv1.EmitterFilter) (filterEmitter, error) {
+	addr, err := vaa.StringToAddress(f.EmitterAddress)
+	if err != nil {
+		return filterEmitter{}, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
+	}
+	if f.GetChainId() > math.MaxUint16 {
+		return filterEmitter{}, status.Error(codes.InvalidArgument, fmt.Sprintf("emitter chain id must be a valid 16 bit unsigned integer: %v", f.ChainId.Number()))
+	}
+	return filterEmitter{
+		chainId:     vaa.ChainID(f.ChainId), // #nosec G115 -- This is validated above
+		emitterAddr: addr,
+	}, nil
+}
@@ -0,0 +1,289 @@
+package spy
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var spySubscriberMessagesDropped = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormhole_spy_subscriber_messages_dropped_total",
+		Help: "Total number of gossip messages dropped because a subscriber did not keep up",
+	}, []string{"stream"})
+
+// subscriptionBufferSize is the number of messages buffered for a subscriber of one of the gossip streams, other than
+// signed VAAs. Messages published while the buffer is full are dropped, so a slow subscriber cannot stall the others.
+const subscriptionBufferSize = 1000
+
+// subscriptionSet tracks the subscribers of one of the gossip streams served by the spy, other than signed VAAs.
+// F is the type of the filters supplied by a subscriber and M the type of the messages sent to it.
+type subscriptionSet[F any, M any] struct {
+	mu      sync.Mutex
+	subs    map[string]*subscription[F, M]
+	dropped prometheus.Counter
+}
+
+type subscription[F any, M any] struct {
+	filters F
+	ch      chan M
+}
+
+// newSubscriptionSet creates a subscription set for the stream with the given name, which is used as a metric label.
+func newSubscriptionSet[F any, M any](stream string) *subscriptionSet[F, M] {
+	return &subscriptionSet[F, M]{
+		subs:    make(map[string]*subscription[F, M]),
+		dropped: spySubscriberMessagesDropped.WithLabelValues(stream),
+	}
+}
+
+// len returns the number of active subscriptions.
+func (s *subscriptionSet[F, M]) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.subs)
+}
+
+// publish calls match for every subscription, while holding the lock, and queues the message it returns, if any.
+// The message is dropped if the subscriber's buffer is full.
+func (s *subscriptionSet[F, M]) publish(match func(filters F) (M, bool)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range s.subs {
+		msg, ok := match(sub.filters)
+		if !ok {
+			continue
+		}
+		select {
+		case sub.ch <- msg:
+		default:
+			s.dropped.Inc()
+		}
+	}
+}
+
+// serve registers a subscription with the given filters and forwards the messages published to it using send,
+// until the context is cancelled or sending fails.
+func (s *subscriptionSet[F, M]) serve(ctx context.Context, filters F, send func(M) error) error {
+	s.mu.Lock()
+	id := subscriptionId()
+	sub := &subscription[F, M]{
+		filters: filters,
+		ch:      make(chan M, subscriptionBufferSize),
+	}
+	s.subs[id] = sub
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.subs, id)
+		s.mu.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg := <-sub.ch:
+			if err := DoWithTimeout(func() error { return send(msg) }, *sendTimeout); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type filterObservation struct {
+	// Exactly one of emitter and guardian is set.
+	emitter  *filterEmitter
+	guardian *ethcommon.Address
+}
+
+func (f filterObservation) matches(guardian ethcommon.Address, obs *gossipv1.Observation) bool {
+	if f.guardian != nil {
+		return *f.guardian == guardian
+	}
+
+	chainId, emitterAddr, ok := parseObservationEmitter(obs.MessageId)
+	return ok && f.emitter.matchesEmitter(chainId, emitterAddr)
+}
+
+// parseObservationEmitter extracts the emitter from an observation's message ID, which is of the form
+// chain/emitter/sequence.
+func parseObservationEmitter(messageId string) (vaa.ChainID, vaa.Address, bool) {
+	parts := strings.Split(messageId, "/")
+	if len(parts) != 3 {
+		return 0, vaa.Address{}, false
+	}
+
+	chainId, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil {
+		return 0, vaa.Address{}, false
+	}
+
+	emitterAddr, err := vaa.StringToAddress(parts[1])
+	if err != nil {
+		return 0, vaa.Address{}, false
+	}
+
+	return vaa.ChainID(chainId), emitterAddr, true
+}
+
+// parseGuardianFilters decodes the guardian addresses of a subscription request.
+func parseGuardianFilters(filters []*spyv1.GuardianFilter) ([]ethcommon.Address, error) {
+	var addrs []ethcommon.Address
+	for _, f := range filters {
+		addr, err := parseGuardianAddress(f.GetGuardianAddress())
+		if err != nil {
+			return nil, err
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+func parseGuardianAddress(s string) (ethcommon.Address, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return ethcommon.Address{}, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode guardian address: %v", err))
+	}
+	if len(b) != ethcommon.AddressLength {
+		return ethcommon.Address{}, status.Error(codes.InvalidArgument, fmt.Sprintf("guardian address must be %d bytes long", ethcommon.AddressLength))
+	}
+	return ethcommon.BytesToAddress(b), nil
+}
+
+// matchesGuardian returns true if there are no filters or if the guardian is one of them.
+func matchesGuardian(filters []ethcommon.Address, guardian ethcommon.Address) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if f == guardian {
+			return true
+		}
+	}
+	return false
+}
+
+// requireGuardianSet returns an error if the spy does not know the guardian set, in which case the p2p layer drops
+// heartbeats and observation batches instead of forwarding them.
+func (s *spyServer) requireGuardianSet() error {
+	if s.gst == nil || s.gst.Get() == nil {
+		return status.Error(codes.FailedPrecondition, `the guardian set is unknown, the spy must be started with "--ethRPC" to stream heartbeats and observations`)
+	}
+	return nil
+}
+
+func (s *spyServer) PublishSignedObservationBatch(batch *gossipv1.SignedObservationBatch) {
+	guardian := ethcommon.BytesToAddress(batch.Addr)
+	for _, obs := range batch.Observations {
+		s.subsObservations.publish(func(filters []filterObservation) (*spyv1.SubscribeSignedObservationsResponse, bool) {
+			matched := len(filters) == 0
+			for _, fi := range filters {
+				if fi.matches(guardian, obs) {
+					matched = true
+					break
+				}
+			}
+			return &spyv1.SubscribeSignedObservationsResponse{Addr: batch.Addr, Observation: obs}, matched
+		})
+	}
+}
+
+func (s *spyServer) PublishHeartbeat(hb *gossipv1.Heartbeat) {
+	guardian := ethcommon.HexToAddress(hb.GuardianAddr)
+	s.subsHeartbeats.publish(func(filters []ethcommon.Address) (*gossipv1.Heartbeat, bool) {
+		return hb, matchesGuardian(filters, guardian)
+	})
+}
+
+func (s *spyServer) PublishSignedChainGovernorConfig(cfg *gossipv1.SignedChainGovernorConfig) {
+	guardian := ethcommon.BytesToAddress(cfg.GuardianAddr)
+	s.subsGovConfigs.publish(func(filters []ethcommon.Address) (*gossipv1.SignedChainGovernorConfig, bool) {
+		return cfg, matchesGuardian(filters, guardian)
+	})
+}
+
+func (s *spyServer) PublishSignedChainGovernorStatus(st *gossipv1.SignedChainGovernorStatus) {
+	guardian := ethcommon.BytesToAddress(st.GuardianAddr)
+	s.subsGovStatuses.publish(func(filters []ethcommon.Address) (*gossipv1.SignedChainGovernorStatus, bool) {
+		return st, matchesGuardian(filters, guardian)
+	})
+}
+
+func (s *spyServer) SubscribeSignedObservations(req *spyv1.SubscribeSignedObservationsRequest, resp spyv1.SpyRPCService_SubscribeSignedObservationsServer) error {
+	if err := s.requireGuardianSet(); err != nil {
+		return err
+	}
+
+	var fi []filterObservation
+	for _, f := range req.Filters {
+		switch t := f.Filter.(type) {
+		case *spyv1.ObservationFilterEntry_EmitterFilter:
+			emitter, err := parseEmitterFilter(t.EmitterFilter)
+			if err != nil {
+				return err
+			}
+			fi = append(fi, filterObservation{emitter: &emitter})
+		case *spyv1.ObservationFilterEntry_GuardianFilter:
+			guardian, err := parseGuardianAddress(t.GuardianFilter.GetGuardianAddress())
+			if err != nil {
+				return err
+			}
+			fi = append(fi, filterObservation{guardian: &guardian})
+		default:
+			return status.Error(codes.InvalidArgument, "unsupported filter type")
+		}
+	}
+
+	return s.subsObservations.serve(resp.Context(), fi, resp.Send)
+}
+
+func (s *spyServer) SubscribeHeartbeats(req *spyv1.SubscribeHeartbeatsRequest, resp spyv1.SpyRPCService_SubscribeHeartbeatsServer) error {
+	if err := s.requireGuardianSet(); err != nil {
+		return err
+	}
+
+	fi, err := parseGuardianFilters(req.Filters)
+	if err != nil {
+		return err
+	}
+
+	return s.subsHeartbeats.serve(resp.Context(), fi, func(hb *gossipv1.Heartbeat) error {
+		return resp.Send(&spyv1.SubscribeHeartbeatsResponse{Heartbeat: hb})
+	})
+}
+
+func (s *spyServer) SubscribeSignedChainGovernorConfigs(req *spyv1.SubscribeSignedChainGovernorConfigsRequest, resp spyv1.SpyRPCService_SubscribeSignedChainGovernorConfigsServer) error {
+	fi, err := parseGuardianFilters(req.Filters)
+	if err != nil {
+		return err
+	}
+
+	return s.subsGovConfigs.serve(resp.Context(), fi, func(cfg *gossipv1.SignedChainGovernorConfig) error {
+		return resp.Send(&spyv1.SubscribeSignedChainGovernorConfigsResponse{Config: cfg})
+	})
+}
+
+func (s *spyServer) SubscribeSignedChainGovernorStatuses(req *spyv1.SubscribeSignedChainGovernorStatusesRequest, resp spyv1.SpyRPCService_SubscribeSignedChainGovernorStatusesServer) error {
+	fi, err := parseGuardianFilters(req.Filters)
+	if err != nil {
+		return err
+	}
+
+	return s.subsGovStatuses.serve(resp.Context(), fi, func(st *gossipv1.SignedChainGovernorStatus) error {
+		return resp.Send(&spyv1.SubscribeSignedChainGovernorStatusesResponse{Status: st})
+	})
+}
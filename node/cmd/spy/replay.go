@@ -0,0 +1,111 @@
+package spy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// replayCursor is the position in the VAA store from which VAAs are replayed to a resuming subscriber.
+type replayCursor struct {
+	position uint64
+	// receivedAfter, if not zero, skips the VAAs received at or before that time.
+	receivedAfter time.Time
+}
+
+// parseResumeCursor resolves the resume cursor of a subscription request. It returns nil if no cursor was supplied.
+func (s *spyServer) parseResumeCursor(req *spyv1.SubscribeSignedVAARequest) (*replayCursor, error) {
+	if req.ResumeFrom == nil {
+		return nil, nil
+	}
+
+	if s.vaaStore == nil {
+		return nil, status.Error(codes.FailedPrecondition, `resuming a subscription requires the spy to be started with "--dataDir"`)
+	}
+
+	switch t := req.ResumeFrom.(type) {
+	case *spyv1.SubscribeSignedVAARequest_LastVaaId:
+		id, err := db.VaaIDFromString(t.LastVaaId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to parse last VAA ID: %v", err))
+		}
+		position, err := s.vaaStore.Position(*id)
+		if errors.Is(err, db.ErrVAANotFound) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("VAA %s is not in the VAA store", t.LastVaaId))
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to look up VAA %s: %v", t.LastVaaId, err))
+		}
+		return &replayCursor{position: position + 1}, nil
+	case *spyv1.SubscribeSignedVAARequest_ReceivedAfter:
+		return &replayCursor{receivedAfter: time.Unix(0, t.ReceivedAfter)}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unsupported resume cursor")
+	}
+}
+
+// storeSignedVAA adds a VAA to the VAA store. It must be called with subsSignedVaaMu held, see subscribeWithReplay.
+func (s *spyServer) storeSignedVAA(v *vaa.VAA, vaaBytes []byte) error {
+	if _, err := s.vaaStore.Store(v, vaaBytes, time.Now()); err != nil {
+		return fmt.Errorf("failed to store VAA: %w", err)
+	}
+	return nil
+}
+
+// subscribeWithReplay sends the stored VAAs matching the subscription's filters from the cursor onwards, and then
+// registers the subscription for live VAAs.
+//
+// VAAs are stored while subsSignedVaaMu is held. Registering the subscription is therefore only done once the replay
+// has caught up with the store while holding the lock, which guarantees that no VAA is missed in between. The bulk of
+// the replay happens without the lock, so that other subscribers are not blocked by it.
+func (s *spyServer) subscribeWithReplay(id string, sub *subscriptionSignedVaa, cursor *replayCursor, send func([]byte) error) error {
+	position := cursor.position
+	for {
+		end := s.vaaStore.Next()
+		if err := s.vaaStore.Iterate(position, end, func(stored *db.SpyStoredVAA) error {
+			if !cursor.receivedAfter.IsZero() && !stored.ReceivedAt.After(cursor.receivedAfter) {
+				return nil
+			}
+
+			v, err := vaa.Unmarshal(stored.VaaBytes)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal stored VAA: %w", err)
+			}
+			if !matchesAnyFilter(sub.filters, v) {
+				return nil
+			}
+
+			return DoWithTimeout(func() error { return send(stored.VaaBytes) }, *sendTimeout)
+		}); err != nil {
+			return err
+		}
+		position = end
+
+		s.subsSignedVaaMu.Lock()
+		if s.vaaStore.Next() == position {
+			s.subsSignedVaa[id] = sub
+			s.subsSignedVaaMu.Unlock()
+			return nil
+		}
+		s.subsSignedVaaMu.Unlock()
+	}
+}
+
+// matchesAnyFilter returns true if there are no filters or if the VAA matches one of them.
+func matchesAnyFilter(filters []filterSignedVaa, v *vaa.VAA) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, fi := range filters {
+		if fi.matches(v) {
+			return true
+		}
+	}
+	return false
+}
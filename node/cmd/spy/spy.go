@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
 	"net"
 	"net/http"
 	"os"
@@ -12,16 +11,19 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	ipfslog "github.com/ipfs/go-log/v2"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -54,6 +56,9 @@ var (
 
 	ethRPC      *string
 	ethContract *string
+
+	dataDir      *string
+	vaaStoreSize *uint64
 )
 
 func init() {
@@ -73,8 +78,11 @@ func init() {
 
 	sendTimeout = SpyCmd.Flags().Duration("sendTimeout", 5*time.Second, "Timeout for sending a message to a subscriber")
 
-	ethRPC = SpyCmd.Flags().String("ethRPC", "", "Ethereum RPC for verifying VAAs (optional)")
+	ethRPC = SpyCmd.Flags().String("ethRPC", "", "Ethereum RPC for verifying VAAs and reading the guardian set, which is required to stream heartbeats and observations (optional)")
 	ethContract = SpyCmd.Flags().String("ethContract", "", "Ethereum core bridge address for verifying VAAs (required if ethRPC is specified)")
+
+	dataDir = SpyCmd.Flags().String("dataDir", "", "Data directory of the VAA store, which allows subscribers to resume their subscription (disabled if blank)")
+	vaaStoreSize = SpyCmd.Flags().Uint64("vaaStoreSize", 100000, "Maximum number of VAAs kept in the VAA store")
 }
 
 // SpyCmd represents the node command
@@ -90,16 +98,29 @@ type spyServer struct {
 	subsSignedVaa   map[string]*subscriptionSignedVaa
 	subsSignedVaaMu sync.Mutex
 	vaaVerifier     *VaaVerifier
+	// vaaStore is only set if the spy runs with a VAA store, which is required to resume subscriptions.
+	vaaStore *db.SpyDB
+
+	// tokenBridges are the known token bridge emitters, only set if the environment is known.
+	tokenBridges map[vaa.ChainID][]byte
+
+	// gst is only set if the guardian set is known, which is required for heartbeats and observations.
+	gst              *common.GuardianSetState
+	subsObservations *subscriptionSet[[]filterObservation, *spyv1.SubscribeSignedObservationsResponse]
+	subsHeartbeats   *subscriptionSet[[]ethcommon.Address, *gossipv1.Heartbeat]
+	subsGovConfigs   *subscriptionSet[[]ethcommon.Address, *gossipv1.SignedChainGovernorConfig]
+	subsGovStatuses  *subscriptionSet[[]ethcommon.Address, *gossipv1.SignedChainGovernorStatus]
 }
 
 type message struct {
 	vaaBytes []byte
 }
 
-type filterSignedVaa struct {
-	chainId     vaa.ChainID
-	emitterAddr vaa.Address
+// filterSignedVaa is a filter of a signed VAA subscription.
+type filterSignedVaa interface {
+	matches(v *vaa.VAA) bool
 }
+
 type subscriptionSignedVaa struct {
 	filters []filterSignedVaa
 	ch      chan message
@@ -117,6 +138,41 @@ func (s *spyServer) PublishSignedVAA(vaaBytes []byte) error {
 	var err error
 	verified := s.vaaVerifier == nil
 
+	if s.vaaStore != nil {
+		v, err = vaa.Unmarshal(vaaBytes)
+		if err != nil {
+			return err
+		}
+		if !verified {
+			verified = true
+			v, err = s.verifyVAA(v, vaaBytes)
+			if err != nil {
+				return err
+			}
+		}
+		if err := s.storeSignedVAA(v, vaaBytes); err != nil {
+			return err
+		}
+	}
+
+	// A VAA signed by a newer guardian set is verified even if nobody subscribed to it, so that the verifier reads the
+	// new guardian set and the p2p layer accepts the heartbeats and observations of its guardians.
+	if !verified && s.gst != nil {
+		if v == nil {
+			v, err = vaa.Unmarshal(vaaBytes)
+			if err != nil {
+				return err
+			}
+		}
+		if current := s.gst.Get(); current == nil || v.GuardianSetIndex > current.Index {
+			verified = true
+			v, err = s.verifyVAA(v, vaaBytes)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	for _, sub := range s.subsSignedVaa {
 		if len(sub.filters) == 0 {
 			if !verified {
@@ -138,7 +194,7 @@ func (s *spyServer) PublishSignedVAA(vaaBytes []byte) error {
 		}
 
 		for _, fi := range sub.filters {
-			if fi.chainId == v.EmitterChain && fi.emitterAddr == v.EmitterAddress {
+			if fi.matches(v) {
 				if !verified {
 					verified = true
 					v, err = s.verifyVAA(v, vaaBytes)
@@ -147,6 +203,8 @@ func (s *spyServer) PublishSignedVAA(vaaBytes []byte) error {
 					}
 				}
 				sub.ch <- message{vaaBytes: vaaBytes} // Note on channel capacity: Don't want to drop incoming VAAs
+				// Filters are ORed, so the VAA must only be sent once.
+				break
 			}
 		}
 
@@ -172,6 +230,7 @@ func (s *spyServer) verifyVAA(v *vaa.VAA, vaaBytes []byte) (*vaa.VAA, error) {
 	if err != nil {
 		return v, fmt.Errorf(`failed to verify VAA: %w`, err)
 	}
+	s.refreshGuardianSet()
 
 	if !valid {
 		return v, errors.New(`invalid VAA signature`)
@@ -180,37 +239,52 @@ func (s *spyServer) verifyVAA(v *vaa.VAA, vaaBytes []byte) (*vaa.VAA, error) {
 	return v, nil
 }
 
+// refreshGuardianSet updates the guardian set state used by the p2p layer if the verifier has read a newer guardian set.
+func (s *spyServer) refreshGuardianSet() {
+	if s.gst == nil {
+		return
+	}
+
+	gs := s.vaaVerifier.CurrentGuardianSet()
+	if current := s.gst.Get(); gs != nil && (current == nil || gs.Index > current.Index) {
+		s.logger.Info("guardian set updated", zap.Uint32("index", gs.Index))
+		s.gst.Set(gs)
+	}
+}
+
 func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, resp spyv1.SpyRPCService_SubscribeSignedVAAServer) error {
 	var fi []filterSignedVaa
 	if req.Filters != nil {
 		for _, f := range req.Filters {
-			switch t := f.Filter.(type) {
-			case *spyv1.FilterEntry_EmitterFilter:
-				addr, err := vaa.StringToAddress(t.EmitterFilter.EmitterAddress)
-				if err != nil {
-					return status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
-				}
-				if t.EmitterFilter.GetChainId() > math.MaxUint16 {
-					return status.Error(codes.InvalidArgument, fmt.Sprintf("emitter chain id must be a valid 16 bit unsigned integer: %v", t.EmitterFilter.ChainId.Number()))
-				}
-				fi = append(fi, filterSignedVaa{
-					chainId:     vaa.ChainID(t.EmitterFilter.ChainId), // #nosec G115 -- This is validated above
-					emitterAddr: addr,
-				})
-			default:
-				return status.Error(codes.InvalidArgument, "unsupported filter type")
+			filter, err := s.parseFilterEntry(f)
+			if err != nil {
+				return err
 			}
+			fi = append(fi, filter)
 		}
 	}
 
-	s.subsSignedVaaMu.Lock()
+	cursor, err := s.parseResumeCursor(req)
+	if err != nil {
+		return err
+	}
+
 	id := subscriptionId()
 	sub := &subscriptionSignedVaa{
 		ch:      make(chan message, 1),
 		filters: fi,
 	}
-	s.subsSignedVaa[id] = sub
-	s.subsSignedVaaMu.Unlock()
+	if cursor != nil {
+		if err := s.subscribeWithReplay(id, sub, cursor, func(vaaBytes []byte) error {
+			return resp.Send(&spyv1.SubscribeSignedVAAResponse{VaaBytes: vaaBytes})
+		}); err != nil {
+			return err
+		}
+	} else {
+		s.subsSignedVaaMu.Lock()
+		s.subsSignedVaa[id] = sub
+		s.subsSignedVaaMu.Unlock()
+	}
 
 	defer func() {
 		for {
@@ -242,8 +316,12 @@ func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, res
 
 func newSpyServer(logger *zap.Logger) *spyServer {
 	return &spyServer{
-		logger:        logger.Named("spyserver"),
-		subsSignedVaa: make(map[string]*subscriptionSignedVaa),
+		logger:           logger.Named("spyserver"),
+		subsSignedVaa:    make(map[string]*subscriptionSignedVaa),
+		subsObservations: newSubscriptionSet[[]filterObservation, *spyv1.SubscribeSignedObservationsResponse]("observations"),
+		subsHeartbeats:   newSubscriptionSet[[]ethcommon.Address, *gossipv1.Heartbeat]("heartbeats"),
+		subsGovConfigs:   newSubscriptionSet[[]ethcommon.Address, *gossipv1.SignedChainGovernorConfig]("governor_configs"),
+		subsGovStatuses:  newSubscriptionSet[[]ethcommon.Address, *gossipv1.SignedChainGovernorStatus]("governor_statuses"),
 	}
 }
 
@@ -295,6 +373,7 @@ func runSpy(cmd *cobra.Command, args []string) {
 
 	ipfslog.SetAllLoggers(lvl)
 
+	var tokenBridges map[vaa.ChainID][]byte
 	if *envStr != "" {
 		// If they specify --env then use the defaults for the network parameters and don't allow them to override them.
 		if *p2pNetworkID != "" || *p2pBootstrap != "" {
@@ -305,6 +384,7 @@ func runSpy(cmd *cobra.Command, args []string) {
 			logger.Fatal(`Invalid value for "--env", should be "mainnet" or "testnet"`)
 		}
 		*p2pNetworkID = p2p.GetNetworkId(env)
+		tokenBridges = sdk.GetTokenBridgeEmitters(env.ToSDK())
 		*p2pBootstrap, envErr = p2p.GetBootstrapPeers(env)
 		if envErr != nil {
 			logger.Fatal("failed to determine p2p bootstrap peers", zap.String("env", string(env)), zap.Error(envErr))
@@ -351,11 +431,17 @@ func runSpy(cmd *cobra.Command, args []string) {
 	// Inbound signed VAAs
 	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, 1024)
 
-	// Guardian set state managed by processor
-	gst := common.NewGuardianSetState(nil)
+	// Inbound observation batches and verified heartbeats, only received if the guardian set is known
+	batchObsvC := make(chan *common.MsgWithTimeStamp[gossipv1.SignedObservationBatch], 1024)
+	heartbeatC := make(chan *gossipv1.Heartbeat, 1024)
+
+	// Inbound governor configs and statuses
+	govConfigC := make(chan *gossipv1.SignedChainGovernorConfig, 1024)
+	govStatusC := make(chan *gossipv1.SignedChainGovernorStatus, 1024)
 
 	// RPC server
 	s := newSpyServer(logger)
+	s.tokenBridges = tokenBridges
 	rpcSvc, _, err := spyServerRunnable(s, logger, *spyRPC)
 	if err != nil {
 		logger.Fatal("failed to start RPC server", zap.Error(err))
@@ -372,7 +458,34 @@ func runSpy(cmd *cobra.Command, args []string) {
 		}
 	}
 
-	// Log signed VAAs
+	// VAA store (optional)
+	if *dataDir != "" {
+		database := db.OpenDb(logger.With(zap.String("component", "badgerDb")), dataDir)
+		defer database.Close()
+		s.vaaStore, err = db.NewSpyDB(database.Conn(), *vaaStoreSize)
+		if err != nil {
+			logger.Fatal("Failed to open VAA store", zap.Error(err))
+		}
+	}
+
+	// Guardian set state, used by the p2p layer to verify heartbeats and observation batches. Without a guardian set,
+	// the p2p layer drops them, so there is no point in subscribing to them.
+	p2pOpts := []p2p.RunOpt{
+		p2p.WithSignedVAAListener(signedInC),
+		p2p.WithChainGovernorConfigListener(govConfigC),
+		p2p.WithChainGovernorStatusListener(govStatusC),
+	}
+	var gst *common.GuardianSetState
+	if s.vaaVerifier != nil {
+		gst = common.NewGuardianSetState(heartbeatC)
+		gst.Set(s.vaaVerifier.CurrentGuardianSet())
+		s.gst = gst
+		p2pOpts = append(p2pOpts, p2p.WithSignedObservationBatchListener(batchObsvC))
+	} else {
+		gst = common.NewGuardianSetState(nil)
+	}
+
+	// Log signed VAAs and forward all gossip messages to the subscribers
 	go func() {
 		for {
 			select {
@@ -384,6 +497,14 @@ func runSpy(cmd *cobra.Command, args []string) {
 				if pubErr := s.PublishSignedVAA(v.Vaa); pubErr != nil {
 					logger.Error("failed to publish signed VAA", zap.Error(pubErr), zap.Any("vaa", v.Vaa))
 				}
+			case b := <-batchObsvC:
+				s.PublishSignedObservationBatch(b.Msg)
+			case hb := <-heartbeatC:
+				s.PublishHeartbeat(hb)
+			case cfg := <-govConfigC:
+				s.PublishSignedChainGovernorConfig(cfg)
+			case st := <-govStatusC:
+				s.PublishSignedChainGovernorStatus(st)
 			}
 		}
 	}()
@@ -405,9 +526,10 @@ func runSpy(cmd *cobra.Command, args []string) {
 			priv,
 			gst,
 			rootCtxCancel,
-			p2p.WithSignedVAAListener(signedInC),
-			p2p.WithComponents(components),
-			p2p.WithProtectedPeers(protectedPeers),
+			append(p2pOpts,
+				p2p.WithComponents(components),
+				p2p.WithProtectedPeers(protectedPeers),
+			)...,
 		)
 		if err != nil {
 			return err
@@ -3,25 +3,40 @@ package spy
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net"
 	"testing"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	spyv1 "github.com/certusone/wormhole/node/pkg/proto/spy/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
+var guardianAddr = ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+var otherGuardianAddr = ethcommon.HexToAddress("0x88d7D8B32a9105d228100E72dFFe2Fae0705D31c")
+
 var govEmitter = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4}
 
 // govAddress is the string representation of the govEmitter Address.
@@ -46,6 +61,13 @@ func getVAA(chainID vaa.ChainID, emitterAddr vaa.Address) *vaa.VAA {
 	}
 }
 
+// wait for the given subscription set to have at least one subscription before returning.
+func waitForSubscription[F any, M any](set *subscriptionSet[F, M]) {
+	for set.len() == 0 {
+		time.Sleep(time.Millisecond * 10) //nolint:forbidigo // TODO: This code should be refactored to not use time.Sleep
+	}
+}
+
 // wait for the server to establish a client subscription before returning.
 func waitForClientSubscriptionInit(server *spyServer) {
 	for {
@@ -84,6 +106,8 @@ func init() {
 	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailFull)
 
 	mockedSpyServer = newSpyServer(logger)
+	mockedSpyServer.gst = common.NewGuardianSetState(nil)
+	mockedSpyServer.gst.Set(&common.GuardianSet{Keys: []ethcommon.Address{guardianAddr}})
 	spyv1.RegisterSpyRPCServiceServer(grpcServer, mockedSpyServer)
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
@@ -293,3 +317,497 @@ func TestSpyHandleEmitterFilter(t *testing.T) {
 
 	<-doneCh
 }
+
+// Tests the emitter and guardian filters of the observation stream
+func TestSpyHandleObservationFilters(t *testing.T) {
+	ctx, conn, client := grpcClientSetup(t)
+	defer conn.Close()
+
+	req := &spyv1.SubscribeSignedObservationsRequest{Filters: []*spyv1.ObservationFilterEntry{
+		{Filter: &spyv1.ObservationFilterEntry_EmitterFilter{EmitterFilter: &spyv1.EmitterFilter{
+			ChainId:        publicrpcv1.ChainID(vaa.ChainIDEthereum),
+			EmitterAddress: govEmitter.String(),
+		}}},
+		{Filter: &spyv1.ObservationFilterEntry_GuardianFilter{GuardianFilter: &spyv1.GuardianFilter{
+			GuardianAddress: otherGuardianAddr.Hex(),
+		}}},
+	}}
+
+	stream, err := client.SubscribeSignedObservations(ctx, req)
+	require.NoError(t, err)
+
+	matching := &gossipv1.Observation{Hash: []byte{1}, MessageId: fmt.Sprintf("2/%s/1", govEmitter)}
+	otherChain := &gossipv1.Observation{Hash: []byte{2}, MessageId: fmt.Sprintf("1/%s/1", govEmitter)}
+	noMessageId := &gossipv1.Observation{Hash: []byte{3}}
+	fromOtherGuardian := &gossipv1.Observation{Hash: []byte{4}}
+
+	doneCh := make(chan []*spyv1.SubscribeSignedObservationsResponse)
+	go func() {
+		var received []*spyv1.SubscribeSignedObservationsResponse
+		for len(received) < 2 {
+			resp, recvErr := stream.Recv()
+			if recvErr != nil {
+				break
+			}
+			received = append(received, resp)
+		}
+		doneCh <- received
+	}()
+	waitForSubscription(mockedSpyServer.subsObservations)
+
+	mockedSpyServer.PublishSignedObservationBatch(&gossipv1.SignedObservationBatch{
+		Addr:         guardianAddr.Bytes(),
+		Observations: []*gossipv1.Observation{otherChain, matching, noMessageId},
+	})
+	mockedSpyServer.PublishSignedObservationBatch(&gossipv1.SignedObservationBatch{
+		Addr:         otherGuardianAddr.Bytes(),
+		Observations: []*gossipv1.Observation{fromOtherGuardian},
+	})
+
+	received := <-doneCh
+	require.Len(t, received, 2)
+	assert.Equal(t, guardianAddr.Bytes(), received[0].Addr)
+	assert.Equal(t, matching.Hash, received[0].Observation.Hash)
+	assert.Equal(t, otherGuardianAddr.Bytes(), received[1].Addr)
+	assert.Equal(t, fromOtherGuardian.Hash, received[1].Observation.Hash)
+}
+
+// Tests the guardian filter of the heartbeat stream
+func TestSpyHandleHeartbeatFilter(t *testing.T) {
+	ctx, conn, client := grpcClientSetup(t)
+	defer conn.Close()
+
+	req := &spyv1.SubscribeHeartbeatsRequest{Filters: []*spyv1.GuardianFilter{{GuardianAddress: guardianAddr.Hex()}}}
+	stream, err := client.SubscribeHeartbeats(ctx, req)
+	require.NoError(t, err)
+
+	doneCh := make(chan *spyv1.SubscribeHeartbeatsResponse)
+	go func() {
+		resp, recvErr := stream.Recv()
+		if recvErr != nil {
+			t.Log("SubscribeHeartbeats returned an error.")
+		}
+		doneCh <- resp
+	}()
+	waitForSubscription(mockedSpyServer.subsHeartbeats)
+
+	mockedSpyServer.PublishHeartbeat(&gossipv1.Heartbeat{NodeName: "other", GuardianAddr: otherGuardianAddr.Hex()})
+	mockedSpyServer.PublishHeartbeat(&gossipv1.Heartbeat{NodeName: "guardian", GuardianAddr: guardianAddr.Hex()})
+
+	resp := <-doneCh
+	require.NotNil(t, resp)
+	assert.Equal(t, "guardian", resp.Heartbeat.NodeName)
+}
+
+// Tests the governor config and status streams forward the signed messages
+func TestSpyHandleGovernorMessages(t *testing.T) {
+	ctx, conn, client := grpcClientSetup(t)
+	defer conn.Close()
+
+	configStream, err := client.SubscribeSignedChainGovernorConfigs(ctx, &spyv1.SubscribeSignedChainGovernorConfigsRequest{})
+	require.NoError(t, err)
+	statusStream, err := client.SubscribeSignedChainGovernorStatuses(ctx, &spyv1.SubscribeSignedChainGovernorStatusesRequest{
+		Filters: []*spyv1.GuardianFilter{{GuardianAddress: guardianAddr.Hex()}},
+	})
+	require.NoError(t, err)
+
+	configCh := make(chan *spyv1.SubscribeSignedChainGovernorConfigsResponse)
+	go func() {
+		resp, _ := configStream.Recv()
+		configCh <- resp
+	}()
+	statusCh := make(chan *spyv1.SubscribeSignedChainGovernorStatusesResponse)
+	go func() {
+		resp, _ := statusStream.Recv()
+		statusCh <- resp
+	}()
+	waitForSubscription(mockedSpyServer.subsGovConfigs)
+	waitForSubscription(mockedSpyServer.subsGovStatuses)
+
+	mockedSpyServer.PublishSignedChainGovernorConfig(&gossipv1.SignedChainGovernorConfig{Config: []byte{1}, GuardianAddr: otherGuardianAddr.Bytes()})
+	mockedSpyServer.PublishSignedChainGovernorStatus(&gossipv1.SignedChainGovernorStatus{Status: []byte{2}, GuardianAddr: otherGuardianAddr.Bytes()})
+	mockedSpyServer.PublishSignedChainGovernorStatus(&gossipv1.SignedChainGovernorStatus{Status: []byte{3}, GuardianAddr: guardianAddr.Bytes()})
+
+	config := <-configCh
+	require.NotNil(t, config)
+	assert.Equal(t, []byte{1}, config.Config.Config)
+
+	st := <-statusCh
+	require.NotNil(t, st)
+	assert.Equal(t, []byte{3}, st.Status.Status)
+}
+
+// Tests invalid guardian filters are rejected
+func TestSpySubscribeInvalidGuardianFilter(t *testing.T) {
+	ctx, conn, client := grpcClientSetup(t)
+	defer conn.Close()
+
+	for _, addr := range []string{"not hex", "0x1234"} {
+		stream, err := client.SubscribeHeartbeats(ctx, &spyv1.SubscribeHeartbeatsRequest{Filters: []*spyv1.GuardianFilter{{GuardianAddress: addr}}})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), addr)
+	}
+}
+
+// Tests heartbeats and observations can't be subscribed to when the guardian set is unknown
+func TestSpyRequireGuardianSet(t *testing.T) {
+	s := newSpyServer(zap.NewNop())
+	assert.Equal(t, codes.FailedPrecondition, status.Code(s.requireGuardianSet()))
+
+	s.gst = common.NewGuardianSetState(nil)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(s.requireGuardianSet()))
+
+	s.gst.Set(&common.GuardianSet{Keys: []ethcommon.Address{guardianAddr}})
+	assert.NoError(t, s.requireGuardianSet())
+}
+
+func TestParseObservationEmitter(t *testing.T) {
+	chainId, emitterAddr, ok := parseObservationEmitter(fmt.Sprintf("2/%s/42", govEmitter))
+	require.True(t, ok)
+	assert.Equal(t, vaa.ChainIDEthereum, chainId)
+	assert.Equal(t, govEmitter, emitterAddr)
+
+	for _, id := range []string{"", "2/0004/1/1", "70000/0004/1", "2/zz/1"} {
+		_, _, ok = parseObservationEmitter(id)
+		assert.False(t, ok, id)
+	}
+}
+
+// helper method for creating a token bridge transfer payload to the given target chain
+func getTransferPayload(targetChain vaa.ChainID) []byte {
+	payload := make([]byte, 133)
+	payload[0] = 1
+	binary.BigEndian.PutUint16(payload[99:101], uint16(targetChain))
+	return payload
+}
+
+func TestSignedVaaFilters(t *testing.T) {
+	ethTokenBridge := vaa.Address(sdk.KnownTokenbridgeEmitters[vaa.ChainIDEthereum])
+
+	transfer := getVAA(vaa.ChainIDEthereum, ethTokenBridge)
+	transfer.Payload = getTransferPayload(vaa.ChainIDSolana)
+
+	governance := getVAA(vaa.GovernanceChain, vaa.GovernanceEmitter)
+	governance.Sequence = 42
+
+	tests := []struct {
+		name     string
+		filter   filterSignedVaa
+		v        *vaa.VAA
+		expected bool
+	}{
+		{"emitter match", filterEmitter{vaa.ChainIDEthereum, govEmitter}, getVAA(vaa.ChainIDEthereum, govEmitter), true},
+		{"emitter wrong chain", filterEmitter{vaa.ChainIDSolana, govEmitter}, getVAA(vaa.ChainIDEthereum, govEmitter), false},
+		{"sequence in range", filterSequenceRange{minSequence: 40, maxSequence: 42}, governance, true},
+		{"sequence below range", filterSequenceRange{minSequence: 43}, governance, false},
+		{"sequence above range", filterSequenceRange{minSequence: 1, maxSequence: 41}, governance, false},
+		{"sequence unbounded", filterSequenceRange{minSequence: 42}, governance, true},
+		{"sequence wrong emitter", filterSequenceRange{emitter: &filterEmitter{vaa.ChainIDEthereum, govEmitter}}, governance, false},
+		{"sequence emitter match", filterSequenceRange{emitter: &filterEmitter{vaa.GovernanceChain, vaa.GovernanceEmitter}}, governance, true},
+		{"consistency level match", filterConsistencyLevel{32}, governance, true},
+		{"consistency level mismatch", filterConsistencyLevel{1}, governance, false},
+		{"governance match", filterGovernance{}, governance, true},
+		{"governance wrong chain", filterGovernance{}, getVAA(vaa.ChainIDEthereum, vaa.GovernanceEmitter), false},
+		{"payload id match", filterPayloadId{1}, transfer, true},
+		{"payload id mismatch", filterPayloadId{97}, transfer, false},
+		{"payload id empty payload", filterPayloadId{0}, &vaa.VAA{}, false},
+		{"transfer target match", filterTokenTransfer{targetChain: vaa.ChainIDSolana}, transfer, true},
+		{"transfer target mismatch", filterTokenTransfer{targetChain: vaa.ChainIDEthereum}, transfer, false},
+		{"transfer known token bridge", filterTokenTransfer{targetChain: vaa.ChainIDSolana, tokenBridges: sdk.KnownTokenbridgeEmitters}, transfer, true},
+		{"transfer testnet token bridge", filterTokenTransfer{targetChain: vaa.ChainIDSolana, tokenBridges: sdk.KnownTestnetTokenbridgeEmitters}, transfer, false},
+		{"transfer not a transfer", filterTokenTransfer{targetChain: vaa.ChainIDSolana}, governance, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.filter.matches(tc.v))
+		})
+	}
+}
+
+func TestParseFilterEntryInvalid(t *testing.T) {
+	s := newSpyServer(zap.NewNop())
+
+	tests := []struct {
+		name  string
+		entry *spyv1.FilterEntry
+	}{
+		{"inverted sequence range", &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_SequenceRangeFilter{SequenceRangeFilter: &spyv1.SequenceRangeFilter{MinSequence: 2, MaxSequence: 1}}}},
+		{"invalid sequence range emitter", &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_SequenceRangeFilter{SequenceRangeFilter: &spyv1.SequenceRangeFilter{Emitter: &spyv1.EmitterFilter{EmitterAddress: "zz"}}}}},
+		{"consistency level too large", &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_ConsistencyLevelFilter{ConsistencyLevelFilter: &spyv1.ConsistencyLevelFilter{ConsistencyLevel: 256}}}},
+		{"payload id too large", &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_PayloadIdFilter{PayloadIdFilter: &spyv1.PayloadIdFilter{PayloadId: 256}}}},
+		{"target chain too large", &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_TokenTransferFilter{TokenTransferFilter: &spyv1.TokenTransferFilter{TargetChainId: math.MaxUint16 + 1}}}},
+		{"batch filter", &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_BatchFilter{BatchFilter: &spyv1.BatchFilter{}}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.parseFilterEntry(tc.entry)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+// Tests a VAA matching several filters of a subscription is only sent once
+func TestSpyHandleTokenTransferFilter(t *testing.T) {
+	ctx, conn, client := grpcClientSetup(t)
+	defer conn.Close()
+
+	req := &spyv1.SubscribeSignedVAARequest{Filters: []*spyv1.FilterEntry{
+		{Filter: &spyv1.FilterEntry_TokenTransferFilter{TokenTransferFilter: &spyv1.TokenTransferFilter{TargetChainId: publicrpcv1.ChainID(vaa.ChainIDSolana)}}},
+		{Filter: &spyv1.FilterEntry_PayloadIdFilter{PayloadIdFilter: &spyv1.PayloadIdFilter{PayloadId: 1}}},
+	}}
+	stream, err := client.SubscribeSignedVAA(ctx, req)
+	require.NoError(t, err)
+
+	doneCh := make(chan [][]byte)
+	go func() {
+		var received [][]byte
+		for len(received) < 2 {
+			resp, recvErr := stream.Recv()
+			if recvErr != nil {
+				break
+			}
+			received = append(received, resp.VaaBytes)
+		}
+		doneCh <- received
+	}()
+	// wait for this subscription specifically, as the ones of other tests may not have been removed yet.
+	for {
+		mockedSpyServer.subsSignedVaaMu.Lock()
+		subscribed := false
+		for _, sub := range mockedSpyServer.subsSignedVaa {
+			subscribed = subscribed || len(sub.filters) == 2
+		}
+		mockedSpyServer.subsSignedVaaMu.Unlock()
+		if subscribed {
+			break
+		}
+		time.Sleep(time.Millisecond * 10) //nolint:forbidigo // TODO: This code should be refactored to not use time.Sleep
+	}
+
+	toSolana := getVAA(vaa.ChainIDEthereum, govEmitter)
+	toSolana.Payload = getTransferPayload(vaa.ChainIDSolana)
+	toEthereum := getVAA(vaa.ChainIDSolana, govEmitter)
+	toEthereum.Payload = getTransferPayload(vaa.ChainIDEthereum)
+	toEthereum.Payload[0] = 3
+	notATransfer := getVAA(vaa.ChainIDEthereum, govEmitter)
+
+	var expected [][]byte
+	for _, v := range []*vaa.VAA{toSolana, notATransfer, toEthereum, toSolana} {
+		vaaBytes, marshalErr := v.Marshal()
+		require.NoError(t, marshalErr)
+		require.NoError(t, mockedSpyServer.PublishSignedVAA(vaaBytes))
+		if v == toSolana {
+			expected = append(expected, vaaBytes)
+		}
+	}
+
+	assert.Equal(t, expected, <-doneCh)
+}
+
+// sets up a spy server with a VAA store of the given size, and a gRPC client connected to it.
+func storeSpyServerSetup(t *testing.T, size uint64) (*spyServer, spyv1.SpyRPCServiceClient) {
+	t.Helper()
+	logger := zap.NewNop()
+
+	database := db.OpenDb(logger, nil)
+	t.Cleanup(func() { database.Close() })
+	store, err := db.NewSpyDB(database.Conn(), size)
+	require.NoError(t, err)
+
+	server := newSpyServer(logger)
+	server.vaaStore = store
+
+	listener := bufconn.Listen(bufSize)
+	grpcServer := common.NewInstrumentedGRPCServer(logger, common.GrpcLogDetailFull)
+	spyv1.RegisterSpyRPCServiceServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return server, spyv1.NewSpyRPCServiceClient(conn)
+}
+
+// publishes VAAs of the given emitter with the given sequence numbers, and returns their bytes.
+func publishSequences(t *testing.T, server *spyServer, emitter vaa.Address, seqs ...uint64) [][]byte {
+	t.Helper()
+	var published [][]byte
+	for _, seq := range seqs {
+		v := getVAA(vaa.ChainIDEthereum, emitter)
+		v.Sequence = seq
+		vaaBytes, err := v.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, server.PublishSignedVAA(vaaBytes))
+		published = append(published, vaaBytes)
+	}
+	return published
+}
+
+// receives n VAAs from a signed VAA stream.
+func receiveVAAs(t *testing.T, stream spyv1.SpyRPCService_SubscribeSignedVAAClient, n int) [][]byte {
+	t.Helper()
+	var received [][]byte
+	for len(received) < n {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		received = append(received, resp.VaaBytes)
+	}
+	return received
+}
+
+// Tests a subscription resumed from the last VAA received replays the missed VAAs before live ones
+func TestSpyResumeFromLastVaaId(t *testing.T) {
+	server, client := storeSpyServerSetup(t, 100)
+	ctx := context.Background()
+
+	published := publishSequences(t, server, govEmitter, 1, 2, 3)
+	otherEmitter := vaa.Address{1}
+	publishSequences(t, server, otherEmitter, 4)
+
+	stream, err := client.SubscribeSignedVAA(ctx, &spyv1.SubscribeSignedVAARequest{
+		Filters: []*spyv1.FilterEntry{{Filter: &spyv1.FilterEntry_EmitterFilter{EmitterFilter: &spyv1.EmitterFilter{
+			ChainId:        publicrpcv1.ChainID(vaa.ChainIDEthereum),
+			EmitterAddress: govEmitter.String(),
+		}}}},
+		ResumeFrom: &spyv1.SubscribeSignedVAARequest_LastVaaId{LastVaaId: fmt.Sprintf("%d/%s/1", vaa.ChainIDEthereum, govEmitter)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, published[1:], receiveVAAs(t, stream, 2))
+
+	waitForClientSubscriptionInit(server)
+	live := publishSequences(t, server, govEmitter, 5)
+	assert.Equal(t, live, receiveVAAs(t, stream, 1))
+}
+
+// Tests a subscription resumed from a timestamp replays the VAAs received after it
+func TestSpyResumeFromTimestamp(t *testing.T) {
+	server, client := storeSpyServerSetup(t, 100)
+	ctx := context.Background()
+
+	publishSequences(t, server, govEmitter, 1)
+	// Make sure the VAAs published next are received at a later time.
+	time.Sleep(time.Millisecond * 10) //nolint:forbidigo // TODO: This code should be refactored to not use time.Sleep
+	cursor := time.Now()
+	published := publishSequences(t, server, govEmitter, 2, 3)
+
+	stream, err := client.SubscribeSignedVAA(ctx, &spyv1.SubscribeSignedVAARequest{
+		ResumeFrom: &spyv1.SubscribeSignedVAARequest_ReceivedAfter{ReceivedAfter: cursor.UnixNano()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, published, receiveVAAs(t, stream, 2))
+}
+
+// Tests the errors returned for invalid resume cursors
+func TestSpyResumeErrors(t *testing.T) {
+	server, client := storeSpyServerSetup(t, 2)
+	ctx := context.Background()
+	publishSequences(t, server, govEmitter, 1, 2, 3)
+
+	// mockedSpyServer has no VAA store.
+	_, conn, noStoreClient := grpcClientSetup(t)
+	defer conn.Close()
+
+	tests := []struct {
+		name     string
+		client   spyv1.SpyRPCServiceClient
+		cursor   *spyv1.SubscribeSignedVAARequest_LastVaaId
+		expected codes.Code
+	}{
+		{"no VAA store", noStoreClient, &spyv1.SubscribeSignedVAARequest_LastVaaId{LastVaaId: "2/0000000000000000000000000000000000000000000000000000000000000004/2"}, codes.FailedPrecondition},
+		{"invalid VAA ID", client, &spyv1.SubscribeSignedVAARequest_LastVaaId{LastVaaId: "2/4"}, codes.InvalidArgument},
+		{"evicted VAA", client, &spyv1.SubscribeSignedVAARequest_LastVaaId{LastVaaId: fmt.Sprintf("2/%s/1", govEmitter)}, codes.NotFound},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stream, err := tc.client.SubscribeSignedVAA(ctx, &spyv1.SubscribeSignedVAARequest{ResumeFrom: tc.cursor})
+			require.NoError(t, err)
+			_, err = stream.Recv()
+			assert.Equal(t, tc.expected, status.Code(err))
+		})
+	}
+}
+
+// Tests the guardian set used for heartbeats and observations follows the guardian sets read by the verifier
+func TestSpyRefreshGuardianSet(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	gs0 := &common.GuardianSet{Keys: []ethcommon.Address{guardianAddr}, Index: 0}
+	gs1 := &common.GuardianSet{Keys: []ethcommon.Address{ethcrypto.PubkeyToAddress(key.PublicKey)}, Index: 1}
+
+	s := newSpyServer(zap.NewNop())
+	s.vaaVerifier = NewVaaVerifier(zap.NewNop(), "", "")
+	// The guardian sets are already known, so the verifier does not need to read them.
+	s.vaaVerifier.guardianSets[gs0.Index] = gs0
+	s.vaaVerifier.guardianSets[gs1.Index] = gs1
+	s.gst = common.NewGuardianSetState(nil)
+	s.gst.Set(gs0)
+
+	// A VAA signed by the current guardian set does not change it.
+	v := getVAA(vaa.ChainIDEthereum, govEmitter)
+	v.GuardianSetIndex = 0
+	vaaBytes, err := v.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, s.PublishSignedVAA(vaaBytes))
+	assert.Equal(t, uint32(0), s.gst.Get().Index)
+
+	// A VAA signed by a newer guardian set is verified even without subscribers, and updates it.
+	v = getVAA(vaa.ChainIDEthereum, govEmitter)
+	v.AddSignature(key, 0)
+	vaaBytes, err = v.Marshal()
+	require.NoError(t, err)
+	require.NoError(t, s.PublishSignedVAA(vaaBytes))
+	assert.Equal(t, gs1, s.gst.Get())
+
+	// A VAA claiming a newer guardian set with an invalid signature is rejected.
+	v = getVAA(vaa.ChainIDEthereum, govEmitter)
+	v.GuardianSetIndex = 2
+	vaaBytes, err = v.Marshal()
+	require.NoError(t, err)
+	s.vaaVerifier.guardianSets[2] = &common.GuardianSet{Keys: []ethcommon.Address{guardianAddr}, Index: 2}
+	require.Error(t, s.PublishSignedVAA(vaaBytes))
+}
+
+// Tests a subscriber that does not keep up does not block publishing, and the messages it misses are dropped
+func TestSubscriptionSetDropsWhenFull(t *testing.T) {
+	set := newSubscriptionSet[[]ethcommon.Address, *gossipv1.Heartbeat]("test")
+	ctx, cancel := context.WithCancel(context.Background())
+
+	blocked := make(chan struct{})
+	sent := make(chan *gossipv1.Heartbeat, subscriptionBufferSize+1)
+	doneCh := make(chan error)
+	go func() {
+		doneCh <- set.serve(ctx, nil, func(hb *gossipv1.Heartbeat) error {
+			sent <- hb
+			<-blocked
+			return nil
+		})
+	}()
+	waitForSubscription(set)
+
+	// The first heartbeat is being sent, the subscriber's buffer then fills up and the rest is dropped.
+	set.publish(func([]ethcommon.Address) (*gossipv1.Heartbeat, bool) { return &gossipv1.Heartbeat{Counter: 0}, true })
+	<-sent
+	for i := 1; i <= subscriptionBufferSize+10; i++ {
+		hb := &gossipv1.Heartbeat{Counter: int64(i)}
+		set.publish(func([]ethcommon.Address) (*gossipv1.Heartbeat, bool) { return hb, true })
+	}
+	assert.Equal(t, float64(10), testutil.ToFloat64(set.dropped))
+
+	close(blocked)
+	for i := 1; i <= subscriptionBufferSize; i++ {
+		assert.Equal(t, int64(i), (<-sent).Counter)
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-doneCh, context.Canceled)
+	assert.Equal(t, 0, set.len())
+}
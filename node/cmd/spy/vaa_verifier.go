@@ -78,6 +78,20 @@ func (v *VaaVerifier) GetInitialGuardianSet() error {
 	return nil
 }
 
+// CurrentGuardianSet returns the guardian set with the highest index read so far, or nil if none has been read yet.
+func (v *VaaVerifier) CurrentGuardianSet() *common.GuardianSet {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	var current *common.GuardianSet
+	for _, gs := range v.guardianSets {
+		if current == nil || gs.Index > current.Index {
+			current = gs
+		}
+	}
+	return current
+}
+
 // VerifySignatures verifies that the signature on a VAA is valid, based on the guardian set contained in the VAA.
 // If the guardian set is not currently in our map, it queries that guardian set and adds it.
 func (v *VaaVerifier) VerifySignatures(vv *vaa.VAA) (bool, error) {
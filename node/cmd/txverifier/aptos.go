@@ -0,0 +1,230 @@
+package txverifier
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/telemetry"
+	txverifier "github.com/certusone/wormhole/node/pkg/txverifier"
+	"github.com/certusone/wormhole/node/pkg/version"
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// CLI args
+var (
+	aptosRPC          *string
+	aptosEnvironment  *string
+	aptosVersion      *uint64
+	aptosPollInterval *time.Duration
+
+	// Aptos accounts and emitter address
+	aptosCoreBridge         *string
+	aptosTokenBridge        *string
+	aptosTokenBridgeEmitter *string
+)
+
+var TransferVerifierCmdAptos = &cobra.Command{
+	Use:   "aptos",
+	Short: "Transfer Verifier for Aptos",
+	Run:   runTransferVerifierAptos,
+}
+
+// CLI parameters
+func init() {
+	aptosRPC = TransferVerifierCmdAptos.Flags().String("aptosRPC", "", "Aptos REST API URL, without the /v1 suffix")
+	aptosEnvironment = TransferVerifierCmdAptos.Flags().String("aptosEnvironment", "mainnet", "The Aptos environment to connect to. Supported values: mainnet, testnet and devnet")
+	aptosVersion = TransferVerifierCmdAptos.Flags().Uint64("aptosVersion", 0, "If provided, perform transaction verification on the transaction at this single ledger version")
+	aptosPollInterval = TransferVerifierCmdAptos.Flags().Duration("aptosPollInterval", 5*time.Second, "How often to poll for new core bridge messages")
+
+	aptosCoreBridge = TransferVerifierCmdAptos.Flags().String("aptosCoreBridge", "", "The Aptos Core Bridge account. If not provided, the default for the selected environment will be used.")
+	aptosTokenBridge = TransferVerifierCmdAptos.Flags().String("aptosTokenBridge", "", "The Aptos Token Bridge account. If not provided, the default for the selected environment will be used.")
+	aptosTokenBridgeEmitter = TransferVerifierCmdAptos.Flags().String("aptosTokenBridgeEmitter", "", "The Aptos Token Bridge emitter address. If not provided, the default for the selected environment will be used.")
+}
+
+// Analyse the commandline arguments and prepare the net effect of the accounts and emitter address
+func resolveAptosConfiguration() {
+	switch *aptosEnvironment {
+	case "mainnet":
+		setIfEmpty(aptosCoreBridge, "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625")
+		setIfEmpty(aptosTokenBridge, txverifier.AptosTokenBridgeAddresses[common.MainNet])
+		setIfEmpty(aptosTokenBridgeEmitter, hex.EncodeToString(sdk.KnownTokenbridgeEmitters[vaa.ChainIDAptos]))
+	case "testnet":
+		setIfEmpty(aptosCoreBridge, "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625")
+		setIfEmpty(aptosTokenBridge, txverifier.AptosTokenBridgeAddresses[common.TestNet])
+		setIfEmpty(aptosTokenBridgeEmitter, hex.EncodeToString(sdk.KnownTestnetTokenbridgeEmitters[vaa.ChainIDAptos]))
+	case "devnet":
+		setIfEmpty(aptosCoreBridge, "0xde0036a9600559e295d5f6802ef6f3f802f510366e0c23912b0655d972166017")
+		setIfEmpty(aptosTokenBridge, txverifier.AptosTokenBridgeAddresses[common.UnsafeDevNet])
+		// The token bridge is the first emitter registered on devnet, as it is on mainnet.
+		setIfEmpty(aptosTokenBridgeEmitter, hex.EncodeToString(sdk.KnownTokenbridgeEmitters[vaa.ChainIDAptos]))
+	}
+}
+
+func runTransferVerifierAptos(cmd *cobra.Command, args []string) {
+	resolveAptosConfiguration()
+
+	ctx := context.Background()
+
+	// Setup logging
+	lvl, err := ipfslog.LevelFromString(*logLevel)
+	if err != nil {
+		fmt.Println("Invalid log level")
+		os.Exit(1)
+	}
+
+	logger := ipfslog.Logger("wormhole-transfer-verifier-aptos").Desugar()
+
+	ipfslog.SetAllLoggers(lvl)
+
+	// Setup logging to Loki if configured
+	if *telemetryLokiUrl != "" && *telemetryNodeName != "" {
+		labels := map[string]string{
+			"node_name": *telemetryNodeName,
+			"version":   version.Version(),
+		}
+
+		tm, lokiErr := telemetry.NewLokiCloudLogger(
+			context.Background(),
+			logger,
+			*telemetryLokiUrl,
+			"transfer-verifier-aptos",
+			// Private logs are not used in this code
+			false,
+			labels,
+		)
+		if lokiErr != nil {
+			logger.Fatal("Failed to initialize telemetry", zap.Error(lokiErr))
+		}
+
+		defer tm.Close()
+		logger = tm.WrapLogger(logger) // Wrap logger with telemetry logger
+	}
+
+	// Verify CLI parameters
+	if *aptosRPC == "" || *aptosCoreBridge == "" || *aptosTokenBridge == "" || *aptosTokenBridgeEmitter == "" {
+		logger.Fatal("One or more CLI parameters are empty",
+			zap.String("aptosRPC", *aptosRPC),
+			zap.String("aptosCoreBridge", *aptosCoreBridge),
+			zap.String("aptosTokenBridge", *aptosTokenBridge),
+			zap.String("aptosTokenBridgeEmitter", *aptosTokenBridgeEmitter))
+	}
+
+	emitter, err := vaa.StringToAddress(*aptosTokenBridgeEmitter)
+	if err != nil {
+		logger.Fatal("Invalid token bridge emitter address", zap.Error(err))
+	}
+
+	logger.Info("Starting Aptos transfer verifier")
+	logger.Debug("Aptos rpc connection", zap.String("url", *aptosRPC))
+	logger.Debug("Aptos core bridge account", zap.String("account", *aptosCoreBridge))
+	logger.Debug("Aptos token bridge account", zap.String("account", *aptosTokenBridge))
+	logger.Debug("Aptos token bridge emitter", zap.Stringer("emitter", emitter))
+
+	aptosTransferVerifier, err := txverifier.NewAptosTransferVerifier(*aptosCoreBridge, *aptosTokenBridge, emitter, txverifier.NewAptosRestClient(*aptosRPC))
+	if err != nil {
+		logger.Fatal("Failed to create Aptos transfer verifier", zap.Error(err))
+	}
+
+	// Process a single transaction and exit
+	if *aptosVersion != 0 {
+		logger.Info("Processing single transaction", zap.Uint64("version", *aptosVersion))
+		valid, processErr := aptosTransferVerifier.ProcessVersion(ctx, *aptosVersion, "", logger)
+
+		if processErr != nil {
+			logger.Error("Error validating the transaction", zap.Error(processErr))
+		}
+
+		logger.Info("Validation completed", zap.Bool("valid", valid))
+
+		return
+	}
+
+	// Live processing: poll the core bridge's message event handle and verify the transaction behind each new
+	// message. The first poll only establishes the starting point, so that the verifier does not re-process
+	// historical transactions.
+	eventsEndpoint := fmt.Sprintf("%s/v1/accounts/%s/events/%s::state::WormholeMessageHandle/event", *aptosRPC, *aptosCoreBridge, *aptosCoreBridge)
+
+	var nextSequence, lastVersion uint64
+	ticker := time.NewTicker(*aptosPollInterval)
+	defer ticker.Stop()
+
+	for {
+		query := fmt.Sprintf("%s?limit=1", eventsEndpoint)
+		if nextSequence != 0 {
+			query = fmt.Sprintf("%s?start=%d", eventsEndpoint, nextSequence)
+		}
+
+		events, pollErr := fetchAptosEvents(ctx, query)
+		if pollErr != nil {
+			logger.Error("Error polling for core bridge messages", zap.Error(pollErr))
+		} else {
+			firstPoll := nextSequence == 0
+			for _, event := range events.Array() {
+				nextSequence = event.Get("sequence_number").Uint() + 1
+				if firstPoll {
+					continue
+				}
+
+				// A transaction is verified as a whole, so it only needs to be processed once.
+				txVersion := event.Get("version").Uint()
+				if txVersion == lastVersion {
+					continue
+				}
+				lastVersion = txVersion
+
+				valid, processErr := aptosTransferVerifier.ProcessVersion(ctx, txVersion, "", logger)
+				if processErr != nil {
+					logger.Error(processErr.Error(), zap.Uint64("version", txVersion))
+				}
+				logger.Info("Processed new transaction", zap.Uint64("version", txVersion), zap.Bool("valid", valid))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Context cancelled")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// fetchAptosEvents queries an Aptos events endpoint and returns the parsed list of events.
+func fetchAptosEvents(ctx context.Context, url string) (gjson.Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+	defer res.Body.Close()
+
+	body, err := common.SafeRead(res.Body)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return gjson.Result{}, fmt.Errorf("unexpected status code %d: %s", res.StatusCode, string(body))
+	}
+
+	if !gjson.ValidBytes(body) {
+		return gjson.Result{}, fmt.Errorf("invalid JSON response: %s", string(body))
+	}
+
+	return gjson.ParseBytes(body), nil
+}
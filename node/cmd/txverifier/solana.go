@@ -0,0 +1,193 @@
+package txverifier
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/telemetry"
+	txverifier "github.com/certusone/wormhole/node/pkg/txverifier"
+	"github.com/certusone/wormhole/node/pkg/version"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// CLI args
+var (
+	solanaRPC          *string
+	solanaEnvironment  *string
+	solanaSignature    *string
+	solanaPollInterval *time.Duration
+
+	// Solana program IDs
+	solanaCoreBridge  *string
+	solanaTokenBridge *string
+)
+
+var TransferVerifierCmdSolana = &cobra.Command{
+	Use:   "solana",
+	Short: "Transfer Verifier for Solana",
+	Run:   runTransferVerifierSolana,
+}
+
+// CLI parameters
+func init() {
+	solanaRPC = TransferVerifierCmdSolana.Flags().String("solanaRPC", "", "Solana RPC URL")
+	solanaEnvironment = TransferVerifierCmdSolana.Flags().String("solanaEnvironment", "mainnet", "The Solana environment to connect to. Supported values: mainnet, testnet and devnet")
+	solanaSignature = TransferVerifierCmdSolana.Flags().String("solanaSignature", "", "If provided, perform transaction verification on this single transaction signature")
+	solanaPollInterval = TransferVerifierCmdSolana.Flags().Duration("solanaPollInterval", 5*time.Second, "How often to poll for new token bridge transactions")
+
+	solanaCoreBridge = TransferVerifierCmdSolana.Flags().String("solanaCoreBridge", "", "The Solana Core Bridge program ID. If not provided, the default for the selected environment will be used.")
+	solanaTokenBridge = TransferVerifierCmdSolana.Flags().String("solanaTokenBridge", "", "The Solana Token Bridge program ID. If not provided, the default for the selected environment will be used.")
+}
+
+// Analyse the commandline arguments and prepare the net effect of the program IDs
+func resolveSolanaConfiguration() {
+	switch *solanaEnvironment {
+	case "mainnet":
+		setIfEmpty(solanaCoreBridge, "worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth")
+		setIfEmpty(solanaTokenBridge, txverifier.SolanaTokenBridgeProgramIds[common.MainNet])
+	case "testnet":
+		setIfEmpty(solanaCoreBridge, "3u8hJUVTA4jH1wYAyUur7FFZVQ8H635K3tSHHF4ssjQ5")
+		setIfEmpty(solanaTokenBridge, txverifier.SolanaTokenBridgeProgramIds[common.TestNet])
+	case "devnet":
+		setIfEmpty(solanaCoreBridge, "Bridge1p5gheXUvJ6jGWGeCsgPKgnE3YgdGKRVCMY9o")
+		setIfEmpty(solanaTokenBridge, txverifier.SolanaTokenBridgeProgramIds[common.UnsafeDevNet])
+	}
+}
+
+func runTransferVerifierSolana(cmd *cobra.Command, args []string) {
+	resolveSolanaConfiguration()
+
+	ctx := context.Background()
+
+	// Setup logging
+	lvl, err := ipfslog.LevelFromString(*logLevel)
+	if err != nil {
+		fmt.Println("Invalid log level")
+		os.Exit(1)
+	}
+
+	logger := ipfslog.Logger("wormhole-transfer-verifier-solana").Desugar()
+
+	ipfslog.SetAllLoggers(lvl)
+
+	// Setup logging to Loki if configured
+	if *telemetryLokiUrl != "" && *telemetryNodeName != "" {
+		labels := map[string]string{
+			"node_name": *telemetryNodeName,
+			"version":   version.Version(),
+		}
+
+		tm, lokiErr := telemetry.NewLokiCloudLogger(
+			context.Background(),
+			logger,
+			*telemetryLokiUrl,
+			"transfer-verifier-solana",
+			// Private logs are not used in this code
+			false,
+			labels,
+		)
+		if lokiErr != nil {
+			logger.Fatal("Failed to initialize telemetry", zap.Error(lokiErr))
+		}
+
+		defer tm.Close()
+		logger = tm.WrapLogger(logger) // Wrap logger with telemetry logger
+	}
+
+	// Verify CLI parameters
+	if *solanaRPC == "" || *solanaCoreBridge == "" || *solanaTokenBridge == "" {
+		logger.Fatal("One or more CLI parameters are empty",
+			zap.String("solanaRPC", *solanaRPC),
+			zap.String("solanaCoreBridge", *solanaCoreBridge),
+			zap.String("solanaTokenBridge", *solanaTokenBridge))
+	}
+
+	coreBridge, err := solana.PublicKeyFromBase58(*solanaCoreBridge)
+	if err != nil {
+		logger.Fatal("Invalid core bridge program ID", zap.Error(err))
+	}
+
+	tokenBridge, err := solana.PublicKeyFromBase58(*solanaTokenBridge)
+	if err != nil {
+		logger.Fatal("Invalid token bridge program ID", zap.Error(err))
+	}
+
+	logger.Info("Starting Solana transfer verifier")
+	logger.Debug("Solana rpc connection", zap.String("url", *solanaRPC))
+	logger.Debug("Solana core bridge program ID", zap.Stringer("programId", coreBridge))
+	logger.Debug("Solana token bridge program ID", zap.Stringer("programId", tokenBridge))
+
+	client := rpc.New(*solanaRPC)
+
+	solanaTransferVerifier, err := txverifier.NewSolanaTransferVerifier(coreBridge, tokenBridge, client)
+	if err != nil {
+		logger.Fatal("Failed to create Solana transfer verifier", zap.Error(err))
+	}
+
+	// Process a single signature and exit
+	if *solanaSignature != "" {
+		signature, sigErr := solana.SignatureFromBase58(*solanaSignature)
+		if sigErr != nil {
+			logger.Fatal("Invalid transaction signature", zap.Error(sigErr))
+		}
+
+		logger.Info("Processing single signature", zap.Stringer("signature", signature))
+		state, processErr := solanaTransferVerifier.ProcessSignature(ctx, signature, solana.PublicKey{}, logger)
+
+		if processErr != nil {
+			logger.Error("Error validating the transaction", zap.Error(processErr))
+		}
+
+		logger.Info("Validation completed", zap.Stringer("state", state))
+
+		return
+	}
+
+	// Live processing: poll for transactions that invoked the token bridge and verify each of them. The first poll
+	// only establishes the starting point, so that the verifier does not re-process historical transactions.
+	var lastSignature solana.Signature
+	ticker := time.NewTicker(*solanaPollInterval)
+	defer ticker.Stop()
+
+	for {
+		signatures, pollErr := client.GetSignaturesForAddressWithOpts(ctx, tokenBridge, &rpc.GetSignaturesForAddressOpts{
+			Until:      lastSignature,
+			Commitment: rpc.CommitmentConfirmed,
+		})
+		if pollErr != nil {
+			logger.Error("Error polling for token bridge transactions", zap.Error(pollErr))
+		} else if len(signatures) > 0 {
+			if !lastSignature.IsZero() {
+				// Signatures are returned newest first, so process them in reverse.
+				for i := len(signatures) - 1; i >= 0; i-- {
+					sig := signatures[i]
+					if sig.Err != nil {
+						continue
+					}
+
+					state, processErr := solanaTransferVerifier.ProcessSignature(ctx, sig.Signature, solana.PublicKey{}, logger)
+					if processErr != nil {
+						logger.Error(processErr.Error(), zap.Stringer("signature", sig.Signature))
+					}
+					logger.Info("Processed new transaction", zap.Stringer("signature", sig.Signature), zap.Stringer("state", state))
+				}
+			}
+			lastSignature = signatures[0].Signature
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Context cancelled")
+			return
+		case <-ticker.C:
+		}
+	}
+}
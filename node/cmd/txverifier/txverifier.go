@@ -20,7 +20,7 @@ var (
 
 // init initializes the global flags and subcommands for the TransferVerifierCmd.
 // It sets up a persistent flag for logging level with a default value of "info"
-// and adds subcommands for EVM and Sui transfer verification.
+// and adds subcommands for EVM, Sui, Solana and Aptos transfer verification.
 func init() {
 	// Global flags
 	logLevel = TransferVerifierCmd.PersistentFlags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
@@ -33,4 +33,6 @@ func init() {
 	// Subcommands corresponding to chains supported by the Transfer Verifier.
 	TransferVerifierCmd.AddCommand(TransferVerifierCmdEvm)
 	TransferVerifierCmd.AddCommand(TransferVerifierCmdSui)
+	TransferVerifierCmd.AddCommand(TransferVerifierCmdSolana)
+	TransferVerifierCmd.AddCommand(TransferVerifierCmdAptos)
 }
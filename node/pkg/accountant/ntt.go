@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ntt"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"go.uber.org/zap"
 )
@@ -57,28 +58,9 @@ func (acct *Accountant) nttStart(ctx context.Context) error {
 	return nil
 }
 
-var WH_PREFIX = []byte{0x99, 0x45, 0xFF, 0x10}
-var NTT_PREFIX = []byte{0x99, 0x4E, 0x54, 0x54}
-
-const NTT_PREFIX_OFFSET = 136
-const NTT_PREFIX_END = NTT_PREFIX_OFFSET + 4
-
-// isNTT determines if the payload bytes are for a Native Token Transfer, according to the following implementation:
-// https://github.com/wormhole-foundation/example-native-token-transfers/blob/22bde0c7d8139675582d861dc8245eb1912324fa/evm/test/TransceiverStructs.t.sol#L42
+// nttIsPayloadNTT determines if the payload bytes are for a Native Token Transfer, see the ntt package.
 func nttIsPayloadNTT(payload []byte) bool {
-	if len(payload) < NTT_PREFIX_END {
-		return false
-	}
-
-	if !bytes.Equal(payload[0:4], WH_PREFIX) {
-		return false
-	}
-
-	if !bytes.Equal(payload[NTT_PREFIX_OFFSET:NTT_PREFIX_END], NTT_PREFIX) {
-		return false
-	}
-
-	return true
+	return ntt.IsTransfer(payload)
 }
 
 // isMsgDirectNTT determines if a message publication is for a Native Token Transfer directly from an NTT endpoint.
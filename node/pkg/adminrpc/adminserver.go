@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/holiman/uint256"
@@ -75,6 +76,12 @@ type nodePrivilegedService struct {
 	guardianAddress           ethcommon.Address
 	rpcMap                    map[string]string
 	reobservers               interfaces.Reobservers
+	watcherReloader           WatcherReloader
+}
+
+// WatcherReloader reloads the watchers configured in the guardian config file.
+type WatcherReloader interface {
+	Reload() (*watchers.ReloadResult, error)
 }
 
 func NewPrivService(
@@ -91,6 +98,7 @@ func NewPrivService(
 	guardianAddress ethcommon.Address,
 	rpcMap map[string]string,
 	reobservers interfaces.Reobservers,
+	watcherReloader WatcherReloader,
 
 ) *nodePrivilegedService {
 	return &nodePrivilegedService{
@@ -107,6 +115,7 @@ func NewPrivService(
 		guardianAddress:           guardianAddress,
 		rpcMap:                    rpcMap,
 		reobservers:               reobservers,
+		watcherReloader:           watcherReloader,
 	}
 }
 
@@ -1339,6 +1348,71 @@ func (s *nodePrivilegedService) ChainGovernorResetReleaseTimer(_ context.Context
 	}, nil
 }
 
+func (s *nodePrivilegedService) ChainGovernorSetTokenLimit(_ context.Context, req *nodev1.ChainGovernorSetTokenLimitRequest) (*nodev1.ChainGovernorSetTokenLimitResponse, error) {
+	if s.governor == nil {
+		return nil, fmt.Errorf("chain governor is not enabled")
+	}
+
+	emitterChain, err := vaa.KnownChainIDFromNumber(req.EmitterChainId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emitter chain: %w", err)
+	}
+
+	tokenChain, err := vaa.KnownChainIDFromNumber(req.TokenChainId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token chain: %w", err)
+	}
+
+	tokenAddr, err := vaa.StringToAddress(req.TokenAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token address: %w", err)
+	}
+
+	var resp string
+	if req.RemoveOverride {
+		resp, err = s.governor.ResetTokenLimit(emitterChain, tokenChain, tokenAddr)
+	} else {
+		resp, err = s.governor.SetTokenLimit(emitterChain, tokenChain, tokenAddr, req.DailyLimit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodev1.ChainGovernorSetTokenLimitResponse{
+		Response: resp,
+	}, nil
+}
+
+func (s *nodePrivilegedService) ChainGovernorSetCorridorLimit(_ context.Context, req *nodev1.ChainGovernorSetCorridorLimitRequest) (*nodev1.ChainGovernorSetCorridorLimitResponse, error) {
+	if s.governor == nil {
+		return nil, fmt.Errorf("chain governor is not enabled")
+	}
+
+	emitterChain, err := vaa.KnownChainIDFromNumber(req.EmitterChainId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid emitter chain: %w", err)
+	}
+
+	targetChain, err := vaa.KnownChainIDFromNumber(req.TargetChainId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target chain: %w", err)
+	}
+
+	var resp string
+	if req.RemoveOverride {
+		resp, err = s.governor.ResetCorridorLimit(emitterChain, targetChain)
+	} else {
+		resp, err = s.governor.SetCorridorLimit(emitterChain, targetChain, req.DailyLimit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodev1.ChainGovernorSetCorridorLimitResponse{
+		Response: resp,
+	}, nil
+}
+
 // Notary commands
 
 // NotaryBlackholeDelayedMessage blacklists a message from the Notary. It succeeds only if the message is found in the Notary's delayed list.
@@ -1508,6 +1582,97 @@ func (s *nodePrivilegedService) NotaryListBlackholedMessages(ctx context.Context
 	}, nil
 }
 
+// NotaryAnnotateDelayedMessage adds a note from an operator to the review of a delayed message. The operator is
+// identified by the signature of the request, not by the admin socket.
+func (s *nodePrivilegedService) NotaryAnnotateDelayedMessage(ctx context.Context, req *nodev1.NotaryAnnotateDelayedMessageRequest) (*nodev1.NotaryAnnotateDelayedMessageResponse, error) {
+	if s.notary == nil {
+		return nil, ErrNotaryNotEnabled
+	}
+
+	timestamp := time.Unix(int64(req.Timestamp), 0) // #nosec G115 -- Out of range timestamps are rejected as stale
+	err := s.notary.AnnotateDelayedMsg(req.VaaId, req.Note, timestamp, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodev1.NotaryAnnotateDelayedMessageResponse{
+		VaaId:    req.VaaId,
+		Response: "Annotated message",
+	}, nil
+}
+
+// NotaryApproveDelayedMessage records the approval of an operator to release a delayed message. The operator is
+// identified by the signature of the request, not by the admin socket. The message is released once it has the
+// number of approvals required by the Notary.
+func (s *nodePrivilegedService) NotaryApproveDelayedMessage(ctx context.Context, req *nodev1.NotaryApproveDelayedMessageRequest) (*nodev1.NotaryApproveDelayedMessageResponse, error) {
+	if s.notary == nil {
+		return nil, ErrNotaryNotEnabled
+	}
+
+	timestamp := time.Unix(int64(req.Timestamp), 0) // #nosec G115 -- Out of range timestamps are rejected as stale
+	review, released, err := s.notary.ApproveDelayedMsg(req.VaaId, timestamp, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	required := s.notary.RequiredApprovals()
+	response := fmt.Sprintf("Approved message (%d of %d approvals)", len(review.Approvals), required)
+	if required == 0 {
+		response = fmt.Sprintf("Approved message (%d approvals, none required)", len(review.Approvals))
+	}
+	if released {
+		response = fmt.Sprintf("Approved and released message (%d of %d approvals)", len(review.Approvals), required)
+	}
+
+	return &nodev1.NotaryApproveDelayedMessageResponse{
+		VaaId:             req.VaaId,
+		Response:          response,
+		NumApprovals:      uint32(len(review.Approvals)), // #nosec G115 -- The number of operators is small
+		RequiredApprovals: uint32(required),              // #nosec G115 -- Bounded by the uint8 guardiand flag
+		Released:          released,
+	}, nil
+}
+
+// NotaryListDelayedMessageReviews lists all delayed messages together with their annotations and approvals.
+func (s *nodePrivilegedService) NotaryListDelayedMessageReviews(ctx context.Context, req *nodev1.NotaryListDelayedMessageReviewsRequest) (*nodev1.NotaryListDelayedMessageReviewsResponse, error) {
+	if s.notary == nil {
+		return nil, ErrNotaryNotEnabled
+	}
+
+	reviews, err := s.notary.ListDelayedMessageReviews()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list delayed message reviews: %w", err)
+	}
+
+	resp := &nodev1.NotaryListDelayedMessageReviewsResponse{
+		RequiredApprovals: uint32(s.notary.RequiredApprovals()), // #nosec G115 -- Bounded by the uint8 guardiand flag
+		Reviews:           make([]*nodev1.NotaryDelayedMessageReview, 0, len(reviews)),
+	}
+	for _, r := range reviews {
+		review := &nodev1.NotaryDelayedMessageReview{
+			VaaId:       r.PendingMsg.Msg.MessageIDString(),
+			ReleaseTime: r.PendingMsg.ReleaseTime.String(),
+			RuleId:      r.RuleID,
+		}
+		for _, a := range r.Review.Approvals {
+			review.Approvals = append(review.Approvals, &nodev1.NotaryApproval{
+				Operator:  a.Operator,
+				Timestamp: a.Timestamp.String(),
+			})
+		}
+		for _, a := range r.Review.Annotations {
+			review.Annotations = append(review.Annotations, &nodev1.NotaryAnnotation{
+				Operator:  a.Operator,
+				Note:      a.Note,
+				Timestamp: a.Timestamp.String(),
+			})
+		}
+		resp.Reviews = append(resp.Reviews, review)
+	}
+
+	return resp, nil
+}
+
 // Miscellaneous commands
 
 func (s *nodePrivilegedService) PurgePythNetVaas(ctx context.Context, req *nodev1.PurgePythNetVaasRequest) (*nodev1.PurgePythNetVaasResponse, error) {
@@ -1822,3 +1987,26 @@ func (s *nodePrivilegedService) BroadcastDelegateSignatures(ctx context.Context,
 			len(broadcast.Signatures), broadcast.EmitterChain, broadcast.Sequence),
 	}, nil
 }
+
+func (s *nodePrivilegedService) ReloadWatchers(ctx context.Context, req *nodev1.ReloadWatchersRequest) (*nodev1.ReloadWatchersResponse, error) {
+	if s.watcherReloader == nil {
+		return nil, fmt.Errorf("watcher config file is not enabled")
+	}
+
+	result, err := s.watcherReloader.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &nodev1.ReloadWatchersResponse{}
+	for _, id := range result.Started {
+		resp.Started = append(resp.Started, string(id))
+	}
+	for _, id := range result.Stopped {
+		resp.Stopped = append(resp.Stopped, string(id))
+	}
+	for _, id := range result.Updated {
+		resp.Updated = append(resp.Updated, string(id))
+	}
+	return resp, nil
+}
@@ -0,0 +1,107 @@
+package db
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// aggregationStatePrefix maps the signing digest of a message to the signatures the processor collected for it
+// before quorum: AGGSTATE:V1:<digest>.
+const aggregationStatePrefix = "AGGSTATE:V1:"
+
+// AggregationState is the part of the processor's aggregation state for a message digest that is kept across restarts.
+type AggregationState struct {
+	Digest []byte
+	// FirstObserved is the first time the digest was seen.
+	FirstObserved time.Time
+	// NextRetry is the earliest time a re-observation request may be sent.
+	NextRetry time.Time
+	// RetryCtr is the number of re-observation requests sent.
+	RetryCtr uint
+	// Settled is set once the misses were counted for the digest.
+	Settled bool
+	// Source is the description of the source of the message used for metrics.
+	Source string
+	// Signatures are the signatures collected by guardian, including ours.
+	Signatures map[ethCommon.Address][]byte
+	// TxHash is the hash of the transaction that emitted the message, if we observed it.
+	TxHash []byte
+	// Observation is our unsigned VAA, or nil if we have not observed the message.
+	Observation   []byte
+	Unreliable    bool
+	Reobservation bool
+	// GuardianSetIndex and GuardianSetKeys are the guardian set valid when we observed the message.
+	GuardianSetIndex uint32
+	GuardianSetKeys  []ethCommon.Address
+}
+
+func aggregationStateKey(digest []byte) []byte {
+	return []byte(aggregationStatePrefix + hex.EncodeToString(digest))
+}
+
+// UpdateAggregationStates stores the given aggregation states and deletes the ones of the given digests in a single batch.
+func (d *Database) UpdateAggregationStates(states []*AggregationState, deleted [][]byte) error {
+	if len(states) == 0 && len(deleted) == 0 {
+		return nil
+	}
+
+	batchTx := d.db.NewWriteBatch()
+	defer batchTx.Cancel()
+
+	for _, s := range states {
+		b, err := json.Marshal(s)
+		if err != nil {
+			return fmt.Errorf("failed to marshal aggregation state %x: %w", s.Digest, err)
+		}
+		if err := batchTx.Set(aggregationStateKey(s.Digest), b); err != nil {
+			return err
+		}
+	}
+
+	for _, digest := range deleted {
+		if err := batchTx.Delete(aggregationStateKey(digest)); err != nil {
+			return err
+		}
+	}
+
+	return batchTx.Flush()
+}
+
+// LoadAggregationStates returns all the stored aggregation states. Entries that can't be decoded are logged and skipped.
+func (d *Database) LoadAggregationStates(logger *zap.Logger) ([]*AggregationState, error) {
+	states := []*AggregationState{}
+	prefix := []byte(aggregationStatePrefix)
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			var s AggregationState
+			if err := json.Unmarshal(val, &s); err != nil {
+				logger.Error("failed to unmarshal aggregation state", zap.String("key", string(item.Key())), zap.Error(err))
+				continue
+			}
+			states = append(states, &s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aggregation states: %w", err)
+	}
+
+	return states, nil
+}
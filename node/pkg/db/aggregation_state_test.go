@@ -0,0 +1,65 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAggregationStates(t *testing.T) {
+	db := OpenDb(zap.NewNop(), nil)
+	defer db.Close()
+
+	states, err := db.LoadAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, states)
+
+	v := getVAA()
+	observation, err := v.Marshal()
+	require.NoError(t, err)
+
+	guardian1 := ethCommon.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")
+	guardian2 := ethCommon.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157")
+	firstObserved := time.Unix(1700000000, 0).UTC()
+
+	ours := &AggregationState{
+		Digest:        []byte{0x01, 0x02},
+		FirstObserved: firstObserved,
+		NextRetry:     firstObserved.Add(5 * time.Minute),
+		RetryCtr:      2,
+		Settled:       true,
+		Source:        "solana",
+		Signatures: map[ethCommon.Address][]byte{
+			guardian1: {0xaa},
+			guardian2: {0xbb},
+		},
+		TxHash:           []byte{0x03},
+		Observation:      observation,
+		Reobservation:    true,
+		GuardianSetIndex: 4,
+		GuardianSetKeys:  []ethCommon.Address{guardian1, guardian2},
+	}
+	theirs := &AggregationState{
+		Digest:        []byte{0x04},
+		FirstObserved: firstObserved,
+		NextRetry:     firstObserved,
+		Source:        "unknown",
+		Signatures:    map[ethCommon.Address][]byte{guardian2: {0xcc}},
+	}
+
+	require.NoError(t, db.UpdateAggregationStates([]*AggregationState{ours, theirs}, nil))
+	states, err = db.LoadAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*AggregationState{ours, theirs}, states)
+
+	// Stores and deletes are applied together.
+	ours.RetryCtr = 3
+	require.NoError(t, db.UpdateAggregationStates([]*AggregationState{ours}, [][]byte{theirs.Digest}))
+	states, err = db.LoadAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, []*AggregationState{ours}, states)
+}
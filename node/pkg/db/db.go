@@ -1,6 +1,7 @@
 package db
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"strconv"
@@ -20,6 +21,8 @@ var storedVaaTotal = promauto.NewCounter(
 
 type Database struct {
 	db *badger.DB
+	// txHashIndex is set if the transaction hash index is enabled, see txhash.go.
+	txHashIndex bool
 }
 
 type VAAID struct {
@@ -67,6 +70,10 @@ func VaaIDFromVAA(v *vaa.VAA) *VAAID {
 	}
 }
 
+// lastSequencePrefix maps an emitter to the highest sequence number of its stored VAAs, as 8 big-endian bytes:
+// LASTSEQ:V1:<chain>/<emitter>.
+const lastSequencePrefix = "LASTSEQ:V1:"
+
 var (
 	ErrVAANotFound = errors.New("requested VAA not found in store")
 	nullAddr       = vaa.Address{}
@@ -105,7 +112,7 @@ func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 		if err := txn.Set(VaaIDFromVAA(v).Bytes(), b); err != nil {
 			return err
 		}
-		return nil
+		return updateLastSequence(txn, *VaaIDFromVAA(v))
 	})
 
 	if err != nil {
@@ -143,7 +150,60 @@ func (d *Database) StoreSignedVAABatch(vaaBatch []*vaa.VAA) error {
 	// Wait for the batch to finish.
 	err := batchTx.Flush()
 	storedVaaTotal.Add(float64(len(vaaBatch)))
-	return err
+	if err != nil {
+		return err
+	}
+
+	// The last sequence numbers need a read, which the batch API doesn't support, so they are updated once per emitter.
+	last := make(map[VAAID]uint64)
+	for _, v := range vaaBatch {
+		emitter := VAAID{EmitterChain: v.EmitterChain, EmitterAddress: v.EmitterAddress}
+		last[emitter] = max(last[emitter], v.Sequence)
+	}
+	return d.db.Update(func(txn *badger.Txn) error {
+		for emitter, seq := range last {
+			emitter.Sequence = seq
+			if err := updateLastSequence(txn, emitter); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// updateLastSequence records the sequence number of a stored VAA as the last one of its emitter, unless a higher one
+// is already recorded.
+func updateLastSequence(txn *badger.Txn, id VAAID) error {
+	key := lastSequenceKey(id)
+	item, err := txn.Get(key)
+	if err == nil {
+		last, err := decodeLastSequence(item)
+		if err != nil {
+			return err
+		}
+		if last >= id.Sequence {
+			return nil
+		}
+	} else if !errors.Is(err, badger.ErrKeyNotFound) {
+		return err
+	}
+
+	return txn.Set(key, binary.BigEndian.AppendUint64(nil, id.Sequence))
+}
+
+func lastSequenceKey(id VAAID) []byte {
+	return []byte(fmt.Sprintf("%s%d/%s", lastSequencePrefix, id.EmitterChain, id.EmitterAddress))
+}
+
+func decodeLastSequence(item *badger.Item) (last uint64, err error) {
+	err = item.Value(func(val []byte) error {
+		if len(val) != 8 {
+			return fmt.Errorf("invalid last sequence number of %s", string(item.Key()))
+		}
+		last = binary.BigEndian.Uint64(val)
+		return nil
+	})
+	return
 }
 
 func (d *Database) HasVAA(id VAAID) (bool, error) {
@@ -181,6 +241,66 @@ func (d *Database) GetSignedVAABytes(id VAAID) (b []byte, err error) {
 	return
 }
 
+// GetSignedVAABytesBySequenceRange returns up to limit VAAs of the emitter, with a sequence number from first to last
+// inclusive, ordered by sequence number. The sequence numbers are looked up one by one, since the keys are ordered
+// lexicographically rather than numerically. If the range was not exhausted, it also returns the sequence number to
+// resume from.
+func (d *Database) GetSignedVAABytesBySequenceRange(emitter VAAID, first uint64, last uint64, limit int) (vaas [][]byte, next uint64, more bool, err error) {
+	vaas = [][]byte{}
+	if first > last || limit <= 0 {
+		return
+	}
+
+	err = d.db.View(func(txn *badger.Txn) error {
+		id := emitter
+		for id.Sequence = first; len(vaas) < limit; id.Sequence++ {
+			item, err := txn.Get(id.Bytes())
+			if err == nil {
+				b, err := item.ValueCopy(nil)
+				if err != nil {
+					return err
+				}
+				vaas = append(vaas, b)
+			} else if !errors.Is(err, badger.ErrKeyNotFound) {
+				return err
+			}
+
+			if id.Sequence == last {
+				return nil
+			}
+		}
+
+		next, more = id.Sequence, true
+		return nil
+	})
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return
+}
+
+// GetLastSignedVAASequence returns the highest sequence number of the VAAs stored for the emitter, and whether it is
+// recorded. It is recorded when a VAA is stored, so it is missing for an emitter whose VAAs were all stored by an
+// older release. Purging VAAs does not change it.
+func (d *Database) GetLastSignedVAASequence(emitter VAAID) (last uint64, found bool, err error) {
+	err = d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(lastSequenceKey(emitter))
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+		last, err = decodeLastSequence(item)
+		return err
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return
+}
+
 func (d *Database) FindEmitterSequenceGap(prefix VAAID) (resp []uint64, firstSeq uint64, lastSeq uint64, err error) {
 	resp = make([]uint64, 0)
 	if err = d.db.View(func(txn *badger.Txn) error {
@@ -249,6 +249,60 @@ func TestFindEmitterSequenceGap(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetLastSignedVAASequence(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	testVaa := getVAA()
+	vaaID := VaaIDFromVAA(&testVaa)
+
+	_, found, err := db.GetLastSignedVAASequence(*vaaID)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	testVaa.AddSignature(privKey, 0)
+
+	// A lower sequence number stored later doesn't replace the last one.
+	for _, seq := range []uint64{9, 10, 2} {
+		testVaa.Sequence = seq
+		require.NoError(t, db.StoreSignedVAA(&testVaa))
+	}
+
+	last, found, err := db.GetLastSignedVAASequence(*vaaID)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(10), last)
+
+	// Batches record the highest sequence number of each emitter.
+	other := getVAA()
+	other.EmitterAddress = vaa.Address{0x02}
+	other.AddSignature(privKey, 0)
+	batch := []*vaa.VAA{}
+	for _, v := range []struct {
+		vaa vaa.VAA
+		seq uint64
+	}{{testVaa, 12}, {testVaa, 11}, {other, 5}} {
+		v.vaa.Sequence = v.seq
+		batch = append(batch, &v.vaa)
+	}
+	require.NoError(t, db.StoreSignedVAABatch(batch))
+
+	last, found, err = db.GetLastSignedVAASequence(*vaaID)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(12), last)
+
+	last, found, err = db.GetLastSignedVAASequence(*VaaIDFromVAA(&other))
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, uint64(5), last)
+}
+
 // BenchmarkVaaLookup benchmarks db.GetSignedVAABytes
 // You need to set the environment variable WH_DBPATH to a path with a populated BadgerDB.
 // You may want to play with the CONCURRENCY parameter.
@@ -20,6 +20,11 @@ type GovernorDB interface {
 	DeleteTransfer(t *Transfer) error
 	DeletePendingMsg(k *PendingTransfer) error
 	GetChainGovernorData(logger *zap.Logger) (transfers []*Transfer, pending []*PendingTransfer, err error)
+	StoreTokenLimit(l *TokenLimit) error
+	DeleteTokenLimit(l *TokenLimit) error
+	StoreCorridorLimit(l *CorridorLimit) error
+	DeleteCorridorLimit(l *CorridorLimit) error
+	GetGovernorLimits() (tokenLimits []*TokenLimit, corridorLimits []*CorridorLimit, err error)
 }
 
 type MockGovernorDB struct {
@@ -45,6 +50,26 @@ func (d *MockGovernorDB) GetChainGovernorData(logger *zap.Logger) (transfers []*
 	return nil, nil, nil
 }
 
+func (d *MockGovernorDB) StoreTokenLimit(l *TokenLimit) error {
+	return nil
+}
+
+func (d *MockGovernorDB) DeleteTokenLimit(l *TokenLimit) error {
+	return nil
+}
+
+func (d *MockGovernorDB) StoreCorridorLimit(l *CorridorLimit) error {
+	return nil
+}
+
+func (d *MockGovernorDB) DeleteCorridorLimit(l *CorridorLimit) error {
+	return nil
+}
+
+func (d *MockGovernorDB) GetGovernorLimits() (tokenLimits []*TokenLimit, corridorLimits []*CorridorLimit, err error) {
+	return nil, nil, nil
+}
+
 // Transfer represents a completed transfer that has been processed by the Governor during its sliding window.
 type Transfer struct {
 	// This value is generated by the Governor. It is not read from the blockchain transaction. It represents the
@@ -235,6 +260,97 @@ func UnmarshalPendingTransfer(data []byte, isOld bool) (*PendingTransfer, error)
 	return p, nil
 }
 
+// TokenLimit is a daily limit on the notional value of a token leaving a chain. It is set by an admin command and
+// overrides the limit in the Governor config, if any.
+type TokenLimit struct {
+	EmitterChain vaa.ChainID
+	// Where the asset was minted
+	OriginChain   vaa.ChainID
+	OriginAddress vaa.Address
+	// Daily limit in USD
+	DailyLimit uint64
+}
+
+// Marshal serializes a TokenLimit.
+func (l *TokenLimit) Marshal() []byte {
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, l.EmitterChain)
+	vaa.MustWrite(buf, binary.BigEndian, l.OriginChain)
+	buf.Write(l.OriginAddress[:])
+	vaa.MustWrite(buf, binary.BigEndian, l.DailyLimit)
+	return buf.Bytes()
+}
+
+// UnmarshalTokenLimit deserializes a TokenLimit.
+func UnmarshalTokenLimit(data []byte) (*TokenLimit, error) {
+	l := &TokenLimit{}
+	reader := bytes.NewReader(data)
+
+	if err := binary.Read(reader, binary.BigEndian, &l.EmitterChain); err != nil {
+		return nil, fmt.Errorf("failed to read emitter chain id: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &l.OriginChain); err != nil {
+		return nil, fmt.Errorf("failed to read origin chain id: %w", err)
+	}
+
+	if n, err := reader.Read(l.OriginAddress[:]); err != nil || n != 32 {
+		return nil, fmt.Errorf("failed to read origin address [%d]: %w", n, err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &l.DailyLimit); err != nil {
+		return nil, fmt.Errorf("failed to read daily limit: %w", err)
+	}
+
+	if reader.Len() != 0 {
+		return nil, fmt.Errorf("token limit has %d trailing bytes", reader.Len())
+	}
+
+	return l, nil
+}
+
+// CorridorLimit is a daily limit on the notional value sent from an emitter chain to a target chain. It is set by an
+// admin command and overrides the limit in the Governor config, if any.
+type CorridorLimit struct {
+	EmitterChain vaa.ChainID
+	TargetChain  vaa.ChainID
+	// Daily limit in USD
+	DailyLimit uint64
+}
+
+// Marshal serializes a CorridorLimit.
+func (l *CorridorLimit) Marshal() []byte {
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, l.EmitterChain)
+	vaa.MustWrite(buf, binary.BigEndian, l.TargetChain)
+	vaa.MustWrite(buf, binary.BigEndian, l.DailyLimit)
+	return buf.Bytes()
+}
+
+// UnmarshalCorridorLimit deserializes a CorridorLimit.
+func UnmarshalCorridorLimit(data []byte) (*CorridorLimit, error) {
+	l := &CorridorLimit{}
+	reader := bytes.NewReader(data)
+
+	if err := binary.Read(reader, binary.BigEndian, &l.EmitterChain); err != nil {
+		return nil, fmt.Errorf("failed to read emitter chain id: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &l.TargetChain); err != nil {
+		return nil, fmt.Errorf("failed to read target chain id: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &l.DailyLimit); err != nil {
+		return nil, fmt.Errorf("failed to read daily limit: %w", err)
+	}
+
+	if reader.Len() != 0 {
+		return nil, fmt.Errorf("corridor limit has %d trailing bytes", reader.Len())
+	}
+
+	return l, nil
+}
+
 // These constants are used when the message format changes. It allows the governor to support both formats.
 // This is important because when the message format changes and the Guardians are restarted, the existing
 // messages will be stored in the old format. Both message formats need to be supported for duration of the
@@ -256,6 +372,10 @@ const (
 	oldPendingLen    = len(oldPendingPrefix)
 	pendingPrefix    = "GOV:PENDING5:"
 	pendingLen       = len(pendingPrefix)
+
+	// Limits set by admin commands.
+	tokenLimitPrefix    = "GOV:TOKENLIMIT1:"
+	corridorLimitPrefix = "GOV:CORRIDORLIMIT1:"
 )
 
 // Since we are changing the DB format of pending entries, we will use a new tag in the pending key field.
@@ -280,6 +400,14 @@ func oldPendingMsgID(k *common.MessagePublication) []byte {
 	return []byte(fmt.Sprintf("%v%v", oldPendingPrefix, k.MessageIDString()))
 }
 
+func TokenLimitID(l *TokenLimit) []byte {
+	return []byte(fmt.Sprintf("%v%d/%d/%v", tokenLimitPrefix, l.EmitterChain, l.OriginChain, l.OriginAddress))
+}
+
+func CorridorLimitID(l *CorridorLimit) []byte {
+	return []byte(fmt.Sprintf("%v%d/%d", corridorLimitPrefix, l.EmitterChain, l.TargetChain))
+}
+
 func IsTransfer(keyBytes []byte) bool {
 	return (len(keyBytes) >= transferLen+common.MinMsgIdLen) && (string(keyBytes[0:transferLen]) == transferPrefix)
 }
@@ -469,3 +597,91 @@ func (d *Database) DeletePendingMsg(pending *PendingTransfer) error {
 
 	return nil
 }
+
+// This is called by the chain governor to persist a token limit set by an admin command.
+func (d *Database) StoreTokenLimit(l *TokenLimit) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(TokenLimitID(l), l.Marshal())
+	}); err != nil {
+		return fmt.Errorf("failed to commit token limit tx: %w", err)
+	}
+
+	return nil
+}
+
+// This is called by the chain governor to delete a token limit, restoring the configured one.
+func (d *Database) DeleteTokenLimit(l *TokenLimit) error {
+	key := TokenLimitID(l)
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	}); err != nil {
+		return fmt.Errorf("failed to delete token limit for key [%v]: %w", key, err)
+	}
+
+	return nil
+}
+
+// This is called by the chain governor to persist a corridor limit set by an admin command.
+func (d *Database) StoreCorridorLimit(l *CorridorLimit) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(CorridorLimitID(l), l.Marshal())
+	}); err != nil {
+		return fmt.Errorf("failed to commit corridor limit tx: %w", err)
+	}
+
+	return nil
+}
+
+// This is called by the chain governor to delete a corridor limit, restoring the configured one.
+func (d *Database) DeleteCorridorLimit(l *CorridorLimit) error {
+	key := CorridorLimitID(l)
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	}); err != nil {
+		return fmt.Errorf("failed to delete corridor limit for key [%v]: %w", key, err)
+	}
+
+	return nil
+}
+
+// This is called by the chain governor on start up to reload the limits set by admin commands.
+func (d *Database) GetGovernorLimits() (tokenLimits []*TokenLimit, corridorLimits []*CorridorLimit, err error) {
+	err = d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchSize = 10
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		prefix := []byte(tokenLimitPrefix)
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			l, err := UnmarshalTokenLimit(val)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal token limit for key [%s]: %w", it.Item().Key(), err)
+			}
+			tokenLimits = append(tokenLimits, l)
+		}
+
+		prefix = []byte(corridorLimitPrefix)
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			l, err := UnmarshalCorridorLimit(val)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal corridor limit for key [%s]: %w", it.Item().Key(), err)
+			}
+			corridorLimits = append(corridorLimits, l)
+		}
+
+		return nil
+	})
+
+	return
+}
@@ -868,3 +868,71 @@ func TestUnmarshalPendingTransferFailures(t *testing.T) {
 	_, err = UnmarshalPendingTransfer(pending1Bytes[0:len(pending1Bytes)-10], false)
 	assert.ErrorContains(t, err, "failed to unmarshal pending transfer msg")
 }
+
+func TestStoreAndReloadGovernorLimits(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	tokenAddr, err := vaa.StringToAddress("0x707f9118e33a9b8998bea41dd0d46f38bb963fc8")
+	require.NoError(t, err)
+
+	tokenLimit1 := &TokenLimit{EmitterChain: vaa.ChainIDEthereum, OriginChain: vaa.ChainIDEthereum, OriginAddress: tokenAddr, DailyLimit: 1000}
+	tokenLimit2 := &TokenLimit{EmitterChain: vaa.ChainIDSolana, OriginChain: vaa.ChainIDEthereum, OriginAddress: tokenAddr, DailyLimit: 0}
+	corridorLimit := &CorridorLimit{EmitterChain: vaa.ChainIDEthereum, TargetChain: vaa.ChainIDSui, DailyLimit: 5000}
+
+	require.NoError(t, db.StoreTokenLimit(tokenLimit1))
+	require.NoError(t, db.StoreTokenLimit(tokenLimit2))
+	require.NoError(t, db.StoreCorridorLimit(corridorLimit))
+
+	// Storing a limit again overwrites it.
+	tokenLimit1.DailyLimit = 2000
+	require.NoError(t, db.StoreTokenLimit(tokenLimit1))
+
+	tokenLimits, corridorLimits, err := db.GetGovernorLimits()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*TokenLimit{tokenLimit1, tokenLimit2}, tokenLimits)
+	assert.Equal(t, []*CorridorLimit{corridorLimit}, corridorLimits)
+
+	// The limits are not mistaken for transfers or pending messages.
+	transfers, pending, err := db.GetChainGovernorData(zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, transfers)
+	assert.Empty(t, pending)
+
+	require.NoError(t, db.DeleteTokenLimit(tokenLimit2))
+	require.NoError(t, db.DeleteCorridorLimit(corridorLimit))
+
+	tokenLimits, corridorLimits, err = db.GetGovernorLimits()
+	require.NoError(t, err)
+	assert.Equal(t, []*TokenLimit{tokenLimit1}, tokenLimits)
+	assert.Empty(t, corridorLimits)
+}
+
+func TestUnmarshalGovernorLimitFailures(t *testing.T) {
+	tokenLimit := &TokenLimit{EmitterChain: vaa.ChainIDEthereum, OriginChain: vaa.ChainIDSolana, DailyLimit: 1000}
+	b := tokenLimit.Marshal()
+
+	l, err := UnmarshalTokenLimit(b)
+	require.NoError(t, err)
+	assert.Equal(t, tokenLimit, l)
+
+	_, err = UnmarshalTokenLimit(b[:len(b)-1])
+	require.Error(t, err)
+	_, err = UnmarshalTokenLimit(append(b, 0))
+	require.Error(t, err)
+
+	corridorLimit := &CorridorLimit{EmitterChain: vaa.ChainIDEthereum, TargetChain: vaa.ChainIDSui, DailyLimit: 1000}
+	b = corridorLimit.Marshal()
+
+	c, err := UnmarshalCorridorLimit(b)
+	require.NoError(t, err)
+	assert.Equal(t, corridorLimit, c)
+
+	_, err = UnmarshalCorridorLimit(b[:3])
+	require.Error(t, err)
+	_, err = UnmarshalCorridorLimit(append(b, 0))
+	require.Error(t, err)
+}
@@ -4,9 +4,12 @@ package db
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/dgraph-io/badger/v3"
@@ -19,6 +22,13 @@ type NotaryDBInterface interface {
 	DeleteBlackholed(msgID []byte) (*common.MessagePublication, error)
 	DeleteDelayed(msgID []byte) (*common.PendingMessage, error)
 	LoadAll(logger *zap.Logger) (*NotaryLoadResult, error)
+	StoreVerdictRule(msgID []byte, ruleID string) error
+	GetVerdictRule(msgID []byte) (string, error)
+	DeleteVerdictRule(msgID []byte) error
+	AddAnnotation(msgID []byte, a NotaryAnnotation) (*NotaryReview, error)
+	AddApproval(msgID []byte, a NotaryApproval) (*NotaryReview, error)
+	CloseReview(msgID []byte, o NotaryReviewOutcome) (*NotaryReview, error)
+	GetReview(msgID []byte) (*NotaryReview, error)
 }
 
 // NotaryDB is a wrapper struct for a database connection.
@@ -38,6 +48,10 @@ func NewNotaryDB(dbConn *badger.DB) *NotaryDB {
 const (
 	delayedPrefix   = "NOTARY:DELAY:V1:"
 	blackholePrefix = "NOTARY:BLACKHOLE:V1:"
+	// verdictRulePrefix maps a message ID to the ID of the policy rule that decided its verdict.
+	verdictRulePrefix = "NOTARY:RULE:V1:"
+	// reviewPrefix maps a message ID to the append-only review record of a delayed message.
+	reviewPrefix = "NOTARY:REVIEW:V1:"
 )
 
 // The type of data stored in the Notary's database.
@@ -47,6 +61,10 @@ const (
 	Unknown    dataType = "unknown"
 	Delayed    dataType = "delayed"
 	Blackholed dataType = "blackholed"
+	// VerdictRule entries record which policy rule caused a message to be delayed or blackholed.
+	VerdictRule dataType = "rule"
+	// Review entries hold the append-only review records of delayed messages.
+	Review dataType = "review"
 )
 
 var (
@@ -154,6 +172,208 @@ func (d *NotaryDB) DeleteBlackholed(msgID []byte) (*common.MessagePublication, e
 	return &msgPub, nil
 }
 
+// StoreVerdictRule records the ID of the policy rule that caused the verdict for a message.
+func (d *NotaryDB) StoreVerdictRule(msgID []byte, ruleID string) error {
+	key := verdictRuleKey(msgID)
+	if updateErr := d.update(key, []byte(ruleID)); updateErr != nil {
+		return &DBError{Op: OpUpdate, Key: key, Err: updateErr}
+	}
+	return nil
+}
+
+// GetVerdictRule returns the ID of the policy rule that caused the verdict for a message. It returns an empty string
+// if the verdict was not caused by a policy rule.
+func (d *NotaryDB) GetVerdictRule(msgID []byte) (string, error) {
+	key := verdictRuleKey(msgID)
+	var ruleID []byte
+	viewErr := d.db.View(func(txn *badger.Txn) error {
+		item, getErr := txn.Get(key)
+		if getErr != nil {
+			return getErr
+		}
+		var copyErr error
+		ruleID, copyErr = item.ValueCopy(nil)
+		return copyErr
+	})
+
+	if errors.Is(viewErr, badger.ErrKeyNotFound) {
+		return "", nil
+	}
+	if viewErr != nil {
+		return "", &DBError{Op: OpRead, Key: key, Err: viewErr}
+	}
+
+	return string(ruleID), nil
+}
+
+// DeleteVerdictRule deletes the ID of the policy rule that caused the verdict for a message. It is a no-op if no rule
+// was recorded for the message.
+func (d *NotaryDB) DeleteVerdictRule(msgID []byte) error {
+	key := verdictRuleKey(msgID)
+	if updateErr := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	}); updateErr != nil {
+		return &DBError{Op: OpDelete, Key: key, Err: updateErr}
+	}
+	return nil
+}
+
+// NotaryReview is the review record of a delayed message: the notes left by operators, the operators that approved
+// its release, and the outcome of each review. The record is append-only. When a message stops being delayed, its
+// review is closed rather than deleted, and a new review starts if the message is delayed again.
+type NotaryReview struct {
+	Annotations []NotaryAnnotation    `json:"annotations"`
+	Approvals   []NotaryApproval      `json:"approvals"`
+	Outcomes    []NotaryReviewOutcome `json:"outcomes"`
+}
+
+// NotaryAnnotation is a note left by an operator on a delayed message.
+type NotaryAnnotation struct {
+	Operator  string    `json:"operator"`
+	Note      string    `json:"note"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NotaryApproval is a vote by an operator to release a delayed message.
+type NotaryApproval struct {
+	Operator  string    `json:"operator"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// NotaryReviewOutcome records that a delayed message was released or blackholed, which closes its current review.
+type NotaryReviewOutcome struct {
+	Outcome   string    `json:"outcome"`
+	Timestamp time.Time `json:"timestamp"`
+	// NumAnnotations and NumApprovals are the number of annotations and approvals in the record when the review was
+	// closed. Later ones belong to the next review.
+	NumAnnotations int `json:"numAnnotations"`
+	NumApprovals   int `json:"numApprovals"`
+}
+
+// Current returns the open review of the message, i.e. the annotations and approvals recorded since the last outcome.
+func (r *NotaryReview) Current() *NotaryReview {
+	current := &NotaryReview{}
+	numAnnotations, numApprovals := 0, 0
+	if len(r.Outcomes) > 0 {
+		last := r.Outcomes[len(r.Outcomes)-1]
+		numAnnotations, numApprovals = last.NumAnnotations, last.NumApprovals
+	}
+	if numAnnotations < len(r.Annotations) {
+		current.Annotations = slices.Clone(r.Annotations[numAnnotations:])
+	}
+	if numApprovals < len(r.Approvals) {
+		current.Approvals = slices.Clone(r.Approvals[numApprovals:])
+	}
+	return current
+}
+
+// IsEmpty returns true if the review has no annotations, approvals or outcomes.
+func (r *NotaryReview) IsEmpty() bool {
+	return len(r.Annotations) == 0 && len(r.Approvals) == 0 && len(r.Outcomes) == 0
+}
+
+// HasApproved returns true if the operator has already approved the release of the message.
+func (r *NotaryReview) HasApproved(operator string) bool {
+	return slices.ContainsFunc(r.Approvals, func(a NotaryApproval) bool {
+		return a.Operator == operator
+	})
+}
+
+// Operators returns the operators that approved the release of the message.
+func (r *NotaryReview) Operators() []string {
+	operators := make([]string, 0, len(r.Approvals))
+	for _, a := range r.Approvals {
+		operators = append(operators, a.Operator)
+	}
+	return operators
+}
+
+// AddAnnotation appends an annotation to the review record of a delayed message and returns the updated record.
+func (d *NotaryDB) AddAnnotation(msgID []byte, a NotaryAnnotation) (*NotaryReview, error) {
+	return d.appendReview(msgID, func(r *NotaryReview) {
+		r.Annotations = append(r.Annotations, a)
+	})
+}
+
+// AddApproval appends an approval to the review record of a delayed message and returns the updated record.
+func (d *NotaryDB) AddApproval(msgID []byte, a NotaryApproval) (*NotaryReview, error) {
+	return d.appendReview(msgID, func(r *NotaryReview) {
+		r.Approvals = append(r.Approvals, a)
+	})
+}
+
+// CloseReview appends an outcome to the review record of a message, which closes its current review, and returns the
+// updated record. The counts of annotations and approvals of the outcome are set from the record.
+func (d *NotaryDB) CloseReview(msgID []byte, o NotaryReviewOutcome) (*NotaryReview, error) {
+	return d.appendReview(msgID, func(r *NotaryReview) {
+		o.NumAnnotations = len(r.Annotations)
+		o.NumApprovals = len(r.Approvals)
+		r.Outcomes = append(r.Outcomes, o)
+	})
+}
+
+// appendReview reads the review record of a message, applies add to it and stores it, in a single transaction.
+func (d *NotaryDB) appendReview(msgID []byte, add func(r *NotaryReview)) (*NotaryReview, error) {
+	key := reviewKey(msgID)
+	var r NotaryReview
+	updateErr := d.db.Update(func(txn *badger.Txn) error {
+		item, getErr := txn.Get(key)
+		if getErr != nil && !errors.Is(getErr, badger.ErrKeyNotFound) {
+			return getErr
+		}
+		if getErr == nil {
+			data, copyErr := item.ValueCopy(nil)
+			if copyErr != nil {
+				return copyErr
+			}
+			if unmarshalErr := json.Unmarshal(data, &r); unmarshalErr != nil {
+				return errors.Join(ErrUnmarshal, unmarshalErr)
+			}
+		}
+
+		add(&r)
+
+		b, marshalErr := json.Marshal(&r)
+		if marshalErr != nil {
+			return errors.Join(ErrMarshal, marshalErr)
+		}
+		return txn.Set(key, b)
+	})
+	if updateErr != nil {
+		return nil, &DBError{Op: OpUpdate, Key: key, Err: updateErr}
+	}
+	return &r, nil
+}
+
+// GetReview returns the review record of a message. It returns an empty record if the message has never been
+// reviewed.
+func (d *NotaryDB) GetReview(msgID []byte) (*NotaryReview, error) {
+	key := reviewKey(msgID)
+	var data []byte
+	viewErr := d.db.View(func(txn *badger.Txn) error {
+		item, getErr := txn.Get(key)
+		if getErr != nil {
+			return getErr
+		}
+		var copyErr error
+		data, copyErr = item.ValueCopy(nil)
+		return copyErr
+	})
+
+	if errors.Is(viewErr, badger.ErrKeyNotFound) {
+		return &NotaryReview{}, nil
+	}
+	if viewErr != nil {
+		return nil, &DBError{Op: OpRead, Key: key, Err: viewErr}
+	}
+
+	var r NotaryReview
+	if unmarshalErr := json.Unmarshal(data, &r); unmarshalErr != nil {
+		return nil, errors.Join(ErrUnmarshal, unmarshalErr)
+	}
+	return &r, nil
+}
+
 type NotaryLoadResult struct {
 	Delayed    []*common.PendingMessage
 	Blackholed []*common.MessagePublication
@@ -199,6 +419,9 @@ func (d *NotaryDB) LoadAll(logger *zap.Logger) (*NotaryLoadResult, error) {
 					)
 				}
 				result.Delayed = append(result.Delayed, &pMsg)
+			case VerdictRule, Review:
+				// Policy rules and reviews are looked up per message and do not need to be loaded.
+				continue
 			case Unknown:
 				// The key-value store is shared across other modules and message types (e.g. Governor, Accountant).
 				// If another key is discovered, just ignore it.
@@ -226,6 +449,12 @@ func dbDataType(key []byte) dataType {
 	if strings.HasPrefix(string(key), delayedPrefix) {
 		return Delayed
 	}
+	if strings.HasPrefix(string(key), verdictRulePrefix) {
+		return VerdictRule
+	}
+	if strings.HasPrefix(string(key), reviewPrefix) {
+		return Review
+	}
 	return Unknown
 
 }
@@ -287,6 +516,16 @@ func blackholeKey(msgID []byte) []byte {
 	return key(blackholePrefix, string(msgID))
 }
 
+// verdictRuleKey returns a unique prefix for the policy rule recorded for a message in the Notary's database.
+func verdictRuleKey(msgID []byte) []byte {
+	return key(verdictRulePrefix, string(msgID))
+}
+
+// reviewKey returns a unique prefix for the review record of a delayed message in the Notary's database.
+func reviewKey(msgID []byte) []byte {
+	return key(reviewPrefix, string(msgID))
+}
+
 // key returns a unique prefix for different data types stored in the Notary's database.
 func key(prefix string, msgID string) (key []byte) {
 	return fmt.Appendf(key, "%v%v", prefix, msgID)
@@ -113,6 +113,108 @@ func TestKeysForStoredMessagesV1(t *testing.T) {
 		[]byte("NOTARY:BLACKHOLE:V1:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"),
 		blackholeKey(msg1.MessageID()),
 	)
+
+	require.Equal(
+		t,
+		[]byte("NOTARY:RULE:V1:2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415"),
+		verdictRuleKey(msg1.MessageID()),
+	)
+}
+
+// TestVerdictRule tests that the policy rule recorded for a message can be retrieved,
+// and that it does not interfere with loading the delayed and blackholed messages.
+func TestVerdictRule(t *testing.T) {
+	t.Parallel()
+
+	// Set-up.
+	dbPath := t.TempDir()
+	database := OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+	defer os.Remove(dbPath)
+	nDB := NotaryDB{db: database.db}
+
+	msg := makeNewMsgPub(t)
+	pendingMsg := makeNewPendingMsg(t, msg)
+	require.NoError(t, nDB.StoreDelayed(pendingMsg))
+
+	// No rule is recorded by default.
+	ruleID, getErr := nDB.GetVerdictRule(msg.MessageID())
+	require.NoError(t, getErr)
+	require.Empty(t, ruleID)
+
+	require.NoError(t, nDB.StoreVerdictRule(msg.MessageID(), "delay-large-transfers"))
+	ruleID, getErr = nDB.GetVerdictRule(msg.MessageID())
+	require.NoError(t, getErr)
+	require.Equal(t, "delay-large-transfers", ruleID)
+
+	res, loadErr := nDB.LoadAll(zap.NewNop())
+	require.NoError(t, loadErr)
+	require.Equal(t, 1, len(res.Delayed))
+	require.Equal(t, 0, len(res.Blackholed))
+
+	require.NoError(t, nDB.DeleteVerdictRule(msg.MessageID()))
+	ruleID, getErr = nDB.GetVerdictRule(msg.MessageID())
+	require.NoError(t, getErr)
+	require.Empty(t, ruleID)
+
+	// Deleting a missing rule is a no-op.
+	require.NoError(t, nDB.DeleteVerdictRule(msg.MessageID()))
+}
+
+// TestReview tests appending to and retrieving the review record of a delayed message.
+func TestReview(t *testing.T) {
+	t.Parallel()
+
+	// Set-up.
+	dbPath := t.TempDir()
+	database := OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+	defer os.Remove(dbPath)
+	nDB := NotaryDB{db: database.db}
+
+	msg := makeNewMsgPub(t)
+	pendingMsg := makeNewPendingMsg(t, msg)
+	require.NoError(t, nDB.StoreDelayed(pendingMsg))
+
+	// A message that has not been reviewed has an empty review.
+	review, getErr := nDB.GetReview(msg.MessageID())
+	require.NoError(t, getErr)
+	require.True(t, review.IsEmpty())
+
+	annotation := NotaryAnnotation{Operator: "alice", Note: "looks legitimate", Timestamp: nowSeconds().UTC()}
+	_, addErr := nDB.AddAnnotation(msg.MessageID(), annotation)
+	require.NoError(t, addErr)
+	review, addErr = nDB.AddApproval(msg.MessageID(), NotaryApproval{Operator: "alice", Timestamp: nowSeconds()})
+	require.NoError(t, addErr)
+	require.Equal(t, []NotaryAnnotation{annotation}, review.Annotations)
+
+	stored, getErr := nDB.GetReview(msg.MessageID())
+	require.NoError(t, getErr)
+	require.Equal(t, review.Annotations, stored.Annotations)
+	require.Equal(t, []string{"alice"}, stored.Operators())
+	require.True(t, stored.HasApproved("alice"))
+	require.False(t, stored.HasApproved("bob"))
+	require.Equal(t, stored.Approvals, stored.Current().Approvals)
+
+	// Reviews are not loaded as delayed or blackholed messages.
+	res, loadErr := nDB.LoadAll(zap.NewNop())
+	require.NoError(t, loadErr)
+	require.Equal(t, 1, len(res.Delayed))
+	require.Equal(t, 0, len(res.Blackholed))
+
+	// Closing the review keeps the record, but the next review starts empty.
+	closed, closeErr := nDB.CloseReview(msg.MessageID(), NotaryReviewOutcome{Outcome: "released", Timestamp: nowSeconds()})
+	require.NoError(t, closeErr)
+	require.Len(t, closed.Outcomes, 1)
+	require.Equal(t, 1, closed.Outcomes[0].NumAnnotations)
+	require.Equal(t, 1, closed.Outcomes[0].NumApprovals)
+	require.True(t, closed.Current().IsEmpty())
+
+	review, addErr = nDB.AddApproval(msg.MessageID(), NotaryApproval{Operator: "bob", Timestamp: nowSeconds()})
+	require.NoError(t, addErr)
+	require.Equal(t, []string{"alice", "bob"}, review.Operators())
+	require.Equal(t, []string{"bob"}, review.Current().Operators())
+	require.Empty(t, review.Current().Annotations)
 }
 
 // nowSeconds is a helper function that returns time.Now() with the nanoseconds truncated.
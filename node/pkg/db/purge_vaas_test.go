@@ -28,6 +28,7 @@ func countVAAs(d *Database, chainId vaa.ChainID) (numThisChain int, numOtherChai
 	if err = d.db.View(func(txn *badger.Txn) error {
 		opts := badger.DefaultIteratorOptions
 		opts.PrefetchSize = 10
+		opts.Prefix = []byte("signed/")
 		it := txn.NewIterator(opts)
 		defer it.Close()
 		for it.Rewind(); it.Valid(); it.Next() {
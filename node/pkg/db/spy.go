@@ -0,0 +1,248 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// SpyDB is a bounded store of the signed VAAs received by the spy, used to replay the VAAs a subscriber missed while it
+// was disconnected. The VAAs are stored under the same keys as in Database. Two indices record the order in which they
+// were received: once the store holds more than its capacity, the oldest VAAs are evicted.
+type SpyDB struct {
+	db       *badger.DB
+	capacity uint64
+
+	mu sync.Mutex
+	// first is the position of the oldest VAA in the store, next the position of the next VAA to be stored.
+	first uint64
+	next  uint64
+}
+
+// Define prefixes of the indices of the spy's VAA store.
+const (
+	// spyArrivalPrefix maps a position to the time the VAA was received and its VAAID key.
+	spyArrivalPrefix = "SPY:ARRIVAL:V1:"
+	// spyPositionPrefix maps a VAAID key to its position.
+	spyPositionPrefix = "SPY:POSITION:V1:"
+)
+
+// SpyStoredVAA is a VAA replayed from the spy's VAA store.
+type SpyStoredVAA struct {
+	Position   uint64
+	ReceivedAt time.Time
+	VaaBytes   []byte
+}
+
+// NewSpyDB returns a SpyDB holding at most capacity VAAs, resuming from the VAAs already in the database.
+func NewSpyDB(dbConn *badger.DB, capacity uint64) (*SpyDB, error) {
+	if capacity == 0 {
+		return nil, errors.New("spy VAA store capacity must be positive")
+	}
+
+	d := &SpyDB{
+		db:       dbConn,
+		capacity: capacity,
+	}
+
+	if err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = []byte(spyArrivalPrefix)
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		it.Rewind()
+		if !it.Valid() {
+			return nil
+		}
+		d.first = spyArrivalPosition(it.Item().Key())
+
+		opts.Reverse = true
+		rit := txn.NewIterator(opts)
+		defer rit.Close()
+		// In reverse mode, seeking to the prefix followed by 0xff lands on the last key with that prefix.
+		rit.Seek(append([]byte(spyArrivalPrefix), 0xff))
+		if rit.Valid() {
+			d.next = spyArrivalPosition(rit.Item().Key()) + 1
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to load spy VAA store: %w", err)
+	}
+
+	return d, nil
+}
+
+// Next returns the position the next stored VAA will have. All VAAs stored so far have a lower position.
+func (d *SpyDB) Next() uint64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.next
+}
+
+// Store adds a VAA to the store, evicting the oldest VAA if the store is full. A VAA that is already in the store is
+// not added a second time, in which case false is returned.
+func (d *SpyDB) Store(v *vaa.VAA, vaaBytes []byte, receivedAt time.Time) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	id := VaaIDFromVAA(v).Bytes()
+	stored := false
+	err := d.db.Update(func(txn *badger.Txn) error {
+		if _, err := txn.Get(spyPositionKey(id)); err == nil {
+			return nil
+		} else if !errors.Is(err, badger.ErrKeyNotFound) {
+			return err
+		}
+
+		if err := txn.Set(id, vaaBytes); err != nil {
+			return err
+		}
+		if err := txn.Set(spyPositionKey(id), binary.BigEndian.AppendUint64(nil, d.next)); err != nil {
+			return err
+		}
+		if err := txn.Set(spyArrivalKey(d.next), spyArrivalValue(receivedAt, id)); err != nil {
+			return err
+		}
+
+		if d.next-d.first >= d.capacity {
+			if err := d.evict(txn, d.first); err != nil {
+				return fmt.Errorf("failed to evict VAA at position %d: %w", d.first, err)
+			}
+		}
+
+		stored = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to commit tx: %w", err)
+	}
+
+	if stored {
+		if d.next-d.first >= d.capacity {
+			d.first++
+		}
+		d.next++
+		storedVaaTotal.Inc()
+	}
+
+	return stored, nil
+}
+
+// evict deletes the VAA at the given position and its index entries.
+func (d *SpyDB) evict(txn *badger.Txn, position uint64) error {
+	item, err := txn.Get(spyArrivalKey(position))
+	if err != nil {
+		return err
+	}
+
+	var id []byte
+	if err := item.Value(func(val []byte) error {
+		_, id, err = parseSpyArrivalValue(val)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range [][]byte{spyArrivalKey(position), spyPositionKey(id), id} {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Position returns the position of a VAA in the store, or ErrVAANotFound if it is not (or no longer) stored.
+func (d *SpyDB) Position(id VAAID) (uint64, error) {
+	var position uint64
+	if err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(spyPositionKey(id.Bytes()))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			if len(val) != 8 {
+				return fmt.Errorf("invalid position of length %d", len(val))
+			}
+			position = binary.BigEndian.Uint64(val)
+			return nil
+		})
+	}); err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return 0, ErrVAANotFound
+		}
+		return 0, err
+	}
+	return position, nil
+}
+
+// Iterate calls f for every stored VAA with a position within [from, to), in the order they were received. Iteration
+// stops at the first error returned by f.
+func (d *SpyDB) Iterate(from uint64, to uint64, f func(*SpyStoredVAA) error) error {
+	return d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = []byte(spyArrivalPrefix)
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(spyArrivalKey(from)); it.Valid(); it.Next() {
+			position := spyArrivalPosition(it.Item().Key())
+			if position >= to {
+				return nil
+			}
+
+			var receivedAt time.Time
+			var id []byte
+			if err := it.Item().Value(func(val []byte) error {
+				var parseErr error
+				receivedAt, id, parseErr = parseSpyArrivalValue(val)
+				return parseErr
+			}); err != nil {
+				return fmt.Errorf("failed to read VAA at position %d: %w", position, err)
+			}
+
+			item, err := txn.Get(id)
+			if err != nil {
+				return fmt.Errorf("failed to read VAA %s: %w", string(id), err)
+			}
+			vaaBytes, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			if err := f(&SpyStoredVAA{Position: position, ReceivedAt: receivedAt, VaaBytes: vaaBytes}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func spyArrivalKey(position uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(spyArrivalPrefix), position)
+}
+
+func spyArrivalPosition(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(spyArrivalPrefix):])
+}
+
+func spyPositionKey(id []byte) []byte {
+	return append([]byte(spyPositionPrefix), id...)
+}
+
+func spyArrivalValue(receivedAt time.Time, id []byte) []byte {
+	return append(binary.BigEndian.AppendUint64(nil, uint64(receivedAt.UnixNano())), id...) // #nosec G115 -- Arrival times are after 1970
+}
+
+func parseSpyArrivalValue(val []byte) (time.Time, []byte, error) {
+	if len(val) < 8 {
+		return time.Time{}, nil, fmt.Errorf("invalid arrival entry of length %d", len(val))
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(val[:8]))), append([]byte(nil), val[8:]...), nil // #nosec G115 -- Written by spyArrivalValue
+}
@@ -0,0 +1,139 @@
+package db
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// storeSpyVAAs stores VAAs with the given sequence numbers in the spy's VAA store.
+func storeSpyVAAs(t *testing.T, d *SpyDB, seqs ...uint64) {
+	t.Helper()
+	for _, seq := range seqs {
+		v := getVAAWithSeqNum(seq)
+		b, err := v.Marshal()
+		require.NoError(t, err)
+		stored, err := d.Store(&v, b, time.Unix(0, int64(seq))) // #nosec G115 -- Test values are small
+		require.NoError(t, err)
+		require.True(t, stored)
+	}
+}
+
+// iterateSpySeqs returns the sequence numbers of the VAAs within [from, to) of the spy's VAA store.
+func iterateSpySeqs(t *testing.T, d *SpyDB, from uint64, to uint64) []uint64 {
+	t.Helper()
+	var seqs []uint64
+	require.NoError(t, d.Iterate(from, to, func(s *SpyStoredVAA) error {
+		v, err := vaa.Unmarshal(s.VaaBytes)
+		require.NoError(t, err)
+		assert.Equal(t, time.Unix(0, int64(v.Sequence)), s.ReceivedAt) // #nosec G115 -- Test values are small
+		seqs = append(seqs, v.Sequence)
+		return nil
+	}))
+	return seqs
+}
+
+func TestSpyDBStoreAndIterate(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	database := OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+	defer os.Remove(dbPath)
+
+	d, err := NewSpyDB(database.Conn(), 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), d.Next())
+
+	storeSpyVAAs(t, d, 5, 3, 4)
+	assert.Equal(t, uint64(3), d.Next())
+
+	// Duplicates are not stored a second time.
+	v := getVAAWithSeqNum(3)
+	b, err := v.Marshal()
+	require.NoError(t, err)
+	stored, err := d.Store(&v, b, time.Now())
+	require.NoError(t, err)
+	assert.False(t, stored)
+	assert.Equal(t, uint64(3), d.Next())
+
+	// VAAs are iterated in the order they were received, and are stored under the regular VAA keys.
+	assert.Equal(t, []uint64{5, 3, 4}, iterateSpySeqs(t, d, 0, d.Next()))
+	assert.Equal(t, []uint64{3}, iterateSpySeqs(t, d, 1, 2))
+	has, err := database.HasVAA(*VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	position, err := d.Position(*VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), position)
+
+	missing := getVAAWithSeqNum(42)
+	_, err = d.Position(*VaaIDFromVAA(&missing))
+	assert.ErrorIs(t, err, ErrVAANotFound)
+}
+
+func TestSpyDBEviction(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	database := OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+	defer os.Remove(dbPath)
+
+	d, err := NewSpyDB(database.Conn(), 3)
+	require.NoError(t, err)
+
+	storeSpyVAAs(t, d, 1, 2, 3, 4, 5)
+	assert.Equal(t, []uint64{3, 4, 5}, iterateSpySeqs(t, d, 0, d.Next()))
+
+	// Evicted VAAs and their index entries are deleted.
+	evicted := getVAAWithSeqNum(2)
+	_, err = d.Position(*VaaIDFromVAA(&evicted))
+	assert.ErrorIs(t, err, ErrVAANotFound)
+	has, err := database.HasVAA(*VaaIDFromVAA(&evicted))
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	// An evicted VAA can be stored again, as the newest one.
+	storeSpyVAAs(t, d, 2)
+	assert.Equal(t, []uint64{4, 5, 2}, iterateSpySeqs(t, d, 0, d.Next()))
+}
+
+func TestSpyDBReload(t *testing.T) {
+	t.Parallel()
+
+	dbPath := t.TempDir()
+	database := OpenDb(zap.NewNop(), &dbPath)
+	d, err := NewSpyDB(database.Conn(), 3)
+	require.NoError(t, err)
+	storeSpyVAAs(t, d, 1, 2, 3, 4)
+	require.NoError(t, database.Close())
+
+	database = OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+	defer os.Remove(dbPath)
+
+	d, err = NewSpyDB(database.Conn(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), d.Next())
+
+	// The store keeps its capacity across restarts.
+	storeSpyVAAs(t, d, 5)
+	assert.Equal(t, []uint64{3, 4, 5}, iterateSpySeqs(t, d, 0, d.Next()))
+}
+
+func TestNewSpyDBZeroCapacity(t *testing.T) {
+	t.Parallel()
+
+	database := OpenDb(zap.NewNop(), nil)
+	defer database.Close()
+
+	_, err := NewSpyDB(database.Conn(), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package db
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// txHashIndexPrefix maps the hash of the transaction that emitted a message to the ID of its VAA. A transaction may
+// emit several messages, so the VAA ID is part of the key: TXHASH:V1:<tx hash>/<chain>/<emitter>/<sequence>.
+const txHashIndexPrefix = "TXHASH:V1:"
+
+var ErrTxHashIndexDisabled = errors.New("transaction hash index is not enabled")
+
+// TxHashIndexEntry is an entry of the transaction hash index.
+type TxHashIndexEntry struct {
+	TxHash []byte
+	VaaID  VAAID
+}
+
+func txHashIndexPrefixBytes(txHash []byte) []byte {
+	return []byte(fmt.Sprintf("%s%s/", txHashIndexPrefix, hex.EncodeToString(txHash)))
+}
+
+func (e *TxHashIndexEntry) key() []byte {
+	return append(txHashIndexPrefixBytes(e.TxHash), fmt.Sprintf("%d/%s/%d", e.VaaID.EmitterChain, e.VaaID.EmitterAddress, e.VaaID.Sequence)...)
+}
+
+// EnableTxHashIndex enables the transaction hash index. Only the VAAs stored afterwards are indexed.
+func (d *Database) EnableTxHashIndex() {
+	d.txHashIndex = true
+}
+
+// TxHashIndexEnabled returns whether the transaction hash index is enabled.
+func (d *Database) TxHashIndexEnabled() bool {
+	return d.txHashIndex
+}
+
+// StoreTxHashIndexBatch adds the entries to the transaction hash index. It does nothing if the index is disabled.
+func (d *Database) StoreTxHashIndexBatch(entries []TxHashIndexEntry) error {
+	if !d.txHashIndex || len(entries) == 0 {
+		return nil
+	}
+
+	batchTx := d.db.NewWriteBatch()
+	defer batchTx.Cancel()
+
+	for i := range entries {
+		if len(entries[i].TxHash) == 0 {
+			continue
+		}
+		if err := batchTx.Set(entries[i].key(), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return batchTx.Flush()
+}
+
+// GetVAAIDsByTxHash returns the IDs of the VAAs of the messages emitted by the transaction. The VAAs may have been
+// purged from the database since they were indexed.
+func (d *Database) GetVAAIDsByTxHash(txHash []byte) ([]VAAID, error) {
+	if !d.txHashIndex {
+		return nil, ErrTxHashIndexDisabled
+	}
+
+	prefix := txHashIndexPrefixBytes(txHash)
+	ids := []VAAID{}
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = prefix
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			key := it.Item().Key()
+			id, err := VaaIDFromString(string(bytes.TrimPrefix(key, prefix)))
+			if err != nil {
+				return fmt.Errorf("invalid transaction hash index key %s: %w", string(key), err)
+			}
+			ids = append(ids, *id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
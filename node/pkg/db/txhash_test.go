@@ -0,0 +1,115 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestTxHashIndex(t *testing.T) {
+	db := OpenDb(zap.NewNop(), nil)
+	defer db.Close()
+
+	txHash := []byte{0x01, 0x02, 0x03}
+	otherTxHash := []byte{0x01, 0x02}
+	v1 := getVAAWithSeqNum(1)
+	v2 := getVAAWithSeqNum(2)
+	v3 := getVAAWithSeqNum(3)
+	entries := []TxHashIndexEntry{
+		{TxHash: txHash, VaaID: *VaaIDFromVAA(&v1)},
+		{TxHash: txHash, VaaID: *VaaIDFromVAA(&v2)},
+		{TxHash: otherTxHash, VaaID: *VaaIDFromVAA(&v3)},
+	}
+
+	// Nothing is indexed while the index is disabled.
+	require.NoError(t, db.StoreTxHashIndexBatch(entries))
+	_, err := db.GetVAAIDsByTxHash(txHash)
+	assert.ErrorIs(t, err, ErrTxHashIndexDisabled)
+
+	db.EnableTxHashIndex()
+	ids, err := db.GetVAAIDsByTxHash(txHash)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+
+	require.NoError(t, db.StoreTxHashIndexBatch(entries))
+
+	// A transaction may emit several messages. A hash that is a prefix of another one does not match it.
+	ids, err = db.GetVAAIDsByTxHash(txHash)
+	require.NoError(t, err)
+	assert.Equal(t, []VAAID{*VaaIDFromVAA(&v1), *VaaIDFromVAA(&v2)}, ids)
+
+	ids, err = db.GetVAAIDsByTxHash(otherTxHash)
+	require.NoError(t, err)
+	assert.Equal(t, []VAAID{*VaaIDFromVAA(&v3)}, ids)
+
+	// Storing an entry again does not duplicate it.
+	require.NoError(t, db.StoreTxHashIndexBatch(entries[:1]))
+	ids, err = db.GetVAAIDsByTxHash(txHash)
+	require.NoError(t, err)
+	assert.Len(t, ids, 2)
+}
+
+func TestGetSignedVAABytesBySequenceRange(t *testing.T) {
+	db := OpenDb(zap.NewNop(), nil)
+	defer db.Close()
+
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	// Store the sequences 0 to 20, except 5, and one from another emitter.
+	expected := map[uint64][]byte{}
+	vaaBatch := []*vaa.VAA{}
+	for seqNum := uint64(0); seqNum <= 20; seqNum++ {
+		if seqNum == 5 {
+			continue
+		}
+		v := getVAAWithSeqNum(seqNum)
+		v.AddSignature(privKey, 0)
+		vaaBatch = append(vaaBatch, &v)
+		expected[seqNum], err = v.Marshal()
+		require.NoError(t, err)
+	}
+	other := getVAAWithSeqNum(3)
+	other.EmitterChain = vaa.ChainIDEthereum
+	other.AddSignature(privKey, 0)
+	vaaBatch = append(vaaBatch, &other)
+	require.NoError(t, db.StoreSignedVAABatch(vaaBatch))
+
+	emitter := *VaaIDFromVAA(vaaBatch[0])
+
+	// The sequences are ordered numerically, and the missing ones are skipped.
+	vaas, _, more, err := db.GetSignedVAABytesBySequenceRange(emitter, 3, 11, 100)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Equal(t, [][]byte{expected[3], expected[4], expected[6], expected[7], expected[8], expected[9], expected[10], expected[11]}, vaas)
+
+	// The page ends at the limit.
+	vaas, next, more, err := db.GetSignedVAABytesBySequenceRange(emitter, 3, 11, 2)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, uint64(5), next)
+	assert.Equal(t, [][]byte{expected[3], expected[4]}, vaas)
+
+	vaas, next, more, err = db.GetSignedVAABytesBySequenceRange(emitter, next, 11, 2)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.Equal(t, uint64(8), next)
+	assert.Equal(t, [][]byte{expected[6], expected[7]}, vaas)
+
+	// A page that ends at the end of the range exhausts it.
+	vaas, _, more, err = db.GetSignedVAABytesBySequenceRange(emitter, 19, 20, 2)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Len(t, vaas, 2)
+
+	vaas, _, more, err = db.GetSignedVAABytesBySequenceRange(emitter, 30, 40, 10)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.Empty(t, vaas)
+}
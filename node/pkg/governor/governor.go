@@ -2,6 +2,7 @@ package governor
 
 // The purpose of the Chain Governor is to limit the notional TVL that can leave a chain in a single day.
 // It works by tracking transfers (types one and three) for a configured set of tokens from a configured set of emitters (chains).
+// Native Token Transfers published by the NTT transceivers configured in mainnet_ntt.go are tracked in the same way.
 //
 // To compute the notional value of a transfer, the governor uses the amount from the transfer multiplied by the maximum of
 // a hard coded price and the latest price pulled from CoinkGecko (every five minutes). Once a transfer is published,
@@ -12,6 +13,9 @@ package governor
 // until it can be published without exceeding the limit. Even if the governor has an enqueued transfer, it will still allow
 // additional transfers that do not exceed the threshold.
 //
+// A chain may also have daily limits on the value of a single token leaving it, and on the value sent to a single target
+// chain. These are configured in mainnet_limits.go, can be overridden by admin commands, and are enforced in the same way.
+//
 // The chain governor checks for pending transfers each minute to see if any can be published yet. It will publish any that can be published
 // without exceeding the daily limit, even if one in front of it in the queue is too big.
 //
@@ -26,6 +30,7 @@ package governor
 // To enable the chain governor, you must specified the --chainGovernorEnabled guardiand command line argument.
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
@@ -75,6 +80,30 @@ type (
 		BigTransactionSize uint64
 	}
 
+	// Layout of the config data for each emitter of Native Token Transfers (NTT)
+	NttEmitterConfigEntry struct {
+		EmitterChainID vaa.ChainID
+		// Addr is the address of the NTT transceiver that publishes the messages, in Wormhole normalized format.
+		Addr string
+	}
+
+	// Layout of the config data for the daily limit of a single token leaving a chain
+	TokenLimitConfigEntry struct {
+		EmitterChainID vaa.ChainID
+		// Chain and Addr identify the token in the same way as in [TokenConfigEntry].
+		Chain      uint16
+		Addr       string
+		DailyLimit uint64
+	}
+
+	// Layout of the config data for the daily limit of the transfers from an emitter chain to a target chain. Unlike
+	// the flow cancel corridors, these are directional.
+	CorridorLimitConfigEntry struct {
+		EmitterChainID vaa.ChainID
+		TargetChainID  vaa.ChainID
+		DailyLimit     uint64
+	}
+
 	// Key to the map of the tokens being monitored
 	tokenKey struct {
 		chain vaa.ChainID
@@ -83,15 +112,15 @@ type (
 
 	// Payload of the map of the tokens being monitored
 	tokenEntry struct {
-		price          *big.Float
-		decimals       *big.Int
-		symbol         string
-		coinGeckoId    string
-		token          tokenKey
-		cfgPrice       *big.Float
-		coinGeckoPrice *big.Float
-		priceTime      time.Time
-		flowCancels    bool
+		price       *big.Float
+		decimals    *big.Int
+		symbol      string
+		coinGeckoId string
+		token       tokenKey
+		cfgPrice    *big.Float
+		oraclePrice *big.Float
+		priceTime   time.Time
+		flowCancels bool
 	}
 
 	// Payload for each enqueued transfer
@@ -121,6 +150,18 @@ type (
 		bigTransactionSize      uint64
 		checkForBigTransactions bool
 
+		// Emitters of Native Token Transfers on the chain. Their transfers are governed in addition to the ones of
+		// the token bridge at emitterAddr.
+		nttEmitters map[vaa.Address]struct{}
+
+		// Optional daily limits on the outgoing value of a single token and on the outgoing value to a single target
+		// chain. The overrides are set by admin commands, persisted in the database, and take precedence over the
+		// configured limits.
+		tokenLimits            map[tokenKey]uint64
+		corridorLimits         map[vaa.ChainID]uint64
+		tokenLimitOverrides    map[tokenKey]uint64
+		corridorLimitOverrides map[vaa.ChainID]uint64
+
 		transfers []transfer
 		pending   []*pendingEntry
 	}
@@ -222,10 +263,152 @@ func (t *transfer) inverse() transfer {
 	return transfer{t.dbTransfer, -t.scaledValue}
 }
 
+// isNttEmitter returns true if the address is a configured emitter of Native Token Transfers on the chain.
+func (ce *chainEntry) isNttEmitter(addr vaa.Address) bool {
+	_, exists := ce.nttEmitters[addr]
+	return exists
+}
+
+// isGovernedEmitter returns true if the address is the token bridge or an NTT emitter on the chain.
+func (ce *chainEntry) isGovernedEmitter(addr vaa.Address) bool {
+	return addr == ce.emitterAddr || ce.isNttEmitter(addr)
+}
+
+// nttEmitterAddrs returns the NTT emitters of the chain, sorted so that they can be reported in a deterministic order.
+func (ce *chainEntry) nttEmitterAddrs() []vaa.Address {
+	addrs := make([]vaa.Address, 0, len(ce.nttEmitters))
+	for addr := range ce.nttEmitters {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs
+}
+
 func (ce *chainEntry) isBigTransfer(value uint64) bool {
 	return value >= ce.bigTransactionSize*guardianDB.ScaledValueFactor && ce.checkForBigTransactions
 }
 
+// tokenLimit returns the daily limit of a token leaving the chain, if there is one.
+func (ce *chainEntry) tokenLimit(tk tokenKey) (uint64, bool) {
+	if limit, exists := ce.tokenLimitOverrides[tk]; exists {
+		return limit, true
+	}
+	limit, exists := ce.tokenLimits[tk]
+	return limit, exists
+}
+
+// corridorLimit returns the daily limit of the transfers from the chain to the target chain, if there is one.
+func (ce *chainEntry) corridorLimit(targetChain vaa.ChainID) (uint64, bool) {
+	if limit, exists := ce.corridorLimitOverrides[targetChain]; exists {
+		return limit, true
+	}
+	limit, exists := ce.corridorLimits[targetChain]
+	return limit, exists
+}
+
+// limitedTokens returns the tokens that have a daily limit on the chain, sorted so that they can be reported in a
+// deterministic order.
+func (ce *chainEntry) limitedTokens() []tokenKey {
+	tokens := make([]tokenKey, 0, len(ce.tokenLimits)+len(ce.tokenLimitOverrides))
+	for tk := range ce.tokenLimits {
+		tokens = append(tokens, tk)
+	}
+	for tk := range ce.tokenLimitOverrides {
+		if _, exists := ce.tokenLimits[tk]; !exists {
+			tokens = append(tokens, tk)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].chain != tokens[j].chain {
+			return tokens[i].chain < tokens[j].chain
+		}
+		return bytes.Compare(tokens[i].addr[:], tokens[j].addr[:]) < 0
+	})
+	return tokens
+}
+
+// limitedTargetChains returns the target chains that have a daily limit from the chain, sorted by chain ID.
+func (ce *chainEntry) limitedTargetChains() []vaa.ChainID {
+	targets := make([]vaa.ChainID, 0, len(ce.corridorLimits)+len(ce.corridorLimitOverrides))
+	for target := range ce.corridorLimits {
+		targets = append(targets, target)
+	}
+	for target := range ce.corridorLimitOverrides {
+		if _, exists := ce.corridorLimits[target]; !exists {
+			targets = append(targets, target)
+		}
+	}
+	slices.Sort(targets)
+	return targets
+}
+
+// sumOutgoingValue sums the scaled value of the outgoing transfers since startTime that match the filter. Incoming
+// flow cancelling transfers are not subtracted, so token and corridor limits apply to the gross outgoing value.
+func (ce *chainEntry) sumOutgoingValue(startTime time.Time, match func(t *guardianDB.Transfer) bool) (uint64, error) {
+	var sum uint64
+	for _, t := range ce.transfers {
+		if t.scaledValue <= 0 || t.dbTransfer.Timestamp.Before(startTime) || !match(t.dbTransfer) {
+			continue
+		}
+		var err error
+		sum, err = CheckedAddUint64(sum, t.dbTransfer.ScaledValue)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return sum, nil
+}
+
+// sumValueForToken returns the scaled value of a token that left the chain since startTime.
+func (ce *chainEntry) sumValueForToken(tk tokenKey, startTime time.Time) (uint64, error) {
+	return ce.sumOutgoingValue(startTime, func(t *guardianDB.Transfer) bool {
+		return t.OriginChain == tk.chain && t.OriginAddress == tk.addr
+	})
+}
+
+// sumValueForTarget returns the scaled value sent from the chain to the target chain since startTime.
+func (ce *chainEntry) sumValueForTarget(targetChain vaa.ChainID, startTime time.Time) (uint64, error) {
+	return ce.sumOutgoingValue(startTime, func(t *guardianDB.Transfer) bool {
+		return t.TargetChain == targetChain
+	})
+}
+
+// exceededLimit checks whether a transfer of a token to a target chain would exceed the token or corridor limit of
+// the chain. It returns a description of the exceeded limit, or an empty string if the transfer fits.
+func (ce *chainEntry) exceededLimit(tk tokenKey, targetChain vaa.ChainID, scaledValue uint64, startTime time.Time) (string, error) {
+	if limit, exists := ce.tokenLimit(tk); exists {
+		prevValue, err := ce.sumValueForToken(tk, startTime)
+		if err != nil {
+			return "", err
+		}
+		newValue, err := CheckedAddUint64(prevValue, scaledValue)
+		if err != nil {
+			return "", err
+		}
+		if newValue > limit*guardianDB.ScaledValueFactor {
+			return fmt.Sprintf("token limit of %d for %s", limit, tk), nil
+		}
+	}
+
+	if limit, exists := ce.corridorLimit(targetChain); exists {
+		prevValue, err := ce.sumValueForTarget(targetChain, startTime)
+		if err != nil {
+			return "", err
+		}
+		newValue, err := CheckedAddUint64(prevValue, scaledValue)
+		if err != nil {
+			return "", err
+		}
+		if newValue > limit*guardianDB.ScaledValueFactor {
+			return fmt.Sprintf("corridor limit of %d to %s", limit, targetChain), nil
+		}
+	}
+
+	return "", nil
+}
+
 type ChainGovernor struct {
 	db                  guardianDB.GovernorDB // protected by `mutex`
 	logger              *zap.Logger
@@ -239,7 +422,8 @@ type ChainGovernor struct {
 	msgsSeen              map[string]bool              // protected by `mutex` // Key is hash, payload is consts transferComplete and transferEnqueued.
 	msgsToPublish         []*common.MessagePublication // protected by `mutex`
 	dayLengthInMinutes    int
-	coinGeckoQueries      []string
+	priceOracleConfig     *PriceOracleConfig
+	priceOracle           *priceOracle
 	env                   common.Environment
 	nextStatusPublishTime time.Time
 	nextConfigPublishTime time.Time
@@ -284,7 +468,7 @@ func (gov *ChainGovernor) Run(ctx context.Context) error {
 			return err
 		}
 
-		if err := gov.initCoinGecko(ctx, true); err != nil {
+		if err := gov.initPriceOracle(ctx, true); err != nil {
 			return err
 		}
 	}
@@ -296,6 +480,12 @@ func (gov *ChainGovernor) IsFlowCancelEnabled() bool {
 	return gov.flowCancelEnabled
 }
 
+// SetPriceOracleConfig sets the price sources used to update the token prices. If it is not called, the prices are
+// only queried from CoinGecko. It must be called before Run.
+func (gov *ChainGovernor) SetPriceOracleConfig(cfg *PriceOracleConfig) {
+	gov.priceOracleConfig = cfg
+}
+
 func (gov *ChainGovernor) initConfig() error {
 	gov.mutex.Lock()
 	defer gov.mutex.Unlock()
@@ -305,6 +495,9 @@ func (gov *ChainGovernor) initConfig() error {
 	configTokens := TokenList()
 	flowCancelTokens := []TokenConfigEntry{}
 	flowCancelCorridors := []corridor{}
+	configTokenLimits := []TokenLimitConfigEntry{}
+	configCorridorLimits := []CorridorLimitConfigEntry{}
+	configNttEmitters := []NttEmitterConfigEntry{}
 
 	if gov.env == common.UnsafeDevNet {
 		configTokens, flowCancelTokens, configChains, flowCancelCorridors = gov.initDevnetConfig()
@@ -316,6 +509,10 @@ func (gov *ChainGovernor) initConfig() error {
 			flowCancelTokens = FlowCancelTokenList()
 			flowCancelCorridors = FlowCancelCorridors()
 		}
+		configTokenLimits = TokenLimitList()
+		configCorridorLimits = CorridorLimitList()
+		configNttEmitters = NttEmitterList()
+		configTokens = append(configTokens, NttTokenList()...)
 	}
 
 	// We're done with this value for the rest of this function, so write it to the governor struct now
@@ -469,6 +666,85 @@ func (gov *ChainGovernor) initConfig() error {
 		return fmt.Errorf("no chains are configured")
 	}
 
+	for _, ne := range configNttEmitters {
+		ce, exists := gov.chains[ne.EmitterChainID]
+		if !exists {
+			return fmt.Errorf("NTT emitter for chain %v, which is not configured", ne.EmitterChainID)
+		}
+
+		addr, err := vaa.StringToAddress(ne.Addr)
+		if err != nil {
+			return fmt.Errorf("invalid NTT emitter address: %s", ne.Addr)
+		}
+
+		if addr == ce.emitterAddr {
+			return fmt.Errorf("NTT emitter %v on chain %v is the token bridge", addr, ne.EmitterChainID)
+		}
+
+		if ce.nttEmitters == nil {
+			ce.nttEmitters = make(map[vaa.Address]struct{})
+		}
+		ce.nttEmitters[addr] = struct{}{}
+
+		if gov.env != common.GoTest {
+			gov.logger.Info("will monitor NTT emitter:", zap.Stringer("emitterChainId", ne.EmitterChainID),
+				zap.Stringer("emitterAddr", addr),
+			)
+		}
+	}
+
+	for _, tl := range configTokenLimits {
+		ce, exists := gov.chains[tl.EmitterChainID]
+		if !exists {
+			return fmt.Errorf("token limit for chain %v, which is not configured", tl.EmitterChainID)
+		}
+
+		addr, err := vaa.StringToAddress(tl.Addr)
+		if err != nil {
+			return fmt.Errorf("invalid address in token limit: %s", tl.Addr)
+		}
+
+		key := tokenKey{chain: vaa.ChainID(tl.Chain), addr: addr}
+		if _, exists := gov.tokens[key]; !exists {
+			return fmt.Errorf("token limit for token %v, which is not configured", key)
+		}
+
+		if ce.tokenLimits == nil {
+			ce.tokenLimits = make(map[tokenKey]uint64)
+		}
+		ce.tokenLimits[key] = tl.DailyLimit
+
+		if gov.env != common.GoTest {
+			gov.logger.Info("will limit token:", zap.Stringer("emitterChainId", tl.EmitterChainID),
+				zap.Stringer("token", key),
+				zap.Uint64("dailyLimit", tl.DailyLimit),
+			)
+		}
+	}
+
+	for _, cl := range configCorridorLimits {
+		ce, exists := gov.chains[cl.EmitterChainID]
+		if !exists {
+			return fmt.Errorf("corridor limit for chain %v, which is not configured", cl.EmitterChainID)
+		}
+
+		if cl.TargetChainID == cl.EmitterChainID || cl.TargetChainID == vaa.ChainIDUnset {
+			return fmt.Errorf("invalid target chain %v in corridor limit for chain %v", cl.TargetChainID, cl.EmitterChainID)
+		}
+
+		if ce.corridorLimits == nil {
+			ce.corridorLimits = make(map[vaa.ChainID]uint64)
+		}
+		ce.corridorLimits[cl.TargetChainID] = cl.DailyLimit
+
+		if gov.env != common.GoTest {
+			gov.logger.Info("will limit corridor:", zap.Stringer("emitterChainId", cl.EmitterChainID),
+				zap.Stringer("targetChainId", cl.TargetChainID),
+				zap.Uint64("dailyLimit", cl.DailyLimit),
+			)
+		}
+	}
+
 	// Populate a sorted list of chain IDs so that we can iterate over maps in a determinstic way.
 	// https://go.dev/blog/maps, "Iteration order" section
 	governedChainIds := make([]vaa.ChainID, len(gov.chains))
@@ -493,9 +769,9 @@ func (gov *ChainGovernor) initConfig() error {
 func (gov *ChainGovernor) ProcessMsg(msg *common.MessagePublication) bool {
 
 	// Fail early for checks that do not require referencing the governor's state.
-	if !vaa.IsTransfer(msg.Payload) {
-		// The Governor should not block transfers that are not wrapped token transfers.
-		gov.logger.Info("ignoring vaa because it is not a wrapped token transfer", zap.String("msgID", msg.MessageIDString()))
+	if !isTransferPayload(msg.Payload) {
+		// The Governor should not block messages that are not token transfers.
+		gov.logger.Info("ignoring vaa because it is not a token transfer", zap.String("msgID", msg.MessageIDString()))
 		return true
 	}
 
@@ -619,6 +895,29 @@ func (gov *ChainGovernor) processMsgForTime(msg *common.MessagePublication, now
 			zap.String("hash", hash),
 			zap.String("txID", msg.TxIDString()),
 		)
+	} else {
+		exceeded, err := emitterChainEntry.exceededLimit(token.token, payload.TargetChain, scaledValue, startTime)
+		if err != nil {
+			gov.logger.Error("failed to check the token and corridor limits",
+				zap.String("msgID", msg.MessageIDString()),
+				zap.String("hash", hash),
+				zap.String("txID", msg.TxIDString()),
+				zap.Error(err),
+			)
+			return false, err
+		}
+
+		if exceeded != "" {
+			enqueueIt = true
+			releaseTime = now.Add(maxEnqueuedTime)
+			gov.logger.Error("enqueuing vaa because it would exceed the "+exceeded,
+				zap.Uint64("value", scaleDownUsdValue(scaledValue)),
+				zap.Stringer("releaseTime", releaseTime),
+				zap.String("msgID", msg.MessageIDString()),
+				zap.String("hash", hash),
+				zap.String("txID", msg.TxIDString()),
+			)
+		}
 	}
 
 	if enqueueIt {
@@ -726,8 +1025,8 @@ func (gov *ChainGovernor) IsGovernedMsg(msg *common.MessagePublication) (msgIsGo
 
 	// Fail early for checks that do not require referencing the governor's state.
 	// This avoids locking the governor's mutex.
-	if !vaa.IsTransfer(msg.Payload) {
-		gov.logger.Info("ignoring vaa because it is not a wrapped token transfer", zap.String("msgID", msg.MessageIDString()))
+	if !isTransferPayload(msg.Payload) {
+		gov.logger.Info("ignoring vaa because it is not a token transfer", zap.String("msgID", msg.MessageIDString()))
 		return false, nil
 	}
 
@@ -742,10 +1041,10 @@ func (gov *ChainGovernor) IsGovernedMsg(msg *common.MessagePublication) (msgIsGo
 func (gov *ChainGovernor) parseMsgAlreadyLocked(
 	msg *common.MessagePublication,
 ) (bool, *chainEntry, *tokenEntry, *vaa.TransferPayloadHdr, error) {
-	// We only care about wrapped token transfers.
-	// The caller SHOULD check that the message is a wrapped token transfer before calling this method because it can be done without acquiring the Governor's lock. However, it is checked here for completeness.
-	if !vaa.IsTransfer(msg.Payload) {
-		gov.logger.Info("ignoring vaa because it is not a wrapped token transfer", zap.String("msgID", msg.MessageIDString()))
+	// We only care about token bridge and native token transfers.
+	// The caller SHOULD check that the message is a token transfer before calling this method because it can be done without acquiring the Governor's lock. However, it is checked here for completeness.
+	if !isTransferPayload(msg.Payload) {
+		gov.logger.Info("ignoring vaa because it is not a token transfer", zap.String("msgID", msg.MessageIDString()))
 		return false, nil, nil, nil, nil
 	}
 
@@ -762,7 +1061,7 @@ func (gov *ChainGovernor) parseMsgAlreadyLocked(
 	}
 
 	// If we don't care about this emitter, the VAA can be published.
-	if msg.EmitterAddress != ce.emitterAddr {
+	if !ce.isGovernedEmitter(msg.EmitterAddress) {
 		gov.logger.Info(
 			"ignoring vaa because the emitter address is not configured",
 			zap.String("msgID", msg.MessageIDString()),
@@ -771,16 +1070,20 @@ func (gov *ChainGovernor) parseMsgAlreadyLocked(
 	}
 
 	// Decode the payload. This is a prerequisite for the rest of the checks.
-	payload, decodeErr := vaa.DecodeTransferPayloadHdr(msg.Payload)
+	payload, token, decodeErr := gov.decodeTransferAlreadyLocked(ce, msg)
 	if decodeErr != nil {
 		gov.logger.Error("failed to decode vaa", zap.String("msgID", msg.MessageIDString()), zap.Error(decodeErr))
 		return false, nil, nil, nil, decodeErr
 	}
 
+	// The token bridge only publishes token bridge transfers, and NTT emitters only publish native token transfers.
+	if payload == nil {
+		gov.logger.Info("ignoring vaa because its payload does not match the emitter", zap.String("msgID", msg.MessageIDString()))
+		return false, nil, nil, nil, nil
+	}
+
 	// If we don't care about this token, the VAA can be published.
-	tk := tokenKey{chain: payload.OriginChain, addr: payload.OriginAddress}
-	token, exists := gov.tokens[tk]
-	if !exists {
+	if token == nil {
 		gov.logger.Info("ignoring vaa because the token is not in the list", zap.String("msgID", msg.MessageIDString()))
 		return false, nil, nil, nil, nil
 	}
@@ -890,6 +1193,22 @@ func (gov *ChainGovernor) checkPendingForTime(now time.Time) ([]*common.MessageP
 						continue
 					}
 
+					// A payload that can't be decoded is dropped below.
+					if payload, _, err := gov.decodeTransferAlreadyLocked(ce, &pe.dbData.Msg); err == nil && payload != nil {
+						exceeded, err := ce.exceededLimit(pe.token.token, payload.TargetChain, scaledValue, startTime)
+						if err != nil {
+							gov.logger.Error("failed to check the token and corridor limits for pending vaa",
+								zap.String("msgID", pe.dbData.Msg.MessageIDString()),
+								zap.Error(err),
+							)
+							continue
+						}
+						if exceeded != "" {
+							// This one won't fit either.
+							continue
+						}
+					}
+
 					gov.logger.Info("posting pending vaa",
 						zap.Stringer("amount", pe.amount),
 						zap.Stringer("price", pe.token.price),
@@ -901,7 +1220,10 @@ func (gov *ChainGovernor) checkPendingForTime(now time.Time) ([]*common.MessageP
 						zap.String("flowCancels", strconv.FormatBool(pe.token.flowCancels)))
 				}
 
-				payload, err := vaa.DecodeTransferPayloadHdr(pe.dbData.Msg.Payload)
+				payload, _, err := gov.decodeTransferAlreadyLocked(ce, &pe.dbData.Msg)
+				if err == nil && payload == nil {
+					err = errors.New("payload is not a transfer from the emitter")
+				}
 				if err != nil {
 					gov.logger.Error("failed to decode payload for pending VAA, dropping it",
 						zap.String("msgID", pe.dbData.Msg.MessageIDString()),
@@ -3,6 +3,7 @@
 package governor
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -19,11 +20,18 @@ func (gov *ChainGovernor) loadFromDB() error {
 	return gov.loadFromDBAlreadyLocked()
 }
 
-// loadFromDBAlreadyLocked method loads transfers and pending data from the database and modifies the corresponding fields in the ChainGovernor.
+// loadFromDBAlreadyLocked method loads the limit overrides, transfers and pending data from the database and modifies the corresponding fields in the ChainGovernor.
 // These fields are slices of transfers or pendingTransfers and will be sorted by their Timestamp property.
 // Modifies the state of the database as a side-effect: 'transfers' that are older than 24 hours are deleted.
 // It assumes that the Governor's mutex is already locked.
 func (gov *ChainGovernor) loadFromDBAlreadyLocked() error {
+	tokenLimits, corridorLimits, err := gov.db.GetGovernorLimits()
+	if err != nil {
+		gov.logger.Error("failed to reload limits from db", zap.Error(err))
+		return err
+	}
+	gov.reloadLimits(tokenLimits, corridorLimits)
+
 	xfers, pending, err := gov.db.GetChainGovernorData(gov.logger)
 	if err != nil {
 		gov.logger.Error("failed to reload transactions from db", zap.Error(err))
@@ -63,6 +71,60 @@ func (gov *ChainGovernor) loadFromDBAlreadyLocked() error {
 	return nil
 }
 
+// reloadLimits replaces the token and corridor limit overrides of all chains with the ones from the database. Overrides
+// for chains or tokens that are no longer governed are ignored, but left in the database.
+func (gov *ChainGovernor) reloadLimits(tokenLimits []*db.TokenLimit, corridorLimits []*db.CorridorLimit) {
+	for _, ce := range gov.chains {
+		ce.tokenLimitOverrides = nil
+		ce.corridorLimitOverrides = nil
+	}
+
+	for _, l := range tokenLimits {
+		ce, exists := gov.chains[l.EmitterChain]
+		tk := tokenKey{chain: l.OriginChain, addr: l.OriginAddress}
+		if _, tokenExists := gov.tokens[tk]; !exists || !tokenExists {
+			gov.logger.Error("ignoring reloaded token limit for unsupported chain or token",
+				zap.Stringer("EmitterChain", l.EmitterChain),
+				zap.Stringer("Token", tk),
+				zap.Uint64("DailyLimit", l.DailyLimit),
+			)
+			continue
+		}
+
+		gov.logger.Info("reloaded token limit",
+			zap.Stringer("EmitterChain", l.EmitterChain),
+			zap.Stringer("Token", tk),
+			zap.Uint64("DailyLimit", l.DailyLimit),
+		)
+		if ce.tokenLimitOverrides == nil {
+			ce.tokenLimitOverrides = make(map[tokenKey]uint64)
+		}
+		ce.tokenLimitOverrides[tk] = l.DailyLimit
+	}
+
+	for _, l := range corridorLimits {
+		ce, exists := gov.chains[l.EmitterChain]
+		if !exists {
+			gov.logger.Error("ignoring reloaded corridor limit for unsupported chain",
+				zap.Stringer("EmitterChain", l.EmitterChain),
+				zap.Stringer("TargetChain", l.TargetChain),
+				zap.Uint64("DailyLimit", l.DailyLimit),
+			)
+			continue
+		}
+
+		gov.logger.Info("reloaded corridor limit",
+			zap.Stringer("EmitterChain", l.EmitterChain),
+			zap.Stringer("TargetChain", l.TargetChain),
+			zap.Uint64("DailyLimit", l.DailyLimit),
+		)
+		if ce.corridorLimitOverrides == nil {
+			ce.corridorLimitOverrides = make(map[vaa.ChainID]uint64)
+		}
+		ce.corridorLimitOverrides[l.TargetChain] = l.DailyLimit
+	}
+}
+
 func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer) {
 	msg := &pending.Msg
 	ce, exists := gov.chains[msg.EmitterChain]
@@ -71,12 +133,15 @@ func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer) {
 		return
 	}
 
-	if msg.EmitterAddress != ce.emitterAddr {
+	if !ce.isGovernedEmitter(msg.EmitterAddress) {
 		gov.logger.Error("reloaded pending transfer for unsupported emitter address, dropping it", msg.ZapFields()...)
 		return
 	}
 
-	payload, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
+	payload, token, err := gov.decodeTransferAlreadyLocked(ce, msg)
+	if err == nil && payload == nil {
+		err = errors.New("payload is not a transfer from the emitter")
+	}
 	if err != nil {
 		gov.logger.Error(
 			fmt.Sprintf("failed to parse payload for reloaded pending transfer, dropping it %v", zap.Error(err)),
@@ -85,9 +150,7 @@ func (gov *ChainGovernor) reloadPendingTransfer(pending *db.PendingTransfer) {
 		return
 	}
 
-	tk := tokenKey{chain: payload.OriginChain, addr: payload.OriginAddress}
-	token, exists := gov.tokens[tk]
-	if !exists {
+	if token == nil {
 		gov.logger.Error("reloaded pending transfer for unsupported token, dropping it",
 			zap.String("MsgID", msg.MessageIDString()),
 			zap.String("txID", msg.TxIDString()),
@@ -157,7 +220,7 @@ func (gov *ChainGovernor) reloadTransfer(xfer *db.Transfer) error {
 		return nil
 	}
 
-	if xfer.EmitterAddress != ce.emitterAddr {
+	if !ce.isGovernedEmitter(xfer.EmitterAddress) {
 		gov.logger.Error("reloaded transfer for unsupported emitter address, dropping it",
 			zap.Stringer("Timestamp", xfer.Timestamp),
 			zap.Uint64("ScaledValue", xfer.ScaledValue),
@@ -9,6 +9,10 @@
 //   - governor-drop-pending-vaa [VAA_ID] - removes the specified transfer from the pending list and discards it.
 //   - governor-release-pending-vaa [VAA_ID] - removes the specified transfer from the pending list and publishes it, without regard to the threshold.
 //   - governor-reset-release-timer - resets the release timer for the specified VAA to the configured maximum.
+//   - governor-set-token-limit [EMITTER_CHAIN] [TOKEN_CHAIN] [TOKEN_ADDRESS] [DAILY_LIMIT|reset] - overrides the daily limit of a token leaving a chain.
+//   - governor-set-corridor-limit [EMITTER_CHAIN] [TARGET_CHAIN] [DAILY_LIMIT|reset] - overrides the daily limit of the transfers from one chain to another.
+//
+// The limit overrides are persisted in the database. Resetting one restores the configured limit, if there is one.
 //
 // The VAA_ID is of the form "2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/3", which is "emitter chain / emitter address / sequence number".
 
@@ -19,7 +23,9 @@
 // Returns:
 // {"entries":[
 //	{"chainId":1,"remainingAvailableNotional":"96217","notionalLimit":"100000","bigTransactionSize":"10000"},
-//  {"chainId":2,"remainingAvailableNotional":"100000","notionalLimit":"100000","bigTransactionSize":"10000"},
+//  {"chainId":2,"remainingAvailableNotional":"100000","notionalLimit":"100000","bigTransactionSize":"10000",
+//   "tokenLimits":[{"originChainId":2,"originAddress":"0x000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2","remainingAvailableNotional":"20000","notionalLimit":"20000"}],
+//   "corridorLimits":[{"targetChainId":21,"remainingAvailableNotional":"45000","notionalLimit":"50000"}]},
 //  {"chainId":5,"remainingAvailableNotional":"275000","notionalLimit":"275000","bigTransactionSize":"20000"}
 // ]}
 //
@@ -68,8 +74,8 @@
 //
 // - SignedChainGovernorStatus
 //   - Published once a minute.
-//   - Contains a list of configured chains along with their remaining available notional value, the number of enqueued VAAs
-//     and information on zero or more enqueued VAAs.
+//   - Contains a list of configured chains along with their remaining available notional value, the remaining value of
+//     their token and corridor limits, the number of enqueued VAAs and information on zero or more enqueued VAAs.
 //   - Only the first 20 enqueued VAAs are include, to constrain the message size.
 
 package governor
@@ -116,6 +122,17 @@ func (gov *ChainGovernor) Status() (resp string) {
 		s1 := fmt.Sprintf("chain: %v, dailyLimit: %v, total: %v, numPending: %v", ce.emitterChainId, ce.dailyLimit, netValue, len(ce.pending))
 		resp += s1 + "\n"
 		gov.logger.Info(s1)
+		tokenUsages, corridorUsages := gov.limitUsagesForChain(ce, startTime)
+		for _, u := range tokenUsages {
+			s1 := fmt.Sprintf("chain: %v, token: %v, dailyLimit: %v, remaining: %v", ce.emitterChainId, u.token, u.limit, u.remaining)
+			gov.logger.Info(s1)
+			resp += "   " + s1 + "\n"
+		}
+		for _, u := range corridorUsages {
+			s1 := fmt.Sprintf("chain: %v, targetChain: %v, dailyLimit: %v, remaining: %v", ce.emitterChainId, u.targetChain, u.limit, u.remaining)
+			gov.logger.Info(s1)
+			resp += "   " + s1 + "\n"
+		}
 		if len(ce.pending) != 0 {
 			for idx, pe := range ce.pending {
 				scaledValue, _ := scaledUsdValue(pe.amount, pe.token)
@@ -258,6 +275,219 @@ func (gov *ChainGovernor) resetReleaseTimerForTime(vaaId string, now time.Time,
 
 }
 
+// Admin command to override the daily limit of a token leaving a chain. The override is persisted in the database.
+func (gov *ChainGovernor) SetTokenLimit(emitterChain vaa.ChainID, tokenChain vaa.ChainID, tokenAddr vaa.Address, dailyLimit uint64) (string, error) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, tk, err := gov.lookUpTokenLimitAlreadyLocked(emitterChain, tokenChain, tokenAddr)
+	if err != nil {
+		return "", err
+	}
+
+	l := guardianDB.TokenLimit{EmitterChain: emitterChain, OriginChain: tokenChain, OriginAddress: tokenAddr, DailyLimit: dailyLimit}
+	if err := gov.db.StoreTokenLimit(&l); err != nil {
+		gov.logger.Error("failed to store token limit", zap.Stringer("emitterChain", emitterChain), zap.Stringer("token", tk), zap.Error(err))
+		return "", err
+	}
+
+	if ce.tokenLimitOverrides == nil {
+		ce.tokenLimitOverrides = make(map[tokenKey]uint64)
+	}
+	ce.tokenLimitOverrides[tk] = dailyLimit
+
+	gov.logger.Info("updated token limit due to admin command",
+		zap.Stringer("emitterChain", emitterChain),
+		zap.Stringer("token", tk),
+		zap.Uint64("dailyLimit", dailyLimit),
+	)
+	return fmt.Sprintf("daily limit of token %v on chain %v has been set to %d", tk, emitterChain, dailyLimit), nil
+}
+
+// Admin command to remove the override of a token limit, restoring the configured limit, if there is one.
+func (gov *ChainGovernor) ResetTokenLimit(emitterChain vaa.ChainID, tokenChain vaa.ChainID, tokenAddr vaa.Address) (string, error) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, tk, err := gov.lookUpTokenLimitAlreadyLocked(emitterChain, tokenChain, tokenAddr)
+	if err != nil {
+		return "", err
+	}
+
+	if _, exists := ce.tokenLimitOverrides[tk]; !exists {
+		return "", fmt.Errorf("the daily limit of token %v on chain %v is not overridden", tk, emitterChain)
+	}
+
+	l := guardianDB.TokenLimit{EmitterChain: emitterChain, OriginChain: tokenChain, OriginAddress: tokenAddr}
+	if err := gov.db.DeleteTokenLimit(&l); err != nil {
+		gov.logger.Error("failed to delete token limit", zap.Stringer("emitterChain", emitterChain), zap.Stringer("token", tk), zap.Error(err))
+		return "", err
+	}
+
+	delete(ce.tokenLimitOverrides, tk)
+
+	gov.logger.Info("reset token limit due to admin command", zap.Stringer("emitterChain", emitterChain), zap.Stringer("token", tk))
+	if limit, exists := ce.tokenLimit(tk); exists {
+		return fmt.Sprintf("daily limit of token %v on chain %v has been reset to the configured %d", tk, emitterChain, limit), nil
+	}
+	return fmt.Sprintf("daily limit of token %v on chain %v has been removed", tk, emitterChain), nil
+}
+
+// lookUpTokenLimitAlreadyLocked checks that the emitter chain and the token of a token limit are governed.
+func (gov *ChainGovernor) lookUpTokenLimitAlreadyLocked(emitterChain vaa.ChainID, tokenChain vaa.ChainID, tokenAddr vaa.Address) (*chainEntry, tokenKey, error) {
+	tk := tokenKey{chain: tokenChain, addr: tokenAddr}
+
+	ce, exists := gov.chains[emitterChain]
+	if !exists {
+		return nil, tk, fmt.Errorf("chain %v is not governed", emitterChain)
+	}
+
+	if _, exists := gov.tokens[tk]; !exists {
+		return nil, tk, fmt.Errorf("token %v is not governed", tk)
+	}
+
+	return ce, tk, nil
+}
+
+// Admin command to override the daily limit of the transfers from one chain to another. The override is persisted in the database.
+func (gov *ChainGovernor) SetCorridorLimit(emitterChain vaa.ChainID, targetChain vaa.ChainID, dailyLimit uint64) (string, error) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, err := gov.lookUpCorridorLimitAlreadyLocked(emitterChain, targetChain)
+	if err != nil {
+		return "", err
+	}
+
+	l := guardianDB.CorridorLimit{EmitterChain: emitterChain, TargetChain: targetChain, DailyLimit: dailyLimit}
+	if err := gov.db.StoreCorridorLimit(&l); err != nil {
+		gov.logger.Error("failed to store corridor limit", zap.Stringer("emitterChain", emitterChain), zap.Stringer("targetChain", targetChain), zap.Error(err))
+		return "", err
+	}
+
+	if ce.corridorLimitOverrides == nil {
+		ce.corridorLimitOverrides = make(map[vaa.ChainID]uint64)
+	}
+	ce.corridorLimitOverrides[targetChain] = dailyLimit
+
+	gov.logger.Info("updated corridor limit due to admin command",
+		zap.Stringer("emitterChain", emitterChain),
+		zap.Stringer("targetChain", targetChain),
+		zap.Uint64("dailyLimit", dailyLimit),
+	)
+	return fmt.Sprintf("daily limit from chain %v to chain %v has been set to %d", emitterChain, targetChain, dailyLimit), nil
+}
+
+// Admin command to remove the override of a corridor limit, restoring the configured limit, if there is one.
+func (gov *ChainGovernor) ResetCorridorLimit(emitterChain vaa.ChainID, targetChain vaa.ChainID) (string, error) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, err := gov.lookUpCorridorLimitAlreadyLocked(emitterChain, targetChain)
+	if err != nil {
+		return "", err
+	}
+
+	if _, exists := ce.corridorLimitOverrides[targetChain]; !exists {
+		return "", fmt.Errorf("the daily limit from chain %v to chain %v is not overridden", emitterChain, targetChain)
+	}
+
+	l := guardianDB.CorridorLimit{EmitterChain: emitterChain, TargetChain: targetChain}
+	if err := gov.db.DeleteCorridorLimit(&l); err != nil {
+		gov.logger.Error("failed to delete corridor limit", zap.Stringer("emitterChain", emitterChain), zap.Stringer("targetChain", targetChain), zap.Error(err))
+		return "", err
+	}
+
+	delete(ce.corridorLimitOverrides, targetChain)
+
+	gov.logger.Info("reset corridor limit due to admin command", zap.Stringer("emitterChain", emitterChain), zap.Stringer("targetChain", targetChain))
+	if limit, exists := ce.corridorLimit(targetChain); exists {
+		return fmt.Sprintf("daily limit from chain %v to chain %v has been reset to the configured %d", emitterChain, targetChain, limit), nil
+	}
+	return fmt.Sprintf("daily limit from chain %v to chain %v has been removed", emitterChain, targetChain), nil
+}
+
+// lookUpCorridorLimitAlreadyLocked checks that the emitter chain of a corridor limit is governed and that the target chain is a different one.
+func (gov *ChainGovernor) lookUpCorridorLimitAlreadyLocked(emitterChain vaa.ChainID, targetChain vaa.ChainID) (*chainEntry, error) {
+	ce, exists := gov.chains[emitterChain]
+	if !exists {
+		return nil, fmt.Errorf("chain %v is not governed", emitterChain)
+	}
+
+	if targetChain == emitterChain || targetChain == vaa.ChainIDUnset {
+		return nil, fmt.Errorf("invalid target chain %v", targetChain)
+	}
+
+	return ce, nil
+}
+
+// limitUsage is the remaining available notional value of a token or corridor limit.
+type limitUsage struct {
+	token       tokenKey
+	targetChain vaa.ChainID
+	limit       uint64
+	remaining   uint64
+}
+
+// limitUsagesForChain returns the remaining available notional value of the token and corridor limits of a chain.
+// A limit is reported as used up if its usage can't be computed.
+func (gov *ChainGovernor) limitUsagesForChain(ce *chainEntry, startTime time.Time) (tokenLimits []limitUsage, corridorLimits []limitUsage) {
+	remaining := func(limit uint64, scaledUsage uint64, err error) uint64 {
+		if err != nil {
+			gov.logger.Error("failed to compute the usage of a token or corridor limit", zap.Stringer("chain", ce.emitterChainId), zap.Error(err))
+			return 0
+		}
+		usage := scaleDownUsdValue(scaledUsage)
+		if usage > limit {
+			return 0
+		}
+		return limit - usage
+	}
+
+	for _, tk := range ce.limitedTokens() {
+		limit, _ := ce.tokenLimit(tk)
+		usage, err := ce.sumValueForToken(tk, startTime)
+		tokenLimits = append(tokenLimits, limitUsage{token: tk, limit: limit, remaining: remaining(limit, usage, err)})
+	}
+
+	for _, target := range ce.limitedTargetChains() {
+		limit, _ := ce.corridorLimit(target)
+		usage, err := ce.sumValueForTarget(target, startTime)
+		corridorLimits = append(corridorLimits, limitUsage{targetChain: target, limit: limit, remaining: remaining(limit, usage, err)})
+	}
+
+	return tokenLimits, corridorLimits
+}
+
+// availableNotionalByChainLimits converts the token and corridor limits of a chain for the REST query.
+func (gov *ChainGovernor) availableNotionalByChainLimits(ce *chainEntry, startTime time.Time) (
+	[]*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_TokenLimit,
+	[]*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_CorridorLimit,
+) {
+	tokenUsages, corridorUsages := gov.limitUsagesForChain(ce, startTime)
+
+	tokenLimits := make([]*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_TokenLimit, 0, len(tokenUsages))
+	for _, u := range tokenUsages {
+		tokenLimits = append(tokenLimits, &publicrpcv1.GovernorGetAvailableNotionalByChainResponse_TokenLimit{
+			OriginChainId:              uint32(u.token.chain),
+			OriginAddress:              "0x" + u.token.addr.String(),
+			RemainingAvailableNotional: u.remaining,
+			NotionalLimit:              u.limit,
+		})
+	}
+
+	corridorLimits := make([]*publicrpcv1.GovernorGetAvailableNotionalByChainResponse_CorridorLimit, 0, len(corridorUsages))
+	for _, u := range corridorUsages {
+		corridorLimits = append(corridorLimits, &publicrpcv1.GovernorGetAvailableNotionalByChainResponse_CorridorLimit{
+			TargetChainId:              uint32(u.targetChain),
+			RemainingAvailableNotional: u.remaining,
+			NotionalLimit:              u.limit,
+		})
+	}
+
+	return tokenLimits, corridorLimits
+}
+
 // sumValue sums the value of all `transfers`, returning separate fields for:
 // - the net sum of all outgoing small tranasfers minus flow cancel sum
 // - the sum of all outgoing small tranasfers
@@ -319,11 +549,14 @@ func (gov *ChainGovernor) GetAvailableNotionalByChain() (resp []*publicrpcv1.Gov
 			gov.logger.Error("GetAvailableNotionalByChain: failed to compute sum of transfers for chain entry",
 				zap.String("chainID", chainId.String()),
 				zap.Error(err))
+			tokenLimits, corridorLimits := gov.availableNotionalByChainLimits(ce, startTime)
 			resp = append(resp, &publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry{
 				ChainId:                    uint32(ce.emitterChainId),
 				RemainingAvailableNotional: 0,
 				NotionalLimit:              ce.dailyLimit,
 				BigTransactionSize:         ce.bigTransactionSize,
+				TokenLimits:                tokenLimits,
+				CorridorLimits:             corridorLimits,
 			})
 			continue
 		}
@@ -354,11 +587,14 @@ func (gov *ChainGovernor) GetAvailableNotionalByChain() (resp []*publicrpcv1.Gov
 
 		}
 
+		tokenLimits, corridorLimits := gov.availableNotionalByChainLimits(ce, startTime)
 		resp = append(resp, &publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry{
 			ChainId:                    uint32(ce.emitterChainId),
 			RemainingAvailableNotional: remaining,
 			NotionalLimit:              ce.dailyLimit,
 			BigTransactionSize:         ce.bigTransactionSize,
+			TokenLimits:                tokenLimits,
+			CorridorLimits:             corridorLimits,
 		})
 
 	}
@@ -660,7 +896,21 @@ func (gov *ChainGovernor) publishStatus(ctx context.Context, hb *gossipv1.Heartb
 			remaining = gov.availableNotionalValue(chainId, netUsage)
 		}
 
-		enqueuedVaas := make([]*gossipv1.ChainGovernorStatus_EnqueuedVAA, 0)
+		// The token bridge is listed first, followed by the NTT emitters, each with its own enqueued VAAs.
+		emitters := []*gossipv1.ChainGovernorStatus_Emitter{{
+			EmitterAddress: "0x" + ce.emitterAddr.String(),
+			EnqueuedVaas:   make([]*gossipv1.ChainGovernorStatus_EnqueuedVAA, 0),
+		}}
+		emittersByAddr := map[vaa.Address]*gossipv1.ChainGovernorStatus_Emitter{ce.emitterAddr: emitters[0]}
+		for _, addr := range ce.nttEmitterAddrs() {
+			emitter := &gossipv1.ChainGovernorStatus_Emitter{
+				EmitterAddress: "0x" + addr.String(),
+				EnqueuedVaas:   make([]*gossipv1.ChainGovernorStatus_EnqueuedVAA, 0),
+			}
+			emitters = append(emitters, emitter)
+			emittersByAddr[addr] = emitter
+		}
+
 		for _, pe := range ce.pending {
 			var value uint64
 			scaledValue, err := scaledUsdValue(pe.amount, pe.token)
@@ -670,9 +920,15 @@ func (gov *ChainGovernor) publishStatus(ctx context.Context, hb *gossipv1.Heartb
 				value = scaleDownUsdValue(scaledValue)
 			}
 
+			emitter, exists := emittersByAddr[pe.dbData.Msg.EmitterAddress]
+			if !exists {
+				emitter = emitters[0]
+			}
+			emitter.TotalEnqueuedVaas++
+
 			if numEnqueued < 20 {
 				numEnqueued = numEnqueued + 1
-				enqueuedVaas = append(enqueuedVaas, &gossipv1.ChainGovernorStatus_EnqueuedVAA{
+				emitter.EnqueuedVaas = append(emitter.EnqueuedVaas, &gossipv1.ChainGovernorStatus_EnqueuedVAA{
 					Sequence:      pe.dbData.Msg.Sequence,
 					ReleaseTime:   uint32(pe.dbData.ReleaseTime.Unix()), // #nosec G115 -- This conversion is safe until year 2106
 					NotionalValue: value,
@@ -681,19 +937,34 @@ func (gov *ChainGovernor) publishStatus(ctx context.Context, hb *gossipv1.Heartb
 			}
 		}
 
-		emitter := gossipv1.ChainGovernorStatus_Emitter{
-			EmitterAddress:    "0x" + ce.emitterAddr.String(),
-			TotalEnqueuedVaas: uint64(len(ce.pending)),
-			EnqueuedVaas:      enqueuedVaas,
+		tokenUsages, corridorUsages := gov.limitUsagesForChain(ce, startTime)
+		tokenLimits := make([]*gossipv1.ChainGovernorStatus_TokenLimit, 0, len(tokenUsages))
+		for _, u := range tokenUsages {
+			tokenLimits = append(tokenLimits, &gossipv1.ChainGovernorStatus_TokenLimit{
+				OriginChainId:              uint32(u.token.chain),
+				OriginAddress:              "0x" + u.token.addr.String(),
+				RemainingAvailableNotional: u.remaining,
+				NotionalLimit:              u.limit,
+			})
+		}
+		corridorLimits := make([]*gossipv1.ChainGovernorStatus_CorridorLimit, 0, len(corridorUsages))
+		for _, u := range corridorUsages {
+			corridorLimits = append(corridorLimits, &gossipv1.ChainGovernorStatus_CorridorLimit{
+				TargetChainId:              uint32(u.targetChain),
+				RemainingAvailableNotional: u.remaining,
+				NotionalLimit:              u.limit,
+			})
 		}
 
 		chains = append(chains, &gossipv1.ChainGovernorStatus_Chain{
 			ChainId:                      uint32(ce.emitterChainId),
 			RemainingAvailableNotional:   remaining,
-			Emitters:                     []*gossipv1.ChainGovernorStatus_Emitter{&emitter},
+			Emitters:                     emitters,
 			SmallTxNetNotionalValue:      netUsage,
 			SmallTxOutgoingNotionalValue: smallTxNotional,
 			FlowCancelNotionalValue:      flowCancelNotional,
+			TokenLimits:                  tokenLimits,
+			CorridorLimits:               corridorLimits,
 		})
 	}
 
@@ -1,8 +1,9 @@
 // This file contains the code to query for and update token prices for the chain governor.
 //
 // The initial prices are read from the static config (tokens.go). After that, prices are
-// queried from CoinGecko. The chain governor then uses the maximum of the static price and
-// the latest CoinGecko price. The CoinGecko poll interval is specified by coinGeckoQueryIntervalInMins.
+// queried from the sources of the price oracle (price_oracle.go), which defaults to CoinGecko only.
+// The chain governor then uses the maximum of the static price and the latest aggregated price.
+// The poll interval is specified by coinGeckoQueryIntervalInMins.
 
 package governor
 
@@ -34,25 +35,32 @@ const coinGeckoQueryIntervalInMins = 120
 // tokensPerCoinGeckoQuery specifies how many tokens will be in each CoinGecko query. The token list will be broken up into chunks of this size.
 const tokensPerCoinGeckoQuery = 200
 
-// initCoinGecko builds the set of CoinGecko queries that will be used to update prices. It also starts a go routine to periodically do the queries.
-func (gov *ChainGovernor) initCoinGecko(ctx context.Context, run bool) error {
-	// Create a slice of all the CoinGecko IDs so we can create the corresponding queries.
-	ids := make([]string, 0, len(gov.tokensByCoinGeckoId))
-	for id := range gov.tokensByCoinGeckoId {
-		ids = append(ids, id)
+// initPriceOracle creates the sources of the price oracle that will be used to update prices. It also starts a go routine to periodically query them.
+func (gov *ChainGovernor) initPriceOracle(ctx context.Context, run bool) error {
+	cfg := gov.priceOracleConfig
+	if cfg == nil {
+		cfg = defaultPriceOracleConfig()
 	}
 
-	// Create the set of queries, breaking the IDs into the appropriate size chunks.
-	gov.coinGeckoQueries = createCoinGeckoQueries(ids, tokensPerCoinGeckoQuery, gov.coinGeckoApiKey)
-	for queryIdx, query := range gov.coinGeckoQueries {
-		gov.logger.Info("coingecko query: ", zap.Int("queryIdx", queryIdx), zap.String("query", query))
+	oracle, err := parsePriceOracle(cfg)
+	if err != nil {
+		return err
 	}
 
-	if len(gov.coinGeckoQueries) == 0 {
+	if len(gov.tokensByCoinGeckoId) == 0 {
 		gov.logger.Info("did not find any tokens, nothing to do!")
 		return nil
 	}
 
+	if err := oracle.connect(ctx, gov.logger, gov.coinGeckoApiKey); err != nil {
+		return err
+	}
+	gov.priceOracle = oracle
+
+	for _, src := range oracle.sources {
+		gov.logger.Info("price source", zap.String("name", src.name), zap.String("type", src.kind), zap.Duration("maxAge", src.maxAge))
+	}
+
 	if run {
 		if err := supervisor.Run(ctx, "govpricer", gov.priceQuery); err != nil {
 			return err
@@ -113,12 +121,14 @@ func createCoinGeckoQuery(ids string, coinGeckoApiKey string) string {
 	return query
 }
 
-// priceQuery is the entry point for the routine that periodically queries CoinGecko for prices.
+// priceQuery is the entry point for the routine that periodically queries the price oracle for prices.
 func (gov *ChainGovernor) priceQuery(ctx context.Context) error {
+	defer gov.priceOracle.close()
+
 	// Do a query immediately, then once each interval.
 	// We ignore the error because an error would already have been logged, and we don't want to bring down the
-	// guardian due to a CoinGecko error. The prices would already have been reverted to the config values.
-	_ = gov.queryCoinGecko(ctx)
+	// guardian due to a price source error. The prices would already have been reverted to the config values.
+	_ = gov.queryPrices(ctx)
 
 	ticker := time.NewTicker(time.Duration(coinGeckoQueryIntervalInMins) * time.Minute)
 	defer ticker.Stop()
@@ -128,16 +138,88 @@ func (gov *ChainGovernor) priceQuery(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		case <-ticker.C:
-			_ = gov.queryCoinGecko(ctx)
+			_ = gov.queryPrices(ctx)
 		}
 	}
 }
 
-// queryCoinGecko sends a series of one or more queries to the CoinGecko server to get the latest prices. It can
-// return an error, but that is only used by the tool that validates the query. In the actual governor,
-// it just logs the error and we will try again next interval. If an error happens, any tokens that have
-// not been updated will be assigned their pre-configured price.
-func (gov *ChainGovernor) queryCoinGecko(ctx context.Context) error {
+// queryPrices queries the price oracle for the latest prices. It can return an error, but that is only used by
+// the tool that validates the query. In the actual governor, it just logs the error and we will try again next
+// interval. If an error happens, any tokens that have not been updated will be assigned their pre-configured price.
+func (gov *ChainGovernor) queryPrices(ctx context.Context) error {
+	gov.mutex.Lock()
+	ids := make([]string, 0, len(gov.tokensByCoinGeckoId))
+	for id := range gov.tokensByCoinGeckoId {
+		ids = append(ids, id)
+	}
+	gov.mutex.Unlock()
+
+	prices, err := gov.priceOracle.queryPrices(ctx, gov.logger, ids)
+	if err != nil {
+		gov.logger.Error("price query failed", zap.Error(err))
+		gov.revertAllPrices()
+		return err
+	}
+
+	now := time.Now()
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	missing := false
+	for coinGeckoId, cge := range gov.tokensByCoinGeckoId {
+		price, exists := prices[coinGeckoId]
+		if !exists {
+			for _, te := range cge {
+				gov.logger.Error("did not receive a price for symbol, reverting to configured price",
+					zap.String("symbol", te.symbol),
+					zap.String("coinGeckoId",
+						te.coinGeckoId),
+					zap.Stringer("cfgPrice", te.cfgPrice),
+				)
+
+				te.price.Set(te.cfgPrice)
+				// Don't update the timestamp so we'll know when we last received a price update.
+			}
+			missing = true
+			continue
+		}
+
+		for _, te := range cge {
+			te.oraclePrice = big.NewFloat(price)
+			te.updatePrice()
+			te.priceTime = now
+		}
+	}
+
+	if missing {
+		return fmt.Errorf("failed to update prices for some tokens")
+	}
+
+	return nil
+}
+
+// coinGeckoSource is the price source that queries the CoinGecko API.
+type coinGeckoSource struct {
+	name            string
+	logger          *zap.Logger
+	coinGeckoApiKey string
+}
+
+func newCoinGeckoSource(name string, logger *zap.Logger, coinGeckoApiKey string) *coinGeckoSource {
+	return &coinGeckoSource{
+		name:            name,
+		logger:          logger,
+		coinGeckoApiKey: coinGeckoApiKey,
+	}
+}
+
+func (s *coinGeckoSource) Name() string {
+	return s.name
+}
+
+// QueryPrices sends a series of one or more queries to the CoinGecko server to get the latest prices.
+func (s *coinGeckoSource) QueryPrices(ctx context.Context, coinGeckoIds []string) (map[string]PriceQuote, error) {
+	queries := createCoinGeckoQueries(coinGeckoIds, tokensPerCoinGeckoQuery, s.coinGeckoApiKey)
 	result := make(map[string]interface{})
 
 	// Cache buster of Unix timestamp concatenated with random number
@@ -164,14 +246,13 @@ func (gov *ChainGovernor) queryCoinGecko(ctx context.Context) error {
 		}
 	}()
 
-	for queryIdx, query := range gov.coinGeckoQueries {
+	for queryIdx, query := range queries {
 		<-throttle
 		query = query + "&" + params.Encode()
-		thisResult, err := gov.queryCoinGeckoChunk(query)
+		thisResult, err := s.queryCoinGeckoChunk(query)
 		if err != nil {
-			gov.logger.Error("CoinGecko query failed", zap.Error(err), zap.Int("queryIdx", queryIdx), zap.String("query", query))
-			gov.revertAllPrices()
-			return err
+			s.logger.Error("CoinGecko query failed", zap.Error(err), zap.Int("queryIdx", queryIdx), zap.String("query", query))
+			return nil, err
 		}
 
 		for key, value := range thisResult {
@@ -182,80 +263,44 @@ func (gov *ChainGovernor) queryCoinGecko(ctx context.Context) error {
 	}
 
 	now := time.Now()
-	gov.mutex.Lock()
-	defer gov.mutex.Unlock()
-
-	localTokenMap := make(map[string][]*tokenEntry)
-	for coinGeckoId, cge := range gov.tokensByCoinGeckoId {
-		localTokenMap[coinGeckoId] = cge
-	}
-
+	quotes := make(map[string]PriceQuote, len(result))
 	for coinGeckoId, data := range result {
-		cge, exists := gov.tokensByCoinGeckoId[coinGeckoId]
-		if exists {
-			// If a price is not set in CoinGecko, they return an empty entry. Treat that as a zero price.
-			var price float64
-			m, ok := data.(map[string]interface{})
+		// If a price is not set in CoinGecko, they return an empty entry. Treat that as a zero price.
+		var price float64
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			s.logger.Error("failed to parse CoinGecko response", zap.String("coinGeckoId", coinGeckoId))
+			// By continuing, we leave this one out of the quotes so the price will get reverted.
+			continue
+		}
+		if len(m) != 0 {
+			var ok bool
+			price_, ok := m["usd"]
 			if !ok {
-				gov.logger.Error("failed to parse CoinGecko response, reverting to configured price for this token", zap.String("coinGeckoId", coinGeckoId))
-				// By continuing, we leave this one in the local map so the price will get reverted below.
+				s.logger.Error("failed to parse CoinGecko response", zap.String("coinGeckoId", coinGeckoId))
+				// By continuing, we leave this one out of the quotes so the price will get reverted.
 				continue
 			}
-			if len(m) != 0 {
-				var ok bool
-				price_, ok := m["usd"]
-				if !ok {
-					gov.logger.Error("failed to parse CoinGecko response, reverting to configured price for this token", zap.String("coinGeckoId", coinGeckoId))
-					// By continuing, we leave this one in the local map so the price will get reverted below.
-					continue
-				}
-
-				price, ok = price_.(float64)
-				if !ok {
-					gov.logger.Error("failed to parse CoinGecko response, reverting to configured price for this token", zap.String("coinGeckoId", coinGeckoId))
-					// By continuing, we leave this one in the local map so the price will get reverted below.
-					continue
-				}
-			}
-
-			for _, te := range cge {
-				te.coinGeckoPrice = big.NewFloat(price)
-				te.updatePrice()
-				te.priceTime = now
-			}
 
-			delete(localTokenMap, coinGeckoId)
-		} else {
-			gov.logger.Error("received a CoinGecko response for an unexpected symbol", zap.String("coinGeckoId", coinGeckoId))
-		}
-	}
-
-	if len(localTokenMap) != 0 {
-		for _, lcge := range localTokenMap {
-			for _, te := range lcge {
-				gov.logger.Error("did not receive a CoinGecko response for symbol, reverting to configured price",
-					zap.String("symbol", te.symbol),
-					zap.String("coinGeckoId",
-						te.coinGeckoId),
-					zap.Stringer("cfgPrice", te.cfgPrice),
-				)
-
-				te.price = te.cfgPrice
-				// Don't update the timestamp so we'll know when we last received an update from CoinGecko.
+			price, ok = price_.(float64)
+			if !ok {
+				s.logger.Error("failed to parse CoinGecko response", zap.String("coinGeckoId", coinGeckoId))
+				// By continuing, we leave this one out of the quotes so the price will get reverted.
+				continue
 			}
 		}
 
-		return fmt.Errorf("failed to update prices for some tokens")
+		quotes[coinGeckoId] = PriceQuote{Price: price, Time: now}
 	}
 
-	return nil
+	return quotes, nil
 }
 
 // queryCoinGeckoChunk sends a single CoinGecko query and returns the result.
-func (gov *ChainGovernor) queryCoinGeckoChunk(query string) (map[string]interface{}, error) {
+func (s *coinGeckoSource) queryCoinGeckoChunk(query string) (map[string]interface{}, error) {
 	var result map[string]interface{}
 
-	gov.logger.Debug("executing CoinGecko query", zap.String("query", query))
+	s.logger.Debug("executing CoinGecko query", zap.String("query", query))
 	// #nosec G107 // the URL is hard-coded to the CoinGecko API. See [createCoinGeckoQuery].
 	response, err := http.Get(query) //nolint:noctx // TODO: a context should be added here.
 	if err != nil {
@@ -265,7 +310,7 @@ func (gov *ChainGovernor) queryCoinGeckoChunk(query string) (map[string]interfac
 	defer func() {
 		err = response.Body.Close()
 		if err != nil {
-			gov.logger.Error("failed to close CoinGecko query: %w", zap.Error(err))
+			s.logger.Error("failed to close CoinGecko query: %w", zap.Error(err))
 		}
 	}()
 
@@ -286,7 +331,7 @@ func (gov *ChainGovernor) queryCoinGeckoChunk(query string) (map[string]interfac
 	return result, nil
 }
 
-// revertAllPrices reverts the price of all tokens to the configured prices. It is used when a CoinGecko query fails.
+// revertAllPrices reverts the price of all tokens to the configured prices. It is used when all price sources fail.
 func (gov *ChainGovernor) revertAllPrices() {
 	gov.mutex.Lock()
 	defer gov.mutex.Unlock()
@@ -299,18 +344,18 @@ func (gov *ChainGovernor) revertAllPrices() {
 				zap.Stringer("cfgPrice", te.cfgPrice),
 			)
 
-			te.price = te.cfgPrice
-			// Don't update the timestamp so we'll know when we last received an update from CoinGecko.
+			te.price.Set(te.cfgPrice)
+			// Don't update the timestamp so we'll know when we last received a price update.
 		}
 	}
 }
 
-// updatePrice updates the price of a single token. We should use the max(coinGeckoPrice, configuredPrice) as our price for computing notional value.
+// updatePrice updates the price of a single token. We should use the max(oraclePrice, configuredPrice) as our price for computing notional value.
 func (te tokenEntry) updatePrice() {
-	if (te.coinGeckoPrice == nil) || (te.coinGeckoPrice.Cmp(te.cfgPrice) < 0) {
+	if (te.oraclePrice == nil) || (te.oraclePrice.Cmp(te.cfgPrice) < 0) {
 		te.price.Set(te.cfgPrice)
 	} else {
-		te.price.Set(te.coinGeckoPrice)
+		te.price.Set(te.oraclePrice)
 	}
 }
 
@@ -326,12 +371,12 @@ func CheckQuery(logger *zap.Logger) error {
 	}
 
 	logger.Info("Building CoinGecko query.")
-	if err := gov.initCoinGecko(ctx, false); err != nil {
+	if err := gov.initPriceOracle(ctx, false); err != nil {
 		return err
 	}
 
 	logger.Info("Initiating CoinGecko query.")
-	if err := gov.queryCoinGecko(ctx); err != nil {
+	if err := gov.queryPrices(ctx); err != nil {
 		return err
 	}
 
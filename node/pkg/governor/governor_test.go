@@ -8,6 +8,7 @@ import (
 	"math"
 	"math/big"
 	"net/url"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	guardianDB "github.com/certusone/wormhole/node/pkg/db"
+	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -4015,3 +4017,202 @@ func Test_SubDollarTransfersAccumulate(t *testing.T) {
 		})
 	}
 }
+
+// newLimitTestMsg returns a transfer of amount WETH from Ethereum to the target chain.
+func newLimitTestMsg(t *testing.T, sequence uint64, targetChain vaa.ChainID, amount float64) *common.MessagePublication {
+	t.Helper()
+	tokenBridgeAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	return &common.MessagePublication{
+		TxID:             hashToTxID("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         sequence,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		ConsistencyLevel: uint8(32),
+		Payload: buildMockTransferPayloadBytes(1,
+			vaa.ChainIDEthereum,
+			"0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E",
+			targetChain,
+			"0x707f9118e33a9b8998bea41dd0d46f38bb963fc8",
+			amount,
+		),
+	}
+}
+
+func TestTokenLimitEnqueuesAndReleasesTransfers(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+
+	wethAddr, err := vaa.StringToAddress("0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E")
+	require.NoError(t, err)
+	otherAddrStr := "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, otherAddrStr, "OTHER", 1774.62, false))
+
+	// Each transfer of 1.25 WETH is worth $2218.
+	_, err = gov.SetTokenLimit(vaa.ChainIDEthereum, vaa.ChainIDEthereum, wethAddr, 5000)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for seq := uint64(1); seq <= 2; seq++ {
+		canPost, err := gov.processMsgForTime(newLimitTestMsg(t, seq, vaa.ChainIDPolygon, 1.25), now)
+		require.NoError(t, err)
+		assert.True(t, canPost)
+	}
+
+	// The third transfer exceeds the token limit, even though it fits in the daily limit of the chain.
+	canPost, err := gov.processMsgForTime(newLimitTestMsg(t, 3, vaa.ChainIDPolygon, 1.25), now)
+	require.NoError(t, err)
+	assert.False(t, canPost)
+
+	// Other tokens are not affected.
+	other := newLimitTestMsg(t, 4, vaa.ChainIDPolygon, 1.25)
+	other.Payload = buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, otherAddrStr, vaa.ChainIDPolygon, otherAddrStr, 1.25)
+	canPost, err = gov.processMsgForTime(other, now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	numTrans, _, numPending, _ := gov.getStatsForAllChains()
+	assert.Equal(t, 3, numTrans)
+	assert.Equal(t, 1, numPending)
+
+	resp := gov.GetAvailableNotionalByChain()
+	idx := slices.IndexFunc(resp, func(e *publicrpcv1.GovernorGetAvailableNotionalByChainResponse_Entry) bool {
+		return e.ChainId == uint32(vaa.ChainIDEthereum)
+	})
+	require.NotEqual(t, -1, idx)
+	require.Len(t, resp[idx].TokenLimits, 1)
+	assert.Equal(t, "0x"+wethAddr.String(), resp[idx].TokenLimits[0].OriginAddress)
+	assert.Equal(t, uint64(5000), resp[idx].TokenLimits[0].NotionalLimit)
+	assert.Equal(t, uint64(5000-4436), resp[idx].TokenLimits[0].RemainingAvailableNotional)
+	assert.Empty(t, resp[idx].CorridorLimits)
+
+	// The pending transfer stays enqueued until the token limit is raised.
+	msgs, err := gov.checkPendingForTime(now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+
+	_, err = gov.SetTokenLimit(vaa.ChainIDEthereum, vaa.ChainIDEthereum, wethAddr, 10000)
+	require.NoError(t, err)
+	msgs, err = gov.checkPendingForTime(now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, uint64(3), msgs[0].Sequence)
+
+	// Removing the override removes the limit, as there is none in the config.
+	resp1, err := gov.ResetTokenLimit(vaa.ChainIDEthereum, vaa.ChainIDEthereum, wethAddr)
+	require.NoError(t, err)
+	assert.Contains(t, resp1, "removed")
+	_, exists := gov.chains[vaa.ChainIDEthereum].tokenLimit(tokenKey{chain: vaa.ChainIDEthereum, addr: wethAddr})
+	assert.False(t, exists)
+	_, err = gov.ResetTokenLimit(vaa.ChainIDEthereum, vaa.ChainIDEthereum, wethAddr)
+	require.Error(t, err)
+}
+
+func TestCorridorLimitEnqueuesTransfers(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+
+	// Use a short day so that the limit frees up well before the release time of the enqueued transfer.
+	gov.setDayLengthInMinutes(60)
+	_, err := gov.SetCorridorLimit(vaa.ChainIDEthereum, vaa.ChainIDPolygon, 3000)
+	require.NoError(t, err)
+
+	now := time.Now()
+	canPost, err := gov.processMsgForTime(newLimitTestMsg(t, 1, vaa.ChainIDPolygon, 1.25), now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	canPost, err = gov.processMsgForTime(newLimitTestMsg(t, 2, vaa.ChainIDPolygon, 1.25), now)
+	require.NoError(t, err)
+	assert.False(t, canPost)
+
+	// Transfers to other target chains are not affected.
+	canPost, err = gov.processMsgForTime(newLimitTestMsg(t, 3, vaa.ChainIDBSC, 1.25), now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	tokenLimits, corridorLimits := gov.limitUsagesForChain(gov.chains[vaa.ChainIDEthereum], now.Add(-time.Hour))
+	assert.Empty(t, tokenLimits)
+	require.Len(t, corridorLimits, 1)
+	assert.Equal(t, limitUsage{targetChain: vaa.ChainIDPolygon, limit: 3000, remaining: 3000 - 2218}, corridorLimits[0])
+
+	// The corridor capacity frees up once the first transfer is more than a day old.
+	msgs, err := gov.checkPendingForTime(now.Add(30 * time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+	msgs, err = gov.checkPendingForTime(now.Add(61 * time.Minute))
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, uint64(2), msgs[0].Sequence)
+
+	_, err = gov.ResetCorridorLimit(vaa.ChainIDEthereum, vaa.ChainIDPolygon)
+	require.NoError(t, err)
+	_, corridorLimits = gov.limitUsagesForChain(gov.chains[vaa.ChainIDEthereum], now)
+	assert.Empty(t, corridorLimits)
+}
+
+func TestConfiguredLimitsCanBeOverridden(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+	ce := gov.chains[vaa.ChainIDEthereum]
+	ce.corridorLimits = map[vaa.ChainID]uint64{vaa.ChainIDSolana: 1000}
+
+	_, err := gov.SetCorridorLimit(vaa.ChainIDEthereum, vaa.ChainIDSolana, 0)
+	require.NoError(t, err)
+	limit, exists := ce.corridorLimit(vaa.ChainIDSolana)
+	assert.True(t, exists)
+	assert.Equal(t, uint64(0), limit)
+
+	resp, err := gov.ResetCorridorLimit(vaa.ChainIDEthereum, vaa.ChainIDSolana)
+	require.NoError(t, err)
+	assert.Contains(t, resp, "configured 1000")
+	limit, exists = ce.corridorLimit(vaa.ChainIDSolana)
+	assert.True(t, exists)
+	assert.Equal(t, uint64(1000), limit)
+}
+
+func TestSetLimitsRejectsUngovernedChainsAndTokens(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+
+	wethAddr, err := vaa.StringToAddress("0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E")
+	require.NoError(t, err)
+
+	_, err = gov.SetTokenLimit(vaa.ChainIDPythNet, vaa.ChainIDEthereum, wethAddr, 1000)
+	require.ErrorContains(t, err, "is not governed")
+	_, err = gov.SetTokenLimit(vaa.ChainIDEthereum, vaa.ChainIDSolana, wethAddr, 1000)
+	require.ErrorContains(t, err, "is not governed")
+	_, err = gov.SetCorridorLimit(vaa.ChainIDPythNet, vaa.ChainIDSolana, 1000)
+	require.ErrorContains(t, err, "is not governed")
+	_, err = gov.SetCorridorLimit(vaa.ChainIDEthereum, vaa.ChainIDEthereum, 1000)
+	require.ErrorContains(t, err, "invalid target chain")
+}
+
+func TestReloadLimits(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+
+	wethAddr, err := vaa.StringToAddress("0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E")
+	require.NoError(t, err)
+	ce := gov.chains[vaa.ChainIDEthereum]
+	ce.corridorLimitOverrides = map[vaa.ChainID]uint64{vaa.ChainIDBSC: 1}
+
+	gov.reloadLimits(
+		[]*guardianDB.TokenLimit{
+			{EmitterChain: vaa.ChainIDEthereum, OriginChain: vaa.ChainIDEthereum, OriginAddress: wethAddr, DailyLimit: 1000},
+			// Not a governed token.
+			{EmitterChain: vaa.ChainIDEthereum, OriginChain: vaa.ChainIDSolana, OriginAddress: wethAddr, DailyLimit: 1000},
+		},
+		[]*guardianDB.CorridorLimit{
+			{EmitterChain: vaa.ChainIDEthereum, TargetChain: vaa.ChainIDSui, DailyLimit: 2000},
+			// Not a governed chain.
+			{EmitterChain: vaa.ChainIDPythNet, TargetChain: vaa.ChainIDSui, DailyLimit: 2000},
+		},
+	)
+
+	assert.Equal(t, map[tokenKey]uint64{{chain: vaa.ChainIDEthereum, addr: wethAddr}: 1000}, ce.tokenLimitOverrides)
+	assert.Equal(t, map[vaa.ChainID]uint64{vaa.ChainIDSui: 2000}, ce.corridorLimitOverrides)
+}
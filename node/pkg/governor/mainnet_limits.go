@@ -0,0 +1,19 @@
+// This file contains the optional token and corridor limits to be used in the mainnet environment.
+//
+// This file is maintained by hand. Add / remove / update entries as appropriate.
+
+package governor
+
+// TokenLimitList returns the daily limits on the notional value of a single token leaving a chain. A token limit
+// keeps a single compromised token from using up the whole daily limit of a chain. The emitter chain and the token
+// must both be configured in the chain and token lists.
+func TokenLimitList() []TokenLimitConfigEntry {
+	return []TokenLimitConfigEntry{}
+}
+
+// CorridorLimitList returns the daily limits on the notional value sent from an emitter chain to a target chain. A
+// corridor limit caps the flow into a destination chain that cannot absorb the full daily limit of the emitter. The
+// emitter chain must be configured in the chain list, but the target chain need not be.
+func CorridorLimitList() []CorridorLimitConfigEntry {
+	return []CorridorLimitConfigEntry{}
+}
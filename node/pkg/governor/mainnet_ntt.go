@@ -0,0 +1,36 @@
+// This file contains the Native Token Transfer (NTT) deployments to be governed in the mainnet environment.
+//
+// This file is maintained by hand. Add / remove / update entries as appropriate.
+
+package governor
+
+import (
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// NttEmitterList returns the NTT transceivers whose transfers are governed, in addition to the token bridge transfers.
+// The emitter chains must be configured in the chain list. The transfers count towards the daily limit of the emitter
+// chain, but only for the tokens in the token list or in NttTokenList.
+func NttEmitterList() []NttEmitterConfigEntry {
+	return []NttEmitterConfigEntry{
+		// W
+		{EmitterChainID: vaa.ChainIDSolana, Addr: "cf5f3614e2cd9b374558f35c7618b25f0d306d5e749b7d29cc030a1a15686238"},
+		{EmitterChainID: vaa.ChainIDEthereum, Addr: "000000000000000000000000Db55492d7190D1baE8ACbE03911C4E3E7426870c"},
+		{EmitterChainID: vaa.ChainIDArbitrum, Addr: "000000000000000000000000D1a8AB69e00266e8B791a15BC47514153A5045a6"},
+		{EmitterChainID: vaa.ChainIDOptimism, Addr: "0000000000000000000000009bD8b7b527CA4e6738cBDaBdF51C22466756073d"},
+		{EmitterChainID: vaa.ChainIDBase, Addr: "000000000000000000000000D1a8AB69e00266e8B791a15BC47514153A5045a6"},
+		// Lido wstETH
+		{EmitterChainID: vaa.ChainIDEthereum, Addr: "000000000000000000000000A1ACC1e6edaB281Febd91E3515093F1DE81F25c0"},
+		{EmitterChainID: vaa.ChainIDBSC, Addr: "000000000000000000000000be3F7e06872E0dF6CD7FF35B7aa4Bb1446DC9986"},
+	}
+}
+
+// NttTokenList returns the tokens sent by the NTT emitters that are missing from the main token list. A native token
+// transfer is identified by the address of the token on the emitter chain, where it is locked or burned, so there is
+// one entry per chain. Tokens that are already in the main token list must not be repeated here.
+func NttTokenList() []TokenConfigEntry {
+	return []TokenConfigEntry{
+		{Chain: 4, Addr: "00000000000000000000000026c5e01524d2e6280a48f2c50ff6de7e52e9611c", Symbol: "wstETH", CoinGeckoId: "wrapped-steth", Decimals: 18, Price: 2112.61}, // Addr: 0x26c5e01524d2E6280A48F2c50fF6De7e52E9611C
+		{Chain: 24, Addr: "000000000000000000000000b0ffa8000886e57f86dd5264b9582b2ad87b2b91", Symbol: "W", CoinGeckoId: "wormhole", Decimals: 18, Price: 0.00947581},       // Addr: 0xB0fFa8000886e57F86dd5264b9582b2Ad87b2b91
+	}
+}
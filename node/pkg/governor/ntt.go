@@ -0,0 +1,97 @@
+package governor
+
+// This file contains the governance of Native Token Transfers (NTT), which are governed like token bridge transfers.
+//
+// Only the transfers published directly by the configured transceivers are governed. Those forwarded by the automatic
+// relayer are not.
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ntt"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// isTransferPayload returns true if the payload may be governed, that is, if it is either a token bridge transfer or
+// a native token transfer. It can be checked without the governor lock.
+func isTransferPayload(payload []byte) bool {
+	return vaa.IsTransfer(payload) || ntt.IsTransfer(payload)
+}
+
+// nttTransferHdr converts a native token transfer sent from emitterChain into the header of a token bridge transfer.
+// The token is identified by its address on the emitter chain, where it is locked or burned. The amount is still
+// expressed in the decimals of the transfer, see nttAmountForToken.
+func nttTransferHdr(nt *ntt.Transfer, emitterChain vaa.ChainID) *vaa.TransferPayloadHdr {
+	return &vaa.TransferPayloadHdr{
+		Amount:        new(big.Int).SetUint64(nt.Amount),
+		OriginAddress: nt.SourceToken,
+		OriginChain:   emitterChain,
+		TargetAddress: nt.To,
+		TargetChain:   nt.ToChain,
+	}
+}
+
+// nttAmountForToken returns the amount of the transfer expressed in the decimals that the governor uses for the token,
+// like the amount of a token bridge transfer. The transfer decimals cannot exceed those of the token, so no precision
+// is lost.
+func nttAmountForToken(nt *ntt.Transfer, token *tokenEntry) (*big.Int, error) {
+	transferDecimals := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(nt.Decimals)), nil)
+	if token.decimals.Cmp(transferDecimals) < 0 {
+		return nil, fmt.Errorf("native token transfer of %s has %d decimals, more than the token", token.symbol, nt.Decimals)
+	}
+
+	amount := new(big.Int).SetUint64(nt.Amount)
+	amount.Mul(amount, token.decimals)
+	return amount.Quo(amount, transferDecimals), nil
+}
+
+// decodeTransferAlreadyLocked decodes the payload of a message from a governed emitter of the chain entry, and looks
+// up the governed token that it transfers. The payload is nil if the message is not a transfer from that emitter, and
+// the token is nil if it is not governed. It assumes the caller holds the lock.
+func (gov *ChainGovernor) decodeTransferAlreadyLocked(
+	ce *chainEntry,
+	msg *common.MessagePublication,
+) (*vaa.TransferPayloadHdr, *tokenEntry, error) {
+	var payload *vaa.TransferPayloadHdr
+	var nt *ntt.Transfer
+	switch {
+	case msg.EmitterAddress == ce.emitterAddr:
+		if !vaa.IsTransfer(msg.Payload) {
+			return nil, nil, nil
+		}
+		var err error
+		payload, err = vaa.DecodeTransferPayloadHdr(msg.Payload)
+		if err != nil {
+			return nil, nil, err
+		}
+	case ce.isNttEmitter(msg.EmitterAddress):
+		if !ntt.IsTransfer(msg.Payload) {
+			return nil, nil, nil
+		}
+		var err error
+		nt, err = ntt.DecodeTransfer(msg.Payload)
+		if err != nil {
+			return nil, nil, err
+		}
+		payload = nttTransferHdr(nt, msg.EmitterChain)
+	default:
+		return nil, nil, nil
+	}
+
+	token, exists := gov.tokens[tokenKey{chain: payload.OriginChain, addr: payload.OriginAddress}]
+	if !exists {
+		return payload, nil, nil
+	}
+
+	if nt != nil {
+		amount, err := nttAmountForToken(nt, token)
+		if err != nil {
+			return nil, nil, err
+		}
+		payload.Amount = amount
+	}
+
+	return payload, token, nil
+}
@@ -0,0 +1,284 @@
+package governor
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	guardianDB "github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/ntt"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	nttTestEmitterAddr = "0x855FA758c77D68a04990E992aA4dcdeF899F654A"
+	nttTestTokenAddr   = "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	nttTestToAddr      = "0x0fd04a68d3c3a692d6fa30384d1a87ef93554ee6"
+)
+
+// buildMockNttPayloadBytes builds the transceiver message of a native token transfer, with an amount expressed in
+// the given decimals.
+func buildMockNttPayloadBytes(decimals uint8, amount uint64, tokenAddrStr string, toChainID vaa.ChainID, toAddrStr string) []byte {
+	transfer := append([]byte{}, ntt.TransferPrefix...)
+	transfer = append(transfer, decimals)
+	transfer = binary.BigEndian.AppendUint64(transfer, amount)
+	tokenAddr, _ := vaa.StringToAddress(tokenAddrStr)
+	transfer = append(transfer, tokenAddr.Bytes()...)
+	toAddr, _ := vaa.StringToAddress(toAddrStr)
+	transfer = append(transfer, toAddr.Bytes()...)
+	transfer = binary.BigEndian.AppendUint16(transfer, uint16(toChainID))
+
+	// Message ID and sender.
+	managerMsg := make([]byte, 64)
+	managerMsg[31] = 1
+	managerMsg = binary.BigEndian.AppendUint16(managerMsg, uint16(len(transfer))) // #nosec G115 -- The transfer is short
+	managerMsg = append(managerMsg, transfer...)
+
+	// Source and recipient NTT managers.
+	payload := append([]byte{}, ntt.TransceiverPrefix...)
+	payload = append(payload, make([]byte, 64)...)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(len(managerMsg))) // #nosec G115 -- The message is short
+	payload = append(payload, managerMsg...)
+	// Empty transceiver payload.
+	return binary.BigEndian.AppendUint16(payload, 0)
+}
+
+func newNttTestMsg(t *testing.T, sequence uint64, emitterChain vaa.ChainID, payload []byte) *common.MessagePublication {
+	t.Helper()
+	emitterAddr, err := vaa.StringToAddress(nttTestEmitterAddr)
+	require.NoError(t, err)
+	return &common.MessagePublication{
+		TxID:             hashToTxID("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063"),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         sequence,
+		EmitterChain:     emitterChain,
+		EmitterAddress:   emitterAddr,
+		ConsistencyLevel: uint8(32),
+		Payload:          payload,
+	}
+}
+
+// setNttEmitterForTesting adds an NTT emitter to a chain that is already governed.
+func (gov *ChainGovernor) setNttEmitterForTesting(t *testing.T, emitterChain vaa.ChainID, emitterAddrStr string) {
+	t.Helper()
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	addr, err := vaa.StringToAddress(emitterAddrStr)
+	require.NoError(t, err)
+	ce, exists := gov.chains[emitterChain]
+	require.True(t, exists)
+	if ce.nttEmitters == nil {
+		ce.nttEmitters = make(map[vaa.Address]struct{})
+	}
+	ce.nttEmitters[addr] = struct{}{}
+}
+
+func TestNttTransferHdr(t *testing.T) {
+	payload := buildMockNttPayloadBytes(8, 125_000_000, nttTestTokenAddr, vaa.ChainIDSolana, nttTestToAddr)
+	assert.True(t, isTransferPayload(payload))
+
+	nt, err := ntt.DecodeTransfer(payload)
+	require.NoError(t, err)
+
+	hdr := nttTransferHdr(nt, vaa.ChainIDEthereum)
+	tokenAddr, err := vaa.StringToAddress(nttTestTokenAddr)
+	require.NoError(t, err)
+	toAddr, err := vaa.StringToAddress(nttTestToAddr)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(125_000_000), hdr.Amount)
+	assert.Equal(t, vaa.ChainIDEthereum, hdr.OriginChain)
+	assert.Equal(t, tokenAddr, hdr.OriginAddress)
+	assert.Equal(t, vaa.ChainIDSolana, hdr.TargetChain)
+	assert.Equal(t, toAddr, hdr.TargetAddress)
+}
+
+func TestNttAmountForToken(t *testing.T) {
+	nt := &ntt.Transfer{Decimals: 6, Amount: 1_500_000}
+
+	// Tokens with eight decimals or more are governed with eight decimals.
+	amount, err := nttAmountForToken(nt, &tokenEntry{decimals: big.NewInt(100_000_000), symbol: "W"})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(150_000_000), amount)
+
+	amount, err = nttAmountForToken(nt, &tokenEntry{decimals: big.NewInt(1_000_000), symbol: "USDC"})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1_500_000), amount)
+
+	// The amount of a transfer cannot have more decimals than its token.
+	_, err = nttAmountForToken(nt, &tokenEntry{decimals: big.NewInt(100), symbol: "TWO"})
+	require.Error(t, err)
+}
+
+func TestNttTransfersAreGoverned(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, "0x0290fb167208af455bb137780163b7b7a9a10c16", 5000, 0))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, nttTestTokenAddr, "NTT", 1774.62, false))
+
+	now := time.Unix(1654543099, 0)
+	payload := buildMockNttPayloadBytes(8, 125_000_000, nttTestTokenAddr, vaa.ChainIDSolana, nttTestToAddr)
+
+	// The transfer is not governed until its emitter is configured.
+	msg := newNttTestMsg(t, 1, vaa.ChainIDEthereum, payload)
+	canPost, err := gov.processMsgForTime(msg, now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+	numTrans, _, _, _ := gov.getStatsForAllChains()
+	assert.Equal(t, 0, numTrans)
+
+	gov.setNttEmitterForTesting(t, vaa.ChainIDEthereum, nttTestEmitterAddr)
+	isGoverned, err := gov.IsGovernedMsg(msg)
+	require.NoError(t, err)
+	assert.True(t, isGoverned)
+
+	// 1.25 tokens at $1774.62 is worth $2218.
+	msg = newNttTestMsg(t, 2, vaa.ChainIDEthereum, payload)
+	canPost, err = gov.processMsgForTime(msg, now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+	numTrans, valueTrans, numPending, _ := gov.getStatsForAllChains()
+	assert.Equal(t, 1, numTrans)
+	assert.Equal(t, uint64(2218), valueTrans)
+	assert.Equal(t, 0, numPending)
+
+	gov.mutex.Lock()
+	recorded := gov.chains[vaa.ChainIDEthereum].transfers[0].dbTransfer
+	gov.mutex.Unlock()
+	assert.Equal(t, vaa.ChainIDEthereum, recorded.OriginChain)
+	assert.Equal(t, vaa.ChainIDSolana, recorded.TargetChain)
+	assert.Equal(t, msg.EmitterAddress, recorded.EmitterAddress)
+
+	// The same amount with six decimals is worth a hundred times more, which exceeds the daily limit.
+	msg = newNttTestMsg(t, 3, vaa.ChainIDEthereum, buildMockNttPayloadBytes(6, 125_000_000, nttTestTokenAddr, vaa.ChainIDSolana, nttTestToAddr))
+	canPost, err = gov.processMsgForTime(msg, now)
+	require.NoError(t, err)
+	assert.False(t, canPost)
+	_, _, numPending, valuePending := gov.getStatsForAllChains()
+	assert.Equal(t, 1, numPending)
+	assert.Equal(t, uint64(221827), valuePending)
+
+	// A token bridge payload from an NTT emitter is not governed.
+	msg = newNttTestMsg(t, 4, vaa.ChainIDEthereum, buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, nttTestTokenAddr, vaa.ChainIDSolana, nttTestToAddr, 1.25))
+	canPost, err = gov.processMsgForTime(msg, now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	// Neither is a malformed native token transfer, which is not published either.
+	msg = newNttTestMsg(t, 5, vaa.ChainIDEthereum, append(slices.Clone(payload), 0))
+	canPost, err = gov.processMsgForTime(msg, now)
+	require.Error(t, err)
+	assert.False(t, canPost)
+
+	numTrans, _, numPending, _ = gov.getStatsForAllChains()
+	assert.Equal(t, 1, numTrans)
+	assert.Equal(t, 1, numPending)
+
+	// The enqueued transfer is released once the release time has passed.
+	toBePublished, err := gov.checkPendingForTime(now.Add(maxEnqueuedTime + time.Minute))
+	require.NoError(t, err)
+	require.Len(t, toBePublished, 1)
+	assert.Equal(t, uint64(3), toBePublished[0].Sequence)
+	_, _, numPending, _ = gov.getStatsForAllChains()
+	assert.Equal(t, 0, numPending)
+}
+
+func TestNttTransfersFlowCancel(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+	require.True(t, gov.corridorCanFlowCancel(&corridor{vaa.ChainIDEthereum, vaa.ChainIDSui}))
+
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, "0x0290fb167208af455bb137780163b7b7a9a10c16", 10000, 0))
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDSui, "0xc57508ee0d4595e5a8728974a4a93a787d38f339757230d441e895422c07aba9", 10000, 0))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDSui, nttTestTokenAddr, "NTT", 1.0, true))
+	gov.setNttEmitterForTesting(t, vaa.ChainIDEthereum, nttTestEmitterAddr)
+	gov.setNttEmitterForTesting(t, vaa.ChainIDSui, nttTestEmitterAddr)
+
+	// A transfer of the token from Sui to Ethereum frees up some of the capacity of Ethereum.
+	now := time.Unix(1654543099, 0)
+	msg := newNttTestMsg(t, 1, vaa.ChainIDSui, buildMockNttPayloadBytes(8, 500*100_000_000, nttTestTokenAddr, vaa.ChainIDEthereum, nttTestToAddr))
+	canPost, err := gov.processMsgForTime(msg, now)
+	require.NoError(t, err)
+	assert.True(t, canPost)
+
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+	startTime := now.Add(-time.Minute * time.Duration(gov.dayLengthInMinutes))
+	suiUsage, err := gov.trimAndSumValueForChain(gov.chains[vaa.ChainIDSui], startTime)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(500*guardianDB.ScaledValueFactor), suiUsage)
+	require.Len(t, gov.chains[vaa.ChainIDEthereum].transfers, 1)
+	assert.Equal(t, -int64(500*guardianDB.ScaledValueFactor), gov.chains[vaa.ChainIDEthereum].transfers[0].scaledValue)
+}
+
+func TestReloadNttTransfers(t *testing.T) {
+	ctx := context.Background()
+	gov := newChainGovernorForTest(t, ctx)
+
+	require.NoError(t, gov.setChainForTesting(vaa.ChainIDEthereum, "0x0290fb167208af455bb137780163b7b7a9a10c16", 1000000, 0))
+	require.NoError(t, gov.setTokenForTesting(vaa.ChainIDEthereum, nttTestTokenAddr, "NTT", 1774.62, false))
+	gov.setNttEmitterForTesting(t, vaa.ChainIDEthereum, nttTestEmitterAddr)
+
+	now := time.Unix(1654543099, 0)
+	msg := newNttTestMsg(t, 1, vaa.ChainIDEthereum, buildMockNttPayloadBytes(8, 125_000_000, nttTestTokenAddr, vaa.ChainIDSolana, nttTestToAddr))
+	tokenAddr, err := vaa.StringToAddress(nttTestTokenAddr)
+	require.NoError(t, err)
+
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+	gov.reloadPendingTransfer(&guardianDB.PendingTransfer{ReleaseTime: now.Add(maxEnqueuedTime), Msg: *msg})
+	require.NoError(t, gov.reloadTransfer(&guardianDB.Transfer{
+		Timestamp:      now,
+		ScaledValue:    2218 * guardianDB.ScaledValueFactor,
+		OriginChain:    vaa.ChainIDEthereum,
+		OriginAddress:  tokenAddr,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: msg.EmitterAddress,
+		TargetChain:    vaa.ChainIDSolana,
+		MsgID:          "2/" + msg.EmitterAddress.String() + "/2",
+		Hash:           "2218",
+	}))
+
+	ce := gov.chains[vaa.ChainIDEthereum]
+	require.Len(t, ce.pending, 1)
+	assert.Equal(t, big.NewInt(125_000_000), ce.pending[0].amount)
+	assert.Len(t, ce.transfers, 1)
+}
+
+func TestNttConfig(t *testing.T) {
+	governedChains := make(map[vaa.ChainID]bool)
+	for _, cc := range ChainList() {
+		governedChains[cc.EmitterChainID] = true
+	}
+
+	for _, ne := range NttEmitterList() {
+		assert.True(t, governedChains[ne.EmitterChainID], "NTT emitter %s on chain %v, which is not governed", ne.Addr, ne.EmitterChainID)
+		assert.Len(t, ne.Addr, 64)
+	}
+
+	tokens := make(map[string]bool)
+	for _, te := range TokenList() {
+		tokens[te.String()] = true
+	}
+	for _, te := range NttTokenList() {
+		assert.False(t, tokens[te.String()], "NTT token %s is already in the token list", te.String())
+		assert.True(t, governedChains[vaa.ChainID(te.Chain)], "NTT token %s on a chain that is not governed", te.String())
+		assert.Len(t, te.Addr, 64)
+	}
+
+	// The mainnet configuration sets up the NTT emitters.
+	gov := newChainGovernorForTest(t, context.Background())
+	solanaEmitter, err := vaa.StringToAddress(NttEmitterList()[0].Addr)
+	require.NoError(t, err)
+	assert.True(t, gov.chains[vaa.ChainIDSolana].isNttEmitter(solanaEmitter))
+	assert.True(t, gov.chains[vaa.ChainIDSolana].isGovernedEmitter(gov.chains[vaa.ChainIDSolana].emitterAddr))
+}
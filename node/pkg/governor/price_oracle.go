@@ -0,0 +1,433 @@
+// This file contains the price oracle of the chain governor, which combines the prices of several price sources.
+//
+// Each source is queried for the tokens keyed by their CoinGecko ID. Quotes that are older than the staleness cutoff
+// of their source are discarded, and the remaining quotes of each token are aggregated using either their median or
+// their trimmed mean. A quote that deviates from the aggregated price by more than the configured fraction raises an
+// alarm, which is logged and counted in the guardian_governor_price_deviation_alarms metric.
+
+package governor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+type (
+	// PriceQuote is the USD price of a token reported by a price source.
+	PriceQuote struct {
+		Price float64
+		// Time is when the price was last updated by the source.
+		Time time.Time
+	}
+
+	// PriceSource provides the USD prices of tokens, keyed by their CoinGecko ID.
+	PriceSource interface {
+		// Name identifies the source in the logs and metrics.
+		Name() string
+		// QueryPrices returns the latest prices of the requested tokens. Tokens that the source cannot price are
+		// omitted from the result. An error is returned if the source could not be queried at all.
+		QueryPrices(ctx context.Context, coinGeckoIds []string) (map[string]PriceQuote, error)
+	}
+
+	// PriceOracleConfig is the content of a price oracle file. For example:
+	//
+	//	{
+	//	  "aggregation": "trimmedMean",
+	//	  "trimFraction": 0.25,
+	//	  "maxDeviation": 0.05,
+	//	  "sources": [
+	//	    {"name": "coingecko", "type": "coinGecko"},
+	//	    {"name": "internal", "type": "http", "url": "https://prices.example.com/usd", "maxAge": "1h"},
+	//	    {
+	//	      "name": "chainlink-ethereum",
+	//	      "type": "chainlink",
+	//	      "rpc": "https://ethereum-rpc.example.com",
+	//	      "maxAge": "25h",
+	//	      "feeds": {"weth": "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"}
+	//	    }
+	//	  ]
+	//	}
+	PriceOracleConfig struct {
+		// Aggregation is either "median" (the default) or "trimmedMean".
+		Aggregation string `json:"aggregation"`
+		// TrimFraction is the fraction of the lowest and of the highest quotes that are discarded by the trimmed mean.
+		// It must be less than 0.5 and defaults to [defaultTrimFraction].
+		TrimFraction *float64 `json:"trimFraction"`
+		// MaxDeviation is the relative deviation from the aggregated price, such as 0.1 for 10%, above which a quote
+		// raises an alarm. Defaults to [defaultMaxDeviation].
+		MaxDeviation *float64 `json:"maxDeviation"`
+
+		Sources []PriceSourceConfig `json:"sources"`
+	}
+
+	// PriceSourceConfig is a price source of a price oracle file.
+	PriceSourceConfig struct {
+		// Name identifies the source in the logs and metrics. It must be unique within the oracle.
+		Name string `json:"name"`
+		// Type is either "coinGecko", "http" or "chainlink".
+		Type string `json:"type"`
+		// MaxAge is the staleness cutoff of the quotes of the source, such as "1h". There is no cutoff if unset.
+		MaxAge string `json:"maxAge"`
+
+		// URL is the endpoint of an "http" source. See [httpPriceSource] for the expected response.
+		URL string `json:"url"`
+
+		// RPC is the EVM node of a "chainlink" source.
+		RPC string `json:"rpc"`
+		// Feeds maps the CoinGecko IDs priced by a "chainlink" source to the addresses of their USD price feeds.
+		Feeds map[string]string `json:"feeds"`
+	}
+
+	// aggregationMethod is how the quotes of a token are combined into a single price.
+	aggregationMethod uint8
+
+	// priceOracle is the validated form of a [PriceOracleConfig].
+	priceOracle struct {
+		aggregation  aggregationMethod
+		trimFraction float64
+		maxDeviation float64
+		sources      []*priceSourceConfig
+	}
+
+	// priceSourceConfig is the validated form of a [PriceSourceConfig]. The source itself is created by connect.
+	priceSourceConfig struct {
+		name   string
+		kind   string
+		maxAge time.Duration
+		url    string
+		rpc    string
+		feeds  map[string]ethCommon.Address
+		source PriceSource
+	}
+)
+
+const (
+	medianAggregation aggregationMethod = iota
+	trimmedMeanAggregation
+)
+
+const (
+	coinGeckoSourceType = "coinGecko"
+	httpSourceType      = "http"
+	chainlinkSourceType = "chainlink"
+
+	defaultTrimFraction = 0.2
+	defaultMaxDeviation = 0.1
+)
+
+var (
+	ErrInvalidPriceOracle = errors.New("governor: invalid price oracle")
+
+	metricPriceSourceErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "guardian_governor_price_source_errors",
+			Help: "Chain governor number of failed price source queries",
+		}, []string{"source"})
+
+	metricStalePrices = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "guardian_governor_stale_prices",
+			Help: "Chain governor number of price quotes discarded for being older than the staleness cutoff of their source",
+		}, []string{"source"})
+
+	metricPriceDeviationAlarms = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "guardian_governor_price_deviation_alarms",
+			Help: "Chain governor number of price quotes that deviated from the aggregated price by more than the maximum deviation",
+		}, []string{"source"})
+)
+
+// LoadPriceOracleConfig reads and validates a price oracle file.
+func LoadPriceOracleConfig(fileName string) (*PriceOracleConfig, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to open price oracle file "%s": %w`, fileName, err)
+	}
+	defer f.Close()
+
+	b, err := common.SafeRead(f)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read price oracle file "%s": %w`, fileName, err)
+	}
+
+	cfg, err := parsePriceOracleConfig(b)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to parse price oracle file "%s": %w`, fileName, err)
+	}
+	return cfg, nil
+}
+
+// parsePriceOracleConfig decodes and validates the content of a price oracle file.
+func parsePriceOracleConfig(b []byte) (*PriceOracleConfig, error) {
+	var cfg PriceOracleConfig
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	// Reject misspelled settings, which would otherwise silently fall back to their defaults.
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPriceOracle, err)
+	}
+
+	if _, err := parsePriceOracle(&cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// defaultPriceOracleConfig returns the configuration used when no price oracle file is set, which only queries CoinGecko.
+func defaultPriceOracleConfig() *PriceOracleConfig {
+	return &PriceOracleConfig{
+		Sources: []PriceSourceConfig{{Name: "coingecko", Type: coinGeckoSourceType}},
+	}
+}
+
+// parsePriceOracle validates a price oracle configuration.
+func parsePriceOracle(cfg *PriceOracleConfig) (*priceOracle, error) {
+	oracle := &priceOracle{
+		trimFraction: defaultTrimFraction,
+		maxDeviation: defaultMaxDeviation,
+	}
+
+	switch cfg.Aggregation {
+	case "", "median":
+		oracle.aggregation = medianAggregation
+	case "trimmedMean":
+		oracle.aggregation = trimmedMeanAggregation
+	default:
+		return nil, fmt.Errorf("%w: invalid aggregation %q", ErrInvalidPriceOracle, cfg.Aggregation)
+	}
+
+	if cfg.TrimFraction != nil {
+		if oracle.aggregation != trimmedMeanAggregation {
+			return nil, fmt.Errorf("%w: trimFraction requires the trimmedMean aggregation", ErrInvalidPriceOracle)
+		}
+		if *cfg.TrimFraction < 0 || *cfg.TrimFraction >= 0.5 {
+			return nil, fmt.Errorf("%w: trimFraction must be at least 0 and less than 0.5", ErrInvalidPriceOracle)
+		}
+		oracle.trimFraction = *cfg.TrimFraction
+	}
+
+	if cfg.MaxDeviation != nil {
+		if *cfg.MaxDeviation <= 0 {
+			return nil, fmt.Errorf("%w: maxDeviation must be positive", ErrInvalidPriceOracle)
+		}
+		oracle.maxDeviation = *cfg.MaxDeviation
+	}
+
+	if len(cfg.Sources) == 0 {
+		return nil, fmt.Errorf("%w: no price sources", ErrInvalidPriceOracle)
+	}
+
+	names := make(map[string]struct{}, len(cfg.Sources))
+	for i := range cfg.Sources {
+		src, err := parsePriceSource(&cfg.Sources[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w: source %d: %w", ErrInvalidPriceOracle, i, err)
+		}
+		if _, exists := names[src.name]; exists {
+			return nil, fmt.Errorf("%w: duplicate source name %q", ErrInvalidPriceOracle, src.name)
+		}
+		if src.kind == coinGeckoSourceType && slices.ContainsFunc(oracle.sources, func(s *priceSourceConfig) bool { return s.kind == coinGeckoSourceType }) {
+			return nil, fmt.Errorf("%w: more than one coinGecko source", ErrInvalidPriceOracle)
+		}
+		names[src.name] = struct{}{}
+		oracle.sources = append(oracle.sources, src)
+	}
+
+	return oracle, nil
+}
+
+// parsePriceSource validates a price source configuration.
+func parsePriceSource(cfg *PriceSourceConfig) (*priceSourceConfig, error) {
+	if cfg.Name == "" {
+		return nil, errors.New("missing name")
+	}
+
+	src := &priceSourceConfig{name: cfg.Name, kind: cfg.Type}
+
+	if cfg.MaxAge != "" {
+		maxAge, err := time.ParseDuration(cfg.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxAge: %w", err)
+		}
+		if maxAge <= 0 {
+			return nil, errors.New("maxAge must be positive")
+		}
+		src.maxAge = maxAge
+	}
+
+	if cfg.URL != "" && cfg.Type != httpSourceType {
+		return nil, errors.New("url is only supported by http sources")
+	}
+	if (cfg.RPC != "" || cfg.Feeds != nil) && cfg.Type != chainlinkSourceType {
+		return nil, errors.New("rpc and feeds are only supported by chainlink sources")
+	}
+
+	switch cfg.Type {
+	case coinGeckoSourceType:
+	case httpSourceType:
+		u, err := url.Parse(cfg.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid url %q", cfg.URL)
+		}
+		src.url = cfg.URL
+	case chainlinkSourceType:
+		if cfg.RPC == "" {
+			return nil, errors.New("missing rpc")
+		}
+		if len(cfg.Feeds) == 0 {
+			return nil, errors.New("missing feeds")
+		}
+		src.rpc = cfg.RPC
+		src.feeds = make(map[string]ethCommon.Address, len(cfg.Feeds))
+		for coinGeckoId, addr := range cfg.Feeds {
+			if !ethCommon.IsHexAddress(addr) {
+				return nil, fmt.Errorf("invalid feed address %q for %s", addr, coinGeckoId)
+			}
+			src.feeds[coinGeckoId] = ethCommon.HexToAddress(addr)
+		}
+	default:
+		return nil, fmt.Errorf("invalid type %q", cfg.Type)
+	}
+
+	return src, nil
+}
+
+// connect creates the price sources of the oracle.
+func (o *priceOracle) connect(ctx context.Context, logger *zap.Logger, coinGeckoApiKey string) error {
+	for _, src := range o.sources {
+		switch src.kind {
+		case coinGeckoSourceType:
+			src.source = newCoinGeckoSource(src.name, logger, coinGeckoApiKey)
+		case httpSourceType:
+			src.source = newHTTPPriceSource(src.name, src.url)
+		case chainlinkSourceType:
+			connector, err := connectors.NewEthereumBaseConnector(ctx, src.name, src.rpc, ethCommon.Address{}, nil, logger)
+			if err != nil {
+				return fmt.Errorf("failed to connect to the rpc of price source %s: %w", src.name, err)
+			}
+			src.source = newChainlinkSource(src.name, logger, connector, src.feeds)
+		}
+	}
+	return nil
+}
+
+// queryPrices queries every source for the prices of the given tokens, and returns the aggregated price of each
+// token that has at least one fresh quote. It returns an error if none of the sources could be queried.
+func (o *priceOracle) queryPrices(ctx context.Context, logger *zap.Logger, coinGeckoIds []string) (map[string]float64, error) {
+	results := make([]map[string]PriceQuote, len(o.sources))
+	var wg sync.WaitGroup
+	for i, src := range o.sources {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			quotes, err := src.source.QueryPrices(ctx, coinGeckoIds)
+			if err != nil {
+				logger.Error("price source query failed", zap.String("source", src.name), zap.Error(err))
+				metricPriceSourceErrors.WithLabelValues(src.name).Inc()
+				return
+			}
+			results[i] = quotes
+		}()
+	}
+	wg.Wait()
+
+	if !slices.ContainsFunc(results, func(quotes map[string]PriceQuote) bool { return quotes != nil }) {
+		return nil, errors.New("all price sources failed")
+	}
+
+	now := time.Now()
+	prices := make(map[string]float64, len(coinGeckoIds))
+	for _, coinGeckoId := range coinGeckoIds {
+		var fresh []float64
+		var sources []*priceSourceConfig
+		for i, src := range o.sources {
+			quote, exists := results[i][coinGeckoId]
+			if !exists {
+				continue
+			}
+			if src.maxAge != 0 && now.Sub(quote.Time) > src.maxAge {
+				logger.Warn("discarding stale price",
+					zap.String("source", src.name),
+					zap.String("coinGeckoId", coinGeckoId),
+					zap.Float64("price", quote.Price),
+					zap.Time("priceTime", quote.Time),
+				)
+				metricStalePrices.WithLabelValues(src.name).Inc()
+				continue
+			}
+			fresh = append(fresh, quote.Price)
+			sources = append(sources, src)
+		}
+
+		if len(fresh) == 0 {
+			continue
+		}
+
+		// The quotes are aggregated over a copy, so that they stay in the order of their sources.
+		price := o.aggregate(slices.Clone(fresh))
+		prices[coinGeckoId] = price
+
+		if price == 0 {
+			continue
+		}
+		for i, quote := range fresh {
+			if math.Abs(quote-price)/price > o.maxDeviation {
+				logger.Warn("price deviates from the aggregated price",
+					zap.String("source", sources[i].name),
+					zap.String("coinGeckoId", coinGeckoId),
+					zap.Float64("price", quote),
+					zap.Float64("aggregatedPrice", price),
+				)
+				metricPriceDeviationAlarms.WithLabelValues(sources[i].name).Inc()
+			}
+		}
+	}
+
+	return prices, nil
+}
+
+// aggregate combines the quotes of a token into a single price. It modifies the order of the quotes.
+func (o *priceOracle) aggregate(quotes []float64) float64 {
+	slices.Sort(quotes)
+
+	if o.aggregation == trimmedMeanAggregation {
+		trim := int(float64(len(quotes)) * o.trimFraction)
+		quotes = quotes[trim : len(quotes)-trim]
+		sum := 0.0
+		for _, quote := range quotes {
+			sum += quote
+		}
+		return sum / float64(len(quotes))
+	}
+
+	mid := len(quotes) / 2
+	if len(quotes)%2 == 0 {
+		return (quotes[mid-1] + quotes[mid]) / 2
+	}
+	return quotes[mid]
+}
+
+// close releases the connections of the price sources.
+func (o *priceOracle) close() {
+	for _, src := range o.sources {
+		if c, ok := src.source.(*chainlinkSource); ok {
+			c.close()
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package governor
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// newPriceServer starts a local stand-in for an http price source that returns the given response.
+func newPriceServer(t *testing.T, response string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, response)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newChainlinkServer starts a local stand-in for an EVM node that serves the given Chainlink price feeds, with
+// 8 decimals. Feeds are keyed by their lowercase address.
+func newChainlinkServer(t *testing.T, answers map[string]int64, updatedAt time.Time) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []json.RawMessage
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_call" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		var call struct {
+			To   string        `json:"to"`
+			Data hexutil.Bytes `json:"data"`
+		}
+		if err := json.Unmarshal(req.Params[0], &call); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		answer, exists := answers[call.To]
+		switch {
+		case !exists:
+			resp["error"] = map[string]interface{}{"code": -32000, "message": "execution reverted"}
+		case hex.EncodeToString(call.Data) == hex.EncodeToString(chainlinkDecimalsSelector):
+			resp["result"] = hexutil.Bytes(ethCommon.LeftPadBytes([]byte{8}, 32))
+		default:
+			result := make([]byte, 0, 5*32)
+			result = append(result, ethCommon.LeftPadBytes([]byte{1}, 32)...)
+			result = append(result, ethCommon.LeftPadBytes(big.NewInt(answer).Bytes(), 32)...)
+			result = append(result, ethCommon.LeftPadBytes(big.NewInt(updatedAt.Unix()).Bytes(), 32)...)
+			result = append(result, ethCommon.LeftPadBytes(big.NewInt(updatedAt.Unix()).Bytes(), 32)...)
+			result = append(result, ethCommon.LeftPadBytes([]byte{1}, 32)...)
+			resp["result"] = hexutil.Bytes(result)
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// mustParsePriceOracle returns the validated price oracle of the given JSON document.
+func mustParsePriceOracle(t *testing.T, config string) *priceOracle {
+	t.Helper()
+	cfg, err := parsePriceOracleConfig([]byte(config))
+	require.NoError(t, err)
+	oracle, err := parsePriceOracle(cfg)
+	require.NoError(t, err)
+	return oracle
+}
+
+func TestParsePriceOracleConfig(t *testing.T) {
+	oracle := mustParsePriceOracle(t, `{
+		"aggregation": "trimmedMean",
+		"trimFraction": 0.25,
+		"maxDeviation": 0.05,
+		"sources": [
+			{"name": "coingecko", "type": "coinGecko"},
+			{"name": "internal", "type": "http", "url": "https://prices.example.com/usd", "maxAge": "1h"},
+			{"name": "chainlink", "type": "chainlink", "rpc": "https://rpc.example.com", "maxAge": "25h",
+			 "feeds": {"weth": "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"}}
+		]
+	}`)
+	assert.Equal(t, trimmedMeanAggregation, oracle.aggregation)
+	assert.Equal(t, 0.25, oracle.trimFraction)
+	assert.Equal(t, 0.05, oracle.maxDeviation)
+	require.Len(t, oracle.sources, 3)
+	assert.Equal(t, time.Duration(0), oracle.sources[0].maxAge)
+	assert.Equal(t, time.Hour, oracle.sources[1].maxAge)
+	assert.Equal(t, "https://prices.example.com/usd", oracle.sources[1].url)
+	assert.Equal(t, ethCommon.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"), oracle.sources[2].feeds["weth"])
+
+	// The defaults only query CoinGecko, using the median.
+	oracle, err := parsePriceOracle(defaultPriceOracleConfig())
+	require.NoError(t, err)
+	assert.Equal(t, medianAggregation, oracle.aggregation)
+	assert.Equal(t, defaultMaxDeviation, oracle.maxDeviation)
+	require.Len(t, oracle.sources, 1)
+	assert.Equal(t, coinGeckoSourceType, oracle.sources[0].kind)
+}
+
+func TestParsePriceOracleConfigInvalid(t *testing.T) {
+	tests := map[string]string{
+		"malformed json":           `{"sources": [`,
+		"unknown field":            `{"aggregaton": "median", "sources": [{"name": "a", "type": "coinGecko"}]}`,
+		"invalid aggregation":      `{"aggregation": "mean", "sources": [{"name": "a", "type": "coinGecko"}]}`,
+		"trim without trimmedMean": `{"trimFraction": 0.1, "sources": [{"name": "a", "type": "coinGecko"}]}`,
+		"trim too large":           `{"aggregation": "trimmedMean", "trimFraction": 0.5, "sources": [{"name": "a", "type": "coinGecko"}]}`,
+		"zero deviation":           `{"maxDeviation": 0, "sources": [{"name": "a", "type": "coinGecko"}]}`,
+		"no sources":               `{"sources": []}`,
+		"missing name":             `{"sources": [{"type": "coinGecko"}]}`,
+		"duplicate name":           `{"sources": [{"name": "a", "type": "coinGecko"}, {"name": "a", "type": "http", "url": "https://a.example.com"}]}`,
+		"two coinGecko sources":    `{"sources": [{"name": "a", "type": "coinGecko"}, {"name": "b", "type": "coinGecko"}]}`,
+		"invalid type":             `{"sources": [{"name": "a", "type": "pyth"}]}`,
+		"invalid maxAge":           `{"sources": [{"name": "a", "type": "coinGecko", "maxAge": "one hour"}]}`,
+		"negative maxAge":          `{"sources": [{"name": "a", "type": "coinGecko", "maxAge": "-1h"}]}`,
+		"missing url":              `{"sources": [{"name": "a", "type": "http"}]}`,
+		"invalid url":              `{"sources": [{"name": "a", "type": "http", "url": "ftp://a.example.com"}]}`,
+		"url on coinGecko":         `{"sources": [{"name": "a", "type": "coinGecko", "url": "https://a.example.com"}]}`,
+		"missing rpc":              `{"sources": [{"name": "a", "type": "chainlink", "feeds": {"weth": "0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419"}}]}`,
+		"missing feeds":            `{"sources": [{"name": "a", "type": "chainlink", "rpc": "https://rpc.example.com"}]}`,
+		"invalid feed":             `{"sources": [{"name": "a", "type": "chainlink", "rpc": "https://rpc.example.com", "feeds": {"weth": "0x1234"}}]}`,
+		"feeds on http":            `{"sources": [{"name": "a", "type": "http", "url": "https://a.example.com", "feeds": {}}]}`,
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parsePriceOracleConfig([]byte(config))
+			require.ErrorIs(t, err, ErrInvalidPriceOracle)
+		})
+	}
+}
+
+func TestLoadPriceOracleConfig(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(fileName, []byte(`{"sources": [{"name": "coingecko", "type": "coinGecko"}]}`), 0600))
+
+	cfg, err := LoadPriceOracleConfig(fileName)
+	require.NoError(t, err)
+	require.Len(t, cfg.Sources, 1)
+
+	_, err = LoadPriceOracleConfig(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestPriceOracleAggregate(t *testing.T) {
+	median := &priceOracle{aggregation: medianAggregation}
+	assert.Equal(t, 2.0, median.aggregate([]float64{3, 1, 2}))
+	assert.Equal(t, 2.5, median.aggregate([]float64{4, 1, 3, 2}))
+	assert.Equal(t, 7.0, median.aggregate([]float64{7}))
+
+	trimmed := &priceOracle{aggregation: trimmedMeanAggregation, trimFraction: 0.2}
+	// The lowest and highest of the five quotes are discarded.
+	assert.Equal(t, 2.0, trimmed.aggregate([]float64{100, 1, 2, 3, 0}))
+	// Nothing is discarded when there are too few quotes.
+	assert.Equal(t, 2.0, trimmed.aggregate([]float64{1, 3}))
+}
+
+func TestHTTPPriceSource(t *testing.T) {
+	server := newPriceServer(t, `{"weth": {"usd": 3000.5, "last_updated_at": 1700000000}, "usd-coin": {"usd": 1.0}, "broken": {}}`)
+	source := newHTTPPriceSource("internal", server.URL)
+
+	start := time.Now()
+	quotes, err := source.QueryPrices(context.Background(), []string{"weth", "usd-coin", "broken", "missing"})
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+
+	assert.Equal(t, 3000.5, quotes["weth"].Price)
+	assert.Equal(t, time.Unix(1700000000, 0), quotes["weth"].Time)
+	// Prices without an update time are as recent as the response.
+	assert.Equal(t, 1.0, quotes["usd-coin"].Price)
+	assert.False(t, quotes["usd-coin"].Time.Before(start))
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	_, err = newHTTPPriceSource("failing", failing.URL).QueryPrices(context.Background(), []string{"weth"})
+	require.Error(t, err)
+}
+
+func TestChainlinkSource(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wethFeed := ethCommon.HexToAddress("0x5f4eC3Df9cbd43714FE2740f5E3616155c5b8419")
+	brokenFeed := ethCommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	updatedAt := time.Unix(1700000000, 0)
+	server := newChainlinkServer(t, map[string]int64{
+		"0x5f4ec3df9cbd43714fe2740f5e3616155c5b8419": 300050000000,
+	}, updatedAt)
+
+	connector, err := connectors.NewEthereumBaseConnector(ctx, "chainlink", server.URL, ethCommon.Address{}, nil, zap.NewNop())
+	require.NoError(t, err)
+	source := newChainlinkSource("chainlink", zap.NewNop(), connector, map[string]ethCommon.Address{
+		"weth":   wethFeed,
+		"broken": brokenFeed,
+	})
+	defer source.close()
+
+	quotes, err := source.QueryPrices(ctx, []string{"weth", "broken", "usd-coin"})
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	assert.InDelta(t, 3000.5, quotes["weth"].Price, 1e-9)
+	assert.Equal(t, updatedAt, quotes["weth"].Time)
+
+	// The source fails if none of the requested feeds could be read.
+	_, err = source.QueryPrices(ctx, []string{"broken"})
+	require.Error(t, err)
+}
+
+func TestPriceOracleQueryPrices(t *testing.T) {
+	fresh := time.Now().Unix()
+	stale := time.Now().Add(-2 * time.Hour).Unix()
+	a := newPriceServer(t, fmt.Sprintf(`{"weth": {"usd": 3000, "last_updated_at": %d}, "usd-coin": {"usd": 1.0}}`, fresh))
+	b := newPriceServer(t, fmt.Sprintf(`{"weth": {"usd": 3100, "last_updated_at": %d}, "usd-coin": {"usd": 1.0}}`, fresh))
+	c := newPriceServer(t, fmt.Sprintf(`{"weth": {"usd": 9000, "last_updated_at": %d}, "usd-coin": {"usd": 5.0, "last_updated_at": %d}}`, fresh, stale))
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	oracle := mustParsePriceOracle(t, fmt.Sprintf(`{"sources": [
+		{"name": "oracle-test-a", "type": "http", "url": %q, "maxAge": "1h"},
+		{"name": "oracle-test-b", "type": "http", "url": %q, "maxAge": "1h"},
+		{"name": "oracle-test-c", "type": "http", "url": %q, "maxAge": "1h"},
+		{"name": "oracle-test-failing", "type": "http", "url": %q}
+	]}`, a.URL, b.URL, c.URL, failing.URL))
+	require.NoError(t, oracle.connect(context.Background(), zap.NewNop(), ""))
+
+	prices, err := oracle.queryPrices(context.Background(), zap.NewNop(), []string{"weth", "usd-coin", "missing"})
+	require.NoError(t, err)
+
+	// The median ignores the outlier, which raises an alarm, and the stale quote is discarded.
+	assert.Equal(t, map[string]float64{"weth": 3100, "usd-coin": 1.0}, prices)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metricPriceDeviationAlarms.WithLabelValues("oracle-test-c")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metricPriceDeviationAlarms.WithLabelValues("oracle-test-a")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metricStalePrices.WithLabelValues("oracle-test-c")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metricPriceSourceErrors.WithLabelValues("oracle-test-failing")))
+
+	// The query fails if every source failed.
+	oracle = mustParsePriceOracle(t, fmt.Sprintf(`{"sources": [{"name": "oracle-test-failing", "type": "http", "url": %q}]}`, failing.URL))
+	require.NoError(t, oracle.connect(context.Background(), zap.NewNop(), ""))
+	_, err = oracle.queryPrices(context.Background(), zap.NewNop(), []string{"weth"})
+	require.Error(t, err)
+}
+
+func TestPriceOracleQueryPricesOutlierFirst(t *testing.T) {
+	// The outlier is listed first, so that sorting the quotes would attribute its deviation to another source.
+	outlier := newPriceServer(t, `{"weth": {"usd": 9000}}`)
+	a := newPriceServer(t, `{"weth": {"usd": 3000}}`)
+	b := newPriceServer(t, `{"weth": {"usd": 3100}}`)
+
+	oracle := mustParsePriceOracle(t, fmt.Sprintf(`{"sources": [
+		{"name": "oracle-order-outlier", "type": "http", "url": %q},
+		{"name": "oracle-order-a", "type": "http", "url": %q},
+		{"name": "oracle-order-b", "type": "http", "url": %q}
+	]}`, outlier.URL, a.URL, b.URL))
+	require.NoError(t, oracle.connect(context.Background(), zap.NewNop(), ""))
+
+	prices, err := oracle.queryPrices(context.Background(), zap.NewNop(), []string{"weth"})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]float64{"weth": 3100}, prices)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metricPriceDeviationAlarms.WithLabelValues("oracle-order-outlier")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metricPriceDeviationAlarms.WithLabelValues("oracle-order-a")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metricPriceDeviationAlarms.WithLabelValues("oracle-order-b")))
+}
+
+func TestGovernorQueryPrices(t *testing.T) {
+	server := newPriceServer(t, `{"weth": {"usd": 3000}, "usd-coin": {"usd": 0.5}}`)
+
+	gov := NewChainGovernor(zap.NewNop(), nil, common.GoTest, false, "")
+	gov.SetPriceOracleConfig(&PriceOracleConfig{
+		Sources: []PriceSourceConfig{{Name: "internal", Type: httpSourceType, URL: server.URL}},
+	})
+
+	tokens := map[string]*tokenEntry{}
+	for coinGeckoId, cfgPrice := range map[string]float64{"weth": 2000, "usd-coin": 1.0, "missing": 42} {
+		te := &tokenEntry{cfgPrice: big.NewFloat(cfgPrice), price: big.NewFloat(cfgPrice), coinGeckoId: coinGeckoId, symbol: coinGeckoId}
+		tokens[coinGeckoId] = te
+		gov.tokensByCoinGeckoId[coinGeckoId] = []*tokenEntry{te}
+	}
+
+	require.NoError(t, gov.initPriceOracle(context.Background(), false))
+	require.Error(t, gov.queryPrices(context.Background()))
+
+	// The governor uses the maximum of the configured and the aggregated prices.
+	weth, _ := tokens["weth"].price.Float64()
+	assert.Equal(t, 3000.0, weth)
+	assert.False(t, tokens["weth"].priceTime.IsZero())
+	usdc, _ := tokens["usd-coin"].price.Float64()
+	assert.Equal(t, 1.0, usdc)
+
+	// Tokens without any price keep their configured price.
+	missing, _ := tokens["missing"].price.Float64()
+	assert.Equal(t, 42.0, missing)
+	assert.True(t, tokens["missing"].priceTime.IsZero())
+}
@@ -0,0 +1,212 @@
+// This file contains the price sources of the chain governor other than CoinGecko, see governor_prices.go for the latter.
+
+package governor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.uber.org/zap"
+)
+
+// priceSourceTimeout is the timeout of the HTTP requests of an http price source.
+const priceSourceTimeout = 30 * time.Second
+
+// The function selectors of the Chainlink AggregatorV3Interface. See
+// https://docs.chain.link/data-feeds/api-reference#aggregatorv3interface
+var (
+	chainlinkDecimalsSelector        = []byte{0x31, 0x3c, 0xe5, 0x67} // decimals()
+	chainlinkLatestRoundDataSelector = []byte{0xfe, 0xaf, 0x96, 0x8c} // latestRoundData()
+)
+
+// httpPriceSource queries a generic HTTP endpoint for the prices of all the tokens it supports. The response must use
+// the format of the CoinGecko simple price API, optionally with the time of the last update in seconds:
+//
+//	{"weth": {"usd": 3012.5, "last_updated_at": 1718000000}, "usd-coin": {"usd": 1.0}}
+//
+// Tokens without a last update time are considered updated at the time of the response.
+type httpPriceSource struct {
+	name   string
+	url    string
+	client *http.Client
+}
+
+// httpPriceEntry is the price of a token in the response of an http price source.
+type httpPriceEntry struct {
+	USD           *float64 `json:"usd"`
+	LastUpdatedAt int64    `json:"last_updated_at"`
+}
+
+func newHTTPPriceSource(name string, url string) *httpPriceSource {
+	return &httpPriceSource{
+		name:   name,
+		url:    url,
+		client: &http.Client{Timeout: priceSourceTimeout},
+	}
+}
+
+func (s *httpPriceSource) Name() string {
+	return s.name
+}
+
+func (s *httpPriceSource) QueryPrices(ctx context.Context, coinGeckoIds []string) (map[string]PriceQuote, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	response, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", s.url, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query %s: unexpected status %s", s.url, response.Status)
+	}
+
+	responseData, err := common.SafeRead(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	var entries map[string]httpPriceEntry
+	if err := json.Unmarshal(responseData, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	now := time.Now()
+	quotes := make(map[string]PriceQuote, len(coinGeckoIds))
+	for _, coinGeckoId := range coinGeckoIds {
+		entry, exists := entries[coinGeckoId]
+		if !exists || entry.USD == nil || *entry.USD < 0 || math.IsNaN(*entry.USD) || math.IsInf(*entry.USD, 0) {
+			continue
+		}
+
+		quote := PriceQuote{Price: *entry.USD, Time: now}
+		if entry.LastUpdatedAt != 0 {
+			quote.Time = time.Unix(entry.LastUpdatedAt, 0)
+		}
+		quotes[coinGeckoId] = quote
+	}
+
+	return quotes, nil
+}
+
+// chainlinkSource reads the USD prices of tokens from Chainlink price feeds through an EVM connector. The time of a
+// quote is the time the feed was last updated on chain.
+type chainlinkSource struct {
+	name      string
+	logger    *zap.Logger
+	connector connectors.Connector
+	// Maps the CoinGecko IDs to the addresses of their price feeds.
+	feeds map[string]ethCommon.Address
+}
+
+func newChainlinkSource(name string, logger *zap.Logger, connector connectors.Connector, feeds map[string]ethCommon.Address) *chainlinkSource {
+	return &chainlinkSource{
+		name:      name,
+		logger:    logger,
+		connector: connector,
+		feeds:     feeds,
+	}
+}
+
+func (s *chainlinkSource) Name() string {
+	return s.name
+}
+
+func (s *chainlinkSource) QueryPrices(ctx context.Context, coinGeckoIds []string) (map[string]PriceQuote, error) {
+	quotes := make(map[string]PriceQuote, len(s.feeds))
+	var lastErr error
+	for _, coinGeckoId := range coinGeckoIds {
+		feed, exists := s.feeds[coinGeckoId]
+		if !exists {
+			continue
+		}
+
+		quote, err := s.readFeed(ctx, feed)
+		if err != nil {
+			// A single broken feed should not prevent the other ones from being used.
+			s.logger.Error("failed to read Chainlink price feed",
+				zap.String("source", s.name),
+				zap.String("coinGeckoId", coinGeckoId),
+				zap.Stringer("feed", feed),
+				zap.Error(err),
+			)
+			lastErr = err
+			continue
+		}
+		quotes[coinGeckoId] = quote
+	}
+
+	if len(quotes) == 0 && lastErr != nil {
+		return nil, fmt.Errorf("failed to read all Chainlink price feeds: %w", lastErr)
+	}
+	return quotes, nil
+}
+
+// readFeed reads the latest answer of a price feed.
+func (s *chainlinkSource) readFeed(ctx context.Context, feed ethCommon.Address) (PriceQuote, error) {
+	decimals, err := s.call(ctx, feed, chainlinkDecimalsSelector, 32)
+	if err != nil {
+		return PriceQuote{}, fmt.Errorf("failed to read decimals: %w", err)
+	}
+
+	// latestRoundData returns (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound).
+	roundData, err := s.call(ctx, feed, chainlinkLatestRoundDataSelector, 5*32)
+	if err != nil {
+		return PriceQuote{}, fmt.Errorf("failed to read latest round data: %w", err)
+	}
+
+	// The answer is a two's complement integer, so a set high bit means a negative price.
+	if roundData[32]&0x80 != 0 {
+		return PriceQuote{}, errors.New("negative answer")
+	}
+	answer := new(big.Int).SetBytes(roundData[32:64])
+	updatedAt := new(big.Int).SetBytes(roundData[96:128])
+	if !updatedAt.IsInt64() {
+		return PriceQuote{}, errors.New("invalid update time")
+	}
+	exponent := new(big.Int).SetBytes(decimals)
+	if !exponent.IsInt64() || exponent.Int64() > 77 {
+		return PriceQuote{}, errors.New("invalid decimals")
+	}
+
+	price, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(answer),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), exponent, nil)),
+	).Float64()
+
+	return PriceQuote{Price: price, Time: time.Unix(updatedAt.Int64(), 0)}, nil
+}
+
+// call calls a view function without arguments of a price feed, and checks the length of the result.
+func (s *chainlinkSource) call(ctx context.Context, feed ethCommon.Address, selector []byte, resultLen int) ([]byte, error) {
+	var result hexutil.Bytes
+	callMsg := map[string]interface{}{
+		"to":   feed,
+		"data": hexutil.Bytes(selector),
+	}
+	if err := s.connector.RawCallContext(ctx, &result, "eth_call", callMsg, "latest"); err != nil {
+		return nil, err
+	}
+	if len(result) != resultLen {
+		return nil, fmt.Errorf("unexpected result length %d", len(result))
+	}
+	return result, nil
+}
+
+func (s *chainlinkSource) close() {
+	s.connector.Close()
+}
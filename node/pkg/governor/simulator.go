@@ -0,0 +1,533 @@
+// This file contains a what-if simulator of the chain governor.
+//
+// The simulator replays historical token bridge transfers through a governor that uses a proposed configuration, and
+// reports which transfers would have been enqueued, how long they would have waited and how much of their value would
+// have been flow cancelled. It never touches the database of a running guardian: the governor it creates uses a mock
+// database, and time is driven by the timestamps of the replayed transfers rather than by the wall clock.
+
+package governor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	guardianDB "github.com/certusone/wormhole/node/pkg/db"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type (
+	// SimulationConfig is the content of a proposed governor configuration. Chains that are not listed keep their
+	// current limits. For example:
+	//
+	//	{
+	//	  "chains": [
+	//	    {"emitterChainId": 2, "dailyLimit": 75000000, "bigTransactionSize": 7500000},
+	//	    {"emitterChainId": 1, "dailyLimit": 50000000}
+	//	  ]
+	//	}
+	SimulationConfig struct {
+		Chains []SimulationChainConfig `json:"chains"`
+	}
+
+	// SimulationChainConfig overrides the limits of a governed chain. Limits that are not set keep their current value.
+	SimulationChainConfig struct {
+		EmitterChainID     uint16  `json:"emitterChainId"`
+		DailyLimit         *uint64 `json:"dailyLimit"`
+		BigTransactionSize *uint64 `json:"bigTransactionSize"`
+	}
+
+	// SimulationTransfer is a historical message publication to replay, as observed at the given time.
+	SimulationTransfer struct {
+		Time time.Time
+		Msg  *common.MessagePublication
+	}
+
+	// SimulatedTransfer is the outcome of a governed transfer in a simulation.
+	SimulatedTransfer struct {
+		MsgID        string
+		EmitterChain vaa.ChainID
+		TargetChain  vaa.ChainID
+		// Value is the notional value of the transfer in USD.
+		Value      uint64
+		ObservedAt time.Time
+		// Enqueued is true if the transfer was delayed by the governor.
+		Enqueued       bool
+		BigTransaction bool
+		// ReleasedAt is when an enqueued transfer was released. It is zero if the transfer was still enqueued at the
+		// end of the simulation.
+		ReleasedAt time.Time
+		// FlowCancelled is true if the transfer reduced the usage of its target chain.
+		FlowCancelled bool
+	}
+
+	// SimulationChainSummary summarizes the simulated transfers emitted by a governed chain.
+	SimulationChainSummary struct {
+		EmitterChain       vaa.ChainID
+		DailyLimit         uint64
+		BigTransactionSize uint64
+		Transfers          int
+		Enqueued           int
+		MaxWait            time.Duration
+		// PeakUsage is the highest 24 hour usage of the chain in USD, net of flow cancelling.
+		PeakUsage uint64
+	}
+
+	// SimulationCorridorSummary summarizes the transfers that were flow cancelled over a corridor.
+	SimulationCorridorSummary struct {
+		EmitterChain vaa.ChainID
+		TargetChain  vaa.ChainID
+		Transfers    int
+		// Value is the total value in USD by which the usage of the target chain was reduced.
+		Value uint64
+	}
+
+	// SimulationResult is the outcome of a simulation.
+	SimulationResult struct {
+		Transfers []*SimulatedTransfer
+		Chains    []*SimulationChainSummary
+		Corridors []*SimulationCorridorSummary
+		// Ignored is the number of replayed messages that were not governed transfers.
+		Ignored int
+	}
+
+	// Simulator replays historical transfers through a governor using a proposed configuration.
+	Simulator struct {
+		gov           *ChainGovernor
+		checkInterval time.Duration
+	}
+
+	// simulation holds the state of a single run of the simulator.
+	simulation struct {
+		gov       *ChainGovernor
+		clock     time.Time
+		result    *SimulationResult
+		transfers map[string]*SimulatedTransfer
+		chains    map[vaa.ChainID]*SimulationChainSummary
+		corridors map[corridor]*SimulationCorridorSummary
+	}
+
+	// simulationVAA is a line of a JSONL file of VAAs to replay.
+	simulationVAA struct {
+		VAA  string    `json:"vaa"`
+		Time time.Time `json:"time"`
+	}
+)
+
+// DefaultSimulationCheckInterval is how often the simulator releases enqueued transfers, matching how often the
+// processor of a guardian checks the governor.
+const DefaultSimulationCheckInterval = time.Minute
+
+// ParseSimulationConfig decodes a proposed governor configuration.
+func ParseSimulationConfig(b []byte) (*SimulationConfig, error) {
+	var cfg SimulationConfig
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse simulation config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// NewSimulator creates a simulator of the governor of the given environment, with the chain limits of the proposed
+// configuration. The configuration can be nil to simulate the current limits.
+func NewSimulator(logger *zap.Logger, env common.Environment, flowCancelEnabled bool, cfg *SimulationConfig, checkInterval time.Duration) (*Simulator, error) {
+	if checkInterval <= 0 {
+		return nil, errors.New("the check interval must be positive")
+	}
+
+	gov := NewChainGovernor(logger, &guardianDB.MockGovernorDB{}, env, flowCancelEnabled, "")
+	if err := gov.initConfig(); err != nil {
+		return nil, err
+	}
+
+	if cfg != nil {
+		for _, cc := range cfg.Chains {
+			ce, exists := gov.chains[vaa.ChainID(cc.EmitterChainID)]
+			if !exists {
+				return nil, fmt.Errorf("chain %d is not governed", cc.EmitterChainID)
+			}
+			if cc.DailyLimit != nil {
+				ce.dailyLimit = *cc.DailyLimit
+			}
+			if cc.BigTransactionSize != nil {
+				ce.bigTransactionSize = *cc.BigTransactionSize
+				ce.checkForBigTransactions = ce.bigTransactionSize != 0
+			}
+		}
+	}
+
+	return &Simulator{gov: gov, checkInterval: checkInterval}, nil
+}
+
+// Run replays the transfers in the order they were observed. It can only be called once per simulator.
+func (s *Simulator) Run(transfers []SimulationTransfer) (*SimulationResult, error) {
+	if len(transfers) == 0 {
+		return &SimulationResult{}, nil
+	}
+
+	transfers = slices.Clone(transfers)
+	slices.SortStableFunc(transfers, func(a, b SimulationTransfer) int { return a.Time.Compare(b.Time) })
+
+	sim := &simulation{
+		gov:       s.gov,
+		clock:     transfers[0].Time,
+		result:    &SimulationResult{},
+		transfers: make(map[string]*SimulatedTransfer),
+		chains:    make(map[vaa.ChainID]*SimulationChainSummary),
+		corridors: make(map[corridor]*SimulationCorridorSummary),
+	}
+	for _, chainId := range s.gov.chainIds {
+		ce := s.gov.chains[chainId]
+		sim.chains[chainId] = &SimulationChainSummary{
+			EmitterChain:       chainId,
+			DailyLimit:         ce.dailyLimit,
+			BigTransactionSize: ce.bigTransactionSize,
+		}
+	}
+
+	for _, xfer := range transfers {
+		if err := sim.advance(xfer.Time, s.checkInterval); err != nil {
+			return nil, err
+		}
+		if err := sim.process(xfer.Msg); err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep the clock running until every enqueued transfer was released. Transfers are released at the latest when
+	// their release time is reached.
+	end := sim.clock.Add(maxEnqueuedTime + s.checkInterval)
+	if err := sim.advance(end, s.checkInterval); err != nil {
+		return nil, err
+	}
+
+	for _, chainId := range s.gov.chainIds {
+		if summary := sim.chains[chainId]; summary.Transfers != 0 {
+			sim.result.Chains = append(sim.result.Chains, summary)
+		}
+	}
+	for _, summary := range sim.corridors {
+		sim.result.Corridors = append(sim.result.Corridors, summary)
+	}
+	slices.SortFunc(sim.result.Corridors, func(a, b *SimulationCorridorSummary) int {
+		if a.EmitterChain != b.EmitterChain {
+			return int(a.EmitterChain) - int(b.EmitterChain)
+		}
+		return int(a.TargetChain) - int(b.TargetChain)
+	})
+
+	return sim.result, nil
+}
+
+// advance moves the clock forward to the given time. While transfers are enqueued, the clock moves one check
+// interval at a time and releases the transfers that are ready, as the processor of a guardian would.
+func (sim *simulation) advance(to time.Time, checkInterval time.Duration) error {
+	for sim.hasPending() && sim.clock.Add(checkInterval).Before(to) {
+		sim.clock = sim.clock.Add(checkInterval)
+		if err := sim.release(); err != nil {
+			return err
+		}
+	}
+
+	if to.After(sim.clock) {
+		sim.clock = to
+	}
+	return sim.release()
+}
+
+// hasPending returns true if a transfer is enqueued.
+func (sim *simulation) hasPending() bool {
+	sim.gov.mutex.Lock()
+	defer sim.gov.mutex.Unlock()
+	for _, ce := range sim.gov.chains {
+		if len(ce.pending) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// release releases the enqueued transfers that are ready at the current time.
+func (sim *simulation) release() error {
+	msgs, err := sim.gov.checkPendingForTime(sim.clock)
+	if err != nil {
+		return fmt.Errorf("failed to release enqueued transfers at %s: %w", sim.clock, err)
+	}
+
+	for _, msg := range msgs {
+		st, exists := sim.transfers[msg.MessageIDString()]
+		if !exists {
+			continue
+		}
+		st.ReleasedAt = sim.clock
+		if wait := st.ReleasedAt.Sub(st.ObservedAt); wait > sim.chains[st.EmitterChain].MaxWait {
+			sim.chains[st.EmitterChain].MaxWait = wait
+		}
+		sim.recordFlowCancel(st, sim.gov.hashFromMsg(msg))
+		sim.recordUsage(st.EmitterChain)
+	}
+	return nil
+}
+
+// process replays a message publication at the current time.
+func (sim *simulation) process(msg *common.MessagePublication) error {
+	if !isTransferPayload(msg.Payload) {
+		sim.result.Ignored++
+		return nil
+	}
+
+	sim.gov.mutex.Lock()
+	governed, ce, token, payload, err := sim.gov.parseMsgAlreadyLocked(msg)
+	sim.gov.mutex.Unlock()
+	if err != nil || !governed {
+		sim.result.Ignored++
+		return nil //nolint:nilerr // Transfers that cannot be decoded are not governed.
+	}
+
+	if _, exists := sim.transfers[msg.MessageIDString()]; exists {
+		// Duplicates are not added to the notional value, see processMsgForTime.
+		return nil
+	}
+
+	value, err := scaledUsdValue(payload.Amount, token)
+	if err != nil {
+		return fmt.Errorf("failed to compute the value of %s: %w", msg.MessageIDString(), err)
+	}
+
+	publish, err := sim.gov.processMsgForTime(msg, sim.clock)
+	if err != nil {
+		return fmt.Errorf("failed to process %s: %w", msg.MessageIDString(), err)
+	}
+
+	st := &SimulatedTransfer{
+		MsgID:          msg.MessageIDString(),
+		EmitterChain:   msg.EmitterChain,
+		TargetChain:    payload.TargetChain,
+		Value:          scaleDownUsdValue(value),
+		ObservedAt:     sim.clock,
+		Enqueued:       !publish,
+		BigTransaction: ce.isBigTransfer(value),
+	}
+	sim.transfers[st.MsgID] = st
+	sim.result.Transfers = append(sim.result.Transfers, st)
+
+	summary := sim.chains[st.EmitterChain]
+	summary.Transfers++
+	if st.Enqueued {
+		summary.Enqueued++
+	} else {
+		sim.recordFlowCancel(st, sim.gov.hashFromMsg(msg))
+		sim.recordUsage(st.EmitterChain)
+	}
+	return nil
+}
+
+// recordFlowCancel checks whether a published transfer added a flow cancelling transfer to its target chain.
+func (sim *simulation) recordFlowCancel(st *SimulatedTransfer, hash string) {
+	sim.gov.mutex.Lock()
+	defer sim.gov.mutex.Unlock()
+
+	target, exists := sim.gov.chains[st.TargetChain]
+	if !exists {
+		return
+	}
+	if !slices.ContainsFunc(target.transfers, func(t transfer) bool { return t.scaledValue < 0 && t.dbTransfer.Hash == hash }) {
+		return
+	}
+
+	st.FlowCancelled = true
+	key := corridor{st.EmitterChain, st.TargetChain}
+	summary, exists := sim.corridors[key]
+	if !exists {
+		summary = &SimulationCorridorSummary{EmitterChain: st.EmitterChain, TargetChain: st.TargetChain}
+		sim.corridors[key] = summary
+	}
+	summary.Transfers++
+	summary.Value += st.Value
+}
+
+// recordUsage updates the peak usage of a chain after a transfer was published.
+func (sim *simulation) recordUsage(chainId vaa.ChainID) {
+	sim.gov.mutex.Lock()
+	defer sim.gov.mutex.Unlock()
+
+	startTime := sim.clock.Add(-time.Minute * time.Duration(sim.gov.dayLengthInMinutes))
+	usage, err := sim.gov.trimAndSumValueForChain(sim.gov.chains[chainId], startTime)
+	if err != nil {
+		return
+	}
+	if usage := scaleDownUsdValue(usage); usage > sim.chains[chainId].PeakUsage {
+		sim.chains[chainId].PeakUsage = usage
+	}
+}
+
+// TransfersFromDB converts the transfers and pending transfers stored by the governor of a guardian into transfers
+// to replay. Stored transfers only record their notional value, so they are replayed as transfers of an amount that
+// has the same value at the configured price of their token.
+func (s *Simulator) TransfersFromDB(transfers []*guardianDB.Transfer, pending []*guardianDB.PendingTransfer) ([]SimulationTransfer, error) {
+	s.gov.mutex.Lock()
+	defer s.gov.mutex.Unlock()
+
+	seen := make(map[string]struct{}, len(transfers)+len(pending))
+	result := make([]SimulationTransfer, 0, len(transfers)+len(pending))
+	for _, p := range pending {
+		msg := p.Msg
+		seen[msg.MessageIDString()] = struct{}{}
+		result = append(result, SimulationTransfer{Time: msg.Timestamp, Msg: &msg})
+	}
+
+	for _, xfer := range transfers {
+		if _, exists := seen[xfer.MsgID]; exists {
+			continue
+		}
+		seen[xfer.MsgID] = struct{}{}
+
+		token, exists := s.gov.tokens[tokenKey{chain: xfer.OriginChain, addr: xfer.OriginAddress}]
+		if !exists {
+			// The token is no longer governed, so it would not be governed in the simulation either.
+			continue
+		}
+
+		msgID, err := guardianDB.VaaIDFromString(xfer.MsgID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid message ID %q: %w", xfer.MsgID, err)
+		}
+
+		// amount = value / price * 10^decimals, with the value scaled by ScaledValueFactor.
+		amount := new(big.Float).SetUint64(xfer.ScaledValue)
+		amount.Quo(amount, big.NewFloat(guardianDB.ScaledValueFactor))
+		amount.Quo(amount, token.cfgPrice)
+		amount.Mul(amount, new(big.Float).SetInt(token.decimals))
+		amountInt, _ := amount.Int(nil)
+
+		if amountInt.BitLen() > 256 {
+			return nil, fmt.Errorf("the amount of %s does not fit in a transfer", xfer.MsgID)
+		}
+
+		// The header of a token bridge transfer, which is all the governor decodes.
+		payload := make([]byte, 101)
+		payload[0] = 1
+		amountInt.FillBytes(payload[1:33])
+		copy(payload[33:65], xfer.OriginAddress.Bytes())
+		binary.BigEndian.PutUint16(payload[65:67], uint16(xfer.OriginChain))
+		copy(payload[67:99], xfer.TargetAddress.Bytes())
+		binary.BigEndian.PutUint16(payload[99:101], uint16(xfer.TargetChain))
+
+		result = append(result, SimulationTransfer{
+			Time: xfer.Timestamp,
+			Msg: &common.MessagePublication{
+				Timestamp:      xfer.Timestamp,
+				Sequence:       msgID.Sequence,
+				EmitterChain:   xfer.EmitterChain,
+				EmitterAddress: xfer.EmitterAddress,
+				Payload:        payload,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// ReadSimulationVAAsJSONL reads the VAAs to replay from a JSONL file. Each line is an object with the hex or base64
+// encoded "vaa" and, optionally, the RFC 3339 "time" at which it was observed, which defaults to the VAA timestamp.
+func ReadSimulationVAAsJSONL(r io.Reader) ([]SimulationTransfer, error) {
+	var transfers []SimulationTransfer
+	decoder := json.NewDecoder(r)
+	for line := 1; ; line++ {
+		var entry simulationVAA
+		if err := decoder.Decode(&entry); errors.Is(err, io.EOF) {
+			return transfers, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		xfer, err := newSimulationTransfer(entry.VAA, entry.Time)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		transfers = append(transfers, xfer)
+	}
+}
+
+// ReadSimulationVAAsCSV reads the VAAs to replay from a CSV file. The header must have a "vaa" column with the hex
+// or base64 encoded VAAs, and can have a "time" column with the RFC 3339 time at which they were observed, which
+// defaults to the VAA timestamp.
+func ReadSimulationVAAsCSV(r io.Reader) ([]SimulationTransfer, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("missing header")
+	}
+
+	vaaIdx := slices.Index(records[0], "vaa")
+	if vaaIdx < 0 {
+		return nil, errors.New(`missing "vaa" column`)
+	}
+	timeIdx := slices.Index(records[0], "time")
+
+	transfers := make([]SimulationTransfer, 0, len(records)-1)
+	for i, record := range records[1:] {
+		var observed time.Time
+		if timeIdx >= 0 && record[timeIdx] != "" {
+			observed, err = time.Parse(time.RFC3339, record[timeIdx])
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid time: %w", i+1, err)
+			}
+		}
+
+		xfer, err := newSimulationTransfer(record[vaaIdx], observed)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", i+1, err)
+		}
+		transfers = append(transfers, xfer)
+	}
+	return transfers, nil
+}
+
+// newSimulationTransfer decodes a hex or base64 encoded VAA into a transfer to replay.
+func newSimulationTransfer(encoded string, observed time.Time) (SimulationTransfer, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(encoded, "0x"))
+	if err != nil {
+		b, err = base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return SimulationTransfer{}, errors.New("the VAA is neither hex nor base64 encoded")
+		}
+	}
+
+	v, err := vaa.Unmarshal(b)
+	if err != nil {
+		return SimulationTransfer{}, fmt.Errorf("failed to unmarshal VAA: %w", err)
+	}
+
+	if observed.IsZero() {
+		observed = v.Timestamp
+	}
+
+	return SimulationTransfer{
+		Time: observed,
+		Msg: &common.MessagePublication{
+			Timestamp:        v.Timestamp,
+			Nonce:            v.Nonce,
+			Sequence:         v.Sequence,
+			ConsistencyLevel: v.ConsistencyLevel,
+			EmitterChain:     v.EmitterChain,
+			EmitterAddress:   v.EmitterAddress,
+			Payload:          v.Payload,
+		},
+	}, nil
+}
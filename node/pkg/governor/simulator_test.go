@@ -0,0 +1,203 @@
+package governor
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	guardianDB "github.com/certusone/wormhole/node/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// The mainnet USDC on Ethereum, which is a flow cancelling token on the Ethereum-Sui corridor.
+const simulationUsdcAddr = "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+
+// newSimulationUsdcTransfer returns a token bridge transfer of USDC with the given value in USD.
+func newSimulationUsdcTransfer(t *testing.T, emitterChain vaa.ChainID, targetChain vaa.ChainID, sequence uint64, value float64, observed time.Time) SimulationTransfer {
+	t.Helper()
+	emitterAddr, err := vaa.BytesToAddress(sdk.KnownTokenbridgeEmitters[emitterChain])
+	require.NoError(t, err)
+
+	return SimulationTransfer{
+		Time: observed,
+		Msg: &common.MessagePublication{
+			Timestamp:      observed,
+			Sequence:       sequence,
+			EmitterChain:   emitterChain,
+			EmitterAddress: emitterAddr,
+			// USDC has 6 decimals and the helper scales amounts by 8 decimals, hence the division by 100.
+			Payload: buildMockTransferPayloadBytes(1, vaa.ChainIDEthereum, simulationUsdcAddr, targetChain, "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8", value/100),
+		},
+	}
+}
+
+func newTestSimulator(t *testing.T, config string) *Simulator {
+	t.Helper()
+	cfg, err := ParseSimulationConfig([]byte(config))
+	require.NoError(t, err)
+	s, err := NewSimulator(zap.NewNop(), common.MainNet, true, cfg, DefaultSimulationCheckInterval)
+	require.NoError(t, err)
+	return s
+}
+
+func TestSimulatorDailyLimit(t *testing.T) {
+	s := newTestSimulator(t, `{"chains": [{"emitterChainId": 2, "dailyLimit": 1000, "bigTransactionSize": 0}]}`)
+
+	start := time.Unix(1700000000, 0)
+	result, err := s.Run([]SimulationTransfer{
+		// Listed out of order, as transfers are replayed in the order they were observed.
+		newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 2, 600, start.Add(time.Hour)),
+		newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 1, 600, start),
+		newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSolana, 3, 600, start.Add(2*time.Hour)),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Transfers, 3)
+
+	first, second, third := result.Transfers[0], result.Transfers[1], result.Transfers[2]
+	assert.True(t, strings.HasSuffix(first.MsgID, "/1"))
+	assert.False(t, first.Enqueued)
+	assert.True(t, first.FlowCancelled)
+	assert.InDelta(t, 600, first.Value, 10)
+
+	// The second and third transfers exceed the daily limit until the first one is more than 24 hours old. The
+	// second one is then released, which uses up the capacity again until it is itself 24 hours old.
+	assert.True(t, second.Enqueued)
+	assert.WithinRange(t, second.ReleasedAt, start.Add(24*time.Hour), start.Add(24*time.Hour+2*time.Minute))
+	assert.True(t, third.Enqueued)
+	assert.WithinRange(t, third.ReleasedAt, start.Add(2*time.Hour+24*time.Hour), start.Add(2*time.Hour+24*time.Hour+2*time.Minute))
+	assert.False(t, third.FlowCancelled)
+
+	require.Len(t, result.Chains, 1)
+	summary := result.Chains[0]
+	assert.Equal(t, vaa.ChainIDEthereum, summary.EmitterChain)
+	assert.Equal(t, uint64(1000), summary.DailyLimit)
+	assert.Equal(t, 3, summary.Transfers)
+	assert.Equal(t, 2, summary.Enqueued)
+	assert.Equal(t, third.ReleasedAt.Sub(third.ObservedAt), summary.MaxWait)
+	assert.InDelta(t, 600, summary.PeakUsage, 10)
+
+	require.Len(t, result.Corridors, 1)
+	assert.Equal(t, vaa.ChainIDEthereum, result.Corridors[0].EmitterChain)
+	assert.Equal(t, vaa.ChainIDSui, result.Corridors[0].TargetChain)
+	assert.Equal(t, 2, result.Corridors[0].Transfers)
+}
+
+func TestSimulatorFlowCancelReleasesTransfers(t *testing.T) {
+	s := newTestSimulator(t, `{"chains": [{"emitterChainId": 2, "dailyLimit": 1000}]}`)
+
+	start := time.Unix(1700000000, 0)
+	result, err := s.Run([]SimulationTransfer{
+		newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 1, 600, start),
+		newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 2, 600, start.Add(time.Hour)),
+		// Transfers of USDC from Sui to Ethereum reduce the usage of Ethereum.
+		newSimulationUsdcTransfer(t, vaa.ChainIDSui, vaa.ChainIDEthereum, 3, 300, start.Add(2*time.Hour)),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Transfers, 3)
+
+	assert.True(t, result.Transfers[1].Enqueued)
+	assert.WithinRange(t, result.Transfers[1].ReleasedAt, start.Add(2*time.Hour), start.Add(2*time.Hour+2*time.Minute))
+	assert.True(t, result.Transfers[2].FlowCancelled)
+	require.Len(t, result.Corridors, 2)
+	assert.Equal(t, vaa.ChainIDSui, result.Corridors[1].EmitterChain)
+}
+
+func TestSimulatorIgnoresUngovernedMessages(t *testing.T) {
+	s := newTestSimulator(t, `{"chains": []}`)
+
+	xfer := newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 1, 600, time.Unix(1700000000, 0))
+	notATransfer := newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 2, 600, time.Unix(1700000001, 0))
+	notATransfer.Msg.Payload = []byte{0x42}
+
+	result, err := s.Run([]SimulationTransfer{xfer, notATransfer, xfer})
+	require.NoError(t, err)
+	assert.Len(t, result.Transfers, 1)
+	assert.Equal(t, 1, result.Ignored)
+}
+
+func TestNewSimulatorInvalid(t *testing.T) {
+	_, err := ParseSimulationConfig([]byte(`{"chains": [{"emitterChainId": 2, "dailyLimt": 1}]}`))
+	require.Error(t, err)
+
+	cfg, err := ParseSimulationConfig([]byte(`{"chains": [{"emitterChainId": 65000, "dailyLimit": 1}]}`))
+	require.NoError(t, err)
+	_, err = NewSimulator(zap.NewNop(), common.MainNet, false, cfg, DefaultSimulationCheckInterval)
+	require.Error(t, err)
+
+	_, err = NewSimulator(zap.NewNop(), common.MainNet, false, nil, 0)
+	require.Error(t, err)
+}
+
+func TestSimulatorTransfersFromDB(t *testing.T) {
+	s := newTestSimulator(t, `{"chains": [{"emitterChainId": 2, "dailyLimit": 1000}]}`)
+
+	start := time.Unix(1700000000, 0)
+	pending := newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 2, 600, start.Add(time.Hour))
+	usdcAddr, err := vaa.StringToAddress(simulationUsdcAddr)
+	require.NoError(t, err)
+	emitterAddr, err := vaa.BytesToAddress(sdk.KnownTokenbridgeEmitters[vaa.ChainIDEthereum])
+	require.NoError(t, err)
+
+	transfers, err := s.TransfersFromDB(
+		[]*guardianDB.Transfer{{
+			Timestamp:      start,
+			ScaledValue:    600 * guardianDB.ScaledValueFactor,
+			OriginChain:    vaa.ChainIDEthereum,
+			OriginAddress:  usdcAddr,
+			EmitterChain:   vaa.ChainIDEthereum,
+			EmitterAddress: emitterAddr,
+			TargetChain:    vaa.ChainIDSui,
+			MsgID:          fmt.Sprintf("2/%s/1", emitterAddr),
+		}},
+		[]*guardianDB.PendingTransfer{{ReleaseTime: start.Add(25 * time.Hour), Msg: *pending.Msg}},
+	)
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+
+	result, err := s.Run(transfers)
+	require.NoError(t, err)
+	require.Len(t, result.Transfers, 2)
+
+	// The stored transfer is replayed with its recorded value.
+	assert.Equal(t, uint64(1), transfers[1].Msg.Sequence)
+	assert.InDelta(t, 600, result.Transfers[0].Value, 1)
+	assert.True(t, result.Transfers[0].FlowCancelled)
+	assert.True(t, result.Transfers[1].Enqueued)
+}
+
+func TestReadSimulationVAAs(t *testing.T) {
+	xfer := newSimulationUsdcTransfer(t, vaa.ChainIDEthereum, vaa.ChainIDSui, 7, 600, time.Unix(1700000000, 0))
+	vaaBytes, err := xfer.Msg.CreateVAA(0).Marshal()
+	require.NoError(t, err)
+	observed := time.Unix(1700000100, 0).UTC()
+
+	jsonl := fmt.Sprintf("{\"vaa\": %q}\n{\"vaa\": %q, \"time\": %q}\n",
+		hex.EncodeToString(vaaBytes), base64.StdEncoding.EncodeToString(vaaBytes), observed.Format(time.RFC3339))
+	transfers, err := ReadSimulationVAAsJSONL(strings.NewReader(jsonl))
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	assert.True(t, xfer.Msg.Timestamp.Equal(transfers[0].Time))
+	assert.True(t, observed.Equal(transfers[1].Time))
+	assert.Equal(t, uint64(7), transfers[1].Msg.Sequence)
+	assert.Equal(t, xfer.Msg.Payload, transfers[1].Msg.Payload)
+
+	csvData := fmt.Sprintf("time,vaa\n,%s\n%s,0x%s\n", hex.EncodeToString(vaaBytes), observed.Format(time.RFC3339), hex.EncodeToString(vaaBytes))
+	transfers, err = ReadSimulationVAAsCSV(strings.NewReader(csvData))
+	require.NoError(t, err)
+	require.Len(t, transfers, 2)
+	assert.True(t, xfer.Msg.Timestamp.Equal(transfers[0].Time))
+	assert.True(t, observed.Equal(transfers[1].Time))
+
+	_, err = ReadSimulationVAAsJSONL(strings.NewReader(`{"vaa": "not a vaa"}`))
+	require.Error(t, err)
+	_, err = ReadSimulationVAAsCSV(strings.NewReader("time\n2024-01-01T00:00:00Z\n"))
+	require.Error(t, err)
+}
@@ -163,40 +163,9 @@ func (a *AmazonKms) Sign(ctx context.Context, hash []byte) (signature []byte, er
 		return nil, fmt.Errorf("failed to decode signature: %w", err)
 	}
 
-	// if s is greater than secp256k1HalfN, we need to subtract secp256k1N from it
-	sBigInt := new(big.Int).SetBytes(s)
-	if sBigInt.Cmp(secp256k1HalfN) > 0 {
-		s = new(big.Int).Sub(secp256k1N, sBigInt).Bytes()
-	}
-
-	// r and s need to be 32 bytes in size
-	r = adjustBufferSize(r)
-	s = adjustBufferSize(s)
-
-	// AWS KMS does not provide the recovery id. But that doesn't matter too much, since we can
-	// attempt recovery id's 0 and 1, and in the process ensure that the signature is valid.
+	// AWS KMS does not provide the recovery id, which recoverableSignature works out.
 	expectedPublicKey := a.PublicKey(ctx)
-	signature = append(r, s...)
-
-	// try recovery id 0
-	ecSigWithRecid := append(signature, []byte{0}...)
-	pubkey, _ := ethcrypto.SigToPub(hash[:], ecSigWithRecid)
-
-	if bytes.Equal(ethcrypto.CompressPubkey(pubkey), ethcrypto.CompressPubkey(&expectedPublicKey)) {
-		return ecSigWithRecid, nil
-	}
-
-	ecSigWithRecid = append(signature, []byte{1}...)
-	pubkey, _ = ethcrypto.SigToPub(hash[:], ecSigWithRecid)
-
-	// try recovery id 1
-	if bytes.Equal(ethcrypto.CompressPubkey(pubkey), ethcrypto.CompressPubkey(&expectedPublicKey)) {
-		return ecSigWithRecid, nil
-	}
-
-	// Reaching this return implies that it wasn't possible to generate a valid signature. This shouldn't
-	// happen, unless there is something seriously wrong with the KMS service.
-	return nil, fmt.Errorf("failed to generate valid signature")
+	return recoverableSignature(hash, r, s, &expectedPublicKey)
 }
 
 func (a *AmazonKms) PublicKey(ctx context.Context) ecdsa.PublicKey {
@@ -225,6 +194,44 @@ func (a *AmazonKms) TypeAsString() string {
 	return "amazonkms"
 }
 
+// recoverableSignature converts the r and s values of an ECDSA signature into the 65-byte Ethereum format, which
+// is r || s || recovery id. Signers such as KMS and HSMs do not provide the recovery id, but it is either 0 or 1, so
+// both are attempted, which also ensures that the signature is valid for the expected public key.
+func recoverableSignature(hash []byte, r []byte, s []byte, expectedPublicKey *ecdsa.PublicKey) ([]byte, error) {
+	// if s is greater than secp256k1HalfN, we need to subtract secp256k1N from it
+	sBigInt := new(big.Int).SetBytes(s)
+	if sBigInt.Cmp(secp256k1HalfN) > 0 {
+		s = new(big.Int).Sub(secp256k1N, sBigInt).Bytes()
+	}
+
+	// r and s need to be 32 bytes in size
+	r = adjustBufferSize(r)
+	s = adjustBufferSize(s)
+
+	// r and s may share a buffer with the signature returned by the signing service, so copy them.
+	signature := append(append(make([]byte, 0, 65), r...), s...)
+
+	// try recovery id 0
+	ecSigWithRecid := append(signature, []byte{0}...)
+	pubkey, _ := ethcrypto.SigToPub(hash[:], ecSigWithRecid)
+
+	if pubkey != nil && bytes.Equal(ethcrypto.CompressPubkey(pubkey), ethcrypto.CompressPubkey(expectedPublicKey)) {
+		return ecSigWithRecid, nil
+	}
+
+	ecSigWithRecid = append(signature, []byte{1}...)
+	pubkey, _ = ethcrypto.SigToPub(hash[:], ecSigWithRecid)
+
+	// try recovery id 1
+	if pubkey != nil && bytes.Equal(ethcrypto.CompressPubkey(pubkey), ethcrypto.CompressPubkey(expectedPublicKey)) {
+		return ecSigWithRecid, nil
+	}
+
+	// Reaching this return implies that it wasn't possible to generate a valid signature. This shouldn't
+	// happen, unless there is something seriously wrong with the signing service.
+	return nil, fmt.Errorf("failed to generate valid signature")
+}
+
 // https://bitcoin.stackexchange.com/questions/92680/what-are-the-der-signature-and-sec-format
 //  1. 0x30 byte: header byte to indicate compound structure
 //  2. one byte to encode the length of the following data
@@ -0,0 +1,205 @@
+package guardiansigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// GrpcSignerConfig is the configuration of a remote signer, served by `guardiand signer-server`. The URI is expected
+// to be in one of the formats
+//
+//	grpc://<host>:<port>?tls-cert=<client-cert>&tls-key=<client-key>&tls-ca=<server-ca>
+//	grpc+unix://<socket-path>
+//
+// Mutual TLS is required over TCP. Over a UNIX socket, the access to the socket is controlled by its file permissions,
+// so TLS is optional. The server name checked against the server certificate defaults to the host, and can be
+// overridden with tls-server-name.
+type GrpcSignerConfig struct {
+	Target        string
+	TlsCert       string
+	TlsKey        string
+	TlsCA         string
+	TlsServerName string
+}
+
+// ParseGrpcSignerConfig parses the key configuration of a grpc:// or grpc+unix:// signer URI. ParseSignerUri prefixes
+// the key configuration of UNIX sockets with "unix://", which is the gRPC target scheme for UNIX sockets.
+func ParseGrpcSignerConfig(keyConfig string) (*GrpcSignerConfig, error) {
+	target, query, _ := strings.Cut(keyConfig, "?")
+	if target == "" || target == "unix://" {
+		return nil, errors.New("missing remote signer address")
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signer parameters: %w", err)
+	}
+
+	for key := range params {
+		switch key {
+		case "tls-cert", "tls-key", "tls-ca", "tls-server-name":
+		default:
+			return nil, fmt.Errorf("unknown remote signer parameter %q", key)
+		}
+	}
+
+	cfg := GrpcSignerConfig{
+		Target:        target,
+		TlsCert:       params.Get("tls-cert"),
+		TlsKey:        params.Get("tls-key"),
+		TlsCA:         params.Get("tls-ca"),
+		TlsServerName: params.Get("tls-server-name"),
+	}
+
+	hasTls := cfg.TlsCert != "" || cfg.TlsKey != "" || cfg.TlsCA != "" || cfg.TlsServerName != ""
+	if hasTls && (cfg.TlsCert == "" || cfg.TlsKey == "" || cfg.TlsCA == "") {
+		return nil, errors.New("the remote signer tls-cert, tls-key and tls-ca must all be set")
+	}
+	if !hasTls && !strings.HasPrefix(target, "unix://") {
+		return nil, errors.New("mutual TLS is required for a remote signer over TCP")
+	}
+
+	return &cfg, nil
+}
+
+// transportCredentials returns the credentials used to connect to the signer server.
+func (cfg *GrpcSignerConfig) transportCredentials() (credentials.TransportCredentials, error) {
+	if cfg.TlsCert == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(cfg.TlsCert, cfg.TlsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load remote signer client certificate: %w", err)
+	}
+
+	roots, err := LoadCertPool(cfg.TlsCA)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      roots,
+		ServerName:   cfg.TlsServerName,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
+
+// LoadCertPool loads the PEM encoded certificate authorities of a file.
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificate authorities: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate authority found in %s", path)
+	}
+	return pool, nil
+}
+
+// GrpcSigner is a signer that forwards signing requests to a signer server, so that the guardian key can live in a
+// separate process or host. The URI is expected to be in the format grpc://<host>:<port>?<parameters> or
+// grpc+unix://<socket-path>, see GrpcSignerConfig.
+type GrpcSigner struct {
+	conn      *grpc.ClientConn
+	client    signerv1.SignerServiceClient
+	purpose   string
+	publicKey ecdsa.PublicKey
+}
+
+// NewGrpcSigner creates a new GrpcSigner, which requests signatures for the given purpose. The public key is
+// retrieved from the server, and stored as a property of the signer.
+func NewGrpcSigner(ctx context.Context, keyConfig string, purpose string) (*GrpcSigner, error) {
+	cfg, err := ParseGrpcSignerConfig(keyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := cfg.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(cfg.Target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create remote signer client: %w", err)
+	}
+
+	signer := &GrpcSigner{
+		conn:    conn,
+		client:  signerv1.NewSignerServiceClient(conn),
+		purpose: purpose,
+	}
+
+	resp, err := signer.client.GetPublicKey(ctx, &signerv1.GetPublicKeyRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to get remote signer public key: %w", err)
+	}
+
+	publicKey, err := ethcrypto.UnmarshalPubkey(resp.PublicKey)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("invalid remote signer public key: %w", err)
+	}
+	signer.publicKey = *publicKey
+
+	return signer, nil
+}
+
+func (g *GrpcSigner) Sign(ctx context.Context, hash []byte) (sig []byte, err error) {
+	resp, err := g.client.Sign(ctx, &signerv1.SignRequest{Digest: hash, Purpose: g.purpose})
+	if err != nil {
+		return nil, fmt.Errorf("remote signing failed: %w", err)
+	}
+
+	// Check the signature, so that a misbehaving server is caught before the signature is published.
+	valid, err := g.Verify(ctx, resp.Signature, hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote signature: %w", err)
+	}
+	if !valid {
+		return nil, errors.New("remote signature does not match the public key of the signer")
+	}
+
+	return resp.Signature, nil
+}
+
+func (g *GrpcSigner) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return g.publicKey
+}
+
+func (g *GrpcSigner) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	recoveredPubKey, err := ethcrypto.SigToPub(hash, sig)
+	if err != nil {
+		return false, err
+	}
+
+	return recoveredPubKey.Equal(&g.publicKey), nil
+}
+
+// Return the signer type as "grpc".
+func (g *GrpcSigner) TypeAsString() string {
+	return "grpc"
+}
+
+// Close closes the connection to the signer server.
+func (g *GrpcSigner) Close() error {
+	return g.conn.Close()
+}
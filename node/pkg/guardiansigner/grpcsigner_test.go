@@ -0,0 +1,272 @@
+package guardiansigner
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseGrpcSignerConfig(t *testing.T) {
+	signerType, keyConfig, err := ParseSignerUri("grpc+unix:///run/guardian/signer.sock")
+	require.NoError(t, err)
+	assert.Equal(t, GrpcSignerType, signerType)
+	cfg, err := ParseGrpcSignerConfig(keyConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "unix:///run/guardian/signer.sock", cfg.Target)
+	assert.Equal(t, "", cfg.TlsCert)
+
+	signerType, keyConfig, err = ParseSignerUri("grpc://signer.internal:7070?tls-cert=/c.pem&tls-key=/k.pem&tls-ca=/ca.pem&tls-server-name=signer")
+	require.NoError(t, err)
+	assert.Equal(t, GrpcSignerType, signerType)
+	cfg, err = ParseGrpcSignerConfig(keyConfig)
+	require.NoError(t, err)
+	assert.Equal(t, &GrpcSignerConfig{
+		Target:        "signer.internal:7070",
+		TlsCert:       "/c.pem",
+		TlsKey:        "/k.pem",
+		TlsCA:         "/ca.pem",
+		TlsServerName: "signer",
+	}, cfg)
+
+	invalid := []struct {
+		label     string
+		keyConfig string
+	}{
+		{label: "NoAddress", keyConfig: "?tls-cert=/c.pem&tls-key=/k.pem&tls-ca=/ca.pem"},
+		{label: "NoSocket", keyConfig: "unix://"},
+		{label: "TcpWithoutTls", keyConfig: "signer.internal:7070"},
+		{label: "MissingCA", keyConfig: "signer.internal:7070?tls-cert=/c.pem&tls-key=/k.pem"},
+		{label: "UnknownParameter", keyConfig: "unix:///signer.sock?insecure=true"},
+	}
+
+	for _, testcase := range invalid {
+		t.Run(testcase.label, func(t *testing.T) {
+			cfg, err := ParseGrpcSignerConfig(testcase.keyConfig)
+			assert.Nil(t, cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// startSignerServer serves a generated signer on the listener, and returns the signer.
+func startSignerServer(t *testing.T, l net.Listener, limiter *rate.Limiter, auditLogPath string, opts ...grpc.ServerOption) GuardianSigner {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer, err := NewGeneratedSigner(key)
+	require.NoError(t, err)
+
+	auditLog, err := OpenAuditLog(auditLogPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { auditLog.Close() })
+
+	server := grpc.NewServer(opts...)
+	signerv1.RegisterSignerServiceServer(server, NewSignerServer(zap.NewNop(), signer, []string{"guardian"}, limiter, auditLog))
+	go func() { _ = server.Serve(l) }()
+	t.Cleanup(server.Stop)
+
+	return signer
+}
+
+// readAuditLog returns the entries of the audit log.
+func readAuditLog(t *testing.T, path string) []AuditLogEntry {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var entries []AuditLogEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditLogEntry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestGrpcSignerUnixSocket(t *testing.T) {
+	ctx := context.Background()
+
+	// UNIX socket paths are limited to about a hundred characters, which t.TempDir() may exceed.
+	dir, err := os.MkdirTemp("", "signer")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	socketPath := filepath.Join(dir, "signer.sock")
+	auditLogPath := filepath.Join(dir, "audit.log")
+	l, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	// Allow two signatures, then one every hour.
+	serverSigner := startSignerServer(t, l, rate.NewLimiter(rate.Every(time.Hour), 2), auditLogPath)
+
+	signer, err := NewGuardianSignerFromUri(ctx, "grpc+unix://"+socketPath, false)
+	require.NoError(t, err)
+	assert.Equal(t, serverSigner.PublicKey(ctx), signer.PublicKey(ctx))
+
+	hash := crypto.Keccak256([]byte("data"))
+	sig, err := signer.Sign(ctx, hash)
+	require.NoError(t, err)
+	valid, err := serverSigner.Verify(ctx, sig, hash)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// The server only signs for the allowed purposes.
+	managerSigner, err := NewGuardianSignerFromUriWithPurpose(ctx, "grpc+unix://"+socketPath, false, "manager-xrpl")
+	require.NoError(t, err)
+	_, err = managerSigner.Sign(ctx, hash)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The server only signs 32 byte digests.
+	_, err = signer.Sign(ctx, hash[:31])
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	otherHash := crypto.Keccak256([]byte("other data"))
+	_, err = signer.Sign(ctx, otherHash)
+	require.NoError(t, err)
+
+	// The rate limit is reached.
+	_, err = signer.Sign(ctx, hash)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Only the signed digests are in the audit log.
+	entries := readAuditLog(t, auditLogPath)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "guardian", entries[0].Purpose)
+	assert.Equal(t, hex.EncodeToString(hash), entries[0].Digest)
+	assert.Equal(t, hex.EncodeToString(sig), entries[0].Signature)
+	assert.Equal(t, hex.EncodeToString(otherHash), entries[1].Digest)
+}
+
+// testCertificate is a certificate and its key, issued by a test certificate authority.
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// newTestCertificate creates a certificate for the common name, signed by the issuer, or self-signed if the issuer
+// is nil.
+func newTestCertificate(t *testing.T, commonName string, issuer *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{commonName},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+
+	parent, signingKey := template, key
+	if issuer == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		parent, signingKey = issuer.cert, issuer.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signingKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+// write writes the certificate and key files, and returns their paths.
+func (c *testCertificate) write(t *testing.T, dir string, name string) (string, string) {
+	certPath := filepath.Join(dir, name+".pem")
+	keyPath := filepath.Join(dir, name+"-key.pem")
+	require.NoError(t, os.WriteFile(certPath, c.certPEM, 0600))
+	require.NoError(t, os.WriteFile(keyPath, c.keyPEM, 0600))
+	return certPath, keyPath
+}
+
+func TestGrpcSignerMutualTls(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	ca := newTestCertificate(t, "test-ca", nil)
+	caPath, _ := ca.write(t, dir, "ca")
+	serverCertPath, serverKeyPath := newTestCertificate(t, "signer", ca).write(t, dir, "server")
+	clientCertPath, clientKeyPath := newTestCertificate(t, "guardian-1", ca).write(t, dir, "client")
+
+	// A client certificate that is not issued by the CA of the server.
+	otherCA := newTestCertificate(t, "other-ca", nil)
+	otherCertPath, otherKeyPath := newTestCertificate(t, "intruder", otherCA).write(t, dir, "other")
+
+	serverCert, err := tls.LoadX509KeyPair(serverCertPath, serverKeyPath)
+	require.NoError(t, err)
+	clientCAs, err := LoadCertPool(caPath)
+	require.NoError(t, err)
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	})
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	auditLogPath := filepath.Join(dir, "audit.log")
+	serverSigner := startSignerServer(t, l, nil, auditLogPath, grpc.Creds(creds))
+
+	uri := "grpc://" + l.Addr().String() + "?tls-ca=" + caPath + "&tls-server-name=signer"
+	signer, err := NewGuardianSignerFromUri(ctx, uri+"&tls-cert="+clientCertPath+"&tls-key="+clientKeyPath, false)
+	require.NoError(t, err)
+	assert.Equal(t, serverSigner.PublicKey(ctx), signer.PublicKey(ctx))
+
+	hash := crypto.Keccak256([]byte("data"))
+	sig, err := signer.Sign(ctx, hash)
+	require.NoError(t, err)
+	valid, err := signer.Verify(ctx, sig, hash)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// The client is identified by its certificate in the audit log.
+	entries := readAuditLog(t, auditLogPath)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "guardian-1", entries[0].Client)
+
+	// A client with a certificate from another CA is rejected.
+	_, err = NewGuardianSignerFromUri(ctx, uri+"&tls-cert="+otherCertPath+"&tls-key="+otherKeyPath, false)
+	assert.Error(t, err)
+}
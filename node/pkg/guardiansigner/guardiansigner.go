@@ -17,6 +17,10 @@ const (
 	FileSignerType
 	// amazonkms://<arn>
 	AmazonKmsSignerType
+	// pkcs11://<module-path>?<parameters>
+	Pkcs11SignerType
+	// grpc://<host>:<port>?<parameters> or grpc+unix://<socket-path>
+	GrpcSignerType
 )
 
 // GuardianSigner interface. Each function in the GuardianSigner interface
@@ -72,6 +76,10 @@ func NewGuardianSignerFromUriWithPurpose(ctx context.Context, signerUri string,
 		guardianSigner, err = NewFileSigner(ctx, unsafeDevMode, signerKeyConfig)
 	case AmazonKmsSignerType:
 		guardianSigner, err = NewAmazonKmsSigner(ctx, signerKeyConfig)
+	case Pkcs11SignerType:
+		guardianSigner, err = NewPkcs11Signer(ctx, signerKeyConfig)
+	case GrpcSignerType:
+		guardianSigner, err = NewGrpcSigner(ctx, signerKeyConfig, purpose)
 	default:
 		return nil, errors.New("unsupported guardian signer type")
 	}
@@ -111,6 +119,13 @@ func ParseSignerUri(signerUri string) (signerType SignerType, signerKeyConfig st
 		return FileSignerType, keyConfig, nil
 	case "amazonkms":
 		return AmazonKmsSignerType, keyConfig, nil
+	case "pkcs11":
+		return Pkcs11SignerType, keyConfig, nil
+	case "grpc":
+		return GrpcSignerType, keyConfig, nil
+	case "grpc+unix":
+		// The gRPC target of a UNIX socket uses the "unix" scheme.
+		return GrpcSignerType, "unix://" + keyConfig, nil
 	default:
 		return InvalidSignerType, "", fmt.Errorf("unsupported guardian signer type: %s", typeStr)
 	}
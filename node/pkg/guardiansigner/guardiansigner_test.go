@@ -2,11 +2,17 @@ package guardiansigner
 
 import (
 	"context"
+	"encoding/asn1"
 	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +32,8 @@ func TestParseSignerUri(t *testing.T) {
 		{label: "FileUriTraversal", path: "file://../../../file", expectedType: FileSignerType},
 		// Amazon KMS
 		{label: "AmazonKmsURI", path: "amazonkms://some-arn", expectedType: AmazonKmsSignerType},
+		// PKCS#11
+		{label: "Pkcs11URI", path: "pkcs11:///usr/lib/softhsm/libsofthsm2.so?token=guardian&label=key&pin-value=1234", expectedType: Pkcs11SignerType},
 	}
 
 	for _, testcase := range tests {
@@ -144,3 +152,195 @@ func TestAmazonKmsAdjustBufferSize(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePkcs11Config(t *testing.T) {
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(pinFile, []byte("1234\n"), 0600))
+
+	cfg, err := ParsePkcs11Config("/usr/lib/softhsm/libsofthsm2.so?token=guardian&label=guardian-key&id=0x0102&pin-source=" + pinFile)
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/lib/softhsm/libsofthsm2.so", cfg.ModulePath)
+	assert.Equal(t, "guardian", cfg.TokenLabel)
+	assert.Nil(t, cfg.Slot)
+	assert.Equal(t, "guardian-key", cfg.KeyLabel)
+	assert.Equal(t, []byte{0x01, 0x02}, cfg.KeyID)
+	assert.Equal(t, "1234", cfg.Pin)
+
+	cfg, err = ParsePkcs11Config("/opt/hsm/libhsm.so?slot=3&id=aa&pin-value=5678")
+	require.NoError(t, err)
+	require.NotNil(t, cfg.Slot)
+	assert.Equal(t, uint(3), *cfg.Slot)
+	assert.Equal(t, "", cfg.KeyLabel)
+	assert.Equal(t, "5678", cfg.Pin)
+
+	invalid := []struct {
+		label     string
+		keyConfig string
+	}{
+		{label: "NoModule", keyConfig: "?token=guardian&label=key&pin-value=1234"},
+		{label: "NoToken", keyConfig: "/lib.so?label=key&pin-value=1234"},
+		{label: "TokenAndSlot", keyConfig: "/lib.so?token=guardian&slot=1&label=key&pin-value=1234"},
+		{label: "InvalidSlot", keyConfig: "/lib.so?slot=one&label=key&pin-value=1234"},
+		{label: "NoKey", keyConfig: "/lib.so?token=guardian&pin-value=1234"},
+		{label: "InvalidID", keyConfig: "/lib.so?token=guardian&id=xyz&pin-value=1234"},
+		{label: "NoPin", keyConfig: "/lib.so?token=guardian&label=key"},
+		{label: "PinSourceAndValue", keyConfig: "/lib.so?token=guardian&label=key&pin-value=1234&pin-source=" + pinFile},
+		{label: "MissingPinSource", keyConfig: "/lib.so?token=guardian&label=key&pin-source=/nonexistent/pin"},
+		{label: "UnknownParameter", keyConfig: "/lib.so?token=guardian&label=key&pin-value=1234&pin=1234"},
+	}
+
+	for _, testcase := range invalid {
+		t.Run(testcase.label, func(t *testing.T) {
+			cfg, err := ParsePkcs11Config(testcase.keyConfig)
+			assert.Nil(t, cfg)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPkcs11SignatureConversion(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	for i := 0; i < 16; i++ {
+		hash := crypto.Keccak256([]byte{byte(i)})
+		expected, err := ethcrypto.Sign(hash, key)
+		require.NoError(t, err)
+
+		r := new(big.Int).SetBytes(expected[:32])
+		s := new(big.Int).SetBytes(expected[32:64])
+
+		// Use the high s value, which HSMs may return, to check that it is normalized.
+		highS := new(big.Int).Sub(secp256k1N, s)
+
+		raw := append(append([]byte{}, expected[:32]...), highS.FillBytes(make([]byte, 32))...)
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{r, highS})
+		require.NoError(t, err)
+
+		for _, signature := range [][]byte{raw, der} {
+			r, s, err := pkcs11SignatureToRS(signature)
+			require.NoError(t, err)
+
+			sig, err := recoverableSignature(hash, r, s, &key.PublicKey)
+			require.NoError(t, err)
+			assert.Equal(t, expected, sig)
+		}
+	}
+}
+
+func TestPkcs11EcPointToPublicKey(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	point := ethcrypto.FromECDSAPub(&key.PublicKey)
+	der, err := asn1.Marshal(point)
+	require.NoError(t, err)
+
+	for _, encoded := range [][]byte{point, der} {
+		publicKey, err := ecPointToPublicKey(encoded)
+		require.NoError(t, err)
+		assert.True(t, publicKey.Equal(&key.PublicKey))
+	}
+
+	_, err = ecPointToPublicKey(point[:64])
+	assert.Error(t, err)
+}
+
+// TestPkcs11SoftHsm tests the PKCS#11 signer against SoftHSM. It only runs when SOFTHSM2_MODULE is set to the path of
+// the SoftHSM module, for example /usr/lib/softhsm/libsofthsm2.so.
+func TestPkcs11SoftHsm(t *testing.T) {
+	modulePath := os.Getenv("SOFTHSM2_MODULE")
+	if modulePath == "" {
+		t.Skip("SOFTHSM2_MODULE is not set")
+	}
+
+	// Use a token directory of our own, so that the test does not depend on the SoftHSM configuration of the host.
+	dir := t.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+tokenDir+"\nobjectstore.backend = file\n"), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	const soPin = "87654321"
+	const pin = "12345678"
+	initSoftHsmToken(t, modulePath, "guardian", soPin, pin)
+
+	ctx := context.Background()
+	keyConfig := modulePath + "?token=guardian&label=guardian-key&id=01&pin-value=" + pin
+
+	publicKey, err := GeneratePkcs11Key(ctx, keyConfig)
+	require.NoError(t, err)
+
+	// The key cannot be generated twice.
+	_, err = GeneratePkcs11Key(ctx, keyConfig)
+	assert.Error(t, err)
+
+	signer, err := NewGuardianSignerFromUri(ctx, "pkcs11://"+keyConfig, false)
+	require.NoError(t, err)
+	assert.True(t, publicKey.Equal(ptr(signer.PublicKey(ctx))))
+
+	for i := 0; i < 8; i++ {
+		hash := crypto.Keccak256([]byte{byte(i)})
+		sig, err := signer.Sign(ctx, hash)
+		require.NoError(t, err)
+		require.Len(t, sig, 65)
+
+		valid, err := signer.Verify(ctx, sig, hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		recovered, err := ethcrypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.Equal(t, ethcrypto.PubkeyToAddress(*publicKey), ethcrypto.PubkeyToAddress(*recovered))
+	}
+
+	// The key can also be found by its ID alone.
+	pkcs11Signer, err := NewPkcs11Signer(ctx, modulePath+"?token=guardian&id=01&pin-value="+pin)
+	require.NoError(t, err)
+	assert.True(t, publicKey.Equal(&pkcs11Signer.publicKey))
+
+	_, err = NewPkcs11Signer(ctx, modulePath+"?token=guardian&label=guardian-key&pin-value=wrong")
+	assert.Error(t, err)
+	_, err = NewPkcs11Signer(ctx, modulePath+"?token=guardian&label=other-key&pin-value="+pin)
+	assert.Error(t, err)
+}
+
+// initSoftHsmToken initializes a token in the first free SoftHSM slot, and sets the PIN of its user.
+func initSoftHsmToken(t *testing.T, modulePath string, label string, soPin string, pin string) {
+	p11 := pkcs11.New(modulePath)
+	require.NotNil(t, p11)
+	err := p11.Initialize()
+	if err != nil {
+		require.ErrorIs(t, err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED))
+	}
+
+	slots, err := p11.GetSlotList(false)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, p11.InitToken(slots[len(slots)-1], soPin, label))
+
+	// SoftHSM moves the initialized token to a new slot.
+	slots, err = p11.GetSlotList(true)
+	require.NoError(t, err)
+	for _, slot := range slots {
+		info, err := p11.GetTokenInfo(slot)
+		require.NoError(t, err)
+		if strings.TrimSpace(info.Label) != label {
+			continue
+		}
+
+		session, err := p11.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+		require.NoError(t, err)
+		require.NoError(t, p11.Login(session, pkcs11.CKU_SO, soPin))
+		require.NoError(t, p11.InitPIN(session, pin))
+		require.NoError(t, p11.Logout(session))
+		require.NoError(t, p11.CloseSession(session))
+		return
+	}
+	t.Fatal("initialized SoftHSM token not found")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,461 @@
+package guardiansigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
+)
+
+// errPkcs11KeyNotFound is returned when no object matches the key label and ID.
+var errPkcs11KeyNotFound = errors.New("no matching key")
+
+// The DER encoding of the secp256k1 curve OID (1.3.132.0.10), as found in the CKA_EC_PARAMS attribute of a key.
+var secp256k1EcParams = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+
+// Pkcs11Config is the configuration of a PKCS#11 signer. The URI is expected to be in the format
+//
+//	pkcs11://<module-path>?token=<token-label>&label=<key-label>&id=<hex-key-id>&pin-source=<pin-file>
+//
+// The token is selected by its label or by its slot number (slot=<n>), and the key by its label, its ID or both. The
+// PIN of the token user is read from pin-source, or given directly with pin-value, which exposes it in the process
+// list and should only be used for testing.
+type Pkcs11Config struct {
+	ModulePath string
+	TokenLabel string
+	Slot       *uint
+	KeyLabel   string
+	KeyID      []byte
+	Pin        string
+}
+
+// ParsePkcs11Config parses the key configuration of a pkcs11:// signer URI.
+func ParsePkcs11Config(keyConfig string) (*Pkcs11Config, error) {
+	modulePath, query, _ := strings.Cut(keyConfig, "?")
+	if modulePath == "" {
+		return nil, errors.New("missing PKCS#11 module path")
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PKCS#11 parameters: %w", err)
+	}
+
+	cfg := Pkcs11Config{
+		ModulePath: modulePath,
+		TokenLabel: params.Get("token"),
+		KeyLabel:   params.Get("label"),
+	}
+
+	for key := range params {
+		switch key {
+		case "token", "slot", "label", "id", "pin-source", "pin-value":
+		default:
+			return nil, fmt.Errorf("unknown PKCS#11 parameter %q", key)
+		}
+	}
+
+	if slotStr := params.Get("slot"); slotStr != "" {
+		slot, err := strconv.ParseUint(slotStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid PKCS#11 slot %q", slotStr)
+		}
+		s := uint(slot)
+		cfg.Slot = &s
+	}
+
+	if (cfg.TokenLabel == "") == (cfg.Slot == nil) {
+		return nil, errors.New("exactly one of the PKCS#11 token label and slot must be set")
+	}
+
+	if idStr := params.Get("id"); idStr != "" {
+		cfg.KeyID, err = hex.DecodeString(strings.TrimPrefix(idStr, "0x"))
+		if err != nil || len(cfg.KeyID) == 0 {
+			return nil, fmt.Errorf("invalid PKCS#11 key ID %q", idStr)
+		}
+	}
+
+	if cfg.KeyLabel == "" && cfg.KeyID == nil {
+		return nil, errors.New("the PKCS#11 key label or ID must be set")
+	}
+
+	pinSource, pinValue := params.Get("pin-source"), params.Get("pin-value")
+	switch {
+	case pinSource != "" && pinValue != "":
+		return nil, errors.New("only one of the PKCS#11 pin-source and pin-value can be set")
+	case pinSource != "":
+		pin, err := os.ReadFile(pinSource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PKCS#11 PIN: %w", err)
+		}
+		cfg.Pin = strings.TrimRight(string(pin), "\r\n")
+	case pinValue != "":
+		cfg.Pin = pinValue
+	default:
+		return nil, errors.New("the PKCS#11 pin-source or pin-value must be set")
+	}
+
+	return &cfg, nil
+}
+
+// keyTemplate returns the attributes that identify the key of the given class.
+func (cfg *Pkcs11Config) keyTemplate(class uint) []*pkcs11.Attribute {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+	}
+	if cfg.KeyLabel != "" {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, cfg.KeyLabel))
+	}
+	if cfg.KeyID != nil {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, cfg.KeyID))
+	}
+	return template
+}
+
+// Pkcs11 is a signer that uses a key stored in a hardware security module, through its PKCS#11 module. The URI is
+// expected to be in the format pkcs11://<module-path>?<parameters>, see Pkcs11Config.
+// NOTE: PKCS#11 calls cannot be cancelled, so the context is not honoured once a call to the module has started.
+type Pkcs11 struct {
+	cfg       *Pkcs11Config
+	ctx       *pkcs11.Ctx
+	slot      uint
+	publicKey ecdsa.PublicKey
+
+	// A session must not be used concurrently, so signing is serialized.
+	mu         sync.Mutex
+	session    pkcs11.SessionHandle
+	privateKey pkcs11.ObjectHandle
+}
+
+// NewPkcs11Signer creates a new Pkcs11 signer. It loads the PKCS#11 module, logs into the token and looks up the
+// secp256k1 key pair, whose public key is stored as a property of the signer.
+func NewPkcs11Signer(ctx context.Context, keyConfig string) (*Pkcs11, error) {
+	cfg, err := ParsePkcs11Config(keyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	p11, slot, err := openPkcs11Module(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &Pkcs11{cfg: cfg, ctx: p11, slot: slot}
+	if err := signer.openSession(); err != nil {
+		return nil, err
+	}
+
+	publicKey, err := signer.findPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	signer.publicKey = *publicKey
+
+	return signer, nil
+}
+
+// openPkcs11Module loads and initializes the PKCS#11 module, and looks up the slot of the configured token.
+func openPkcs11Module(cfg *Pkcs11Config) (*pkcs11.Ctx, uint, error) {
+	p11 := pkcs11.New(cfg.ModulePath)
+	if p11 == nil {
+		return nil, 0, fmt.Errorf("failed to load PKCS#11 module %s", cfg.ModulePath)
+	}
+
+	// The module may already be initialized by another signer in the same process.
+	if err := p11.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		return nil, 0, fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+
+	slots, err := p11.GetSlotList(true)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+
+	for _, slot := range slots {
+		if cfg.Slot != nil {
+			if slot == *cfg.Slot {
+				return p11, slot, nil
+			}
+			continue
+		}
+
+		info, err := p11.GetTokenInfo(slot)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to get PKCS#11 token info: %w", err)
+		}
+		if strings.TrimSpace(info.Label) == cfg.TokenLabel {
+			return p11, slot, nil
+		}
+	}
+
+	return nil, 0, errors.New("PKCS#11 token not found")
+}
+
+// openSession opens a session, logs into it and looks up the private key. It assumes the caller holds the lock, or
+// has exclusive access to the signer.
+func (p *Pkcs11) openSession() error {
+	session, err := p.ctx.OpenSession(p.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return fmt.Errorf("failed to open PKCS#11 session: %w", err)
+	}
+
+	// The login state is shared by all the sessions of the application.
+	if err := p.ctx.Login(session, pkcs11.CKU_USER, p.cfg.Pin); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		_ = p.ctx.CloseSession(session)
+		return fmt.Errorf("failed to log into PKCS#11 token: %w", err)
+	}
+
+	privateKey, err := findPkcs11Object(p.ctx, session, p.cfg.keyTemplate(pkcs11.CKO_PRIVATE_KEY))
+	if err != nil {
+		_ = p.ctx.CloseSession(session)
+		return fmt.Errorf("failed to find PKCS#11 private key: %w", err)
+	}
+
+	p.session = session
+	p.privateKey = privateKey
+	return nil
+}
+
+// findPkcs11Object returns the only object that matches the template.
+func findPkcs11Object(p11 *pkcs11.Ctx, session pkcs11.SessionHandle, template []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+	if err := p11.FindObjectsInit(session, template); err != nil {
+		return 0, err
+	}
+
+	objects, _, err := p11.FindObjects(session, 2)
+	if finalErr := p11.FindObjectsFinal(session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, errPkcs11KeyNotFound
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, errors.New("several keys match, set both the label and the ID")
+	}
+}
+
+// findPublicKey reads the public key that matches the private key, and checks that it is on the secp256k1 curve.
+func (p *Pkcs11) findPublicKey() (*ecdsa.PublicKey, error) {
+	object, err := findPkcs11Object(p.ctx, p.session, p.cfg.keyTemplate(pkcs11.CKO_PUBLIC_KEY))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find PKCS#11 public key: %w", err)
+	}
+
+	attrs, err := p.ctx.GetAttributeValue(p.session, object, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PKCS#11 public key: %w", err)
+	}
+
+	var params, point []byte
+	for _, attr := range attrs {
+		switch attr.Type {
+		case pkcs11.CKA_EC_PARAMS:
+			params = attr.Value
+		case pkcs11.CKA_EC_POINT:
+			point = attr.Value
+		}
+	}
+
+	if !bytes.Equal(params, secp256k1EcParams) {
+		return nil, errors.New("PKCS#11 key is not on the secp256k1 curve")
+	}
+
+	return ecPointToPublicKey(point)
+}
+
+// ecPointToPublicKey decodes the CKA_EC_POINT attribute of a public key. The point is normally the DER encoding of
+// an octet string that contains the uncompressed point, but some modules return the uncompressed point itself.
+func ecPointToPublicKey(point []byte) (*ecdsa.PublicKey, error) {
+	if len(point) != 65 {
+		var octets []byte
+		if rest, err := asn1.Unmarshal(point, &octets); err != nil || len(rest) != 0 {
+			return nil, errors.New("invalid PKCS#11 EC point")
+		}
+		point = octets
+	}
+
+	publicKey, err := ethcrypto.UnmarshalPubkey(point)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PKCS#11 public key: %w", err)
+	}
+	return publicKey, nil
+}
+
+// pkcs11SignatureToRS decodes the signature returned by the module. PKCS#11 specifies that ECDSA signatures are the
+// concatenation of r and s, but some modules return them DER encoded.
+func pkcs11SignatureToRS(signature []byte) ([]byte, []byte, error) {
+	if len(signature) == 64 {
+		return signature[:32], signature[32:], nil
+	}
+	return derSignatureToRS(signature)
+}
+
+// isPkcs11SessionError returns true if the error means that the session has to be reopened, for example after the
+// HSM restarted.
+func isPkcs11SessionError(err error) bool {
+	var p11Err pkcs11.Error
+	if !errors.As(err, &p11Err) {
+		return false
+	}
+	switch p11Err {
+	case pkcs11.CKR_SESSION_HANDLE_INVALID, pkcs11.CKR_SESSION_CLOSED, pkcs11.CKR_USER_NOT_LOGGED_IN,
+		pkcs11.CKR_OBJECT_HANDLE_INVALID, pkcs11.CKR_KEY_HANDLE_INVALID, pkcs11.CKR_DEVICE_REMOVED, pkcs11.CKR_TOKEN_NOT_PRESENT:
+		return true
+	default:
+		return false
+	}
+}
+
+// signAlreadyLocked signs the hash with the private key. It assumes the caller holds the lock.
+func (p *Pkcs11) signAlreadyLocked(hash []byte) ([]byte, error) {
+	if err := p.ctx.SignInit(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, p.privateKey); err != nil {
+		return nil, err
+	}
+	return p.ctx.Sign(p.session, hash)
+}
+
+func (p *Pkcs11) Sign(ctx context.Context, hash []byte) (signature []byte, err error) {
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("invalid hash length: %d", len(hash))
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	der, err := p.signAlreadyLocked(hash)
+	if isPkcs11SessionError(err) {
+		// Reopen the session once, in case the HSM or the connection to it was restarted.
+		_ = p.ctx.CloseSession(p.session)
+		if err = p.openSession(); err == nil {
+			der, err = p.signAlreadyLocked(hash)
+		}
+	}
+	p.mu.Unlock()
+
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 signing failed: %w", err)
+	}
+
+	r, s, err := pkcs11SignatureToRS(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	return recoverableSignature(hash, r, s, &p.publicKey)
+}
+
+func (p *Pkcs11) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return p.publicKey
+}
+
+func (p *Pkcs11) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	recoveredPubKey, err := ethcrypto.SigToPub(hash, sig)
+	if err != nil {
+		return false, err
+	}
+
+	return recoveredPubKey.Equal(&p.publicKey), nil
+}
+
+// Return the signer type as "pkcs11".
+func (p *Pkcs11) TypeAsString() string {
+	return "pkcs11"
+}
+
+// GeneratePkcs11Key generates a secp256k1 key pair on the token of a pkcs11:// signer URI, and returns its public
+// key. The private key is marked sensitive and cannot be extracted from the token. It fails if a key with the same
+// label or ID already exists.
+func GeneratePkcs11Key(ctx context.Context, keyConfig string) (*ecdsa.PublicKey, error) {
+	cfg, err := ParsePkcs11Config(keyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	p11, slot, err := openPkcs11Module(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := p11.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 session: %w", err)
+	}
+	defer func() { _ = p11.CloseSession(session) }()
+
+	if err := p11.Login(session, pkcs11.CKU_USER, cfg.Pin); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return nil, fmt.Errorf("failed to log into PKCS#11 token: %w", err)
+	}
+
+	// Check that neither the label nor the ID is in use, so that the new key can be found unambiguously.
+	for _, template := range [][]*pkcs11.Attribute{
+		{pkcs11.NewAttribute(pkcs11.CKA_LABEL, cfg.KeyLabel)},
+		{pkcs11.NewAttribute(pkcs11.CKA_ID, cfg.KeyID)},
+	} {
+		if len(template[0].Value) == 0 {
+			continue
+		}
+		if _, err := findPkcs11Object(p11, session, template); !errors.Is(err, errPkcs11KeyNotFound) {
+			return nil, errors.New("a PKCS#11 object with the same key label or ID already exists")
+		}
+	}
+
+	common := func(class uint) []*pkcs11.Attribute {
+		template := []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+			pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_EC),
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		}
+		if cfg.KeyLabel != "" {
+			template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, cfg.KeyLabel))
+		}
+		if cfg.KeyID != nil {
+			template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, cfg.KeyID))
+		}
+		return template
+	}
+
+	publicTemplate := append(common(pkcs11.CKO_PUBLIC_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, secp256k1EcParams),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+	)
+	privateTemplate := append(common(pkcs11.CKO_PRIVATE_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+	)
+
+	publicKeyObject, _, err := p11.GenerateKeyPair(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)}, publicTemplate, privateTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate PKCS#11 key pair: %w", err)
+	}
+
+	attrs, err := p11.GetAttributeValue(session, publicKeyObject, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read PKCS#11 public key: %w", err)
+	}
+
+	return ecPointToPublicKey(attrs[0].Value)
+}
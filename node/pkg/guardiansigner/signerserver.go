@@ -0,0 +1,157 @@
+package guardiansigner
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AuditLog is an append-only log of the digests signed by a SignerServer, with one JSON entry per line.
+type AuditLog struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// AuditLogEntry is an entry of the audit log.
+type AuditLogEntry struct {
+	Time      time.Time `json:"time"`
+	Client    string    `json:"client"`
+	Purpose   string    `json:"purpose"`
+	Digest    string    `json:"digest"`
+	Signature string    `json:"signature"`
+}
+
+// OpenAuditLog opens the audit log at the given path, creating it if it does not exist. Existing entries are kept.
+func OpenAuditLog(path string) (*AuditLog, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	return &AuditLog{file: file}, nil
+}
+
+// Append writes the entry to the log and flushes it to disk.
+func (a *AuditLog) Append(entry *AuditLogEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if _, err := a.file.Write(b); err != nil {
+		return err
+	}
+	return a.file.Sync()
+}
+
+// Close closes the audit log.
+func (a *AuditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.file.Close()
+}
+
+// SignerServer serves a guardian signer to remote GrpcSigners. It only signs for the allowed purposes, limits the
+// rate of signatures and records every signature in the audit log before returning it.
+type SignerServer struct {
+	signerv1.UnsafeSignerServiceServer
+	logger          *zap.Logger
+	signer          GuardianSigner
+	allowedPurposes map[string]struct{}
+	limiter         *rate.Limiter
+	auditLog        *AuditLog
+}
+
+// NewSignerServer creates a new SignerServer. The limiter may be nil, in which case the rate of signatures is not
+// limited.
+func NewSignerServer(logger *zap.Logger, signer GuardianSigner, allowedPurposes []string, limiter *rate.Limiter, auditLog *AuditLog) *SignerServer {
+	purposes := make(map[string]struct{}, len(allowedPurposes))
+	for _, purpose := range allowedPurposes {
+		purposes[purpose] = struct{}{}
+	}
+
+	return &SignerServer{
+		logger:          logger,
+		signer:          signer,
+		allowedPurposes: purposes,
+		limiter:         limiter,
+		auditLog:        auditLog,
+	}
+}
+
+// clientName identifies the client in the logs: the common name of its certificate if it uses mutual TLS, otherwise
+// its address.
+func clientName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "unknown"
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+		return tlsInfo.State.PeerCertificates[0].Subject.CommonName
+	}
+	return p.Addr.String()
+}
+
+func (s *SignerServer) Sign(ctx context.Context, req *signerv1.SignRequest) (*signerv1.SignResponse, error) {
+	client := clientName(ctx)
+
+	if len(req.Digest) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid digest length: %d", len(req.Digest))
+	}
+
+	if _, allowed := s.allowedPurposes[req.Purpose]; !allowed {
+		s.logger.Warn("rejected signing request for a purpose that is not allowed",
+			zap.String("client", client),
+			zap.String("purpose", req.Purpose),
+		)
+		return nil, status.Errorf(codes.PermissionDenied, "signing purpose %q is not allowed", req.Purpose)
+	}
+
+	if s.limiter != nil && !s.limiter.Allow() {
+		s.logger.Warn("rejected signing request because of the rate limit",
+			zap.String("client", client),
+			zap.String("purpose", req.Purpose),
+		)
+		return nil, status.Error(codes.ResourceExhausted, "signing rate limit exceeded")
+	}
+
+	sig, err := s.signer.Sign(ctx, req.Digest)
+	if err != nil {
+		s.logger.Error("failed to sign", zap.String("client", client), zap.String("purpose", req.Purpose), zap.Error(err))
+		return nil, status.Error(codes.Internal, "signing failed")
+	}
+
+	// The signature is only returned once it is recorded, so that nothing can be signed without a trace.
+	if err := s.auditLog.Append(&AuditLogEntry{
+		Time:      time.Now().UTC(),
+		Client:    client,
+		Purpose:   req.Purpose,
+		Digest:    hex.EncodeToString(req.Digest),
+		Signature: hex.EncodeToString(sig),
+	}); err != nil {
+		s.logger.Error("failed to write the audit log", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to write the audit log")
+	}
+
+	return &signerv1.SignResponse{Signature: sig}, nil
+}
+
+func (s *SignerServer) GetPublicKey(ctx context.Context, req *signerv1.GetPublicKeyRequest) (*signerv1.GetPublicKeyResponse, error) {
+	publicKey := s.signer.PublicKey(ctx)
+	return &signerv1.GetPublicKeyResponse{PublicKey: ethcrypto.FromECDSAPub(&publicKey)}, nil
+}
@@ -44,6 +44,7 @@ func adminServiceRunnable(
 	rpcMap map[string]string,
 	reobservers interfaces.Reobservers,
 	managerSvc *manager.ManagerService,
+	watcherFile *WatcherFile,
 ) (supervisor.Runnable, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
@@ -88,6 +89,12 @@ func adminServiceRunnable(
 		}
 	}
 
+	// A nil *WatcherFile must not become a non-nil interface.
+	var watcherReloader adminrpc.WatcherReloader
+	if watcherFile != nil {
+		watcherReloader = watcherFile
+	}
+
 	nodeService := adminrpc.NewPrivService(
 		db,
 		injectC,
@@ -102,6 +109,7 @@ func adminServiceRunnable(
 		ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(ctx)),
 		rpcMap,
 		reobservers,
+		watcherReloader,
 	)
 
 	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov, managerSvc)
@@ -17,6 +17,7 @@ import (
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/tracing"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
@@ -116,6 +117,10 @@ type G struct {
 	alternatePublisher *altpub.AlternatePublisher
 	managerService     *manager.ManagerService
 	managerSigners     map[vaa.ChainID][]guardiansigner.GuardianSigner
+	// messageTracer traces messages from the watchers to quorum. It does nothing unless tracing is enabled.
+	messageTracer *tracing.MessageTracer
+	// watcherFile runs the watchers of the guardian config file. It is nil unless they are enabled.
+	watcherFile *WatcherFile
 
 	// runnables
 	runnablesWithScissors map[string]supervisor.Runnable
@@ -207,6 +212,9 @@ func (g *G) initializeBasic(rootCtxCancel context.CancelFunc) {
 	// Delegated guardian config
 	g.dgc = processor.NewDelegatedGuardianConfig()
 
+	// Message tracing is enabled by GuardianOptionTracing, which may be applied after the components that trace.
+	g.messageTracer = tracing.NewDisabledMessageTracer()
+
 	// allocate maps
 	g.runnablesWithScissors = make(map[string]supervisor.Runnable)
 	g.runnables = make(map[string]supervisor.Runnable)
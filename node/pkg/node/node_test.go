@@ -186,10 +186,10 @@ func mockGuardianRunnable(t testing.TB, gs []*mockGuardian, mockGuardianIndex ui
 		// assemble all the options
 		guardianOptions := []*GuardianOption{
 			GuardianOptionDatabase(db),
-			GuardianOptionWatchers(watcherConfigs, nil),
+			GuardianOptionWatchers(watcherConfigs, nil, nil),
 			GuardianOptionNoAccountant(), // disable accountant
-			GuardianOptionGovernor(true, false, ""),
-			GuardianOptionNotary(true),
+			GuardianOptionGovernor(true, false, "", ""),
+			GuardianOptionNotary(true, "", "", 0),
 			GuardianOptionGatewayRelayer("", nil),        // disable gateway relayer
 			GuardianOptionQueryHandler(false, ""),        // disable queries
 			GuardianOptionManagerService(false, nil, ""), // disable manager service
@@ -890,7 +890,7 @@ func TestWatcherConfigs(t *testing.T) {
 						NetworkID: "mock2",
 						ChainID:   vaa.ChainIDEthereum,
 					},
-				}, nil),
+				}, nil, nil),
 			},
 			err: "",
 		},
@@ -906,7 +906,7 @@ func TestWatcherConfigs(t *testing.T) {
 						NetworkID: "mock",
 						ChainID:   vaa.ChainIDSolana,
 					},
-				}, nil),
+				}, nil, nil),
 			},
 			err: "NetworkID already configured: mock",
 		},
@@ -22,10 +22,13 @@ import (
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/tracing"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm"
 	"github.com/certusone/wormhole/node/pkg/watchers/ibc"
+	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/certusone/wormhole/node/pkg/wormconn"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/gorilla/mux"
 	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -250,9 +253,10 @@ func GuardianOptionAccountant(
 		}}
 }
 
-// GuardianOptionGovernor enables or disables the governor.
+// GuardianOptionGovernor enables or disables the governor. If priceOracleFile is set, the governor aggregates the
+// token prices of the sources listed in that file instead of only querying CoinGecko.
 // Dependencies: db
-func GuardianOptionGovernor(governorEnabled bool, flowCancelEnabled bool, coinGeckoApiKey string) *GuardianOption {
+func GuardianOptionGovernor(governorEnabled bool, flowCancelEnabled bool, coinGeckoApiKey string, priceOracleFile string) *GuardianOption {
 	return &GuardianOption{
 		name:         "governor",
 		dependencies: []string{"db"},
@@ -268,6 +272,14 @@ func GuardianOptionGovernor(governorEnabled bool, flowCancelEnabled bool, coinGe
 					logger.Info("coingecko pro API key in use")
 				}
 				g.gov = governor.NewChainGovernor(logger, g.db, g.env, flowCancelEnabled, coinGeckoApiKey)
+				if priceOracleFile != "" {
+					cfg, err := governor.LoadPriceOracleConfig(priceOracleFile)
+					if err != nil {
+						return fmt.Errorf("failed to load governor price oracle: %w", err)
+					}
+					g.gov.SetPriceOracleConfig(cfg)
+					logger.Info("governor price oracle loaded", zap.String("priceOracleFile", priceOracleFile), zap.Int("sources", len(cfg.Sources)))
+				}
 			} else {
 				logger.Info("chain governor is disabled")
 			}
@@ -275,15 +287,36 @@ func GuardianOptionGovernor(governorEnabled bool, flowCancelEnabled bool, coinGe
 		}}
 }
 
-// GuardianOptionNotary enables or disables the Notary.
+// GuardianOptionNotary enables or disables the Notary. If notaryPolicyFile is set, the Notary also evaluates the
+// rules of that policy file. notaryOperators is a comma separated list of the operator key addresses allowed to
+// annotate and approve delayed messages. If notaryRequiredApprovals is not zero, delayed messages can only be released
+// early once that many operators approved them.
 // Dependencies: db
-func GuardianOptionNotary(notaryEnabled bool) *GuardianOption {
+func GuardianOptionNotary(notaryEnabled bool, notaryPolicyFile string, notaryOperators string, notaryRequiredApprovals uint) *GuardianOption {
 	return &GuardianOption{
 		name:         "notary",
 		dependencies: []string{"db"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			if notaryEnabled {
+				operators, err := notary.ParseOperators(notaryOperators)
+				if err != nil {
+					return fmt.Errorf("failed to parse notary operators: %w", err)
+				}
+				if notaryRequiredApprovals > uint(len(operators)) {
+					return fmt.Errorf("notary requires %d approvals but only %d operators are configured", notaryRequiredApprovals, len(operators))
+				}
+
 				g.notary = notary.NewNotary(ctx, logger, g.db, g.env)
+				g.notary.SetOperators(operators)
+				g.notary.SetRequiredApprovals(notaryRequiredApprovals)
+				if notaryPolicyFile != "" {
+					policy, err := notary.NewPolicy(notaryPolicyFile)
+					if err != nil {
+						return fmt.Errorf("failed to load notary policy: %w", err)
+					}
+					g.notary.SetPolicy(policy)
+					logger.Info("notary policy loaded", zap.String("notaryPolicyFile", notaryPolicyFile), zap.Int("rules", policy.Len()))
+				}
 			} else {
 				logger.Info("notary is disabled")
 			}
@@ -393,6 +426,39 @@ func GuardianOptionStatusServer(statusAddr string) *GuardianOption {
 		}}
 }
 
+// GuardianOptionTracing enables the OpenTelemetry tracing of messages, from the watchers to quorum, and exports the
+// traces to the OTLP/HTTP collector at otlpEndpoint. Only sampleRatio of the messages are traced.
+// Dependencies: none
+func GuardianOptionTracing(otlpEndpoint string, insecure bool, sampleRatio float64, nodeName string) *GuardianOption {
+	return &GuardianOption{
+		name: "tracing",
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if otlpEndpoint == "" {
+				return nil
+			}
+
+			guardianAddr := ethcrypto.PubkeyToAddress(g.guardianSigner.PublicKey(ctx)).Hex()
+			tp, err := tracing.NewOtlpTracerProvider(otlpEndpoint, insecure, sampleRatio, nodeName, guardianAddr)
+			if err != nil {
+				return err
+			}
+			g.messageTracer.SetTracerProvider(tp)
+
+			g.runnables["tracing"] = func(ctx context.Context) error {
+				logger := supervisor.Logger(ctx)
+				logger.Info("exporting message traces", zap.String("otlp_endpoint", otlpEndpoint), zap.Float64("sample_ratio", sampleRatio))
+
+				<-ctx.Done()
+				//nolint:contextcheck // We use context.Background() instead of ctx here because ctx is already canceled at this point and Shutdown would not flush the traces then.
+				if err := tp.Shutdown(context.Background()); err != nil {
+					logger.Error("error while shutting down the trace exporter", zap.Error(err))
+				}
+				return nil
+			}
+			return nil
+		}}
+}
+
 type IbcWatcherConfig struct {
 	Websocket      string
 	Lcd            string
@@ -401,9 +467,10 @@ type IbcWatcherConfig struct {
 }
 
 // GuardianOptionWatchers configures all normal watchers and all IBC watchers. They need to be all configured at the same time because they may depend on each other.
+// The watchers of watcherFile, if not nil, run alongside the others and can be reloaded without restarting the guardian.
 // TODO: currently, IBC watchers are partially statically configured in ibc.ChainConfig. It might make sense to refactor this to instead provide this as a parameter here.
 // Dependencies: none
-func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherConfig *IbcWatcherConfig) *GuardianOption {
+func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherConfig *IbcWatcherConfig, watcherFile *WatcherFile) *GuardianOption {
 	return &GuardianOption{
 		name: "watchers",
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
@@ -460,6 +527,7 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 									zap.String("txID", msg.TxIDString()),
 									zap.Time("timestamp", msg.Timestamp))
 							} else {
+								g.messageTracer.Emitted(msg)
 								g.msgC.writeC <- msg // Note on channel capacity: The channel to the processor is buffered and shared across chains, if it backs up we should stop processing new observations
 							}
 						}
@@ -493,13 +561,14 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 			}
 
 			configuredWatchers := make(map[watchers.NetworkID]struct{})
+			configuredChains := []vaa.ChainID{}
 
 			for _, wc := range watcherConfigs {
 				if _, ok := configuredWatchers[wc.GetNetworkID()]; ok {
 					return fmt.Errorf("NetworkID already configured: %s", string(wc.GetNetworkID()))
 				}
 
-				watcherName := string(wc.GetNetworkID()) + "_watch"
+				watcherName := watcherRunnableName(wc.GetNetworkID())
 				logger.Debug("Setting up watcher: " + watcherName)
 
 				if wc.GetNetworkID() != "solana-confirmed" && wc.GetNetworkID() != "fogo-confirmed" { // TODO this should not be a special case, see comment in common/readiness.go
@@ -521,12 +590,53 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 
 				g.runnablesWithScissors[watcherName] = runnable
 				configuredWatchers[wc.GetNetworkID()] = struct{}{}
+				configuredChains = append(configuredChains, wc.GetChainID())
 
 				if reobserver != nil {
 					g.reobservers[wc.GetChainID()] = reobserver
 				}
 			}
 
+			if watcherFile != nil {
+				// The channels of the chains of the file must exist before the reobservation requests are handled, since
+				// they can't be added later. A reload can't add a watcher of another chain.
+				for _, wc := range watcherFile.Configs() {
+					if _, ok := configuredWatchers[wc.GetNetworkID()]; ok {
+						return fmt.Errorf("NetworkID already configured: %s", string(wc.GetNetworkID()))
+					}
+
+					chainID := wc.GetChainID()
+					if _, exists := chainObsvReqC[chainID]; !exists && wc.GetNetworkID() != "solana-confirmed" && wc.GetNetworkID() != "fogo-confirmed" {
+						common.MustRegisterReadinessSyncing(chainID)
+						chainObsvReqC[chainID] = make(chan *gossipv1.ObservationRequest, observationRequestPerChainBufferSize)
+						g.chainQueryReqC[chainID] = make(chan *query.PerChainQueryInternal, query.QueryRequestBufferSize)
+					}
+
+					if _, exists := g.reobservers[chainID]; !exists {
+						g.reobservers[chainID] = watcherFile.reobserver(chainID)
+					}
+				}
+
+				create := func(wc watchers.WatcherConfig) (supervisor.Runnable, interfaces.Reobserver, error) {
+					chainID := wc.GetChainID()
+					if evmWc, ok := wc.(*evm.WatcherConfig); ok {
+						if g.env == common.MainNet && !evm.SupportedInMainnet(chainID) {
+							return nil, nil, fmt.Errorf("chain %s is not supported in mainnet", chainID)
+						}
+						evmWc.DgConfigC = g.dgConfigC.writeC
+					}
+
+					return wc.Create(chainMsgC[chainID], chainObsvReqC[chainID], g.chainQueryReqC[chainID], chainQueryResponseC[chainID], g.setC.writeC, g.env)
+				}
+
+				runnable, err := watcherFile.setup(logger, configuredWatchers, configuredChains, create)
+				if err != nil {
+					return fmt.Errorf("error creating watchers of the config file: %w", err)
+				}
+				g.runnablesWithScissors["watcherfile"] = runnable
+				g.watcherFile = watcherFile
+			}
+
 			if ibcWatcherConfig != nil {
 
 				var chainConfig ibc.ChainConfig
@@ -568,12 +678,12 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 }
 
 // GuardianOptionAdminService enables the admin rpc service on a unix socket.
-// Dependencies: db
+// Dependencies: db, watchers
 // Note: governor and notary are optional - they may be nil if not enabled
 func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *string, rpcMap map[string]string) *GuardianOption {
 	return &GuardianOption{
 		name:         "admin-service",
-		dependencies: []string{"db"},
+		dependencies: []string{"db", "watchers"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			//nolint:contextcheck // Independent service that should not be affected by other services
 			adminService, err := adminServiceRunnable(
@@ -593,6 +703,7 @@ func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *
 				rpcMap,
 				g.reobservers,
 				g.managerService,
+				g.watcherFile,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to create admin service: %w", err)
@@ -720,6 +831,7 @@ func GuardianOptionProcessor(networkId string, delegatedGuardiansEnabled bool) *
 				g.alternatePublisher,
 				delegatedGuardiansEnabled,
 				managerC,
+				g.messageTracer,
 			).Run
 
 			return nil
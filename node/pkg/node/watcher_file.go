@@ -0,0 +1,464 @@
+package node
+
+// Watchers can also be configured in the guardian config file, as a list under the `watchers` key. Unlike the
+// watchers configured by flags, they can be reloaded while the guardian runs, with a SIGHUP or the ReloadWatchers
+// admin RPC. Each of them runs in its own supervised runnable, so a reload only restarts the watchers whose
+// configuration changed, and the processor keeps its state.
+//
+// An entry has the type of the watcher and the fields of its WatcherConfig, for example:
+//
+//	watchers:
+//	  - type: evm
+//	    networkId: base
+//	    chainId: base
+//	    rpc: ws://base-node:8546
+//	    contract: "0xbebdb6C8ddC678FfA9f8748f85C815C556Dd8ac6"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/certusone/wormhole/node/pkg/watchers/algorand"
+	"github.com/certusone/wormhole/node/pkg/watchers/aptos"
+	"github.com/certusone/wormhole/node/pkg/watchers/cosmwasm"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm"
+	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
+	"github.com/certusone/wormhole/node/pkg/watchers/near"
+	"github.com/certusone/wormhole/node/pkg/watchers/solana"
+	"github.com/certusone/wormhole/node/pkg/watchers/sui"
+	"github.com/certusone/wormhole/node/pkg/watchers/xrpl"
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// watcherFileKey is the key of the list of watchers in the config file.
+const watcherFileKey = "watchers"
+
+// errWatcherReloaded is returned by the runnable of a watcher whose configuration changed, so that the supervisor
+// restarts it with the new configuration.
+var errWatcherReloaded = errors.New("watcher configuration reloaded")
+
+// watcherCreator creates a watcher with the channels of its chain.
+type watcherCreator func(wc watchers.WatcherConfig) (supervisor.Runnable, interfaces.Reobserver, error)
+
+// WatcherFile runs the watchers of the guardian config file, and applies the changes of the file when it is reloaded.
+type WatcherFile struct {
+	path string
+
+	// initial are the watchers of the file when the guardian started.
+	initial []watcherFileEntry
+
+	mu     sync.Mutex
+	logger *zap.Logger
+	// create is set by GuardianOptionWatchers.
+	create watcherCreator
+	// static are the network IDs of the watchers configured by flags, which can't be configured in the file.
+	static map[watchers.NetworkID]struct{}
+	// chains are the chains of the watchers configured at startup. Their channels and readiness can't be created
+	// later, so a reload can't add a watcher of another chain.
+	chains map[vaa.ChainID]struct{}
+	// slots are the watchers of the file by network ID. The slot of a watcher removed from the file stays idle, so
+	// that it can be added back.
+	slots map[watchers.NetworkID]*watcherSlot
+	// ctx is the context of the runnable supervising the watchers. It is nil while that runnable is not running.
+	ctx context.Context
+}
+
+// watcherFileEntry is a watcher of the config file, along with its raw fields to detect changes.
+type watcherFileEntry struct {
+	config watchers.WatcherConfig
+	fields map[string]interface{}
+}
+
+// watcherSlot is the supervised runnable of a watcher of the config file.
+type watcherSlot struct {
+	chainID vaa.ChainID
+	fields  map[string]interface{}
+	// runnable is the current watcher, or nil if it was removed from the file.
+	runnable   supervisor.Runnable
+	reobserver interfaces.Reobserver
+	// changed is closed when the watcher is replaced.
+	changed chan struct{}
+}
+
+// LoadWatcherFile reads the watchers of a config file. The file doesn't need to have any, so that they can be added
+// by a later reload.
+func LoadWatcherFile(path string) (*WatcherFile, error) {
+	entries, err := readWatcherFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WatcherFile{
+		path:    path,
+		initial: entries,
+		slots:   make(map[watchers.NetworkID]*watcherSlot),
+	}, nil
+}
+
+// Configs returns the watchers of the file when the guardian started.
+func (f *WatcherFile) Configs() []watchers.WatcherConfig {
+	configs := make([]watchers.WatcherConfig, 0, len(f.initial))
+	for _, entry := range f.initial {
+		configs = append(configs, entry.config)
+	}
+	return configs
+}
+
+func readWatcherFile(path string) ([]watcherFileEntry, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	return decodeWatcherFile(v.Get(watcherFileKey))
+}
+
+// decodeWatcherFile decodes the list of watchers of the config file.
+func decodeWatcherFile(raw interface{}) ([]watcherFileEntry, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	list, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", watcherFileKey)
+	}
+
+	entries := make([]watcherFileEntry, 0, len(list))
+	seen := make(map[watchers.NetworkID]struct{})
+	for i, item := range list {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s[%d] must be a map", watcherFileKey, i)
+		}
+
+		wc, err := decodeWatcherConfig(fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s[%d]: %w", watcherFileKey, i, err)
+		}
+
+		if _, exists := seen[wc.GetNetworkID()]; exists {
+			return nil, fmt.Errorf("NetworkID already configured: %s", wc.GetNetworkID())
+		}
+		seen[wc.GetNetworkID()] = struct{}{}
+
+		entries = append(entries, watcherFileEntry{config: wc, fields: fields})
+	}
+
+	return entries, nil
+}
+
+// decodeWatcherConfig decodes a watcher of the config file into the WatcherConfig of its type. Unknown fields are
+// rejected, so that a typo doesn't silently leave a setting unset.
+func decodeWatcherConfig(fields map[string]interface{}) (watchers.WatcherConfig, error) {
+	var watcherType string
+	rest := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		if strings.EqualFold(key, "type") {
+			watcherType, _ = value.(string)
+			continue
+		}
+		rest[key] = value
+	}
+
+	var wc watchers.WatcherConfig
+	switch watcherType {
+	case "algorand":
+		wc = &algorand.WatcherConfig{}
+	case "aptos":
+		wc = &aptos.WatcherConfig{}
+	case "cosmwasm":
+		wc = &cosmwasm.WatcherConfig{}
+	case "evm":
+		wc = &evm.WatcherConfig{}
+	case "near":
+		wc = &near.WatcherConfig{}
+	case "solana":
+		wc = &solana.WatcherConfig{}
+	case "sui":
+		wc = &sui.WatcherConfig{}
+	case "xrpl":
+		wc = &xrpl.WatcherConfig{}
+	default:
+		return nil, fmt.Errorf("unknown watcher type %q", watcherType)
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:  decodeChainIDHook,
+		ErrorUnused: true,
+		Result:      wc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(rest); err != nil {
+		return nil, err
+	}
+
+	if wc.GetNetworkID() == "" {
+		return nil, errors.New("missing networkId")
+	}
+	if _, err := vaa.KnownChainIDFromNumber(uint16(wc.GetChainID())); err != nil {
+		return nil, fmt.Errorf("invalid chainId of %s: %w", wc.GetNetworkID(), err)
+	}
+
+	// The guardian set must keep coming from the same chain, which is configured by flags.
+	if evmWc, ok := wc.(*evm.WatcherConfig); ok && evmWc.GuardianSetUpdateChain {
+		return nil, fmt.Errorf("%s: guardianSetUpdateChain can't be set in the config file", wc.GetNetworkID())
+	}
+
+	return wc, nil
+}
+
+// decodeChainIDHook decodes chain IDs from their names, such as "ethereum", as well as from their numbers.
+func decodeChainIDHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if to != reflect.TypeOf(vaa.ChainID(0)) || from.Kind() != reflect.String {
+		return data, nil
+	}
+	return vaa.ChainIDFromString(data.(string))
+}
+
+// setup creates the initial watchers of the file, and returns the runnable supervising them. static and chains are
+// the network IDs and the chains of the watchers configured by flags.
+func (f *WatcherFile) setup(logger *zap.Logger, static map[watchers.NetworkID]struct{}, chains []vaa.ChainID, create watcherCreator) (supervisor.Runnable, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.logger = logger
+	f.static = static
+	f.create = create
+	f.chains = make(map[vaa.ChainID]struct{}, len(chains)+len(f.initial))
+	for _, chainID := range chains {
+		f.chains[chainID] = struct{}{}
+	}
+	for _, entry := range f.initial {
+		f.chains[entry.config.GetChainID()] = struct{}{}
+	}
+	if _, err := f.apply(f.initial); err != nil {
+		return nil, err
+	}
+
+	return f.run, nil
+}
+
+// Reload reads the config file again, and starts, stops or restarts the watchers that changed. Nothing changes if
+// any watcher of the file is invalid.
+func (f *WatcherFile) Reload() (*watchers.ReloadResult, error) {
+	entries, err := readWatcherFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.create == nil {
+		return nil, errors.New("the watchers are not set up yet")
+	}
+
+	return f.apply(entries)
+}
+
+// apply replaces the watchers of the file with the given ones. The caller must hold the lock.
+func (f *WatcherFile) apply(entries []watcherFileEntry) (*watchers.ReloadResult, error) {
+	type change struct {
+		id         watchers.NetworkID
+		entry      watcherFileEntry
+		runnable   supervisor.Runnable
+		reobserver interfaces.Reobserver
+	}
+
+	// Create every new watcher before changing anything, so that an invalid file has no effect.
+	var changes []change
+	wanted := make(map[watchers.NetworkID]struct{}, len(entries))
+	for _, entry := range entries {
+		id := entry.config.GetNetworkID()
+		if _, exists := f.static[id]; exists {
+			return nil, fmt.Errorf("NetworkID already configured by flags: %s", id)
+		}
+		wanted[id] = struct{}{}
+
+		slot, exists := f.slots[id]
+		if exists && slot.runnable != nil && reflect.DeepEqual(slot.fields, entry.fields) {
+			continue
+		}
+		if exists && slot.chainID != entry.config.GetChainID() {
+			return nil, fmt.Errorf("the chain of watcher %s can't be changed without a restart", id)
+		}
+		if _, exists := f.chains[entry.config.GetChainID()]; !exists {
+			return nil, fmt.Errorf("watcher %s of chain %s can't be added without a restart, since no watcher of that chain was configured at startup", id, entry.config.GetChainID())
+		}
+
+		runnable, reobserver, err := f.create(entry.config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating watcher %s: %w", id, err)
+		}
+		changes = append(changes, change{id, entry, runnable, reobserver})
+	}
+
+	result := &watchers.ReloadResult{}
+	for _, c := range changes {
+		slot, exists := f.slots[c.id]
+		if !exists {
+			slot = &watcherSlot{
+				chainID: c.entry.config.GetChainID(),
+				changed: make(chan struct{}),
+			}
+			f.slots[c.id] = slot
+
+			if f.ctx != nil {
+				if err := supervisor.Run(f.ctx, watcherRunnableName(c.id), f.runSlot(c.id, slot)); err != nil {
+					return nil, fmt.Errorf("failed to start watcher %s: %w", c.id, err)
+				}
+			}
+		}
+
+		if slot.runnable == nil {
+			result.Started = append(result.Started, c.id)
+		} else {
+			result.Updated = append(result.Updated, c.id)
+		}
+		slot.replace(c.entry.fields, c.runnable, c.reobserver)
+	}
+
+	for id, slot := range f.slots {
+		if _, exists := wanted[id]; !exists && slot.runnable != nil {
+			result.Stopped = append(result.Stopped, id)
+			slot.replace(nil, nil, nil)
+		}
+	}
+
+	slices.Sort(result.Started)
+	slices.Sort(result.Stopped)
+	slices.Sort(result.Updated)
+	for _, id := range result.Started {
+		f.logger.Info("starting watcher from the config file", zap.String("networkID", string(id)))
+	}
+	for _, id := range result.Stopped {
+		f.logger.Info("stopping watcher removed from the config file", zap.String("networkID", string(id)))
+	}
+	for _, id := range result.Updated {
+		f.logger.Info("restarting watcher with its new configuration", zap.String("networkID", string(id)))
+	}
+
+	return result, nil
+}
+
+// replace sets the watcher of the slot, and notifies its runnable. The caller must hold the lock of the file.
+func (s *watcherSlot) replace(fields map[string]interface{}, runnable supervisor.Runnable, reobserver interfaces.Reobserver) {
+	s.fields = fields
+	s.runnable = runnable
+	s.reobserver = reobserver
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// run supervises the watchers of the file.
+func (f *WatcherFile) run(ctx context.Context) error {
+	f.mu.Lock()
+	for id, slot := range f.slots {
+		if err := supervisor.Run(ctx, watcherRunnableName(id), f.runSlot(id, slot)); err != nil {
+			f.mu.Unlock()
+			return err
+		}
+	}
+	f.ctx = ctx
+	f.mu.Unlock()
+
+	// The supervisor only lets a runnable start children until it signals healthy. This one never does, so that it
+	// can start the watchers added to the file by a reload.
+	<-ctx.Done()
+
+	f.mu.Lock()
+	if f.ctx == ctx {
+		f.ctx = nil
+	}
+	f.mu.Unlock()
+
+	return ctx.Err()
+}
+
+// runSlot returns the runnable of a watcher of the file. It runs the current watcher of the slot until the slot
+// changes, and then returns an error so that the supervisor restarts it with the new watcher.
+func (f *WatcherFile) runSlot(id watchers.NetworkID, slot *watcherSlot) supervisor.Runnable {
+	name := watcherRunnableName(id)
+	return func(ctx context.Context) error {
+		f.mu.Lock()
+		runnable, changed := slot.runnable, slot.changed
+		f.mu.Unlock()
+
+		if runnable == nil {
+			// The watcher was removed from the file. Wait for it to be added back.
+			supervisor.Signal(ctx, supervisor.SignalHealthy)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-changed:
+				return errWatcherReloaded
+			}
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			select {
+			case <-changed:
+				cancel()
+			case <-runCtx.Done():
+			}
+		}()
+
+		err := common.WrapWithScissors(runnable, name)(runCtx)
+		select {
+		case <-changed:
+			return errWatcherReloaded
+		default:
+			return err
+		}
+	}
+}
+
+// reobserver returns the reobserver of a chain, which forwards the requests to the current watcher of the file.
+func (f *WatcherFile) reobserver(chainID vaa.ChainID) interfaces.Reobserver {
+	return &watcherFileReobserver{f: f, chainID: chainID}
+}
+
+// watcherFileReobserver forwards reobservation requests to the watcher of the file that supports them for a chain.
+type watcherFileReobserver struct {
+	f       *WatcherFile
+	chainID vaa.ChainID
+}
+
+func (r *watcherFileReobserver) Reobserve(ctx context.Context, chainID vaa.ChainID, txID []byte, customEndpoint string) (uint32, error) {
+	var reobserver interfaces.Reobserver
+	r.f.mu.Lock()
+	for _, slot := range r.f.slots {
+		if slot.chainID == r.chainID && slot.reobserver != nil {
+			reobserver = slot.reobserver
+			break
+		}
+	}
+	r.f.mu.Unlock()
+
+	if reobserver == nil {
+		return 0, fmt.Errorf("no watcher of the config file supports reobservation for chain %s", r.chainID)
+	}
+	return reobserver.Reobserve(ctx, chainID, txID, customEndpoint)
+}
+
+// watcherRunnableName returns the name of the supervised runnable of a watcher.
+func watcherRunnableName(id watchers.NetworkID) string {
+	return string(id) + "_watch"
+}
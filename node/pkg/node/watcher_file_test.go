@@ -0,0 +1,327 @@
+package node
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/certusone/wormhole/node/pkg/watchers/evm"
+	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
+	"github.com/certusone/wormhole/node/pkg/watchers/solana"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func writeWatcherFile(t *testing.T, path string, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+// startedWatcher is a watcher started by testWatcherCreator.
+type startedWatcher struct {
+	id  watchers.NetworkID
+	rpc string
+	ctx context.Context
+}
+
+// testWatcherCreator creates watchers that report on startedC when they start.
+func testWatcherCreator(startedC chan<- startedWatcher) watcherCreator {
+	return func(wc watchers.WatcherConfig) (supervisor.Runnable, interfaces.Reobserver, error) {
+		rpc := wc.(*evm.WatcherConfig).Rpc
+		return func(ctx context.Context) error {
+			supervisor.Signal(ctx, supervisor.SignalHealthy)
+			startedC <- startedWatcher{wc.GetNetworkID(), rpc, ctx}
+			<-ctx.Done()
+			return ctx.Err()
+		}, nil, nil
+	}
+}
+
+func TestDecodeWatcherConfig(t *testing.T) {
+	wc, err := decodeWatcherConfig(map[string]interface{}{
+		"type":      "evm",
+		"networkId": "base",
+		"chainId":   "base",
+		"rpc":       "ws://base-node:8546",
+		"contract":  "0xbebdb6C8ddC678FfA9f8748f85C815C556Dd8ac6",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &evm.WatcherConfig{
+		NetworkID: "base",
+		ChainID:   vaa.ChainIDBase,
+		Rpc:       "ws://base-node:8546",
+		Contract:  "0xbebdb6C8ddC678FfA9f8748f85C815C556Dd8ac6",
+	}, wc)
+
+	wc, err = decodeWatcherConfig(map[string]interface{}{
+		"type":       "solana",
+		"networkId":  "solana-finalized",
+		"chainId":    1,
+		"rpc":        "http://solana-node:8899",
+		"commitment": "finalized",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, vaa.ChainIDSolana, wc.GetChainID())
+	assert.IsType(t, &solana.WatcherConfig{}, wc)
+}
+
+func TestDecodeWatcherConfigErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "unknown type",
+			fields: map[string]interface{}{"type": "bitcoin", "networkId": "btc", "chainId": 2},
+			err:    `unknown watcher type "bitcoin"`,
+		},
+		{
+			name:   "unknown field",
+			fields: map[string]interface{}{"type": "evm", "networkId": "eth", "chainId": 2, "rcp": "ws://eth"},
+			err:    "rcp",
+		},
+		{
+			name:   "unknown chain name",
+			fields: map[string]interface{}{"type": "evm", "networkId": "eth", "chainId": "ethereum2"},
+			err:    "ethereum2",
+		},
+		{
+			name:   "unknown chain number",
+			fields: map[string]interface{}{"type": "evm", "networkId": "eth", "chainId": 60000},
+			err:    "invalid chainId of eth",
+		},
+		{
+			name:   "missing network ID",
+			fields: map[string]interface{}{"type": "evm", "chainId": 2},
+			err:    "missing networkId",
+		},
+		{
+			name:   "guardian set update chain",
+			fields: map[string]interface{}{"type": "evm", "networkId": "eth", "chainId": 2, "guardianSetUpdateChain": true},
+			err:    "guardianSetUpdateChain can't be set in the config file",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decodeWatcherConfig(tc.fields)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestLoadWatcherFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "guardiand.yaml")
+
+	writeWatcherFile(t, path, "logLevel: info\n")
+	f, err := LoadWatcherFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, f.Configs())
+
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+  - type: evm
+    networkId: base
+    chainId: base
+`)
+	_, err = LoadWatcherFile(path)
+	require.ErrorContains(t, err, "NetworkID already configured: base")
+}
+
+func TestWatcherFileApply(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "guardiand.yaml")
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+    rpc: ws://base-1
+  - type: evm
+    networkId: arbitrum
+    chainId: arbitrum
+    rpc: ws://arbitrum
+`)
+	f, err := LoadWatcherFile(path)
+	require.NoError(t, err)
+
+	created := []watchers.NetworkID{}
+	create := func(wc watchers.WatcherConfig) (supervisor.Runnable, interfaces.Reobserver, error) {
+		created = append(created, wc.GetNetworkID())
+		return func(ctx context.Context) error { return nil }, nil, nil
+	}
+	// The flags configure watchers of ethereum and optimism, so that the file can add a watcher of optimism.
+	static := map[watchers.NetworkID]struct{}{"ethereum": {}, "optimism-flags": {}}
+	_, err = f.setup(zap.NewNop(), static, []vaa.ChainID{vaa.ChainIDEthereum, vaa.ChainIDOptimism}, create)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []watchers.NetworkID{"base", "arbitrum"}, created)
+
+	// Only the changed watchers are created again.
+	created = created[:0]
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+    rpc: ws://base-2
+  - type: evm
+    networkId: optimism
+    chainId: optimism
+`)
+	result, err := f.Reload()
+	require.NoError(t, err)
+	assert.Equal(t, &watchers.ReloadResult{
+		Started: []watchers.NetworkID{"optimism"},
+		Stopped: []watchers.NetworkID{"arbitrum"},
+		Updated: []watchers.NetworkID{"base"},
+	}, result)
+	assert.ElementsMatch(t, []watchers.NetworkID{"base", "optimism"}, created)
+
+	// A watcher added back is started again.
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+    rpc: ws://base-2
+  - type: evm
+    networkId: optimism
+    chainId: optimism
+  - type: evm
+    networkId: arbitrum
+    chainId: arbitrum
+`)
+	result, err = f.Reload()
+	require.NoError(t, err)
+	assert.Equal(t, &watchers.ReloadResult{Started: []watchers.NetworkID{"arbitrum"}}, result)
+
+	// Watchers configured by flags can't be configured in the file.
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: ethereum
+    chainId: ethereum
+`)
+	_, err = f.Reload()
+	require.ErrorContains(t, err, "NetworkID already configured by flags: ethereum")
+
+	// The chain of a watcher can't change.
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: optimism
+`)
+	_, err = f.Reload()
+	require.ErrorContains(t, err, "the chain of watcher base can't be changed without a restart")
+
+	// A watcher of a chain that was not configured at startup can't be added.
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+    rpc: ws://base-2
+  - type: evm
+    networkId: polygon
+    chainId: polygon
+`)
+	_, err = f.Reload()
+	require.ErrorContains(t, err, "watcher polygon of chain polygon can't be added without a restart")
+
+	// A failed reload changes nothing.
+	assert.Len(t, f.slots, 3)
+	for _, slot := range f.slots {
+		assert.NotNil(t, slot.runnable)
+	}
+}
+
+func TestWatcherFileRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "guardiand.yaml")
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+    rpc: ws://base-1
+`)
+	f, err := LoadWatcherFile(path)
+	require.NoError(t, err)
+
+	startedC := make(chan startedWatcher, 10)
+	runnable, err := f.setup(zap.NewNop(), nil, []vaa.ChainID{vaa.ChainIDOptimism}, testWatcherCreator(startedC))
+	require.NoError(t, err)
+
+	supervisor.New(ctx, zap.NewNop(), func(ctx context.Context) error {
+		if err := supervisor.Run(ctx, "watcherfile", runnable); err != nil {
+			return err
+		}
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+		<-ctx.Done()
+		return nil
+	}, supervisor.WithPropagatePanic)
+
+	waitStarted := func() startedWatcher {
+		select {
+		case started := <-startedC:
+			return started
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "timed out waiting for a watcher to start")
+			return startedWatcher{}
+		}
+	}
+
+	first := waitStarted()
+	assert.Equal(t, watchers.NetworkID("base"), first.id)
+	assert.Equal(t, "ws://base-1", first.rpc)
+
+	// Changing the RPC restarts the watcher, and adding one starts it alongside.
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+    rpc: ws://base-2
+  - type: evm
+    networkId: optimism
+    chainId: optimism
+    rpc: ws://optimism
+`)
+	_, err = f.Reload()
+	require.NoError(t, err)
+
+	started := map[watchers.NetworkID]startedWatcher{}
+	for len(started) < 2 {
+		w := waitStarted()
+		started[w.id] = w
+	}
+	assert.Equal(t, "ws://base-2", started["base"].rpc)
+	assert.Equal(t, "ws://optimism", started["optimism"].rpc)
+	<-first.ctx.Done()
+
+	// Removing a watcher stops it.
+	writeWatcherFile(t, path, `
+watchers:
+  - type: evm
+    networkId: base
+    chainId: base
+    rpc: ws://base-2
+`)
+	result, err := f.Reload()
+	require.NoError(t, err)
+	assert.Equal(t, []watchers.NetworkID{"optimism"}, result.Stopped)
+	<-started["optimism"].ctx.Done()
+	assert.NoError(t, started["base"].ctx.Err())
+}
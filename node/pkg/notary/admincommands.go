@@ -18,17 +18,30 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
 )
 
 var (
-	ErrDelayExceedsMax = errors.New("notary: delay exceeds maximum")
-	ErrInvalidMsgID    = errors.New("notary: the message ID must be specified as \"chainId/emitterAddress/seqNum\"")
-	ErrNotDevMode      = errors.New("notary: inject commands only available in dev mode")
+	ErrAlreadyApproved   = errors.New("notary: the operator already approved the message")
+	ErrApprovalsRequired = errors.New("notary: releasing a delayed message requires approvals from operators")
+	ErrDelayExceedsMax   = errors.New("notary: delay exceeds maximum")
+	ErrInvalidMsgID      = errors.New("notary: the message ID must be specified as \"chainId/emitterAddress/seqNum\"")
+	ErrMissingNote       = errors.New("notary: the note must not be empty")
+	ErrNotDevMode        = errors.New("notary: inject commands only available in dev mode")
 )
 
+// DelayedMessageReview is a delayed message together with its current review.
+type DelayedMessageReview struct {
+	PendingMsg *common.PendingMessage
+	Review     *db.NotaryReview
+	// RuleID is the ID of the policy rule that delayed the message, if any.
+	RuleID string
+}
+
 // BlackholeDelayedMsg adds a message publication to the blackholed in-memory set and stores it in the database.
 // It also removes the message from the delayed list and database.
 func (n *Notary) BlackholeDelayedMsg(msgID string) error {
@@ -56,10 +69,14 @@ func (n *Notary) BlackholeDelayedMsg(msgID string) error {
 }
 
 // ReleaseDelayedMsg removes a message from the delayed list and publishes it immediately.
+// It fails if the Notary requires approvals from operators: see [Notary.ApproveDelayedMsg].
 func (n *Notary) ReleaseDelayedMsg(msgID string) error {
 	if len(msgID) < common.MinMsgIdLen {
 		return ErrInvalidMsgID
 	}
+	if n.requiredApprovals > 0 {
+		return ErrApprovalsRequired
+	}
 	err := n.release([]byte(msgID))
 	if err != nil {
 		return err
@@ -69,7 +86,8 @@ func (n *Notary) ReleaseDelayedMsg(msgID string) error {
 }
 
 // RemoveBlackholedMsg removes a message from the blackholed list and adds it to the delayed list with a delay of zero,
-// so that it will be published on the next cycle.
+// so that it will be published on the next cycle. If the Notary requires approvals from operators, the message is
+// delayed by [DefaultDelay] instead, so that it can be reviewed.
 func (n *Notary) RemoveBlackholedMsg(msgID string) error {
 	if len(msgID) < common.MinMsgIdLen {
 		return ErrInvalidMsgID
@@ -80,7 +98,12 @@ func (n *Notary) RemoveBlackholedMsg(msgID string) error {
 		return removeErr
 	}
 
-	delayErr := n.delay(removedMsgPub, time.Duration(0))
+	delay := time.Duration(0)
+	if n.requiredApprovals > 0 {
+		delay = DefaultDelay
+	}
+
+	delayErr := n.delay(removedMsgPub, delay)
 	if delayErr != nil {
 		return delayErr
 	}
@@ -98,6 +121,11 @@ func (n *Notary) ResetReleaseTimer(msgID string, delayDays uint8) error {
 		return ErrDelayExceedsMax
 	}
 
+	// A delay of zero releases the message, which must be approved by operators if required.
+	if delayDays == 0 && n.requiredApprovals > 0 {
+		return ErrApprovalsRequired
+	}
+
 	const hoursInDay = time.Hour * 24
 	delay := time.Duration(delayDays) * hoursInDay
 
@@ -113,6 +141,111 @@ func (n *Notary) ResetReleaseTimer(msgID string, delayDays uint8) error {
 	return nil
 }
 
+// AnnotateDelayedMsg adds a note to the review of a delayed message. The note must be signed over [AnnotationDigest]
+// by one of the configured operators.
+func (n *Notary) AnnotateDelayedMsg(msgID string, note string, timestamp time.Time, signature []byte) error {
+	if len(msgID) < common.MinMsgIdLen {
+		return ErrInvalidMsgID
+	}
+	if note == "" {
+		return ErrMissingNote
+	}
+
+	operator, err := n.authenticateOperator(AnnotationDigest(msgID, note, timestamp), timestamp, signature)
+	if err != nil {
+		return err
+	}
+
+	n.reviewMutex.Lock()
+	defer n.reviewMutex.Unlock()
+
+	msgPub := n.delayed.FetchMessagePublication([]byte(msgID))
+	if msgPub == nil {
+		return ErrMsgNotFound
+	}
+
+	if _, err := n.database.AddAnnotation(msgPub.MessageID(), db.NotaryAnnotation{
+		Operator:  operator,
+		Note:      note,
+		Timestamp: time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	n.logger.Info("notary: operator annotated delayed message",
+		msgPub.ZapFields(zap.String("operator", operator), zap.String("note", note))...,
+	)
+	return nil
+}
+
+// ApproveDelayedMsg records the approval of an operator to release a delayed message. The approval must be signed over
+// [ApprovalDigest] by one of the configured operators, and each operator can approve a message once. If the Notary
+// requires approvals and the message has enough of them, it is released and published on the next cycle. Returns the
+// current review of the message and whether it was released.
+func (n *Notary) ApproveDelayedMsg(msgID string, timestamp time.Time, signature []byte) (*db.NotaryReview, bool, error) {
+	if len(msgID) < common.MinMsgIdLen {
+		return nil, false, ErrInvalidMsgID
+	}
+
+	operator, err := n.authenticateOperator(ApprovalDigest(msgID, timestamp), timestamp, signature)
+	if err != nil {
+		return nil, false, err
+	}
+
+	n.reviewMutex.Lock()
+	defer n.reviewMutex.Unlock()
+
+	msgPub := n.delayed.FetchMessagePublication([]byte(msgID))
+	if msgPub == nil {
+		return nil, false, ErrMsgNotFound
+	}
+
+	record, err := n.database.GetReview(msgPub.MessageID())
+	if err != nil {
+		return nil, false, err
+	}
+
+	if review := record.Current(); review.HasApproved(operator) {
+		return review, false, ErrAlreadyApproved
+	}
+
+	record, err = n.database.AddApproval(msgPub.MessageID(), db.NotaryApproval{
+		Operator:  operator,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	review := record.Current()
+
+	n.logger.Info("notary: operator approved delayed message",
+		msgPub.ZapFields(
+			zap.String("operator", operator),
+			zap.Int("approvals", len(review.Approvals)),
+			zap.Uint("requiredApprovals", n.requiredApprovals),
+		)...,
+	)
+
+	if n.requiredApprovals == 0 || uint(len(review.Approvals)) < n.requiredApprovals {
+		return review, false, nil
+	}
+
+	if err := n.release(msgPub.MessageID()); err != nil {
+		return review, false, err
+	}
+
+	n.logger.Info("notary: releasing delayed message approved by operators",
+		msgPub.ZapFields(zap.Strings("operators", review.Operators()))...,
+	)
+	return review, true, nil
+}
+
+// RequiredApprovals returns the number of operator approvals required to release a delayed message, or zero if a
+// single admin command can release it.
+func (n *Notary) RequiredApprovals() uint {
+	return n.requiredApprovals
+}
+
 // InjectDelayedMessage creates a synthetic delayed message for testing (dev mode only).
 func (n *Notary) InjectDelayedMessage(delayDays uint32) (string, error) {
 	if n.env != common.UnsafeDevNet && n.env != common.GoTest {
@@ -218,6 +351,36 @@ func (n *Notary) ListDelayedMessages() ([]string, error) {
 	return msgIDs, nil
 }
 
+// ListDelayedMessageReviews returns all delayed messages from the database together with their current review.
+func (n *Notary) ListDelayedMessageReviews() ([]*DelayedMessageReview, error) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	result, err := n.database.LoadAll(n.logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load delayed messages from database: %w", err)
+	}
+
+	reviews := make([]*DelayedMessageReview, 0, len(result.Delayed))
+	for _, pendingMsg := range result.Delayed {
+		record, err := n.database.GetReview(pendingMsg.Msg.MessageID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load review of %s: %w", pendingMsg.Msg.MessageIDString(), err)
+		}
+		ruleID, err := n.database.GetVerdictRule(pendingMsg.Msg.MessageID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load policy rule of %s: %w", pendingMsg.Msg.MessageIDString(), err)
+		}
+		reviews = append(reviews, &DelayedMessageReview{
+			PendingMsg: pendingMsg,
+			Review:     record.Current(),
+			RuleID:     ruleID,
+		})
+	}
+
+	return reviews, nil
+}
+
 // ListBlackholedMessages returns all blackholed message IDs from the database.
 func (n *Notary) ListBlackholedMessages() ([]string, error) {
 	n.mutex.RLock()
@@ -0,0 +1,243 @@
+package notary
+
+import (
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// testOperator is an operator key used to sign annotations and approvals.
+type testOperator struct {
+	key *ecdsa.PrivateKey
+}
+
+func newTestOperator(t *testing.T) *testOperator {
+	t.Helper()
+	key, err := eth_crypto.GenerateKey()
+	require.NoError(t, err)
+	return &testOperator{key: key}
+}
+
+func (o *testOperator) address() string {
+	return eth_crypto.PubkeyToAddress(o.key.PublicKey).Hex()
+}
+
+func (o *testOperator) sign(t *testing.T, digest eth_common.Hash) []byte {
+	t.Helper()
+	signature, err := eth_crypto.Sign(digest.Bytes(), o.key)
+	require.NoError(t, err)
+	return signature
+}
+
+func (o *testOperator) annotate(t *testing.T, n *Notary, msgID string, note string) error {
+	t.Helper()
+	now := time.Now()
+	return n.AnnotateDelayedMsg(msgID, note, now, o.sign(t, AnnotationDigest(msgID, note, now)))
+}
+
+func (o *testOperator) approve(t *testing.T, n *Notary, msgID string) (*db.NotaryReview, bool, error) {
+	t.Helper()
+	now := time.Now()
+	return n.ApproveDelayedMsg(msgID, now, o.sign(t, ApprovalDigest(msgID, now)))
+}
+
+// makeReviewTestNotary returns a Notary backed by an in-memory database, with a single message delayed for a day and
+// the given operators allowed to review it.
+func makeReviewTestNotary(t *testing.T, requiredApprovals uint, operators ...*testOperator) (*Notary, *db.NotaryDB, string) {
+	t.Helper()
+
+	database := db.OpenDb(zap.NewNop(), nil)
+	t.Cleanup(func() { database.Close() })
+	notaryDB := db.NewNotaryDB(database.Conn())
+
+	n := makeTestNotary(t)
+	n.database = notaryDB
+	n.SetRequiredApprovals(requiredApprovals)
+	addrs := make([]eth_common.Address, 0, len(operators))
+	for _, o := range operators {
+		addrs = append(addrs, eth_common.HexToAddress(o.address()))
+	}
+	n.SetOperators(addrs)
+
+	msg := makeUniqueMessagePublication(t)
+	require.NoError(t, n.delay(msg, 24*time.Hour))
+
+	return n, notaryDB, msg.MessageIDString()
+}
+
+func TestNotary_AnnotateDelayedMsg(t *testing.T) {
+	alice := newTestOperator(t)
+	n, notaryDB, msgID := makeReviewTestNotary(t, 0, alice)
+
+	require.ErrorIs(t, alice.annotate(t, n, "1/2", "note"), ErrInvalidMsgID)
+	require.ErrorIs(t, alice.annotate(t, n, msgID, ""), ErrMissingNote)
+	require.ErrorIs(t, alice.annotate(t, n, makeUniqueMessagePublication(t).MessageIDString(), "note"), ErrMsgNotFound)
+
+	require.NoError(t, alice.annotate(t, n, msgID, "checking the source transaction"))
+	require.NoError(t, alice.annotate(t, n, msgID, "the transfer is legitimate"))
+
+	review, err := notaryDB.GetReview([]byte(msgID))
+	require.NoError(t, err)
+	require.Len(t, review.Annotations, 2)
+	require.Equal(t, alice.address(), review.Annotations[1].Operator)
+	require.Equal(t, "the transfer is legitimate", review.Annotations[1].Note)
+	require.Empty(t, review.Approvals)
+}
+
+func TestNotary_ReviewRequiresOperatorSignature(t *testing.T) {
+	alice := newTestOperator(t)
+	mallory := newTestOperator(t)
+	n, _, msgID := makeReviewTestNotary(t, 2, alice)
+	now := time.Now()
+
+	// The operator is the signer of the request, which must be a configured operator.
+	_, _, err := mallory.approve(t, n, msgID)
+	require.ErrorIs(t, err, ErrUnknownOperator)
+	require.ErrorIs(t, mallory.annotate(t, n, msgID, "note"), ErrUnknownOperator)
+
+	_, _, err = n.ApproveDelayedMsg(msgID, now, []byte{0x01})
+	require.ErrorIs(t, err, ErrInvalidOperatorSignature)
+
+	// A signature does not authenticate a different request.
+	_, _, err = n.ApproveDelayedMsg(msgID, now, alice.sign(t, AnnotationDigest(msgID, "note", now)))
+	require.ErrorIs(t, err, ErrUnknownOperator)
+	require.ErrorIs(t, n.AnnotateDelayedMsg(msgID, "other note", now, alice.sign(t, AnnotationDigest(msgID, "note", now))), ErrUnknownOperator)
+
+	// Old signatures cannot be replayed.
+	old := now.Add(-2 * MaxOperatorSignatureAge)
+	_, _, err = n.ApproveDelayedMsg(msgID, old, alice.sign(t, ApprovalDigest(msgID, old)))
+	require.ErrorIs(t, err, ErrStaleOperatorSignature)
+
+	// Without configured operators, nobody can review messages.
+	n.SetOperators(nil)
+	_, _, err = alice.approve(t, n, msgID)
+	require.ErrorIs(t, err, ErrNoOperators)
+}
+
+func TestNotary_ParseOperators(t *testing.T) {
+	operators, err := ParseOperators("")
+	require.NoError(t, err)
+	require.Empty(t, operators)
+
+	operators, err = ParseOperators("0x88d7D8B32a9105d228100E72dFFe2Fae0705D31c,beFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	require.NoError(t, err)
+	require.Equal(t, []eth_common.Address{
+		eth_common.HexToAddress("0x88d7D8B32a9105d228100E72dFFe2Fae0705D31c"),
+		eth_common.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe"),
+	}, operators)
+
+	_, err = ParseOperators("alice")
+	require.Error(t, err)
+	_, err = ParseOperators("0x88d7D8B32a9105d228100E72dFFe2Fae0705D31c,0x88d7D8B32a9105d228100E72dFFe2Fae0705D31c")
+	require.Error(t, err)
+}
+
+func TestNotary_ApproveDelayedMsgWithoutRequiredApprovals(t *testing.T) {
+	alice := newTestOperator(t)
+	n, _, msgID := makeReviewTestNotary(t, 0, alice)
+
+	// Approvals are recorded, but do not release the message.
+	review, released, err := alice.approve(t, n, msgID)
+	require.NoError(t, err)
+	require.False(t, released)
+	require.Equal(t, []string{alice.address()}, review.Operators())
+
+	_, _, err = alice.approve(t, n, msgID)
+	require.ErrorIs(t, err, ErrAlreadyApproved)
+
+	// A single admin command can release the message.
+	require.NoError(t, n.ReleaseDelayedMsg(msgID))
+	require.Len(t, n.ReleaseReadyMessages(), 1)
+}
+
+func TestNotary_ApproveDelayedMsgWithRequiredApprovals(t *testing.T) {
+	alice, bob, carol := newTestOperator(t), newTestOperator(t), newTestOperator(t)
+	n, notaryDB, msgID := makeReviewTestNotary(t, 2, alice, bob, carol)
+
+	// The message cannot be released by a single admin command.
+	require.ErrorIs(t, n.ReleaseDelayedMsg(msgID), ErrApprovalsRequired)
+	require.ErrorIs(t, n.ResetReleaseTimer(msgID, 0), ErrApprovalsRequired)
+	require.NoError(t, n.ResetReleaseTimer(msgID, 1))
+
+	review, released, err := alice.approve(t, n, msgID)
+	require.NoError(t, err)
+	require.False(t, released)
+	require.Len(t, review.Approvals, 1)
+
+	// Approvals from the same operator are only counted once.
+	_, _, err = alice.approve(t, n, msgID)
+	require.ErrorIs(t, err, ErrAlreadyApproved)
+	require.Empty(t, n.ReleaseReadyMessages())
+
+	review, released, err = bob.approve(t, n, msgID)
+	require.NoError(t, err)
+	require.True(t, released)
+	require.Equal(t, []string{alice.address(), bob.address()}, review.Operators())
+
+	ready := n.ReleaseReadyMessages()
+	require.Len(t, ready, 1)
+	require.Equal(t, msgID, ready[0].MessageIDString())
+
+	// The review is closed once the message is released, but kept in the record.
+	review, err = notaryDB.GetReview([]byte(msgID))
+	require.NoError(t, err)
+	require.Equal(t, []string{alice.address(), bob.address()}, review.Operators())
+	require.Len(t, review.Outcomes, 1)
+	require.Equal(t, reviewOutcomeReleased, review.Outcomes[0].Outcome)
+	require.True(t, review.Current().IsEmpty())
+
+	_, _, err = carol.approve(t, n, msgID)
+	require.ErrorIs(t, err, ErrMsgNotFound)
+}
+
+func TestNotary_BlackholeDelayedMsgClosesReview(t *testing.T) {
+	alice, bob := newTestOperator(t), newTestOperator(t)
+	n, notaryDB, msgID := makeReviewTestNotary(t, 2, alice, bob)
+
+	require.NoError(t, alice.annotate(t, n, msgID, "this looks like an exploit"))
+	_, _, err := bob.approve(t, n, msgID)
+	require.NoError(t, err)
+	require.NoError(t, n.BlackholeDelayedMsg(msgID))
+
+	review, err := notaryDB.GetReview([]byte(msgID))
+	require.NoError(t, err)
+	require.Len(t, review.Annotations, 1)
+	require.Len(t, review.Outcomes, 1)
+	require.Equal(t, reviewOutcomeBlackholed, review.Outcomes[0].Outcome)
+
+	// A message delayed again is reviewed from scratch, so earlier approvals do not count towards its release.
+	require.NoError(t, n.RemoveBlackholedMsg(msgID))
+	_, released, err := bob.approve(t, n, msgID)
+	require.NoError(t, err)
+	require.False(t, released)
+
+	reviews, err := n.ListDelayedMessageReviews()
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Empty(t, reviews[0].Review.Annotations)
+	require.Equal(t, []string{bob.address()}, reviews[0].Review.Operators())
+}
+
+func TestNotary_ListDelayedMessageReviews(t *testing.T) {
+	alice, bob := newTestOperator(t), newTestOperator(t)
+	n, notaryDB, msgID := makeReviewTestNotary(t, 2, alice, bob)
+
+	require.NoError(t, notaryDB.StoreVerdictRule([]byte(msgID), "delay-large-transfers"))
+	require.NoError(t, alice.annotate(t, n, msgID, "checking the source transaction"))
+	_, _, err := bob.approve(t, n, msgID)
+	require.NoError(t, err)
+
+	reviews, err := n.ListDelayedMessageReviews()
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, msgID, reviews[0].PendingMsg.Msg.MessageIDString())
+	require.Equal(t, "delay-large-transfers", reviews[0].RuleID)
+	require.Len(t, reviews[0].Review.Annotations, 1)
+	require.Equal(t, []string{bob.address()}, reviews[0].Review.Operators())
+}
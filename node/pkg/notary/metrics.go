@@ -13,6 +13,8 @@ var (
 	notaryBlackholedMessagesGauge prometheus.Gauge
 	notaryErrors                  *prometheus.CounterVec
 	notaryTokenTransferNonApprove *prometheus.CounterVec
+	notaryPolicyVerdicts          *prometheus.CounterVec
+	notaryPolicyReloads           *prometheus.CounterVec
 )
 
 // initMetrics registers all notary metrics with Prometheus.
@@ -55,6 +57,18 @@ func initMetrics(logger *zap.Logger) {
 			Help: "Total number of token transfers that received a non-Approve verdict from the notary",
 		}, []string{"verdict"})
 
+	notaryPolicyVerdicts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_notary_policy_verdicts_total",
+			Help: "Total number of messages whose verdict was decided by a notary policy rule",
+		}, []string{"rule", "verdict"})
+
+	notaryPolicyReloads = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_notary_policy_reloads_total",
+			Help: "Total number of attempts to reload the notary policy file",
+		}, []string{"result"})
+
 	// Register all metrics with the default Prometheus registry
 	prometheus.MustRegister(
 		notaryReleasedMessagesCounter,
@@ -62,5 +76,7 @@ func initMetrics(logger *zap.Logger) {
 		notaryBlackholedMessagesGauge,
 		notaryErrors,
 		notaryTokenTransferNonApprove,
+		notaryPolicyVerdicts,
+		notaryPolicyReloads,
 	)
 }
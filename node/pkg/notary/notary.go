@@ -26,12 +26,18 @@
 // be stored in the database forever. In practice, messages will be marked as
 // Rejected only in very extreme circumstances, so the database should always
 // be small.
+//
+// In addition, Guardians can configure a [Policy]: declarative rules that delay
+// or blackhole any message publication, regardless of the Transfer Verifier.
+// When a rule decides the verdict for a message, the rule's ID is recorded in
+// the database alongside the message.
 package notary
 
 import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"slices"
 	"sync"
 	"time"
@@ -39,6 +45,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/txverifier"
+	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
@@ -53,7 +60,7 @@ type (
 const (
 	Unknown Verdict = iota
 	// Approve means a message should be processed normally. All messages that are not Token Transfers
-	// must always be Approve, as the Notary does not support other message types.
+	// are Approve unless a policy rule applies to them.
 	Approve
 	// Delay means a message should be temporarily delayed so that it can be manually inspected.
 	Delay
@@ -120,6 +127,17 @@ type (
 
 		// env reports whether the guardian is running in production or a test environment.
 		env common.Environment
+
+		// policy holds the rules evaluated before the Transfer Verifier verdicts. May be nil.
+		policy *Policy
+
+		// requiredApprovals is the number of distinct operators that must approve the early release of a delayed
+		// message. If zero, a single admin command can release it.
+		requiredApprovals uint
+		// operators are the addresses of the operator keys allowed to annotate and approve delayed messages.
+		operators []eth_common.Address
+		// reviewMutex serializes updates to the review records of delayed messages.
+		reviewMutex sync.Mutex
 	}
 )
 
@@ -141,6 +159,17 @@ func NewNotary(
 	}
 }
 
+// SetPolicy sets the policy rules evaluated by the Notary. It must be called before [Notary.Run].
+func (n *Notary) SetPolicy(policy *Policy) {
+	n.policy = policy
+}
+
+// SetRequiredApprovals sets the number of distinct operators that must approve the early release of a delayed
+// message. It must be called before [Notary.Run].
+func (n *Notary) SetRequiredApprovals(approvals uint) {
+	n.requiredApprovals = approvals
+}
+
 func (n *Notary) Run() error {
 	// Initialize and register Prometheus metrics when notary starts.
 	// This ensures metrics are only registered when the notary is actually enabled.
@@ -154,7 +183,13 @@ func (n *Notary) Run() error {
 		}
 	}
 
-	n.logger.Info("notary ready")
+	if n.policy != nil {
+		if err := n.policy.StartWatcher(n.ctx, n.logger); err != nil {
+			return fmt.Errorf("failed to start policy watcher: %w", err)
+		}
+	}
+
+	n.logger.Info("notary ready", zap.Int("policyRules", n.policy.Len()))
 
 	// Spawn a goroutine to periodically update prometheus gauge metrics
 	go n.updateMetrics()
@@ -206,6 +241,11 @@ func (n *Notary) ProcessMsg(msg *common.MessagePublication) (v Verdict, err erro
 
 	n.logger.Debug("notary: processing message", msg.ZapFields()...)
 
+	// Policy rules apply to any message, so they are evaluated before the checks below.
+	if r := n.policy.evaluate(msg); r != nil {
+		return n.processRule(msg, r)
+	}
+
 	// For the initial implementation, the Notary only rules on messages based
 	// on the Transfer Verifier. However, there is no technical barrier to
 	// supporting other message types.
@@ -293,6 +333,46 @@ func (n *Notary) ProcessMsg(msg *common.MessagePublication) (v Verdict, err erro
 	return
 }
 
+// processRule applies the verdict of the policy rule matching a message and records the rule in the database.
+func (n *Notary) processRule(msg *common.MessagePublication, r *rule) (v Verdict, err error) {
+	if n.IsBlackholed(msg.MessageID()) {
+		n.logger.Warn("notary: got message publication that is already blackholed",
+			msg.ZapFields(zap.String("verdict", Blackhole.String()))...,
+		)
+		return Blackhole, nil
+	}
+
+	v = r.verdict
+	switch v {
+	case Blackhole:
+		err = n.blackhole(msg)
+	case Delay:
+		// A rule must not shorten the delay applied to messages that the Transfer Verifier could not validate.
+		delay := r.delay
+		switch msg.VerificationState() {
+		case common.Anomalous, common.Rejected, common.CouldNotVerify:
+			delay = max(delay, DefaultDelay)
+		}
+		err = n.delay(msg, delay)
+	default:
+		return Unknown, fmt.Errorf("notary: rule %s has unsupported verdict %s", r.id, v)
+	}
+
+	if dbErr := n.database.StoreVerdictRule(msg.MessageID(), r.id); dbErr != nil {
+		err = errors.Join(err, dbErr)
+	}
+
+	n.logger.Info("notary: policy rule matched message",
+		msg.ZapFields(zap.String("rule", r.id), zap.String("verdict", v.String()))...,
+	)
+
+	if notaryPolicyVerdicts != nil {
+		notaryPolicyVerdicts.WithLabelValues(r.id, v.String()).Inc()
+	}
+
+	return
+}
+
 // ReleaseReadyMessages removes messages from the database and the delayed queue if they are ready to
 // be released. Returns the messages that are ready to be published.
 func (n *Notary) ReleaseReadyMessages() []*common.MessagePublication {
@@ -337,6 +417,9 @@ func (n *Notary) ReleaseReadyMessages() []*common.MessagePublication {
 			n.logger.Warn("notary: delete pending message from notary database: deleted value was nil")
 		}
 
+		n.closeReview(&pMsg.Msg, reviewOutcomeReleased)
+		n.deleteVerdictRule(pMsg.Msg.MessageID())
+
 		// If the message is in the delayed queue, it should not be in the blackholed queue.
 		// This is a sanity check to ensure that the blackholed queue is not published,
 		// but it should never happen.
@@ -443,9 +526,49 @@ func (n *Notary) blackhole(msg *common.MessagePublication) error {
 
 	n.logger.Info("notary: blackholed message", msg.ZapFields()...)
 
+	n.closeReview(msg, reviewOutcomeBlackholed)
+
 	return nil
 }
 
+// closeReview closes the current review of a message that is no longer delayed, if it has one. The review record
+// is kept so that the decision remains auditable. Errors are logged rather than returned, as the message is not
+// delayed anymore either way.
+func (n *Notary) closeReview(msg *common.MessagePublication, outcome string) {
+	n.reviewMutex.Lock()
+	defer n.reviewMutex.Unlock()
+
+	review, err := n.database.GetReview(msg.MessageID())
+	if err != nil {
+		n.logger.Error("notary: failed to load review of message", msg.ZapFields(zap.Error(err))...)
+		return
+	}
+	current := review.Current()
+	if current.IsEmpty() {
+		return
+	}
+
+	if _, err := n.database.CloseReview(msg.MessageID(), db.NotaryReviewOutcome{Outcome: outcome, Timestamp: time.Now()}); err != nil {
+		n.logger.Error("notary: failed to close review of message", msg.ZapFields(zap.Error(err))...)
+		return
+	}
+	n.logger.Info("notary: closed review of message that is no longer delayed",
+		msg.ZapFields(
+			zap.String("outcome", outcome),
+			zap.Any("annotations", current.Annotations),
+			zap.Strings("approvals", current.Operators()),
+		)...,
+	)
+}
+
+// deleteVerdictRule deletes the policy rule recorded for a message that is no longer delayed or blackholed. Errors are
+// logged rather than returned, as the rule is not needed anymore.
+func (n *Notary) deleteVerdictRule(msgID []byte) {
+	if err := n.database.DeleteVerdictRule(msgID); err != nil {
+		n.logger.Error("notary: failed to delete policy rule of message", zap.String("msgID", string(msgID)), zap.Error(err))
+	}
+}
+
 // forget removes a message from the database and from the delayed and blackholed lists.
 func (n *Notary) forget(msg *common.MessagePublication) error {
 	if msg == nil {
@@ -503,6 +626,8 @@ func (n *Notary) removeBlackholed(msgID []byte) (*common.MessagePublication, err
 		return nil, err
 	}
 
+	n.deleteVerdictRule(msgID)
+
 	// No-op if the message is not in the database.
 	if deletedMsgPub == nil {
 		return nil, nil
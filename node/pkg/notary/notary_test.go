@@ -33,6 +33,21 @@ func (md MockNotaryDB) DeleteBlackholed(msgID []byte) (*common.MessagePublicatio
 }
 func (md MockNotaryDB) DeleteDelayed(msgID []byte) (*common.PendingMessage, error) { return nil, nil }
 func (md MockNotaryDB) LoadAll(l *zap.Logger) (*db.NotaryLoadResult, error)        { return nil, nil }
+func (md MockNotaryDB) StoreVerdictRule(msgID []byte, ruleID string) error         { return nil }
+func (md MockNotaryDB) GetVerdictRule(msgID []byte) (string, error)                { return "", nil }
+func (md MockNotaryDB) DeleteVerdictRule(msgID []byte) error                       { return nil }
+func (md MockNotaryDB) AddAnnotation(msgID []byte, a db.NotaryAnnotation) (*db.NotaryReview, error) {
+	return &db.NotaryReview{}, nil
+}
+func (md MockNotaryDB) AddApproval(msgID []byte, a db.NotaryApproval) (*db.NotaryReview, error) {
+	return &db.NotaryReview{}, nil
+}
+func (md MockNotaryDB) CloseReview(msgID []byte, o db.NotaryReviewOutcome) (*db.NotaryReview, error) {
+	return &db.NotaryReview{}, nil
+}
+func (md MockNotaryDB) GetReview(msgID []byte) (*db.NotaryReview, error) {
+	return &db.NotaryReview{}, nil
+}
 
 func makeTestNotary(t *testing.T) *Notary {
 	t.Helper()
@@ -51,7 +66,7 @@ func makeTestNotary(t *testing.T) *Notary {
 // TestNotary_AlwaysApproveNonTransferVerifierEmitters tests that all messages are approve if the emitter chain does not have a transfer verifier.
 // This test can be removed if the Notary is extended to support other chains.
 func TestNotary_AlwaysApproveNonTransferVerifierEmitters(t *testing.T) {
-	// NOTE: Solana does not have a transfer verifier implementation
+	// NOTE: Algorand does not have a transfer verifier implementation
 	tests := map[string]struct {
 		verificationState common.VerificationState
 		emitterChain      vaa.ChainID
@@ -59,12 +74,12 @@ func TestNotary_AlwaysApproveNonTransferVerifierEmitters(t *testing.T) {
 	}{
 		"approve non-transfer verifier when Rejected": {
 			common.Rejected,
-			vaa.ChainIDSolana,
+			vaa.ChainIDAlgorand,
 			Approve,
 		},
 		"approve non-transfer verifier when Anomalous": {
 			common.Anomalous,
-			vaa.ChainIDSolana,
+			vaa.ChainIDAlgorand,
 			Approve,
 		},
 		"delay non-Ethereum messages for chain with transfer verifier when Rejected": {
@@ -0,0 +1,113 @@
+package notary
+
+// Operators review delayed messages using admin commands. The admin socket does not tell operators apart, so each
+// annotation and approval is signed with the key of the operator making it, and the Notary only accepts signatures
+// from the operator keys it is configured with.
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	eth_common "github.com/ethereum/go-ethereum/common"
+	eth_crypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// OperatorKeyArmoredBlock is the block type of operator key files, as created by
+	// `guardiand keygen --block-type "WORMHOLE NOTARY OPERATOR KEY"`.
+	OperatorKeyArmoredBlock = "WORMHOLE NOTARY OPERATOR KEY"
+
+	// MaxOperatorSignatureAge is how far the timestamp of a signed annotation or approval can be from the current
+	// time. It bounds the window during which a signed request can be replayed.
+	MaxOperatorSignatureAge = 5 * time.Minute
+
+	// Outcomes recorded when the review of a delayed message is closed.
+	reviewOutcomeReleased   = "released"
+	reviewOutcomeBlackholed = "blackholed"
+)
+
+var (
+	annotationDigestPrefix = []byte("notary_annotate_delayed_message|")
+	approvalDigestPrefix   = []byte("notary_approve_delayed_message|")
+)
+
+var (
+	ErrInvalidOperatorSignature = errors.New("notary: invalid operator signature")
+	ErrNoOperators              = errors.New("notary: no operators are configured to review delayed messages")
+	ErrStaleOperatorSignature   = errors.New("notary: the operator signature timestamp is too far from the current time")
+	ErrUnknownOperator          = errors.New("notary: the operator is not allowed to review delayed messages")
+)
+
+// AnnotationDigest returns the digest an operator signs to annotate a delayed message.
+func AnnotationDigest(msgID string, note string, timestamp time.Time) eth_common.Hash {
+	return operatorDigest(annotationDigestPrefix, msgID, timestamp, []byte(note))
+}
+
+// ApprovalDigest returns the digest an operator signs to approve the release of a delayed message.
+func ApprovalDigest(msgID string, timestamp time.Time) eth_common.Hash {
+	return operatorDigest(approvalDigestPrefix, msgID, timestamp, nil)
+}
+
+// operatorDigest hashes the prefix, the timestamp, the length-prefixed message ID and the payload.
+func operatorDigest(prefix []byte, msgID string, timestamp time.Time, payload []byte) eth_common.Hash {
+	b := slices.Clone(prefix)
+	b = binary.BigEndian.AppendUint64(b, uint64(timestamp.Unix())) // #nosec G115 -- The timestamp is after the epoch
+	b = binary.BigEndian.AppendUint32(b, uint32(len(msgID)))       // #nosec G115 -- Message IDs are short
+	b = append(b, msgID...)
+	b = append(b, payload...)
+	return eth_crypto.Keccak256Hash(b)
+}
+
+// ParseOperators parses a comma separated list of operator key addresses. It returns nil for an empty list.
+func ParseOperators(operators string) ([]eth_common.Address, error) {
+	if operators == "" {
+		return nil, nil
+	}
+
+	var result []eth_common.Address
+	for _, str := range strings.Split(operators, ",") {
+		if !eth_common.IsHexAddress(str) {
+			return nil, fmt.Errorf("invalid notary operator address: `%s`", str)
+		}
+		addr := eth_common.HexToAddress(str)
+		if slices.Contains(result, addr) {
+			return nil, fmt.Errorf("duplicate notary operator address: `%s`", str)
+		}
+		result = append(result, addr)
+	}
+	return result, nil
+}
+
+// SetOperators sets the addresses of the operator keys allowed to annotate and approve delayed messages. It must be
+// called before [Notary.Run].
+func (n *Notary) SetOperators(operators []eth_common.Address) {
+	n.operators = operators
+}
+
+// authenticateOperator returns the address of the configured operator that signed the digest at the given time.
+func (n *Notary) authenticateOperator(digest eth_common.Hash, timestamp time.Time, signature []byte) (string, error) {
+	if len(n.operators) == 0 {
+		return "", ErrNoOperators
+	}
+
+	age := time.Since(timestamp)
+	if age > MaxOperatorSignatureAge || age < -MaxOperatorSignatureAge {
+		return "", ErrStaleOperatorSignature
+	}
+
+	pubKey, err := eth_crypto.SigToPub(digest.Bytes(), signature)
+	if err != nil {
+		return "", errors.Join(ErrInvalidOperatorSignature, err)
+	}
+
+	operator := eth_crypto.PubkeyToAddress(*pubKey)
+	if !slices.Contains(n.operators, operator) {
+		return "", ErrUnknownOperator
+	}
+
+	return operator.Hex(), nil
+}
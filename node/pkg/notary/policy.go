@@ -0,0 +1,418 @@
+package notary
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ntt"
+	"github.com/fsnotify/fsnotify"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type (
+	// PolicyConfig is the content of a policy file.
+	PolicyConfig struct {
+		Rules []RuleConfig `json:"rules"`
+	}
+
+	// RuleConfig is a rule of a policy file. All the criteria that are set must match for the rule to apply.
+	RuleConfig struct {
+		// ID identifies the rule in the logs and in the database. It must be unique within the policy.
+		ID string `json:"id"`
+		// Action is either "delay" or "blackhole".
+		Action string `json:"action"`
+		// Delay is how long a message is delayed, such as "48h". Defaults to [DefaultDelay] for the "delay" action.
+		Delay string `json:"delay"`
+
+		EmitterChain   *uint16 `json:"emitterChain"`
+		EmitterAddress string  `json:"emitterAddress"`
+		// PayloadType is either "tokenTransfer" or "ntt".
+		PayloadType string `json:"payloadType"`
+		// MinAmount matches transfers of at least this amount, as encoded in the payload. Requires a PayloadType.
+		MinAmount string `json:"minAmount"`
+		// PayloadPrefix matches payloads starting with these hex-encoded bytes.
+		PayloadPrefix string `json:"payloadPrefix"`
+	}
+
+	// payloadType is the kind of transfer a rule applies to.
+	payloadType uint8
+
+	// rule is a validated [RuleConfig].
+	rule struct {
+		id      string
+		verdict Verdict
+		delay   time.Duration
+
+		emitterChain   *vaa.ChainID
+		emitterAddress *vaa.Address
+		payloadType    payloadType
+		minAmount      *big.Int
+		payloadPrefix  []byte
+	}
+
+	// Policy is a set of declarative rules, loaded from a JSON file, that delay or blackhole message
+	// publications in addition to the verdicts based on the Transfer Verifier. For example:
+	//
+	//	{
+	//	  "rules": [
+	//	    {
+	//	      "id": "delay-large-ntt-transfers",
+	//	      "action": "delay",
+	//	      "delay": "48h",
+	//	      "emitterChain": 2,
+	//	      "emitterAddress": "000000000000000000000000c072b1289f1cc6b05a31ad2f8e4f2c99d1bf94d0",
+	//	      "payloadType": "ntt",
+	//	      "minAmount": "100000000000000"
+	//	    },
+	//	    {
+	//	      "id": "blackhole-exploit-payloads",
+	//	      "action": "blackhole",
+	//	      "payloadPrefix": "01deadbeef"
+	//	    }
+	//	  ]
+	//	}
+	//
+	// Rules are evaluated in the order they appear in the file and the first matching rule wins. Rules only depend on
+	// the content of the message publication, so that every Guardian running the same policy reaches the same verdict.
+	// The file is watched and reloaded when it changes. If the new file is invalid, the previous rules are kept.
+	Policy struct {
+		// Guards rules and contents, which are replaced when the file is reloaded.
+		mutex sync.RWMutex
+		rules []*rule
+		// contents is the last content read from the file, valid or not, so that reloads which do not change it are
+		// skipped.
+		contents []byte
+		fileName string
+	}
+)
+
+const (
+	anyPayload payloadType = iota
+	tokenTransferPayload
+	nttPayload
+)
+
+var ErrInvalidPolicy = errors.New("notary: invalid policy")
+
+// NewPolicy loads a policy file.
+func NewPolicy(fileName string) (*Policy, error) {
+	contents, err := readPolicyFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := parsePolicyFile(fileName, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Policy{
+		rules:    rules,
+		contents: contents,
+		fileName: fileName,
+	}, nil
+}
+
+// StartWatcher watches the policy file and reloads it when it changes, until the context is done.
+func (p *Policy) StartWatcher(ctx context.Context, logger *zap.Logger) error {
+	watcher, createErr := fsnotify.NewWatcher()
+	if createErr != nil {
+		return createErr
+	}
+
+	// fsnotify requires watching the parent directory rather than the file. The file may also be a symlink that is
+	// swapped, such as a Kubernetes ConfigMap, whose events are on other names in the directory.
+	watchDir := filepath.Dir(p.fileName)
+	if addErr := watcher.Add(watchDir); addErr != nil {
+		watcher.Close()
+		return addErr
+	}
+	logger.Info("notary: starting policy watcher", zap.String("dir", watchDir))
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				// Any change in the directory may change the file, so it is read again and only reloaded if its content
+				// has changed.
+				if event.Op == fsnotify.Chmod {
+					continue
+				}
+				logger.Debug("notary: the policy directory has changed", zap.String("fileName", event.Name), zap.String("event", event.String()))
+				p.Reload(logger)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Error("notary: policy watcher error", zap.Error(err))
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Reload reloads the policy file if its content has changed. The previous rules are kept if the file is invalid.
+func (p *Policy) Reload(logger *zap.Logger) {
+	contents, err := readPolicyFile(p.fileName)
+	if err != nil {
+		p.reloadFailed(logger, err)
+		return
+	}
+
+	p.mutex.Lock()
+	unchanged := bytes.Equal(contents, p.contents)
+	p.contents = contents
+	p.mutex.Unlock()
+	if unchanged {
+		return
+	}
+
+	rules, err := parsePolicyFile(p.fileName, contents)
+	if err != nil {
+		p.reloadFailed(logger, err)
+		return
+	}
+
+	p.mutex.Lock()
+	p.rules = rules
+	p.mutex.Unlock()
+
+	logger.Info("notary: reloaded the policy file", zap.String("fileName", p.fileName), zap.Int("rules", len(rules)))
+	if notaryPolicyReloads != nil {
+		notaryPolicyReloads.WithLabelValues("success").Inc()
+	}
+}
+
+func (p *Policy) reloadFailed(logger *zap.Logger, err error) {
+	logger.Error("notary: failed to reload the policy file, keeping the previous rules", zap.String("fileName", p.fileName), zap.Error(err))
+	if notaryPolicyReloads != nil {
+		notaryPolicyReloads.WithLabelValues("failure").Inc()
+	}
+}
+
+// evaluate returns the first rule matching the message, or nil if there is none.
+func (p *Policy) evaluate(msg *common.MessagePublication) *rule {
+	if p == nil {
+		return nil
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	for _, r := range p.rules {
+		if r.matches(msg) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Len returns the number of rules of the policy.
+func (p *Policy) Len() int {
+	if p == nil {
+		return 0
+	}
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return len(p.rules)
+}
+
+func (r *rule) matches(msg *common.MessagePublication) bool {
+	if r.emitterChain != nil && msg.EmitterChain != *r.emitterChain {
+		return false
+	}
+	if r.emitterAddress != nil && msg.EmitterAddress != *r.emitterAddress {
+		return false
+	}
+	if r.payloadPrefix != nil && !bytes.HasPrefix(msg.Payload, r.payloadPrefix) {
+		return false
+	}
+
+	var amount *big.Int
+	switch r.payloadType {
+	case anyPayload:
+		return true
+	case tokenTransferPayload:
+		if !vaa.IsTransfer(msg.Payload) {
+			return false
+		}
+		hdr, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
+		if err != nil {
+			return false
+		}
+		amount = hdr.Amount
+	case nttPayload:
+		var ok bool
+		amount, ok = nttAmount(msg.Payload)
+		if !ok {
+			return false
+		}
+	default:
+		return false
+	}
+
+	return r.minAmount == nil || amount.Cmp(r.minAmount) >= 0
+}
+
+// nttAmount returns the trimmed amount of a Native Token Transfer, or false if the payload is not a valid one.
+func nttAmount(payload []byte) (*big.Int, bool) {
+	if !ntt.IsTransfer(payload) {
+		return nil, false
+	}
+	nt, err := ntt.DecodeTransfer(payload)
+	if err != nil {
+		return nil, false
+	}
+	return new(big.Int).SetUint64(nt.Amount), true
+}
+
+// readPolicyFile reads a policy file, following any symlink.
+func readPolicyFile(fileName string) ([]byte, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to open policy file "%s": %w`, fileName, err)
+	}
+	defer f.Close()
+
+	b, err := common.SafeRead(f)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read policy file "%s": %w`, fileName, err)
+	}
+	return b, nil
+}
+
+// parsePolicyFile validates the content of a policy file.
+func parsePolicyFile(fileName string, b []byte) ([]*rule, error) {
+	rules, err := parsePolicy(b)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to parse policy file "%s": %w`, fileName, err)
+	}
+	return rules, nil
+}
+
+// parsePolicy validates the rules of a policy.
+func parsePolicy(b []byte) ([]*rule, error) {
+	var config PolicyConfig
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	// Reject misspelled criteria, which would otherwise make a rule broader than intended.
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, errors.Join(ErrInvalidPolicy, err)
+	}
+
+	rules := make([]*rule, 0, len(config.Rules))
+	ids := make(map[string]struct{}, len(config.Rules))
+	for i, rc := range config.Rules {
+		r, err := parseRule(rc)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidPolicy, fmt.Errorf("rule %d: %w", i, err))
+		}
+		if _, exists := ids[r.id]; exists {
+			return nil, errors.Join(ErrInvalidPolicy, fmt.Errorf(`rule %d: duplicate id "%s"`, i, r.id))
+		}
+		ids[r.id] = struct{}{}
+		rules = append(rules, r)
+	}
+
+	return rules, nil
+}
+
+// parseRule validates a rule of a policy.
+func parseRule(rc RuleConfig) (*rule, error) {
+	if rc.ID == "" {
+		return nil, errors.New("missing id")
+	}
+
+	r := &rule{id: rc.ID}
+
+	switch rc.Action {
+	case "delay":
+		r.verdict = Delay
+		r.delay = DefaultDelay
+		if rc.Delay != "" {
+			delay, err := time.ParseDuration(rc.Delay)
+			if err != nil {
+				return nil, fmt.Errorf("invalid delay: %w", err)
+			}
+			if delay <= 0 || delay > MaxDelay {
+				return nil, fmt.Errorf("delay must be positive and at most %d days", MaxDelayDays)
+			}
+			r.delay = delay
+		}
+	case "blackhole":
+		if rc.Delay != "" {
+			return nil, errors.New(`delay is only supported by the "delay" action`)
+		}
+		r.verdict = Blackhole
+	default:
+		return nil, fmt.Errorf(`invalid action "%s"`, rc.Action)
+	}
+
+	if rc.EmitterChain != nil {
+		chainID := vaa.ChainID(*rc.EmitterChain)
+		r.emitterChain = &chainID
+	}
+
+	if rc.EmitterAddress != "" {
+		addr, err := vaa.StringToAddress(rc.EmitterAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emitter address: %w", err)
+		}
+		r.emitterAddress = &addr
+	}
+
+	switch rc.PayloadType {
+	case "":
+		r.payloadType = anyPayload
+	case "tokenTransfer":
+		r.payloadType = tokenTransferPayload
+	case "ntt":
+		r.payloadType = nttPayload
+	default:
+		return nil, fmt.Errorf(`invalid payload type "%s"`, rc.PayloadType)
+	}
+
+	if rc.MinAmount != "" {
+		if r.payloadType == anyPayload {
+			return nil, errors.New("minAmount requires a payloadType")
+		}
+		amount, ok := new(big.Int).SetString(rc.MinAmount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf(`invalid minAmount "%s"`, rc.MinAmount)
+		}
+		r.minAmount = amount
+	}
+
+	if rc.PayloadPrefix != "" {
+		prefix, err := hex.DecodeString(rc.PayloadPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload prefix: %w", err)
+		}
+		r.payloadPrefix = prefix
+	}
+
+	// A rule without any criteria would apply to every message.
+	if r.emitterChain == nil && r.emitterAddress == nil && r.payloadType == anyPayload && r.payloadPrefix == nil {
+		return nil, errors.New("at least one matching criterion is required")
+	}
+
+	return r, nil
+}
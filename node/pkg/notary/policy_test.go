@@ -0,0 +1,328 @@
+package notary
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/ntt"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// makeNttPayload returns a Native Token Transfer sent through the Wormhole transceiver with the given amount.
+func makeNttPayload(amount uint64) []byte {
+	transfer := append([]byte{}, ntt.TransferPrefix...)
+	transfer = append(transfer, 8) // decimals
+	transfer = binary.BigEndian.AppendUint64(transfer, amount)
+	// Source token, recipient and recipient chain.
+	transfer = append(transfer, make([]byte, 32+32+2)...)
+
+	// Message ID and sender, followed by the transfer.
+	managerMsg := make([]byte, 32+32)
+	managerMsg = binary.BigEndian.AppendUint16(managerMsg, uint16(len(transfer))) // #nosec G115 -- The transfer is short
+	managerMsg = append(managerMsg, transfer...)
+
+	// Source and recipient NTT managers, followed by the manager message and an empty transceiver payload.
+	payload := append([]byte{}, ntt.TransceiverPrefix...)
+	payload = append(payload, make([]byte, 32+32)...)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(len(managerMsg))) // #nosec G115 -- The message is short
+	payload = append(payload, managerMsg...)
+	return binary.BigEndian.AppendUint16(payload, 0)
+}
+
+// mustParsePolicy returns a policy with the rules of the given JSON document.
+func mustParsePolicy(t *testing.T, config string) *Policy {
+	t.Helper()
+	rules, err := parsePolicy([]byte(config))
+	require.NoError(t, err)
+	return &Policy{rules: rules}
+}
+
+func TestParsePolicy(t *testing.T) {
+	rules, err := parsePolicy([]byte(`{"rules": [
+		{"id": "a", "action": "delay", "emitterChain": 2},
+		{"id": "b", "action": "delay", "delay": "48h", "payloadType": "ntt", "minAmount": "100"},
+		{"id": "c", "action": "blackhole", "payloadPrefix": "deadbeef"}
+	]}`))
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+
+	require.Equal(t, Delay, rules[0].verdict)
+	require.Equal(t, DefaultDelay, rules[0].delay)
+	require.Equal(t, vaa.ChainIDEthereum, *rules[0].emitterChain)
+
+	require.Equal(t, 48*time.Hour, rules[1].delay)
+	require.Equal(t, nttPayload, rules[1].payloadType)
+	require.Equal(t, int64(100), rules[1].minAmount.Int64())
+
+	require.Equal(t, Blackhole, rules[2].verdict)
+	require.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, rules[2].payloadPrefix)
+
+	// An empty policy is valid.
+	rules, err = parsePolicy([]byte(`{"rules": []}`))
+	require.NoError(t, err)
+	require.Empty(t, rules)
+}
+
+func TestParsePolicyInvalid(t *testing.T) {
+	tests := map[string]string{
+		"malformed json":         `{"rules": [`,
+		"unknown field":          `{"rules": [{"id": "a", "action": "delay", "emitterChian": 2}]}`,
+		"missing id":             `{"rules": [{"action": "delay", "emitterChain": 2}]}`,
+		"duplicate id":           `{"rules": [{"id": "a", "action": "delay", "emitterChain": 2}, {"id": "a", "action": "delay", "emitterChain": 3}]}`,
+		"invalid action":         `{"rules": [{"id": "a", "action": "approve", "emitterChain": 2}]}`,
+		"invalid delay":          `{"rules": [{"id": "a", "action": "delay", "delay": "two days", "emitterChain": 2}]}`,
+		"negative delay":         `{"rules": [{"id": "a", "action": "delay", "delay": "-1h", "emitterChain": 2}]}`,
+		"delay above maximum":    `{"rules": [{"id": "a", "action": "delay", "delay": "1000h", "emitterChain": 2}]}`,
+		"delay with blackhole":   `{"rules": [{"id": "a", "action": "blackhole", "delay": "1h", "emitterChain": 2}]}`,
+		"invalid emitter":        `{"rules": [{"id": "a", "action": "delay", "emitterAddress": "xyz"}]}`,
+		"invalid payload type":   `{"rules": [{"id": "a", "action": "delay", "payloadType": "nft"}]}`,
+		"amount without type":    `{"rules": [{"id": "a", "action": "delay", "emitterChain": 2, "minAmount": "1"}]}`,
+		"invalid amount":         `{"rules": [{"id": "a", "action": "delay", "payloadType": "ntt", "minAmount": "1e9"}]}`,
+		"invalid payload prefix": `{"rules": [{"id": "a", "action": "blackhole", "payloadPrefix": "zz"}]}`,
+		"no criteria":            `{"rules": [{"id": "a", "action": "blackhole"}]}`,
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parsePolicy([]byte(config))
+			require.ErrorIs(t, err, ErrInvalidPolicy)
+		})
+	}
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+	policy := mustParsePolicy(t, `{"rules": [
+		{"id": "blackhole-prefix", "action": "blackhole", "payloadPrefix": "01deadbeef"},
+		{"id": "delay-large-ntt", "action": "delay", "delay": "1h", "emitterChain": 2, "payloadType": "ntt", "minAmount": "1000"},
+		{"id": "delay-large-transfers", "action": "delay", "delay": "2h", "payloadType": "tokenTransfer", "minAmount": "27000000000"},
+		{"id": "delay-solana", "action": "delay", "emitterChain": 1}
+	]}`)
+
+	tests := map[string]struct {
+		chain   vaa.ChainID
+		payload []byte
+		rule    string
+	}{
+		"large token transfer": {
+			chain: vaa.ChainIDEthereum,
+			rule:  "delay-large-transfers",
+		},
+		"large ntt transfer": {
+			chain:   vaa.ChainIDEthereum,
+			payload: makeNttPayload(1000),
+			rule:    "delay-large-ntt",
+		},
+		"small ntt transfer": {
+			chain:   vaa.ChainIDEthereum,
+			payload: makeNttPayload(999),
+		},
+		"malformed ntt transfer": {
+			chain:   vaa.ChainIDEthereum,
+			payload: append(makeNttPayload(1000), 0),
+		},
+		"ntt transfer from another chain": {
+			chain:   vaa.ChainIDBSC,
+			payload: makeNttPayload(1000),
+		},
+		"any payload from emitter chain": {
+			chain:   vaa.ChainIDSolana,
+			payload: []byte{0x42},
+			rule:    "delay-solana",
+		},
+		"first matching rule wins": {
+			chain:   vaa.ChainIDSolana,
+			payload: []byte{0x01, 0xde, 0xad, 0xbe, 0xef, 0x00},
+			rule:    "blackhole-prefix",
+		},
+		"no matching rule": {
+			chain:   vaa.ChainIDEthereum,
+			payload: []byte{0x42},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg := makeUniqueMessagePublication(t)
+			msg.EmitterChain = test.chain
+			if test.payload != nil {
+				msg.Payload = test.payload
+			}
+
+			r := policy.evaluate(msg)
+			if test.rule == "" {
+				require.Nil(t, r)
+			} else {
+				require.NotNil(t, r)
+				require.Equal(t, test.rule, r.id)
+			}
+		})
+	}
+
+	// A nil policy has no rules.
+	var nilPolicy *Policy
+	require.Nil(t, nilPolicy.evaluate(makeUniqueMessagePublication(t)))
+	require.Equal(t, 0, nilPolicy.Len())
+}
+
+func TestPolicyReload(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(fileName, []byte(`{"rules": [{"id": "a", "action": "delay", "emitterChain": 1}]}`), 0600))
+
+	policy, err := NewPolicy(fileName)
+	require.NoError(t, err)
+	require.Equal(t, 1, policy.Len())
+
+	require.NoError(t, os.WriteFile(fileName, []byte(`{"rules": [{"id": "a", "action": "delay", "emitterChain": 1}, {"id": "b", "action": "delay", "emitterChain": 2}]}`), 0600))
+	policy.Reload(zap.NewNop())
+	require.Equal(t, 2, policy.Len())
+
+	// An invalid file does not replace the current rules.
+	require.NoError(t, os.WriteFile(fileName, []byte(`{"rules": [{"id": "a"}]}`), 0600))
+	policy.Reload(zap.NewNop())
+	require.Equal(t, 2, policy.Len())
+
+	// A symlink is resolved again on every reload.
+	dir := t.TempDir()
+	writeConfigMapPolicy(t, dir, "1", `{"rules": []}`)
+	policy, err = NewPolicy(filepath.Join(dir, "policy.json"))
+	require.NoError(t, err)
+	writeConfigMapPolicy(t, dir, "2", `{"rules": [{"id": "a", "action": "delay", "emitterChain": 1}]}`)
+	policy.Reload(zap.NewNop())
+	require.Equal(t, 1, policy.Len())
+
+	_, err = NewPolicy(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
+
+func TestPolicyWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fileName := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(fileName, []byte(`{"rules": []}`), 0600))
+
+	policy, err := NewPolicy(fileName)
+	require.NoError(t, err)
+	require.NoError(t, policy.StartWatcher(ctx, zap.NewNop()))
+
+	require.NoError(t, os.WriteFile(fileName, []byte(`{"rules": [{"id": "a", "action": "delay", "emitterChain": 1}]}`), 0600))
+	require.Eventually(t, func() bool { return policy.Len() == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+// writeConfigMapPolicy writes a policy file the way Kubernetes updates a mounted ConfigMap: into a new directory, which
+// the ..data symlink is then atomically switched to. The policy file itself is a symlink through ..data.
+func writeConfigMapPolicy(t *testing.T, dir string, version string, config string) {
+	t.Helper()
+	dataDir := filepath.Join(dir, "..data_"+version)
+	require.NoError(t, os.Mkdir(dataDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "policy.json"), []byte(config), 0600))
+	require.NoError(t, os.Symlink(filepath.Base(dataDir), filepath.Join(dir, "..data_tmp")))
+	require.NoError(t, os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data")))
+	if _, err := os.Lstat(filepath.Join(dir, "policy.json")); os.IsNotExist(err) {
+		require.NoError(t, os.Symlink(filepath.Join("..data", "policy.json"), filepath.Join(dir, "policy.json")))
+	}
+}
+
+func TestPolicyWatcherConfigMap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	writeConfigMapPolicy(t, dir, "1", `{"rules": []}`)
+
+	policy, err := NewPolicy(filepath.Join(dir, "policy.json"))
+	require.NoError(t, err)
+	require.NoError(t, policy.StartWatcher(ctx, zap.NewNop()))
+
+	// The policy file itself is not modified, only the ..data symlink.
+	writeConfigMapPolicy(t, dir, "2", `{"rules": [{"id": "a", "action": "delay", "emitterChain": 1}]}`)
+	require.Eventually(t, func() bool { return policy.Len() == 1 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNotary_ProcessMsgPolicyRules(t *testing.T) {
+	database := db.OpenDb(zap.NewNop(), nil)
+	defer database.Close()
+	notaryDB := db.NewNotaryDB(database.Conn())
+
+	n := makeTestNotary(t)
+	n.database = notaryDB
+	n.SetPolicy(mustParsePolicy(t, `{"rules": [
+		{"id": "blackhole-prefix", "action": "blackhole", "payloadPrefix": "01deadbeef"},
+		{"id": "delay-solana", "action": "delay", "delay": "1h", "emitterChain": 1}
+	]}`))
+
+	// Rules apply to chains without a transfer verifier and to payloads that are not token transfers.
+	delayed := makeUniqueMessagePublication(t)
+	delayed.EmitterChain = vaa.ChainIDSolana
+	delayed.Payload = []byte{0x42}
+	verdict, err := n.ProcessMsg(delayed)
+	require.NoError(t, err)
+	require.Equal(t, Delay, verdict)
+	require.True(t, n.IsDelayed(delayed))
+	require.WithinDuration(t, time.Now().Add(time.Hour), n.delayed.Peek().ReleaseTime, 2*time.Second)
+
+	ruleID, err := notaryDB.GetVerdictRule(delayed.MessageID())
+	require.NoError(t, err)
+	require.Equal(t, "delay-solana", ruleID)
+
+	blackholed := makeUniqueMessagePublication(t)
+	blackholed.Payload = []byte{0x01, 0xde, 0xad, 0xbe, 0xef}
+	verdict, err = n.ProcessMsg(blackholed)
+	require.NoError(t, err)
+	require.Equal(t, Blackhole, verdict)
+	require.True(t, n.IsBlackholed(blackholed.MessageID()))
+
+	ruleID, err = notaryDB.GetVerdictRule(blackholed.MessageID())
+	require.NoError(t, err)
+	require.Equal(t, "blackhole-prefix", ruleID)
+
+	// Messages that no rule matches fall back to the Transfer Verifier verdicts.
+	approved := makeUniqueMessagePublication(t)
+	require.NoError(t, approved.SetVerificationState(common.Valid))
+	verdict, err = n.ProcessMsg(approved)
+	require.NoError(t, err)
+	require.Equal(t, Approve, verdict)
+	ruleID, err = notaryDB.GetVerdictRule(approved.MessageID())
+	require.NoError(t, err)
+	require.Empty(t, ruleID)
+
+	// Changing the delay keeps the rule, which is deleted together with the message once it is released.
+	require.NoError(t, n.release(delayed.MessageID()))
+	ruleID, err = notaryDB.GetVerdictRule(delayed.MessageID())
+	require.NoError(t, err)
+	require.Equal(t, "delay-solana", ruleID)
+	require.Len(t, n.ReleaseReadyMessages(), 1)
+	ruleID, err = notaryDB.GetVerdictRule(delayed.MessageID())
+	require.NoError(t, err)
+	require.Empty(t, ruleID)
+
+	// The rule is deleted together with a blackholed message that is removed.
+	_, err = n.removeBlackholed(blackholed.MessageID())
+	require.NoError(t, err)
+	ruleID, err = notaryDB.GetVerdictRule(blackholed.MessageID())
+	require.NoError(t, err)
+	require.Empty(t, ruleID)
+}
+
+// TestNotary_PolicyDoesNotShortenTransferVerifierDelay tests that a delay rule matching an anomalous message does not
+// release it sooner than the Transfer Verifier verdict would.
+func TestNotary_PolicyDoesNotShortenTransferVerifierDelay(t *testing.T) {
+	n := makeTestNotary(t)
+	n.SetPolicy(mustParsePolicy(t, `{"rules": [{"id": "delay-ethereum", "action": "delay", "delay": "1h", "emitterChain": 2}]}`))
+
+	msg := makeUniqueMessagePublication(t)
+	require.NoError(t, msg.SetVerificationState(common.Anomalous))
+
+	verdict, err := n.ProcessMsg(msg)
+	require.NoError(t, err)
+	require.Equal(t, Delay, verdict)
+	require.WithinDuration(t, time.Now().Add(DefaultDelay), n.delayed.Peek().ReleaseTime, 2*time.Second)
+}
@@ -0,0 +1,137 @@
+// Package ntt decodes Native Token Transfers (NTT). A transfer is published by an NTT transceiver as a transceiver
+// message, which wraps the message of the NTT manager, which in turn wraps the transfer itself:
+// https://github.com/wormhole-foundation/native-token-transfers/blob/main/evm/src/libraries/TransceiverStructs.sol
+package ntt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	// TransceiverPrefix starts the message of a Wormhole NTT transceiver.
+	TransceiverPrefix = []byte{0x99, 0x45, 0xFF, 0x10}
+	// TransferPrefix starts a native token transfer in the payload of the NTT manager message.
+	TransferPrefix = []byte{0x99, 0x4E, 0x54, 0x54}
+)
+
+const (
+	// TransferPrefixOffset is the offset of the native token transfer in the transceiver message: the transceiver
+	// prefix, the source and recipient managers and the length of the manager message, then the message ID, the sender
+	// and the length of the manager payload.
+	TransferPrefixOffset = 4 + 32 + 32 + 2 + 32 + 32 + 2
+	// transferMinLength is the length of a native token transfer without the optional additional payload.
+	transferMinLength = 4 + 1 + 8 + 32 + 32 + 2
+	// MaxDecimals is the maximum number of decimals of a transfer amount, which are trimmed to eight decimals.
+	MaxDecimals = 8
+)
+
+// Transfer is a decoded native token transfer.
+type Transfer struct {
+	// Decimals is the number of decimals of Amount, which is at most eight.
+	Decimals    uint8
+	Amount      uint64
+	SourceToken vaa.Address
+	To          vaa.Address
+	ToChain     vaa.ChainID
+}
+
+// IsTransfer returns true if the payload looks like a native token transfer. It does not check the emitter, nor that
+// the payload can be decoded.
+func IsTransfer(payload []byte) bool {
+	return len(payload) >= TransferPrefixOffset+len(TransferPrefix) &&
+		bytes.Equal(payload[:len(TransceiverPrefix)], TransceiverPrefix) &&
+		bytes.Equal(payload[TransferPrefixOffset:TransferPrefixOffset+len(TransferPrefix)], TransferPrefix)
+}
+
+// DecodeTransfer decodes the transceiver message of a native token transfer. Every length in the message is checked,
+// so that a malformed message is not decoded with a truncated or misaligned amount.
+func DecodeTransfer(payload []byte) (*Transfer, error) {
+	if !IsTransfer(payload) {
+		return nil, fmt.Errorf("not a native token transfer")
+	}
+
+	reader := bytes.NewReader(payload[len(TransceiverPrefix):])
+
+	// Skip the source and recipient NTT managers, which are bound to the transceiver that emitted the message.
+	var managers [64]byte
+	if n, err := reader.Read(managers[:]); err != nil || n != len(managers) {
+		return nil, fmt.Errorf("failed to read NTT managers")
+	}
+
+	managerMsg, err := readLengthPrefixed(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read NTT manager message: %w", err)
+	}
+
+	// Any transceiver specific payload follows the manager message.
+	if _, err := readLengthPrefixed(reader); err != nil {
+		return nil, fmt.Errorf("failed to read NTT transceiver payload: %w", err)
+	}
+	if reader.Len() != 0 {
+		return nil, fmt.Errorf("NTT transceiver message has %d trailing bytes", reader.Len())
+	}
+
+	reader = bytes.NewReader(managerMsg)
+
+	// Skip the message ID and the sender.
+	var header [64]byte
+	if n, err := reader.Read(header[:]); err != nil || n != len(header) {
+		return nil, fmt.Errorf("failed to read NTT manager message header")
+	}
+
+	transfer, err := readLengthPrefixed(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read NTT manager payload: %w", err)
+	}
+	if reader.Len() != 0 {
+		return nil, fmt.Errorf("NTT manager message has %d trailing bytes", reader.Len())
+	}
+
+	if len(transfer) < transferMinLength {
+		return nil, fmt.Errorf("native token transfer is too short: %d bytes", len(transfer))
+	}
+
+	nt := &Transfer{}
+	reader = bytes.NewReader(transfer[len(TransferPrefix):])
+	if err := binary.Read(reader, binary.BigEndian, &nt.Decimals); err != nil {
+		return nil, fmt.Errorf("failed to read NTT decimals: %w", err)
+	}
+	if nt.Decimals > MaxDecimals {
+		return nil, fmt.Errorf("invalid number of NTT decimals: %d", nt.Decimals)
+	}
+	if err := binary.Read(reader, binary.BigEndian, &nt.Amount); err != nil {
+		return nil, fmt.Errorf("failed to read NTT amount: %w", err)
+	}
+	if err := binary.Read(reader, binary.BigEndian, &nt.SourceToken); err != nil {
+		return nil, fmt.Errorf("failed to read NTT source token: %w", err)
+	}
+	if err := binary.Read(reader, binary.BigEndian, &nt.To); err != nil {
+		return nil, fmt.Errorf("failed to read NTT recipient: %w", err)
+	}
+	if err := binary.Read(reader, binary.BigEndian, &nt.ToChain); err != nil {
+		return nil, fmt.Errorf("failed to read NTT target chain: %w", err)
+	}
+
+	// The rest is an optional additional payload, which is not decoded.
+	return nt, nil
+}
+
+// readLengthPrefixed reads a field prefixed by its length on two bytes.
+func readLengthPrefixed(reader *bytes.Reader) ([]byte, error) {
+	var length uint16
+	if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	if int(length) > reader.Len() {
+		return nil, fmt.Errorf("length %d exceeds the remaining %d bytes", length, reader.Len())
+	}
+	b := make([]byte, length)
+	if _, err := reader.Read(b); err != nil && length != 0 {
+		return nil, err
+	}
+	return b, nil
+}
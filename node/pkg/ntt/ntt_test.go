@@ -0,0 +1,95 @@
+package ntt
+
+import (
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	testTokenAddr = "0x707f9118e33a9b8998bea41dd0d46f38bb963fc8"
+	testToAddr    = "0x0fd04a68d3c3a692d6fa30384d1a87ef93554ee6"
+)
+
+// buildTransferPayload builds the transceiver message of a native token transfer.
+func buildTransferPayload(t *testing.T, decimals uint8, amount uint64, toChainID vaa.ChainID) []byte {
+	t.Helper()
+	transfer := append([]byte{}, TransferPrefix...)
+	transfer = append(transfer, decimals)
+	transfer = binary.BigEndian.AppendUint64(transfer, amount)
+	tokenAddr, err := vaa.StringToAddress(testTokenAddr)
+	require.NoError(t, err)
+	transfer = append(transfer, tokenAddr.Bytes()...)
+	toAddr, err := vaa.StringToAddress(testToAddr)
+	require.NoError(t, err)
+	transfer = append(transfer, toAddr.Bytes()...)
+	transfer = binary.BigEndian.AppendUint16(transfer, uint16(toChainID))
+
+	// Message ID and sender.
+	managerMsg := make([]byte, 64)
+	managerMsg[31] = 1
+	managerMsg = binary.BigEndian.AppendUint16(managerMsg, uint16(len(transfer))) // #nosec G115 -- The transfer is short
+	managerMsg = append(managerMsg, transfer...)
+
+	// Source and recipient NTT managers.
+	payload := append([]byte{}, TransceiverPrefix...)
+	payload = append(payload, make([]byte, 64)...)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(len(managerMsg))) // #nosec G115 -- The message is short
+	payload = append(payload, managerMsg...)
+	// Empty transceiver payload.
+	return binary.BigEndian.AppendUint16(payload, 0)
+}
+
+func TestDecodeTransfer(t *testing.T) {
+	payload := buildTransferPayload(t, 8, 125_000_000, vaa.ChainIDSolana)
+	assert.True(t, IsTransfer(payload))
+	assert.False(t, vaa.IsTransfer(payload))
+
+	nt, err := DecodeTransfer(payload)
+	require.NoError(t, err)
+	assert.Equal(t, uint8(8), nt.Decimals)
+	assert.Equal(t, uint64(125_000_000), nt.Amount)
+	assert.Equal(t, vaa.ChainIDSolana, nt.ToChain)
+	tokenAddr, err := vaa.StringToAddress(testTokenAddr)
+	require.NoError(t, err)
+	assert.Equal(t, tokenAddr, nt.SourceToken)
+	toAddr, err := vaa.StringToAddress(testToAddr)
+	require.NoError(t, err)
+	assert.Equal(t, toAddr, nt.To)
+
+	// The lengths of the manager message and of the transfer are at offsets 68 and 134.
+	tests := []struct {
+		name    string
+		corrupt func(p []byte) []byte
+	}{
+		{"not a transceiver message", func(p []byte) []byte { p[0] = 0x01; return p }},
+		{"not a transfer", func(p []byte) []byte { p[TransferPrefixOffset] = 0x01; return p }},
+		{"truncated", func(p []byte) []byte { return p[:len(p)-1] }},
+		{"trailing bytes", func(p []byte) []byte { return append(p, 0) }},
+		{"manager message too long", func(p []byte) []byte { p[69]++; return p }},
+		{"manager message too short", func(p []byte) []byte { p[69]--; return p }},
+		{"transfer too long", func(p []byte) []byte { p[135]++; return p }},
+		{"transfer too short", func(p []byte) []byte { return append(p[:TransferPrefixOffset+len(TransferPrefix)], 0, 0) }},
+		{"too many decimals", func(p []byte) []byte { p[140] = 9; return p }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			corrupted := tc.corrupt(slices.Clone(payload))
+			_, err := DecodeTransfer(corrupted)
+			require.Error(t, err)
+		})
+	}
+
+	// A transfer may carry an additional payload.
+	withAdditionalPayload := slices.Clone(payload[:len(payload)-2])
+	withAdditionalPayload[69] += 4
+	withAdditionalPayload[135] += 4
+	withAdditionalPayload = append(withAdditionalPayload, 0, 2, 0xab, 0xcd, 0, 0)
+	nt, err = DecodeTransfer(withAdditionalPayload)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(125_000_000), nt.Amount)
+}
@@ -178,7 +178,7 @@ func createProcessorForTest(b *testing.B, numVAAs int, ctx context.Context, db *
 		gst:                    gst,
 		db:                     db,
 		logger:                 logger,
-		state:                  &aggregationState{observationMap{}},
+		state:                  &aggregationState{signatures: observationMap{}},
 		ourAddr:                crypto.PubkeyToAddress(ourSigner.PublicKey(context.Background())),
 		pythnetVaas:            make(map[string]PythNetVaaEntry),
 		updatedVAAs:            make(map[string]*updateVaaEntry),
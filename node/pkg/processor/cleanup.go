@@ -90,8 +90,14 @@ var (
 func (p *Processor) handleCleanup(ctx context.Context) {
 	p.logger.Info("aggregation state summary", zap.Int("cached", len(p.state.signatures)))
 	aggregationStateEntries.Set(float64(len(p.state.signatures)))
+	p.messageTracer.ExpireTraces(time.Now())
 
 	for hash, s := range p.state.signatures {
+		if p.gs == nil {
+			// Only states reloaded from the database exist before the guardian set is known, and settling them needs it.
+			break
+		}
+
 		delta := time.Since(s.firstObserved)
 
 		if !s.submitted && s.ourObservation != nil && delta > settlementTime {
@@ -123,6 +129,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 			// arrive, barring special circumstances. This is a better time to count misses than submission,
 			// because we submit right when we quorum rather than waiting for all observations to arrive.
 			s.settled = true
+			s.dirty = true
 
 			// Use either the most recent (in case of a observation we haven't seen) or stored gs, if available.
 			var gs *common.GuardianSet
@@ -259,6 +266,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 					}
 					s.retryCtr++
 					s.nextRetry = time.Now().Add(nextRetryDuration(s.retryCtr))
+					s.dirty = true
 					aggregationStateRetries.Inc()
 				}
 			} else {
@@ -283,6 +291,9 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 		}
 	}
 
+	// Write the states that changed and delete the expired ones, so that they survive a restart.
+	p.persistAggregationState()
+
 	// Clean up old pythnet VAAs.
 	oldestTime := time.Now().Add(-time.Hour)
 	for key, pe := range p.pythnetVaas {
@@ -11,11 +11,13 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/tracing"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -66,6 +68,8 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 		Reobservation: k.IsReobservation,
 	}
 
+	p.messageTracer.StartStage(k.MessageIDString(), tracing.StageSign)
+
 	// Generate digest of the unsigned VAA.
 	digest := v.SigningDigest()
 	hash := hex.EncodeToString(digest.Bytes())
@@ -95,6 +99,10 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 
 	// Broadcast the signature.
 	ourObs, msg := p.broadcastSignature(v.MessageID(), k, digest, signature, shouldPublishImmediately)
+	p.messageTracer.StartStage(k.MessageIDString(), tracing.StageQuorum,
+		attribute.String("wormhole.digest", hash),
+		attribute.Bool("wormhole.published_immediately", shouldPublishImmediately),
+	)
 
 	// Indicate that we observed this one.
 	observationsReceivedTotal.Inc()
@@ -132,6 +140,7 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 	s.signatures[p.ourAddr] = signature
 	s.ourObs = ourObs
 	s.ourMsg = msg
+	s.dirty = true
 
 	// Fast path for our own signature.
 	if !s.submitted {
@@ -11,6 +11,7 @@ import (
 	node_common "github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
 	guardianNotary "github.com/certusone/wormhole/node/pkg/notary"
+	"github.com/certusone/wormhole/node/pkg/tracing"
 	"github.com/mr-tron/base58"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -184,10 +185,12 @@ func (p *Processor) processWithNotary(k *node_common.MessagePublication) bool {
 	// Notary: check whether a message is well-formed.
 	if p.notary != nil {
 		p.logger.Debug("processor: sending message to notary for evaluation", k.ZapFields()...)
+		p.messageTracer.StartStage(k.MessageIDString(), tracing.StageNotary)
 
 		// NOTE: Always returns Approve for messages that are not token transfers.
 		verdict, err := p.notary.ProcessMsg(k)
 		if err != nil {
+			p.messageTracer.Dropped(k.MessageIDString(), "notary failed to process message")
 			// TODO: The error is deliberately ignored so that the processor does not panic and restart.
 			// In contrast, the Accountant does not ignore the error and restarts the processor if it fails.
 			// The error-handling strategy can be revisited once the Notary is considered stable.
@@ -202,9 +205,11 @@ func (p *Processor) processWithNotary(k *node_common.MessagePublication) bool {
 			if verdict == guardianNotary.Blackhole {
 				// Black-holed messages should not be processed.
 				p.logger.Error("message will not be processed", k.ZapFields(zap.String("verdict", verdict.String()))...)
+				p.messageTracer.Dropped(k.MessageIDString(), "black-holed by the notary")
 			} else {
 				// Delayed messages are added to a separate queue and processed elsewhere.
 				p.logger.Error("message will be delayed", k.ZapFields(zap.String("verdict", verdict.String()))...)
+				p.messageTracer.Event(k.MessageIDString(), "notary delayed message")
 			}
 			// We're done processing the message.
 			return false
@@ -230,8 +235,10 @@ func (p *Processor) processWithGovernor(k *node_common.MessagePublication) bool
 	}
 
 	if p.governor != nil {
+		p.messageTracer.StartStage(k.MessageIDString(), tracing.StageGovernor)
 		if !p.governor.ProcessMsg(k) {
 			// We're done processing the message.
+			p.messageTracer.Event(k.MessageIDString(), "governor delayed message")
 			return false
 		}
 	}
@@ -248,12 +255,14 @@ func (p *Processor) processWithAccountant(ctx context.Context, k *node_common.Me
 	}
 
 	if p.acct != nil {
+		p.messageTracer.StartStage(k.MessageIDString(), tracing.StageAccountant)
 		shouldPub, err := p.acct.SubmitObservation(k)
 		if err != nil {
 			return fmt.Errorf("accountant: failed to process message `%s`: %w", k.MessageIDString(), err)
 		}
 		if !shouldPub {
 			// We're done processing the message.
+			p.messageTracer.Event(k.MessageIDString(), "accountant is waiting for quorum")
 			return nil
 		}
 	}
@@ -446,6 +455,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 	}
 
 	s.signatures[their_addr] = m.Signature
+	s.dirty = true
 
 	if s.ourObservation != nil {
 		p.checkForQuorum(m, s, gs, hash)
@@ -529,6 +539,7 @@ func (p *Processor) checkForQuorum(m *gossipv1.Observation, s *state, gs *node_c
 	start := time.Now()
 	s.ourObservation.HandleQuorum(sigsVaaFormat, hash, p)
 	s.submitted = true
+	p.messageTracer.Completed(m.MessageId)
 	timeToHandleQuorum.Observe(float64(time.Since(start).Microseconds()))
 }
 
@@ -597,6 +608,10 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(m *gossipv1.SignedVAAWithQu
 	//  - the signature's addresses match the node's current guardian set
 	//  - enough signatures are present for the VAA to reach quorum
 
+	// The VAA may reach quorum on other guardians before it does here.
+	p.messageTracer.Event(v.MessageID(), "received signed VAA from gossip")
+	p.messageTracer.Completed(v.MessageID())
+
 	// Store signed VAA in database.
 	if p.logger.Level().Enabled(zapcore.DebugLevel) {
 		p.logger.Debug("storing inbound signed VAA with quorum",
@@ -607,7 +622,13 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(m *gossipv1.SignedVAAWithQu
 		)
 	}
 
-	p.storeSignedVAA(v)
+	// The transaction hash is only known if we observed the message ourselves.
+	var txHash []byte
+	if s, exists := p.state.signatures[hex.EncodeToString(v.SigningDigest().Bytes())]; exists {
+		txHash = s.txHash
+	}
+
+	p.storeSignedVAA(v, txHash)
 }
 
 // handleSignedDelegateObservation processes a signed delegate observation
@@ -0,0 +1,200 @@
+package processor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	guardianDB "github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// The aggregation state of observations that have not reached quorum is written to the database by handleCleanup,
+// so that the signatures we collected are not lost on restart. Most observations reach quorum within seconds, long
+// before the next cleanup, so they are never written. The states are reloaded by NewProcessor and then expire
+// through handleCleanup as if the guardian had not restarted, since their first observation and retry times are kept.
+
+var (
+	aggregationStatePersisted = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_aggregation_state_persisted_entries",
+			Help: "Current number of aggregation state entries stored in the database",
+		})
+	aggregationStateReloaded = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_aggregation_state_reloaded_total",
+			Help: "Total number of aggregation state entries reloaded from the database on startup",
+		})
+)
+
+// loadAggregationState adds the aggregation states stored in the database to the in-memory state.
+func (p *Processor) loadAggregationState() {
+	stored, err := p.db.LoadAggregationStates(p.logger)
+	if err != nil {
+		p.logger.Error("failed to load aggregation state from the database", zap.Error(err))
+		return
+	}
+
+	for _, entry := range stored {
+		hash := hex.EncodeToString(entry.Digest)
+		s, err := aggregationStateFromDB(entry, p.ourAddr)
+		if err != nil {
+			p.logger.Error("failed to restore aggregation state", zap.String("digest", hash), zap.Error(err))
+			continue
+		}
+
+		p.state.signatures[hash] = s
+		p.state.persisted[hash] = struct{}{}
+	}
+
+	aggregationStateReloaded.Add(float64(len(p.state.persisted)))
+	aggregationStatePersisted.Set(float64(len(p.state.persisted)))
+	p.logger.Info("reloaded aggregation state", zap.Int("entries", len(p.state.persisted)))
+}
+
+// persistAggregationState writes the states that changed since the last call to the database, and deletes the ones
+// that expired or reached quorum.
+func (p *Processor) persistAggregationState() {
+	if p.db == nil {
+		return
+	}
+	if p.state.persisted == nil {
+		p.state.persisted = map[string]struct{}{}
+	}
+
+	var updated []*guardianDB.AggregationState
+	var deleted [][]byte
+	var updatedHashes, deletedHashes []string
+
+	for hash := range p.state.persisted {
+		if s, ok := p.state.signatures[hash]; !ok || s.submitted {
+			digest, err := hex.DecodeString(hash)
+			if err != nil {
+				p.logger.Error("invalid aggregation state digest", zap.String("digest", hash), zap.Error(err))
+				delete(p.state.persisted, hash)
+				continue
+			}
+			deleted = append(deleted, digest)
+			deletedHashes = append(deletedHashes, hash)
+		}
+	}
+
+	for hash, s := range p.state.signatures {
+		if !s.dirty || s.submitted || !shouldPersist(s) {
+			continue
+		}
+		entry, err := aggregationStateToDB(hash, s)
+		if err != nil {
+			p.logger.Error("failed to persist aggregation state", zap.String("message_id", s.LoggingID()), zap.String("digest", hash), zap.Error(err))
+			continue
+		}
+		updated = append(updated, entry)
+		updatedHashes = append(updatedHashes, hash)
+	}
+
+	if err := p.db.UpdateAggregationStates(updated, deleted); err != nil {
+		// The dirty flags are kept so that the write is attempted again on the next cleanup.
+		p.logger.Error("failed to write aggregation state to the database", zap.Int("updated", len(updated)), zap.Int("deleted", len(deleted)), zap.Error(err))
+		return
+	}
+
+	for _, hash := range updatedHashes {
+		p.state.signatures[hash].dirty = false
+		p.state.persisted[hash] = struct{}{}
+	}
+	for _, hash := range deletedHashes {
+		delete(p.state.persisted, hash)
+	}
+	aggregationStatePersisted.Set(float64(len(p.state.persisted)))
+}
+
+// shouldPersist returns whether a state is worth keeping across restarts. PythNet VAAs are only kept in memory,
+// and so are their pending signatures.
+func shouldPersist(s *state) bool {
+	return s.ourObservation == nil || s.ourObservation.GetEmitterChain() != vaa.ChainIDPythNet
+}
+
+// aggregationStateToDB converts a state to its database representation.
+func aggregationStateToDB(hash string, s *state) (*guardianDB.AggregationState, error) {
+	digest, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid digest: %w", err)
+	}
+
+	entry := &guardianDB.AggregationState{
+		Digest:        digest,
+		FirstObserved: s.firstObserved,
+		NextRetry:     s.nextRetry,
+		RetryCtr:      s.retryCtr,
+		Settled:       s.settled,
+		Source:        s.source,
+		Signatures:    s.signatures,
+		TxHash:        s.txHash,
+	}
+
+	if s.ourObservation != nil {
+		v, ok := s.ourObservation.(*VAA)
+		if !ok {
+			return nil, fmt.Errorf("unsupported observation type %T", s.ourObservation)
+		}
+		entry.Observation, err = v.VAA.Marshal()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal observation: %w", err)
+		}
+		entry.Unreliable = v.Unreliable
+		entry.Reobservation = v.Reobservation
+	}
+
+	if s.gs != nil {
+		entry.GuardianSetIndex = s.gs.Index
+		entry.GuardianSetKeys = s.gs.Keys
+	}
+
+	return entry, nil
+}
+
+// aggregationStateFromDB restores a state from its database representation. Our gossip observation is rebuilt from
+// our signature, so that it can be resubmitted by handleCleanup.
+func aggregationStateFromDB(entry *guardianDB.AggregationState, ourAddr ethcommon.Address) (*state, error) {
+	s := &state{
+		firstObserved: entry.FirstObserved,
+		nextRetry:     entry.NextRetry,
+		retryCtr:      entry.RetryCtr,
+		signatures:    entry.Signatures,
+		settled:       entry.Settled,
+		source:        entry.Source,
+		txHash:        entry.TxHash,
+	}
+	if s.signatures == nil {
+		s.signatures = map[ethcommon.Address][]byte{}
+	}
+
+	if entry.Observation != nil {
+		v, err := vaa.Unmarshal(entry.Observation)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal observation: %w", err)
+		}
+		if len(entry.GuardianSetKeys) == 0 {
+			return nil, fmt.Errorf("observation has no guardian set")
+		}
+
+		s.ourObservation = &VAA{VAA: *v, Unreliable: entry.Unreliable, Reobservation: entry.Reobservation}
+		s.gs = common.NewGuardianSet(entry.GuardianSetKeys, entry.GuardianSetIndex)
+
+		if signature, ok := s.signatures[ourAddr]; ok {
+			s.ourObs = &gossipv1.Observation{
+				Hash:      entry.Digest,
+				Signature: signature,
+				TxHash:    entry.TxHash,
+				MessageId: v.MessageID(),
+			}
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,123 @@
+package processor
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	guardianDB "github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPersistAggregationState(t *testing.T) {
+	db := guardianDB.OpenDb(zap.NewNop(), nil)
+	t.Cleanup(func() { _ = db.Close() })
+
+	ourAddr := ethcommon.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")
+	theirAddr := ethcommon.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157")
+	gs := common.NewGuardianSet([]ethcommon.Address{ourAddr, theirAddr}, 3)
+	firstObserved := time.Unix(1700000000, 0).UTC()
+
+	newProcessor := func() *Processor {
+		return &Processor{
+			db:      db,
+			logger:  zap.NewNop(),
+			ourAddr: ourAddr,
+			state:   &aggregationState{signatures: observationMap{}, persisted: map[string]struct{}{}},
+		}
+	}
+
+	ourVAA := &VAA{VAA: getVAA(), Reobservation: true}
+	ourHash := hex.EncodeToString(ourVAA.SigningDigest().Bytes())
+	ours := &state{
+		firstObserved:  firstObserved,
+		nextRetry:      firstObserved.Add(FirstRetryMinWait),
+		retryCtr:       1,
+		ourObservation: ourVAA,
+		signatures:     map[ethcommon.Address][]byte{ourAddr: {0x01}, theirAddr: {0x02}},
+		settled:        true,
+		source:         ourVAA.EmitterChain.String(),
+		ourObs: &gossipv1.Observation{
+			Hash:      ourVAA.SigningDigest().Bytes(),
+			Signature: []byte{0x01},
+			TxHash:    []byte{0xaa},
+			MessageId: ourVAA.MessageID(),
+		},
+		txHash: []byte{0xaa},
+		gs:     gs,
+		dirty:  true,
+	}
+
+	theirHash := hex.EncodeToString([]byte{0x02})
+	theirs := &state{
+		firstObserved: firstObserved,
+		nextRetry:     firstObserved.Add(FirstRetryMinWait),
+		signatures:    map[ethcommon.Address][]byte{theirAddr: {0x03}},
+		source:        "unknown",
+		dirty:         true,
+	}
+
+	pythVAA := &VAA{VAA: getVAA()}
+	pythVAA.EmitterChain = vaa.ChainIDPythNet
+	pythHash := hex.EncodeToString(pythVAA.SigningDigest().Bytes())
+
+	submittedHash := hex.EncodeToString([]byte{0x04})
+
+	p := newProcessor()
+	p.state.signatures[ourHash] = ours
+	p.state.signatures[theirHash] = theirs
+	p.state.signatures[pythHash] = &state{ourObservation: pythVAA, signatures: map[ethcommon.Address][]byte{}, dirty: true}
+	p.state.signatures[submittedHash] = &state{signatures: map[ethcommon.Address][]byte{}, submitted: true, dirty: true}
+	p.persistAggregationState()
+
+	// PythNet and submitted states are not written.
+	assert.Equal(t, map[string]struct{}{ourHash: {}, theirHash: {}}, p.state.persisted)
+	assert.False(t, ours.dirty)
+
+	// The states are reloaded as they were. Our gossip observation is rebuilt from our signature.
+	reloaded := newProcessor()
+	reloaded.loadAggregationState()
+	require.Len(t, reloaded.state.signatures, 2)
+	s := reloaded.state.signatures[ourHash]
+	require.NotNil(t, s)
+	assert.True(t, s.firstObserved.Equal(ours.firstObserved))
+	assert.True(t, s.nextRetry.Equal(ours.nextRetry))
+	assert.Equal(t, ours.retryCtr, s.retryCtr)
+	require.NotNil(t, s.ourObservation)
+	assert.Equal(t, ourVAA.SigningDigest(), s.ourObservation.SigningDigest())
+	assert.True(t, s.ourObservation.IsReobservation())
+	assert.True(t, s.ourObservation.IsReliable())
+	assert.Equal(t, ours.signatures, s.signatures)
+	assert.Equal(t, ours.settled, s.settled)
+	assert.Equal(t, ours.source, s.source)
+	assert.True(t, proto.Equal(ours.ourObs, s.ourObs))
+	assert.Equal(t, ours.txHash, s.txHash)
+	assert.Equal(t, gs.Keys, s.gs.Keys)
+	assert.Equal(t, gs.Index, s.gs.Index)
+	assert.False(t, s.dirty)
+
+	s = reloaded.state.signatures[theirHash]
+	require.NotNil(t, s)
+	assert.Equal(t, theirs.signatures, s.signatures)
+	assert.Nil(t, s.ourObservation)
+	assert.Nil(t, s.ourObs)
+	assert.Nil(t, s.gs)
+	assert.Equal(t, p.state.persisted, reloaded.state.persisted)
+
+	// Expired and submitted states are deleted.
+	delete(p.state.signatures, theirHash)
+	ours.submitted = true
+	p.persistAggregationState()
+	assert.Empty(t, p.state.persisted)
+
+	reloaded = newProcessor()
+	reloaded.loadAggregationState()
+	assert.Empty(t, reloaded.state.signatures)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/gwrelayer"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/tracing"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -80,6 +81,8 @@ type (
 		txHash []byte
 		// Copy of the guardian set valid at observation/injection time.
 		gs *common.GuardianSet
+		// Flag set when the state changed since it was last written to the database.
+		dirty bool
 	}
 
 	observationMap map[string]*state
@@ -87,6 +90,8 @@ type (
 	// aggregationState represents the node's aggregation of guardian signatures.
 	aggregationState struct {
 		signatures observationMap
+		// persisted holds the digests of the states stored in the database.
+		persisted map[string]struct{}
 	}
 
 	// delegateState represents the local view of a given delegate observation
@@ -210,12 +215,17 @@ type Processor struct {
 
 	// managerC is the channel used to send signed VAAs to the manager service (nil if manager service is disabled)
 	managerC chan<- *vaa.VAA
+
+	// messageTracer traces messages through the notary, governor and accountant until quorum (nil disables tracing)
+	messageTracer *tracing.MessageTracer
 }
 
 // updateVaaEntry is used to queue up a VAA to be written to the database.
 type updateVaaEntry struct {
-	v     *vaa.VAA
-	dirty bool
+	v *vaa.VAA
+	// txHash is the hash of the transaction that emitted the message, if known. It is added to the transaction hash index.
+	txHash []byte
+	dirty  bool
 }
 
 var (
@@ -309,9 +319,10 @@ func NewProcessor(
 	alternatePublisher *altpub.AlternatePublisher,
 	delegatedGuardiansEnabled bool,
 	managerC chan<- *vaa.VAA,
+	messageTracer *tracing.MessageTracer,
 ) *Processor {
 
-	return &Processor{
+	p := &Processor{
 		msgC:                            msgC,
 		setC:                            setC,
 		dgConfigC:                       dgConfigC,
@@ -329,7 +340,7 @@ func NewProcessor(
 
 		logger:                    supervisor.Logger(ctx),
 		gs:                        nil,
-		state:                     &aggregationState{observationMap{}},
+		state:                     &aggregationState{signatures: observationMap{}, persisted: map[string]struct{}{}},
 		delegateState:             &delegateAggregationState{delegateObservationMap{}},
 		ourAddr:                   crypto.PubkeyToAddress(guardianSigner.PublicKey(ctx)),
 		governor:                  g,
@@ -345,7 +356,14 @@ func NewProcessor(
 		dgc:                       dgc,
 		delegatedGuardiansEnabled: delegatedGuardiansEnabled,
 		managerC:                  managerC,
+		messageTracer:             messageTracer,
+	}
+
+	if db != nil {
+		p.loadAggregationState()
 	}
+
+	return p
 }
 
 func (p *Processor) Run(ctx context.Context) error {
@@ -590,6 +608,7 @@ func (p *Processor) Run(ctx context.Context) error {
 			if !p.acct.IsMessageCoveredByAccountant(k) {
 				return fmt.Errorf("accountant published a message that is not covered by it: `%s`", k.MessageIDString())
 			}
+			p.messageTracer.Event(k.MessageIDString(), "accountant released message")
 			p.handleMessage(ctx, k)
 		case m := <-p.batchObsvC:
 			if m == nil {
@@ -693,8 +712,8 @@ func (p *Processor) Run(ctx context.Context) error {
 	}
 }
 
-// storeSignedVAA schedules a database update for a VAA.
-func (p *Processor) storeSignedVAA(v *vaa.VAA) {
+// storeSignedVAA schedules a database update for a VAA. The transaction hash may be nil if it is not known.
+func (p *Processor) storeSignedVAA(v *vaa.VAA, txHash []byte) {
 	if v.EmitterChain == vaa.ChainIDPythNet {
 		key := fmt.Sprintf("%v/%v", v.EmitterAddress, v.Sequence)
 		p.pythnetVaas[key] = PythNetVaaEntry{v: v, updateTime: time.Now()}
@@ -702,7 +721,7 @@ func (p *Processor) storeSignedVAA(v *vaa.VAA) {
 	}
 	key := fmt.Sprintf("%d/%v/%v", v.EmitterChain, v.EmitterAddress, v.Sequence)
 	p.updateVAALock.Lock()
-	p.updatedVAAs[key] = &updateVaaEntry{v: v, dirty: true}
+	p.updatedVAAs[key] = &updateVaaEntry{v: v, txHash: txHash, dirty: true}
 	p.updateVAALock.Unlock()
 
 	// Send to manager service if enabled
@@ -784,14 +803,22 @@ func (p *Processor) vaaWriter(ctx context.Context) error {
 			if updatedVAAs != nil {
 				// If there's anything to write, do that.
 				vaaBatch := make([]*vaa.VAA, 0, len(updatedVAAs))
+				txHashEntries := make([]guardianDB.TxHashIndexEntry, 0, len(updatedVAAs))
 				for _, entry := range updatedVAAs {
 					vaaBatch = append(vaaBatch, entry.v)
+					if entry.txHash != nil {
+						txHashEntries = append(txHashEntries, guardianDB.TxHashIndexEntry{TxHash: entry.txHash, VaaID: *guardianDB.VaaIDFromVAA(entry.v)})
+					}
 				}
 
 				if err := p.db.StoreSignedVAABatch(vaaBatch); err != nil {
 					p.logger.Error("failed to write VAAs to database", zap.Int("numVAAs", len(vaaBatch)), zap.Error(err))
 				}
 
+				if err := p.db.StoreTxHashIndexBatch(txHashEntries); err != nil {
+					p.logger.Error("failed to write transaction hash index to database", zap.Int("numEntries", len(txHashEntries)), zap.Error(err))
+				}
+
 				// Go through the map and delete anything we have written that hasn't been updated again.
 				// If something has been updated again, it will get written next interval.
 				p.updateVAALock.Lock()
@@ -0,0 +1,111 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/tracing"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.uber.org/zap"
+)
+
+// TestMessageTracing follows a message from the watcher to quorum, with a guardian set of two guardians.
+func TestMessageTracing(t *testing.T) {
+	ctx := context.Background()
+
+	ourSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	theirSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	ourAddr := crypto.PubkeyToAddress(ourSigner.PublicKey(ctx))
+	theirAddr := crypto.PubkeyToAddress(theirSigner.PublicKey(ctx))
+
+	gs := common.NewGuardianSet([]ethCommon.Address{ourAddr, theirAddr}, 0)
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(gs)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := tracing.NewMessageTracer(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	p := &Processor{
+		gossipAttestationSendC: make(chan []byte, 10),
+		gossipVaaSendC:         make(chan []byte, 10),
+		batchObsvPubC:          make(chan *gossipv1.Observation, 10),
+		guardianSigner:         ourSigner,
+		gs:                     gs,
+		gst:                    gst,
+		logger:                 zap.NewNop(),
+		state:                  &aggregationState{signatures: observationMap{}},
+		ourAddr:                ourAddr,
+		pythnetVaas:            make(map[string]PythNetVaaEntry),
+		updatedVAAs:            make(map[string]*updateVaaEntry),
+		messageTracer:          tracer,
+	}
+
+	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	require.NoError(t, err)
+	k := &common.MessagePublication{
+		TxID:             ethCommon.HexToHash("0x01").Bytes(),
+		Timestamp:        time.Unix(1700000000, 0),
+		Nonce:            42,
+		Sequence:         1,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddress,
+		Payload:          []byte{0x01, 0x02, 0x03, 0x04},
+		ConsistencyLevel: 32,
+	}
+
+	tracer.Emitted(k)
+	require.NoError(t, p.handleMessagePublication(ctx, k))
+
+	// Our observation alone does not reach quorum, so the trace is still open.
+	assert.Empty(t, spanNames(exporter.GetSpans(), "message"))
+
+	// The observation of the other guardian completes the quorum.
+	ourObs := <-p.batchObsvPubC
+	signature, err := theirSigner.Sign(ctx, ourObs.Hash)
+	require.NoError(t, err)
+	p.handleSingleObservation(theirAddr.Bytes(), &gossipv1.Observation{
+		Hash:      ourObs.Hash,
+		Signature: signature,
+		TxHash:    ourObs.TxHash,
+		MessageId: ourObs.MessageId,
+	})
+
+	spans := exporter.GetSpans()
+	roots := spanNames(spans, "message")
+	require.Len(t, roots, 1)
+	root := roots[0]
+	assert.Equal(t, codes.Ok, root.Status.Code)
+
+	// The stages are the children of the root span, in order.
+	var stages []string
+	for _, span := range spans {
+		if span.Parent.SpanID() == root.SpanContext.SpanID() {
+			stages = append(stages, span.Name)
+		}
+	}
+	assert.Equal(t, []string{tracing.StageQueue, tracing.StageSign, tracing.StageQuorum}, stages)
+}
+
+// spanNames returns the spans with the given name.
+func spanNames(spans tracetest.SpanStubs, name string) tracetest.SpanStubs {
+	var result tracetest.SpanStubs
+	for _, span := range spans {
+		if span.Name == name {
+			result = append(result, span)
+		}
+	}
+	return result
+}
@@ -32,9 +32,15 @@ func (v *VAA) HandleQuorum(sigs []*vaa.Signature, hash string, p *Processor) {
 		zap.String("digest", hash),
 	)
 
+	// The state of the observation holds the hash of the transaction that emitted the message.
+	var txHash []byte
+	if s, exists := p.state.signatures[hash]; exists {
+		txHash = s.txHash
+	}
+
 	// Broadcast the VAA and store it in the database.
 	p.broadcastSignedVAA(signed)
-	p.storeSignedVAA(signed)
+	p.storeSignedVAA(signed, txHash)
 }
 
 func (v *VAA) IsReliable() bool {
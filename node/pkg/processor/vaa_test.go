@@ -0,0 +1,31 @@
+package processor
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestHandleQuorumKeepsTxHash(t *testing.T) {
+	v := &VAA{VAA: getVAA()}
+	hash := hex.EncodeToString(v.SigningDigest().Bytes())
+	txHash := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	p := &Processor{
+		gossipVaaSendC: make(chan []byte, 1),
+		logger:         zap.NewNop(),
+		state:          &aggregationState{signatures: observationMap{hash: &state{txHash: txHash}}},
+		updatedVAAs:    make(map[string]*updateVaaEntry),
+	}
+
+	v.HandleQuorum([]*vaa.Signature{{Index: 0}}, hash, p)
+
+	// The VAA is queued for the database along with the hash of its transaction, for the transaction hash index.
+	entry, exists := p.updatedVAAs[v.MessageID()]
+	require.True(t, exists)
+	assert.Equal(t, txHash, entry.txHash)
+}
@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: geyser/geyser.proto
+
+/*
+Package geyser is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package geyser
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Suppress "imported and not used" errors
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+var _ = metadata.Join
+
+func request_Geyser_Subscribe_0(ctx context.Context, marshaler runtime.Marshaler, client GeyserClient, req *http.Request, pathParams map[string]string) (Geyser_SubscribeClient, runtime.ServerMetadata, error) {
+	var metadata runtime.ServerMetadata
+	stream, err := client.Subscribe(ctx)
+	if err != nil {
+		grpclog.Infof("Failed to start streaming: %v", err)
+		return nil, metadata, err
+	}
+	dec := marshaler.NewDecoder(req.Body)
+	handleSend := func() error {
+		var protoReq SubscribeRequest
+		err := dec.Decode(&protoReq)
+		if err == io.EOF {
+			return err
+		}
+		if err != nil {
+			grpclog.Infof("Failed to decode request: %v", err)
+			return err
+		}
+		if err := stream.Send(&protoReq); err != nil {
+			grpclog.Infof("Failed to send request: %v", err)
+			return err
+		}
+		return nil
+	}
+	go func() {
+		for {
+			if err := handleSend(); err != nil {
+				break
+			}
+		}
+		if err := stream.CloseSend(); err != nil {
+			grpclog.Infof("Failed to terminate client stream: %v", err)
+		}
+	}()
+	header, err := stream.Header()
+	if err != nil {
+		grpclog.Infof("Failed to get header from client: %v", err)
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+}
+
+// RegisterGeyserHandlerServer registers the http handlers for service Geyser to "mux".
+// UnaryRPC     :call GeyserServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterGeyserHandlerFromEndpoint instead.
+func RegisterGeyserHandlerServer(ctx context.Context, mux *runtime.ServeMux, server GeyserServer) error {
+
+	mux.Handle("POST", pattern_Geyser_Subscribe_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
+	return nil
+}
+
+// RegisterGeyserHandlerFromEndpoint is same as RegisterGeyserHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterGeyserHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterGeyserHandler(ctx, mux, conn)
+}
+
+// RegisterGeyserHandler registers the http handlers for service Geyser to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterGeyserHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterGeyserHandlerClient(ctx, mux, NewGeyserClient(conn))
+}
+
+// RegisterGeyserHandlerClient registers the http handlers for service Geyser
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "GeyserClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "GeyserClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "GeyserClient" to call the correct interceptors.
+func RegisterGeyserHandlerClient(ctx context.Context, mux *runtime.ServeMux, client GeyserClient) error {
+
+	mux.Handle("POST", pattern_Geyser_Subscribe_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req, "/geyser.Geyser/Subscribe", runtime.WithHTTPPathPattern("/geyser.Geyser/Subscribe"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_Geyser_Subscribe_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_Geyser_Subscribe_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_Geyser_Subscribe_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"geyser.Geyser", "Subscribe"}, ""))
+)
+
+var (
+	forward_Geyser_Subscribe_0 = runtime.ForwardResponseStream
+)
@@ -23,6 +23,7 @@ type NodePrivilegedServiceClient interface {
 	//
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
+	//
 	InjectGovernanceVAA(ctx context.Context, in *InjectGovernanceVAARequest, opts ...grpc.CallOption) (*InjectGovernanceVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
@@ -46,6 +47,10 @@ type NodePrivilegedServiceClient interface {
 	ChainGovernorReleasePendingVAA(ctx context.Context, in *ChainGovernorReleasePendingVAARequest, opts ...grpc.CallOption) (*ChainGovernorReleasePendingVAAResponse, error)
 	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the configured maximum.
 	ChainGovernorResetReleaseTimer(ctx context.Context, in *ChainGovernorResetReleaseTimerRequest, opts ...grpc.CallOption) (*ChainGovernorResetReleaseTimerResponse, error)
+	// ChainGovernorSetTokenLimit overrides the daily limit of a token leaving a chain.
+	ChainGovernorSetTokenLimit(ctx context.Context, in *ChainGovernorSetTokenLimitRequest, opts ...grpc.CallOption) (*ChainGovernorSetTokenLimitResponse, error)
+	// ChainGovernorSetCorridorLimit overrides the daily limit of the transfers from one chain to another.
+	ChainGovernorSetCorridorLimit(ctx context.Context, in *ChainGovernorSetCorridorLimitRequest, opts ...grpc.CallOption) (*ChainGovernorSetCorridorLimitResponse, error)
 	// NotaryBlackholeDelayedMessage blacklists a VAA from the notary pending list.
 	NotaryBlackholeDelayedMessage(ctx context.Context, in *NotaryBlackholeDelayedMessageRequest, opts ...grpc.CallOption) (*NotaryBlackholeDelayedMessageResponse, error)
 	// NotaryReleaseDelayedMessage releases a VAA from the notary pending list, publishing it immediately.
@@ -66,6 +71,13 @@ type NodePrivilegedServiceClient interface {
 	NotaryListDelayedMessages(ctx context.Context, in *NotaryListDelayedMessagesRequest, opts ...grpc.CallOption) (*NotaryListDelayedMessagesResponse, error)
 	// NotaryListBlackholedMessages lists all blackholed message IDs.
 	NotaryListBlackholedMessages(ctx context.Context, in *NotaryListBlackholedMessagesRequest, opts ...grpc.CallOption) (*NotaryListBlackholedMessagesResponse, error)
+	// NotaryAnnotateDelayedMessage adds a note from an operator to the review of a notary delayed VAA.
+	NotaryAnnotateDelayedMessage(ctx context.Context, in *NotaryAnnotateDelayedMessageRequest, opts ...grpc.CallOption) (*NotaryAnnotateDelayedMessageResponse, error)
+	// NotaryApproveDelayedMessage records the approval of an operator to release a notary delayed VAA.
+	// The VAA is released once it has been approved by the number of operators required by the notary.
+	NotaryApproveDelayedMessage(ctx context.Context, in *NotaryApproveDelayedMessageRequest, opts ...grpc.CallOption) (*NotaryApproveDelayedMessageResponse, error)
+	// NotaryListDelayedMessageReviews lists all notary delayed VAAs together with their annotations and approvals.
+	NotaryListDelayedMessageReviews(ctx context.Context, in *NotaryListDelayedMessageReviewsRequest, opts ...grpc.CallOption) (*NotaryListDelayedMessageReviewsResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(ctx context.Context, in *PurgePythNetVaasRequest, opts ...grpc.CallOption) (*PurgePythNetVaasResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
@@ -76,6 +88,8 @@ type NodePrivilegedServiceClient interface {
 	GetAndObserveMissingVAAs(ctx context.Context, in *GetAndObserveMissingVAAsRequest, opts ...grpc.CallOption) (*GetAndObserveMissingVAAsResponse, error)
 	// BroadcastDelegateSignatures fetches delegate observations from wormholescan and broadcasts them.
 	BroadcastDelegateSignatures(ctx context.Context, in *BroadcastDelegateSignaturesRequest, opts ...grpc.CallOption) (*BroadcastDelegateSignaturesResponse, error)
+	// ReloadWatchers reloads the watchers of the guardian config file, starting, stopping or updating the ones that changed.
+	ReloadWatchers(ctx context.Context, in *ReloadWatchersRequest, opts ...grpc.CallOption) (*ReloadWatchersResponse, error)
 }
 
 type nodePrivilegedServiceClient struct {
@@ -167,6 +181,24 @@ func (c *nodePrivilegedServiceClient) ChainGovernorResetReleaseTimer(ctx context
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) ChainGovernorSetTokenLimit(ctx context.Context, in *ChainGovernorSetTokenLimitRequest, opts ...grpc.CallOption) (*ChainGovernorSetTokenLimitResponse, error) {
+	out := new(ChainGovernorSetTokenLimitResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ChainGovernorSetTokenLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ChainGovernorSetCorridorLimit(ctx context.Context, in *ChainGovernorSetCorridorLimitRequest, opts ...grpc.CallOption) (*ChainGovernorSetCorridorLimitResponse, error) {
+	out := new(ChainGovernorSetCorridorLimitResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ChainGovernorSetCorridorLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodePrivilegedServiceClient) NotaryBlackholeDelayedMessage(ctx context.Context, in *NotaryBlackholeDelayedMessageRequest, opts ...grpc.CallOption) (*NotaryBlackholeDelayedMessageResponse, error) {
 	out := new(NotaryBlackholeDelayedMessageResponse)
 	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/NotaryBlackholeDelayedMessage", in, out, opts...)
@@ -257,6 +289,33 @@ func (c *nodePrivilegedServiceClient) NotaryListBlackholedMessages(ctx context.C
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) NotaryAnnotateDelayedMessage(ctx context.Context, in *NotaryAnnotateDelayedMessageRequest, opts ...grpc.CallOption) (*NotaryAnnotateDelayedMessageResponse, error) {
+	out := new(NotaryAnnotateDelayedMessageResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/NotaryAnnotateDelayedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) NotaryApproveDelayedMessage(ctx context.Context, in *NotaryApproveDelayedMessageRequest, opts ...grpc.CallOption) (*NotaryApproveDelayedMessageResponse, error) {
+	out := new(NotaryApproveDelayedMessageResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/NotaryApproveDelayedMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) NotaryListDelayedMessageReviews(ctx context.Context, in *NotaryListDelayedMessageReviewsRequest, opts ...grpc.CallOption) (*NotaryListDelayedMessageReviewsResponse, error) {
+	out := new(NotaryListDelayedMessageReviewsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/NotaryListDelayedMessageReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodePrivilegedServiceClient) PurgePythNetVaas(ctx context.Context, in *PurgePythNetVaasRequest, opts ...grpc.CallOption) (*PurgePythNetVaasResponse, error) {
 	out := new(PurgePythNetVaasResponse)
 	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/PurgePythNetVaas", in, out, opts...)
@@ -302,6 +361,15 @@ func (c *nodePrivilegedServiceClient) BroadcastDelegateSignatures(ctx context.Co
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) ReloadWatchers(ctx context.Context, in *ReloadWatchersRequest, opts ...grpc.CallOption) (*ReloadWatchersResponse, error) {
+	out := new(ReloadWatchersResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ReloadWatchers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodePrivilegedServiceServer is the server API for NodePrivilegedService service.
 // All implementations must embed UnimplementedNodePrivilegedServiceServer
 // for forward compatibility
@@ -311,6 +379,7 @@ type NodePrivilegedServiceServer interface {
 	//
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
+	//
 	InjectGovernanceVAA(context.Context, *InjectGovernanceVAARequest) (*InjectGovernanceVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
@@ -334,6 +403,10 @@ type NodePrivilegedServiceServer interface {
 	ChainGovernorReleasePendingVAA(context.Context, *ChainGovernorReleasePendingVAARequest) (*ChainGovernorReleasePendingVAAResponse, error)
 	// ChainGovernorResetReleaseTimer resets the release timer for a chain governor pending VAA to the configured maximum.
 	ChainGovernorResetReleaseTimer(context.Context, *ChainGovernorResetReleaseTimerRequest) (*ChainGovernorResetReleaseTimerResponse, error)
+	// ChainGovernorSetTokenLimit overrides the daily limit of a token leaving a chain.
+	ChainGovernorSetTokenLimit(context.Context, *ChainGovernorSetTokenLimitRequest) (*ChainGovernorSetTokenLimitResponse, error)
+	// ChainGovernorSetCorridorLimit overrides the daily limit of the transfers from one chain to another.
+	ChainGovernorSetCorridorLimit(context.Context, *ChainGovernorSetCorridorLimitRequest) (*ChainGovernorSetCorridorLimitResponse, error)
 	// NotaryBlackholeDelayedMessage blacklists a VAA from the notary pending list.
 	NotaryBlackholeDelayedMessage(context.Context, *NotaryBlackholeDelayedMessageRequest) (*NotaryBlackholeDelayedMessageResponse, error)
 	// NotaryReleaseDelayedMessage releases a VAA from the notary pending list, publishing it immediately.
@@ -354,6 +427,13 @@ type NodePrivilegedServiceServer interface {
 	NotaryListDelayedMessages(context.Context, *NotaryListDelayedMessagesRequest) (*NotaryListDelayedMessagesResponse, error)
 	// NotaryListBlackholedMessages lists all blackholed message IDs.
 	NotaryListBlackholedMessages(context.Context, *NotaryListBlackholedMessagesRequest) (*NotaryListBlackholedMessagesResponse, error)
+	// NotaryAnnotateDelayedMessage adds a note from an operator to the review of a notary delayed VAA.
+	NotaryAnnotateDelayedMessage(context.Context, *NotaryAnnotateDelayedMessageRequest) (*NotaryAnnotateDelayedMessageResponse, error)
+	// NotaryApproveDelayedMessage records the approval of an operator to release a notary delayed VAA.
+	// The VAA is released once it has been approved by the number of operators required by the notary.
+	NotaryApproveDelayedMessage(context.Context, *NotaryApproveDelayedMessageRequest) (*NotaryApproveDelayedMessageResponse, error)
+	// NotaryListDelayedMessageReviews lists all notary delayed VAAs together with their annotations and approvals.
+	NotaryListDelayedMessageReviews(context.Context, *NotaryListDelayedMessageReviewsRequest) (*NotaryListDelayedMessageReviewsResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
@@ -364,6 +444,8 @@ type NodePrivilegedServiceServer interface {
 	GetAndObserveMissingVAAs(context.Context, *GetAndObserveMissingVAAsRequest) (*GetAndObserveMissingVAAsResponse, error)
 	// BroadcastDelegateSignatures fetches delegate observations from wormholescan and broadcasts them.
 	BroadcastDelegateSignatures(context.Context, *BroadcastDelegateSignaturesRequest) (*BroadcastDelegateSignaturesResponse, error)
+	// ReloadWatchers reloads the watchers of the guardian config file, starting, stopping or updating the ones that changed.
+	ReloadWatchers(context.Context, *ReloadWatchersRequest) (*ReloadWatchersResponse, error)
 	mustEmbedUnimplementedNodePrivilegedServiceServer()
 }
 
@@ -398,6 +480,12 @@ func (UnimplementedNodePrivilegedServiceServer) ChainGovernorReleasePendingVAA(c
 func (UnimplementedNodePrivilegedServiceServer) ChainGovernorResetReleaseTimer(context.Context, *ChainGovernorResetReleaseTimerRequest) (*ChainGovernorResetReleaseTimerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChainGovernorResetReleaseTimer not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) ChainGovernorSetTokenLimit(context.Context, *ChainGovernorSetTokenLimitRequest) (*ChainGovernorSetTokenLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainGovernorSetTokenLimit not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ChainGovernorSetCorridorLimit(context.Context, *ChainGovernorSetCorridorLimitRequest) (*ChainGovernorSetCorridorLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainGovernorSetCorridorLimit not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) NotaryBlackholeDelayedMessage(context.Context, *NotaryBlackholeDelayedMessageRequest) (*NotaryBlackholeDelayedMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotaryBlackholeDelayedMessage not implemented")
 }
@@ -428,6 +516,15 @@ func (UnimplementedNodePrivilegedServiceServer) NotaryListDelayedMessages(contex
 func (UnimplementedNodePrivilegedServiceServer) NotaryListBlackholedMessages(context.Context, *NotaryListBlackholedMessagesRequest) (*NotaryListBlackholedMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotaryListBlackholedMessages not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) NotaryAnnotateDelayedMessage(context.Context, *NotaryAnnotateDelayedMessageRequest) (*NotaryAnnotateDelayedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotaryAnnotateDelayedMessage not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) NotaryApproveDelayedMessage(context.Context, *NotaryApproveDelayedMessageRequest) (*NotaryApproveDelayedMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotaryApproveDelayedMessage not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) NotaryListDelayedMessageReviews(context.Context, *NotaryListDelayedMessageReviewsRequest) (*NotaryListDelayedMessageReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotaryListDelayedMessageReviews not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgePythNetVaas not implemented")
 }
@@ -443,6 +540,9 @@ func (UnimplementedNodePrivilegedServiceServer) GetAndObserveMissingVAAs(context
 func (UnimplementedNodePrivilegedServiceServer) BroadcastDelegateSignatures(context.Context, *BroadcastDelegateSignaturesRequest) (*BroadcastDelegateSignaturesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastDelegateSignatures not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) ReloadWatchers(context.Context, *ReloadWatchersRequest) (*ReloadWatchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadWatchers not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) mustEmbedUnimplementedNodePrivilegedServiceServer() {}
 
 // UnsafeNodePrivilegedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -618,6 +718,42 @@ func _NodePrivilegedService_ChainGovernorResetReleaseTimer_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_ChainGovernorSetTokenLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainGovernorSetTokenLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ChainGovernorSetTokenLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ChainGovernorSetTokenLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ChainGovernorSetTokenLimit(ctx, req.(*ChainGovernorSetTokenLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ChainGovernorSetCorridorLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainGovernorSetCorridorLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ChainGovernorSetCorridorLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ChainGovernorSetCorridorLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ChainGovernorSetCorridorLimit(ctx, req.(*ChainGovernorSetCorridorLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodePrivilegedService_NotaryBlackholeDelayedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NotaryBlackholeDelayedMessageRequest)
 	if err := dec(in); err != nil {
@@ -798,6 +934,60 @@ func _NodePrivilegedService_NotaryListBlackholedMessages_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_NotaryAnnotateDelayedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotaryAnnotateDelayedMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).NotaryAnnotateDelayedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/NotaryAnnotateDelayedMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).NotaryAnnotateDelayedMessage(ctx, req.(*NotaryAnnotateDelayedMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_NotaryApproveDelayedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotaryApproveDelayedMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).NotaryApproveDelayedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/NotaryApproveDelayedMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).NotaryApproveDelayedMessage(ctx, req.(*NotaryApproveDelayedMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_NotaryListDelayedMessageReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotaryListDelayedMessageReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).NotaryListDelayedMessageReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/NotaryListDelayedMessageReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).NotaryListDelayedMessageReviews(ctx, req.(*NotaryListDelayedMessageReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodePrivilegedService_PurgePythNetVaas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurgePythNetVaasRequest)
 	if err := dec(in); err != nil {
@@ -888,6 +1078,24 @@ func _NodePrivilegedService_BroadcastDelegateSignatures_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_ReloadWatchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadWatchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ReloadWatchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ReloadWatchers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ReloadWatchers(ctx, req.(*ReloadWatchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodePrivilegedService_ServiceDesc is the grpc.ServiceDesc for NodePrivilegedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -931,6 +1139,14 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChainGovernorResetReleaseTimer",
 			Handler:    _NodePrivilegedService_ChainGovernorResetReleaseTimer_Handler,
 		},
+		{
+			MethodName: "ChainGovernorSetTokenLimit",
+			Handler:    _NodePrivilegedService_ChainGovernorSetTokenLimit_Handler,
+		},
+		{
+			MethodName: "ChainGovernorSetCorridorLimit",
+			Handler:    _NodePrivilegedService_ChainGovernorSetCorridorLimit_Handler,
+		},
 		{
 			MethodName: "NotaryBlackholeDelayedMessage",
 			Handler:    _NodePrivilegedService_NotaryBlackholeDelayedMessage_Handler,
@@ -971,6 +1187,18 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NotaryListBlackholedMessages",
 			Handler:    _NodePrivilegedService_NotaryListBlackholedMessages_Handler,
 		},
+		{
+			MethodName: "NotaryAnnotateDelayedMessage",
+			Handler:    _NodePrivilegedService_NotaryAnnotateDelayedMessage_Handler,
+		},
+		{
+			MethodName: "NotaryApproveDelayedMessage",
+			Handler:    _NodePrivilegedService_NotaryApproveDelayedMessage_Handler,
+		},
+		{
+			MethodName: "NotaryListDelayedMessageReviews",
+			Handler:    _NodePrivilegedService_NotaryListDelayedMessageReviews_Handler,
+		},
 		{
 			MethodName: "PurgePythNetVaas",
 			Handler:    _NodePrivilegedService_PurgePythNetVaas_Handler,
@@ -991,6 +1219,10 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastDelegateSignatures",
 			Handler:    _NodePrivilegedService_BroadcastDelegateSignatures_Handler,
 		},
+		{
+			MethodName: "ReloadWatchers",
+			Handler:    _NodePrivilegedService_ReloadWatchers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "node/v1/node.proto",
@@ -23,6 +23,11 @@ type PublicRPCServiceClient interface {
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(ctx context.Context, in *GetLastHeartbeatsRequest, opts ...grpc.CallOption) (*GetLastHeartbeatsResponse, error)
 	GetSignedVAA(ctx context.Context, in *GetSignedVAARequest, opts ...grpc.CallOption) (*GetSignedVAAResponse, error)
+	// GetSignedVAAsByTxHash returns the signed VAAs of the messages emitted by a transaction. It requires the
+	// transaction hash index, which only covers the VAAs that the node stored since the index was enabled.
+	GetSignedVAAsByTxHash(ctx context.Context, in *GetSignedVAAsByTxHashRequest, opts ...grpc.CallOption) (*GetSignedVAAsByTxHashResponse, error)
+	// GetSignedVAAsByEmitter pages through the signed VAAs of an emitter, ordered by sequence number.
+	GetSignedVAAsByEmitter(ctx context.Context, in *GetSignedVAAsByEmitterRequest, opts ...grpc.CallOption) (*GetSignedVAAsByEmitterResponse, error)
 	GetCurrentGuardianSet(ctx context.Context, in *GetCurrentGuardianSetRequest, opts ...grpc.CallOption) (*GetCurrentGuardianSetResponse, error)
 	GovernorGetAvailableNotionalByChain(ctx context.Context, in *GovernorGetAvailableNotionalByChainRequest, opts ...grpc.CallOption) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(ctx context.Context, in *GovernorGetEnqueuedVAAsRequest, opts ...grpc.CallOption) (*GovernorGetEnqueuedVAAsResponse, error)
@@ -60,6 +65,24 @@ func (c *publicRPCServiceClient) GetSignedVAA(ctx context.Context, in *GetSigned
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) GetSignedVAAsByTxHash(ctx context.Context, in *GetSignedVAAsByTxHashRequest, opts ...grpc.CallOption) (*GetSignedVAAsByTxHashResponse, error) {
+	out := new(GetSignedVAAsByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedVAAsByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicRPCServiceClient) GetSignedVAAsByEmitter(ctx context.Context, in *GetSignedVAAsByEmitterRequest, opts ...grpc.CallOption) (*GetSignedVAAsByEmitterResponse, error) {
+	out := new(GetSignedVAAsByEmitterResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedVAAsByEmitter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicRPCServiceClient) GetCurrentGuardianSet(ctx context.Context, in *GetCurrentGuardianSetRequest, opts ...grpc.CallOption) (*GetCurrentGuardianSetResponse, error) {
 	out := new(GetCurrentGuardianSetResponse)
 	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetCurrentGuardianSet", in, out, opts...)
@@ -132,6 +155,11 @@ type PublicRPCServiceServer interface {
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(context.Context, *GetLastHeartbeatsRequest) (*GetLastHeartbeatsResponse, error)
 	GetSignedVAA(context.Context, *GetSignedVAARequest) (*GetSignedVAAResponse, error)
+	// GetSignedVAAsByTxHash returns the signed VAAs of the messages emitted by a transaction. It requires the
+	// transaction hash index, which only covers the VAAs that the node stored since the index was enabled.
+	GetSignedVAAsByTxHash(context.Context, *GetSignedVAAsByTxHashRequest) (*GetSignedVAAsByTxHashResponse, error)
+	// GetSignedVAAsByEmitter pages through the signed VAAs of an emitter, ordered by sequence number.
+	GetSignedVAAsByEmitter(context.Context, *GetSignedVAAsByEmitterRequest) (*GetSignedVAAsByEmitterResponse, error)
 	GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error)
 	GovernorGetAvailableNotionalByChain(context.Context, *GovernorGetAvailableNotionalByChainRequest) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(context.Context, *GovernorGetEnqueuedVAAsRequest) (*GovernorGetEnqueuedVAAsResponse, error)
@@ -154,6 +182,12 @@ func (UnimplementedPublicRPCServiceServer) GetLastHeartbeats(context.Context, *G
 func (UnimplementedPublicRPCServiceServer) GetSignedVAA(context.Context, *GetSignedVAARequest) (*GetSignedVAAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAA not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) GetSignedVAAsByTxHash(context.Context, *GetSignedVAAsByTxHashRequest) (*GetSignedVAAsByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAAsByTxHash not implemented")
+}
+func (UnimplementedPublicRPCServiceServer) GetSignedVAAsByEmitter(context.Context, *GetSignedVAAsByEmitterRequest) (*GetSignedVAAsByEmitterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAAsByEmitter not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentGuardianSet not implemented")
 }
@@ -224,6 +258,42 @@ func _PublicRPCService_GetSignedVAA_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_GetSignedVAAsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignedVAAsByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetSignedVAAsByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByTxHash(ctx, req.(*GetSignedVAAsByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicRPCService_GetSignedVAAsByEmitter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignedVAAsByEmitterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByEmitter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetSignedVAAsByEmitter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByEmitter(ctx, req.(*GetSignedVAAsByEmitterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicRPCService_GetCurrentGuardianSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrentGuardianSetRequest)
 	if err := dec(in); err != nil {
@@ -365,6 +435,14 @@ var PublicRPCService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSignedVAA",
 			Handler:    _PublicRPCService_GetSignedVAA_Handler,
 		},
+		{
+			MethodName: "GetSignedVAAsByTxHash",
+			Handler:    _PublicRPCService_GetSignedVAAsByTxHash_Handler,
+		},
+		{
+			MethodName: "GetSignedVAAsByEmitter",
+			Handler:    _PublicRPCService_GetSignedVAAsByEmitter_Handler,
+		},
 		{
 			MethodName: "GetCurrentGuardianSet",
 			Handler:    _PublicRPCService_GetCurrentGuardianSet_Handler,
@@ -7,7 +7,7 @@
 package spyv1
 
 import (
-	_ "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	v11 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	v1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -204,36 +204,925 @@ func (x *BatchTransactionFilter) GetTxId() []byte {
 	return nil
 }
 
+// A SequenceRangeFilter matches VAAs whose sequence number falls within a range.
+type SequenceRangeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Emitter to restrict the range to. If not set, VAAs of all emitters are matched.
+	Emitter *EmitterFilter `protobuf:"bytes,1,opt,name=emitter,proto3" json:"emitter,omitempty"`
+	// First sequence number of the range (inclusive).
+	MinSequence uint64 `protobuf:"varint,2,opt,name=min_sequence,json=minSequence,proto3" json:"min_sequence,omitempty"`
+	// Last sequence number of the range (inclusive). If zero, the range has no upper bound.
+	MaxSequence uint64 `protobuf:"varint,3,opt,name=max_sequence,json=maxSequence,proto3" json:"max_sequence,omitempty"`
+}
+
+func (x *SequenceRangeFilter) Reset() {
+	*x = SequenceRangeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SequenceRangeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequenceRangeFilter) ProtoMessage() {}
+
+func (x *SequenceRangeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequenceRangeFilter.ProtoReflect.Descriptor instead.
+func (*SequenceRangeFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SequenceRangeFilter) GetEmitter() *EmitterFilter {
+	if x != nil {
+		return x.Emitter
+	}
+	return nil
+}
+
+func (x *SequenceRangeFilter) GetMinSequence() uint64 {
+	if x != nil {
+		return x.MinSequence
+	}
+	return 0
+}
+
+func (x *SequenceRangeFilter) GetMaxSequence() uint64 {
+	if x != nil {
+		return x.MaxSequence
+	}
+	return 0
+}
+
+// A ConsistencyLevelFilter represents an exact match for the consistency level of a VAA.
+type ConsistencyLevelFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsistencyLevel uint32 `protobuf:"varint,1,opt,name=consistency_level,json=consistencyLevel,proto3" json:"consistency_level,omitempty"`
+}
+
+func (x *ConsistencyLevelFilter) Reset() {
+	*x = ConsistencyLevelFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsistencyLevelFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyLevelFilter) ProtoMessage() {}
+
+func (x *ConsistencyLevelFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyLevelFilter.ProtoReflect.Descriptor instead.
+func (*ConsistencyLevelFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConsistencyLevelFilter) GetConsistencyLevel() uint32 {
+	if x != nil {
+		return x.ConsistencyLevel
+	}
+	return 0
+}
+
+// A GovernanceFilter matches VAAs emitted by the governance emitter.
+type GovernanceFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GovernanceFilter) Reset() {
+	*x = GovernanceFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GovernanceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GovernanceFilter) ProtoMessage() {}
+
+func (x *GovernanceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GovernanceFilter.ProtoReflect.Descriptor instead.
+func (*GovernanceFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{5}
+}
+
+// A PayloadIdFilter represents an exact match for the first byte of the payload of a VAA.
+type PayloadIdFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayloadId uint32 `protobuf:"varint,1,opt,name=payload_id,json=payloadId,proto3" json:"payload_id,omitempty"`
+}
+
+func (x *PayloadIdFilter) Reset() {
+	*x = PayloadIdFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayloadIdFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadIdFilter) ProtoMessage() {}
+
+func (x *PayloadIdFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadIdFilter.ProtoReflect.Descriptor instead.
+func (*PayloadIdFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PayloadIdFilter) GetPayloadId() uint32 {
+	if x != nil {
+		return x.PayloadId
+	}
+	return 0
+}
+
+// A TokenTransferFilter matches token bridge transfers (with or without payload) to a target chain.
+// If the spy runs against mainnet or testnet, only transfers emitted by a known token bridge are matched.
+type TokenTransferFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Target chain of the transfer
+	TargetChainId v1.ChainID `protobuf:"varint,1,opt,name=target_chain_id,json=targetChainId,proto3,enum=publicrpc.v1.ChainID" json:"target_chain_id,omitempty"`
+}
+
+func (x *TokenTransferFilter) Reset() {
+	*x = TokenTransferFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenTransferFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransferFilter) ProtoMessage() {}
+
+func (x *TokenTransferFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransferFilter.ProtoReflect.Descriptor instead.
+func (*TokenTransferFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TokenTransferFilter) GetTargetChainId() v1.ChainID {
+	if x != nil {
+		return x.TargetChainId
+	}
+	return v1.ChainID(0)
+}
+
 type FilterEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Filter:
-	//
 	//	*FilterEntry_EmitterFilter
 	//	*FilterEntry_BatchFilter
 	//	*FilterEntry_BatchTransactionFilter
+	//	*FilterEntry_SequenceRangeFilter
+	//	*FilterEntry_ConsistencyLevelFilter
+	//	*FilterEntry_GovernanceFilter
+	//	*FilterEntry_PayloadIdFilter
+	//	*FilterEntry_TokenTransferFilter
 	Filter isFilterEntry_Filter `protobuf_oneof:"filter"`
 }
 
-func (x *FilterEntry) Reset() {
-	*x = FilterEntry{}
+func (x *FilterEntry) Reset() {
+	*x = FilterEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterEntry) ProtoMessage() {}
+
+func (x *FilterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterEntry.ProtoReflect.Descriptor instead.
+func (*FilterEntry) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *FilterEntry) GetFilter() isFilterEntry_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetEmitterFilter() *EmitterFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_EmitterFilter); ok {
+		return x.EmitterFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetBatchFilter() *BatchFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_BatchFilter); ok {
+		return x.BatchFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetBatchTransactionFilter() *BatchTransactionFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_BatchTransactionFilter); ok {
+		return x.BatchTransactionFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetSequenceRangeFilter() *SequenceRangeFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_SequenceRangeFilter); ok {
+		return x.SequenceRangeFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetConsistencyLevelFilter() *ConsistencyLevelFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_ConsistencyLevelFilter); ok {
+		return x.ConsistencyLevelFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetGovernanceFilter() *GovernanceFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_GovernanceFilter); ok {
+		return x.GovernanceFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetPayloadIdFilter() *PayloadIdFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_PayloadIdFilter); ok {
+		return x.PayloadIdFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetTokenTransferFilter() *TokenTransferFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_TokenTransferFilter); ok {
+		return x.TokenTransferFilter
+	}
+	return nil
+}
+
+type isFilterEntry_Filter interface {
+	isFilterEntry_Filter()
+}
+
+type FilterEntry_EmitterFilter struct {
+	EmitterFilter *EmitterFilter `protobuf:"bytes,1,opt,name=emitter_filter,json=emitterFilter,proto3,oneof"`
+}
+
+type FilterEntry_BatchFilter struct {
+	BatchFilter *BatchFilter `protobuf:"bytes,2,opt,name=batch_filter,json=batchFilter,proto3,oneof"`
+}
+
+type FilterEntry_BatchTransactionFilter struct {
+	BatchTransactionFilter *BatchTransactionFilter `protobuf:"bytes,3,opt,name=batch_transaction_filter,json=batchTransactionFilter,proto3,oneof"`
+}
+
+type FilterEntry_SequenceRangeFilter struct {
+	SequenceRangeFilter *SequenceRangeFilter `protobuf:"bytes,4,opt,name=sequence_range_filter,json=sequenceRangeFilter,proto3,oneof"`
+}
+
+type FilterEntry_ConsistencyLevelFilter struct {
+	ConsistencyLevelFilter *ConsistencyLevelFilter `protobuf:"bytes,5,opt,name=consistency_level_filter,json=consistencyLevelFilter,proto3,oneof"`
+}
+
+type FilterEntry_GovernanceFilter struct {
+	GovernanceFilter *GovernanceFilter `protobuf:"bytes,6,opt,name=governance_filter,json=governanceFilter,proto3,oneof"`
+}
+
+type FilterEntry_PayloadIdFilter struct {
+	PayloadIdFilter *PayloadIdFilter `protobuf:"bytes,7,opt,name=payload_id_filter,json=payloadIdFilter,proto3,oneof"`
+}
+
+type FilterEntry_TokenTransferFilter struct {
+	TokenTransferFilter *TokenTransferFilter `protobuf:"bytes,8,opt,name=token_transfer_filter,json=tokenTransferFilter,proto3,oneof"`
+}
+
+func (*FilterEntry_EmitterFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_BatchFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_BatchTransactionFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_SequenceRangeFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_ConsistencyLevelFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_GovernanceFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_PayloadIdFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_TokenTransferFilter) isFilterEntry_Filter() {}
+
+type SubscribeSignedVAARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of filters to apply to the stream (OR).
+	// If empty, all messages are streamed.
+	Filters []*FilterEntry `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Resume cursor. If set, the VAAs received by the spy after the cursor are replayed before live VAAs are streamed.
+	// This requires the spy to run with a VAA store.
+	//
+	// Types that are assignable to ResumeFrom:
+	//	*SubscribeSignedVAARequest_LastVaaId
+	//	*SubscribeSignedVAARequest_ReceivedAfter
+	ResumeFrom isSubscribeSignedVAARequest_ResumeFrom `protobuf_oneof:"resume_from"`
+}
+
+func (x *SubscribeSignedVAARequest) Reset() {
+	*x = SubscribeSignedVAARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSignedVAARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSignedVAARequest) ProtoMessage() {}
+
+func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSignedVAARequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedVAARequest) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SubscribeSignedVAARequest) GetFilters() []*FilterEntry {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (m *SubscribeSignedVAARequest) GetResumeFrom() isSubscribeSignedVAARequest_ResumeFrom {
+	if m != nil {
+		return m.ResumeFrom
+	}
+	return nil
+}
+
+func (x *SubscribeSignedVAARequest) GetLastVaaId() string {
+	if x, ok := x.GetResumeFrom().(*SubscribeSignedVAARequest_LastVaaId); ok {
+		return x.LastVaaId
+	}
+	return ""
+}
+
+func (x *SubscribeSignedVAARequest) GetReceivedAfter() int64 {
+	if x, ok := x.GetResumeFrom().(*SubscribeSignedVAARequest_ReceivedAfter); ok {
+		return x.ReceivedAfter
+	}
+	return 0
+}
+
+type isSubscribeSignedVAARequest_ResumeFrom interface {
+	isSubscribeSignedVAARequest_ResumeFrom()
+}
+
+type SubscribeSignedVAARequest_LastVaaId struct {
+	// Message ID (chain/emitter/sequence) of the last VAA received by the client.
+	// The VAA must still be in the spy's VAA store.
+	LastVaaId string `protobuf:"bytes,2,opt,name=last_vaa_id,json=lastVaaId,proto3,oneof"`
+}
+
+type SubscribeSignedVAARequest_ReceivedAfter struct {
+	// UNIX time in nanoseconds. The VAAs received by the spy after this time are replayed.
+	ReceivedAfter int64 `protobuf:"varint,3,opt,name=received_after,json=receivedAfter,proto3,oneof"`
+}
+
+func (*SubscribeSignedVAARequest_LastVaaId) isSubscribeSignedVAARequest_ResumeFrom() {}
+
+func (*SubscribeSignedVAARequest_ReceivedAfter) isSubscribeSignedVAARequest_ResumeFrom() {}
+
+type SubscribeSignedVAAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Raw VAA bytes
+	VaaBytes []byte `protobuf:"bytes,1,opt,name=vaa_bytes,json=vaaBytes,proto3" json:"vaa_bytes,omitempty"`
+}
+
+func (x *SubscribeSignedVAAResponse) Reset() {
+	*x = SubscribeSignedVAAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSignedVAAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSignedVAAResponse) ProtoMessage() {}
+
+func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSignedVAAResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedVAAResponse) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SubscribeSignedVAAResponse) GetVaaBytes() []byte {
+	if x != nil {
+		return x.VaaBytes
+	}
+	return nil
+}
+
+// A GuardianFilter represents an exact match for the guardian that signed a message.
+type GuardianFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hex-encoded (with or without leading 0x) guardian address.
+	GuardianAddress string `protobuf:"bytes,1,opt,name=guardian_address,json=guardianAddress,proto3" json:"guardian_address,omitempty"`
+}
+
+func (x *GuardianFilter) Reset() {
+	*x = GuardianFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GuardianFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GuardianFilter) ProtoMessage() {}
+
+func (x *GuardianFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GuardianFilter.ProtoReflect.Descriptor instead.
+func (*GuardianFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GuardianFilter) GetGuardianAddress() string {
+	if x != nil {
+		return x.GuardianAddress
+	}
+	return ""
+}
+
+type ObservationFilterEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Filter:
+	//	*ObservationFilterEntry_EmitterFilter
+	//	*ObservationFilterEntry_GuardianFilter
+	Filter isObservationFilterEntry_Filter `protobuf_oneof:"filter"`
+}
+
+func (x *ObservationFilterEntry) Reset() {
+	*x = ObservationFilterEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObservationFilterEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObservationFilterEntry) ProtoMessage() {}
+
+func (x *ObservationFilterEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObservationFilterEntry.ProtoReflect.Descriptor instead.
+func (*ObservationFilterEntry) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *ObservationFilterEntry) GetFilter() isObservationFilterEntry_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *ObservationFilterEntry) GetEmitterFilter() *EmitterFilter {
+	if x, ok := x.GetFilter().(*ObservationFilterEntry_EmitterFilter); ok {
+		return x.EmitterFilter
+	}
+	return nil
+}
+
+func (x *ObservationFilterEntry) GetGuardianFilter() *GuardianFilter {
+	if x, ok := x.GetFilter().(*ObservationFilterEntry_GuardianFilter); ok {
+		return x.GuardianFilter
+	}
+	return nil
+}
+
+type isObservationFilterEntry_Filter interface {
+	isObservationFilterEntry_Filter()
+}
+
+type ObservationFilterEntry_EmitterFilter struct {
+	EmitterFilter *EmitterFilter `protobuf:"bytes,1,opt,name=emitter_filter,json=emitterFilter,proto3,oneof"`
+}
+
+type ObservationFilterEntry_GuardianFilter struct {
+	GuardianFilter *GuardianFilter `protobuf:"bytes,2,opt,name=guardian_filter,json=guardianFilter,proto3,oneof"`
+}
+
+func (*ObservationFilterEntry_EmitterFilter) isObservationFilterEntry_Filter() {}
+
+func (*ObservationFilterEntry_GuardianFilter) isObservationFilterEntry_Filter() {}
+
+type SubscribeSignedObservationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of filters to apply to the stream (OR).
+	// If empty, all observations are streamed.
+	Filters []*ObservationFilterEntry `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *SubscribeSignedObservationsRequest) Reset() {
+	*x = SubscribeSignedObservationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSignedObservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSignedObservationsRequest) ProtoMessage() {}
+
+func (x *SubscribeSignedObservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSignedObservationsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedObservationsRequest) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SubscribeSignedObservationsRequest) GetFilters() []*ObservationFilterEntry {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type SubscribeSignedObservationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Guardian pubkey as truncated eth address.
+	Addr []byte `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// Signed observation, as contained in the gossiped observation batch.
+	Observation *v11.Observation `protobuf:"bytes,2,opt,name=observation,proto3" json:"observation,omitempty"`
+}
+
+func (x *SubscribeSignedObservationsResponse) Reset() {
+	*x = SubscribeSignedObservationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSignedObservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSignedObservationsResponse) ProtoMessage() {}
+
+func (x *SubscribeSignedObservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSignedObservationsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedObservationsResponse) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SubscribeSignedObservationsResponse) GetAddr() []byte {
+	if x != nil {
+		return x.Addr
+	}
+	return nil
+}
+
+func (x *SubscribeSignedObservationsResponse) GetObservation() *v11.Observation {
+	if x != nil {
+		return x.Observation
+	}
+	return nil
+}
+
+type SubscribeHeartbeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of guardians to stream heartbeats for (OR).
+	// If empty, heartbeats of all guardians are streamed.
+	Filters []*GuardianFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *SubscribeHeartbeatsRequest) Reset() {
+	*x = SubscribeHeartbeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeHeartbeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeHeartbeatsRequest) ProtoMessage() {}
+
+func (x *SubscribeHeartbeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeHeartbeatsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeHeartbeatsRequest) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubscribeHeartbeatsRequest) GetFilters() []*GuardianFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type SubscribeHeartbeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Heartbeat, after its signature has been verified against the current guardian set.
+	Heartbeat *v11.Heartbeat `protobuf:"bytes,1,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+}
+
+func (x *SubscribeHeartbeatsResponse) Reset() {
+	*x = SubscribeHeartbeatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeHeartbeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeHeartbeatsResponse) ProtoMessage() {}
+
+func (x *SubscribeHeartbeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeHeartbeatsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeHeartbeatsResponse) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SubscribeHeartbeatsResponse) GetHeartbeat() *v11.Heartbeat {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+type SubscribeSignedChainGovernorConfigsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of guardians to stream governor configs for (OR).
+	// If empty, governor configs of all guardians are streamed.
+	Filters []*GuardianFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *SubscribeSignedChainGovernorConfigsRequest) Reset() {
+	*x = SubscribeSignedChainGovernorConfigsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[3]
+		mi := &file_spy_v1_spy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilterEntry) String() string {
+func (x *SubscribeSignedChainGovernorConfigsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterEntry) ProtoMessage() {}
+func (*SubscribeSignedChainGovernorConfigsRequest) ProtoMessage() {}
 
-func (x *FilterEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[3]
+func (x *SubscribeSignedChainGovernorConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,88 +1133,93 @@ func (x *FilterEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterEntry.ProtoReflect.Descriptor instead.
-func (*FilterEntry) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use SubscribeSignedChainGovernorConfigsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedChainGovernorConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{17}
 }
 
-func (m *FilterEntry) GetFilter() isFilterEntry_Filter {
-	if m != nil {
-		return m.Filter
+func (x *SubscribeSignedChainGovernorConfigsRequest) GetFilters() []*GuardianFilter {
+	if x != nil {
+		return x.Filters
 	}
 	return nil
 }
 
-func (x *FilterEntry) GetEmitterFilter() *EmitterFilter {
-	if x, ok := x.GetFilter().(*FilterEntry_EmitterFilter); ok {
-		return x.EmitterFilter
-	}
-	return nil
-}
+type SubscribeSignedChainGovernorConfigsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *FilterEntry) GetBatchFilter() *BatchFilter {
-	if x, ok := x.GetFilter().(*FilterEntry_BatchFilter); ok {
-		return x.BatchFilter
-	}
-	return nil
+	// Signed governor config, as received on the network. The signature is not verified by the spy.
+	Config *v11.SignedChainGovernorConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (x *FilterEntry) GetBatchTransactionFilter() *BatchTransactionFilter {
-	if x, ok := x.GetFilter().(*FilterEntry_BatchTransactionFilter); ok {
-		return x.BatchTransactionFilter
+func (x *SubscribeSignedChainGovernorConfigsResponse) Reset() {
+	*x = SubscribeSignedChainGovernorConfigsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isFilterEntry_Filter interface {
-	isFilterEntry_Filter()
+func (x *SubscribeSignedChainGovernorConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type FilterEntry_EmitterFilter struct {
-	EmitterFilter *EmitterFilter `protobuf:"bytes,1,opt,name=emitter_filter,json=emitterFilter,proto3,oneof"`
-}
+func (*SubscribeSignedChainGovernorConfigsResponse) ProtoMessage() {}
 
-type FilterEntry_BatchFilter struct {
-	BatchFilter *BatchFilter `protobuf:"bytes,2,opt,name=batch_filter,json=batchFilter,proto3,oneof"`
+func (x *SubscribeSignedChainGovernorConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type FilterEntry_BatchTransactionFilter struct {
-	BatchTransactionFilter *BatchTransactionFilter `protobuf:"bytes,3,opt,name=batch_transaction_filter,json=batchTransactionFilter,proto3,oneof"`
+// Deprecated: Use SubscribeSignedChainGovernorConfigsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedChainGovernorConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{18}
 }
 
-func (*FilterEntry_EmitterFilter) isFilterEntry_Filter() {}
-
-func (*FilterEntry_BatchFilter) isFilterEntry_Filter() {}
-
-func (*FilterEntry_BatchTransactionFilter) isFilterEntry_Filter() {}
+func (x *SubscribeSignedChainGovernorConfigsResponse) GetConfig() *v11.SignedChainGovernorConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
 
-type SubscribeSignedVAARequest struct {
+type SubscribeSignedChainGovernorStatusesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of filters to apply to the stream (OR).
-	// If empty, all messages are streamed.
-	Filters []*FilterEntry `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	// List of guardians to stream governor statuses for (OR).
+	// If empty, governor statuses of all guardians are streamed.
+	Filters []*GuardianFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
 }
 
-func (x *SubscribeSignedVAARequest) Reset() {
-	*x = SubscribeSignedVAARequest{}
+func (x *SubscribeSignedChainGovernorStatusesRequest) Reset() {
+	*x = SubscribeSignedChainGovernorStatusesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[4]
+		mi := &file_spy_v1_spy_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeSignedVAARequest) String() string {
+func (x *SubscribeSignedChainGovernorStatusesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeSignedVAARequest) ProtoMessage() {}
+func (*SubscribeSignedChainGovernorStatusesRequest) ProtoMessage() {}
 
-func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[4]
+func (x *SubscribeSignedChainGovernorStatusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,44 +1230,44 @@ func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeSignedVAARequest.ProtoReflect.Descriptor instead.
-func (*SubscribeSignedVAARequest) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SubscribeSignedChainGovernorStatusesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedChainGovernorStatusesRequest) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SubscribeSignedVAARequest) GetFilters() []*FilterEntry {
+func (x *SubscribeSignedChainGovernorStatusesRequest) GetFilters() []*GuardianFilter {
 	if x != nil {
 		return x.Filters
 	}
 	return nil
 }
 
-type SubscribeSignedVAAResponse struct {
+type SubscribeSignedChainGovernorStatusesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Raw VAA bytes
-	VaaBytes []byte `protobuf:"bytes,1,opt,name=vaa_bytes,json=vaaBytes,proto3" json:"vaa_bytes,omitempty"`
+	// Signed governor status, as received on the network. The signature is not verified by the spy.
+	Status *v11.SignedChainGovernorStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *SubscribeSignedVAAResponse) Reset() {
-	*x = SubscribeSignedVAAResponse{}
+func (x *SubscribeSignedChainGovernorStatusesResponse) Reset() {
+	*x = SubscribeSignedChainGovernorStatusesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[5]
+		mi := &file_spy_v1_spy_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeSignedVAAResponse) String() string {
+func (x *SubscribeSignedChainGovernorStatusesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeSignedVAAResponse) ProtoMessage() {}
+func (*SubscribeSignedChainGovernorStatusesResponse) ProtoMessage() {}
 
-func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[5]
+func (x *SubscribeSignedChainGovernorStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,14 +1278,14 @@ func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeSignedVAAResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeSignedVAAResponse) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use SubscribeSignedChainGovernorStatusesResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeSignedChainGovernorStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SubscribeSignedVAAResponse) GetVaaBytes() []byte {
+func (x *SubscribeSignedChainGovernorStatusesResponse) GetStatus() *v11.SignedChainGovernorStatus {
 	if x != nil {
-		return x.VaaBytes
+		return x.Status
 	}
 	return nil
 }
@@ -425,44 +1319,206 @@ var file_spy_v1_spy_proto_rawDesc = []byte{
 	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76,
 	0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x5a, 0x0a, 0x18, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x16, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a,
-	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x61, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x32, 0x94,
-	0x01, 0x0a, 0x0d, 0x53, 0x70, 0x79, 0x52, 0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x79,
+	0x0c, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x2f, 0x0a, 0x07, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x45, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x12, 0x0a,
+	0x10, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0x30, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x13, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0f, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xff, 0x04, 0x0a, 0x0b, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x18, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x16, 0x62, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x51, 0x0a, 0x15, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x5a, 0x0a, 0x18, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x47,
+	0x0a, 0x11, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x70, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x10, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x51,
+	0x0a, 0x15, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x19,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56,
+	0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x76, 0x61, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x61, 0x61, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0e, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x22, 0x39, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x61, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x3b, 0x0a,
+	0x0e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x10, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64,
+	0x69, 0x61, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
+	0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69,
+	0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x5e, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x70, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x73, 0x0a, 0x23, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x38, 0x0a,
+	0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x22, 0x5e, 0x0a, 0x2a, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x6b, 0x0a, 0x2b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5f, 0x0a, 0x2b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x47,
+	0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x6c, 0x0a, 0x2c, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0xdf, 0x06, 0x0a, 0x0d, 0x53, 0x70, 0x79, 0x52, 0x50,
+	0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12,
+	0x21, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18,
+	0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0xa6, 0x01,
+	0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e,
+	0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x22, 0x21,
+	0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x85, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x12, 0x22,
+	0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a,
+	0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x30, 0x01, 0x12, 0xc8,
+	0x01, 0x0a, 0x23, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x32, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x73, 0x70, 0x79,
 	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61,
-	0x3a, 0x01, 0x2a, 0x30, 0x01, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x75, 0x73, 0x6f, 0x6e, 0x65, 0x2f, 0x77, 0x6f,
-	0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x79,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0xcc, 0x01, 0x0a, 0x24, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x33, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x31, 0x22, 0x2c, 0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x75, 0x73, 0x6f, 0x6e, 0x65,
+	0x2f, 0x77, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x79, 0x2f, 0x76, 0x31, 0x3b,
+	0x73, 0x70, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -477,31 +1533,75 @@ func file_spy_v1_spy_proto_rawDescGZIP() []byte {
 	return file_spy_v1_spy_proto_rawDescData
 }
 
-var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_spy_v1_spy_proto_goTypes = []interface{}{
-	(*EmitterFilter)(nil),              // 0: spy.v1.EmitterFilter
-	(*BatchFilter)(nil),                // 1: spy.v1.BatchFilter
-	(*BatchTransactionFilter)(nil),     // 2: spy.v1.BatchTransactionFilter
-	(*FilterEntry)(nil),                // 3: spy.v1.FilterEntry
-	(*SubscribeSignedVAARequest)(nil),  // 4: spy.v1.SubscribeSignedVAARequest
-	(*SubscribeSignedVAAResponse)(nil), // 5: spy.v1.SubscribeSignedVAAResponse
-	(v1.ChainID)(0),                    // 6: publicrpc.v1.ChainID
+	(*EmitterFilter)(nil),                                // 0: spy.v1.EmitterFilter
+	(*BatchFilter)(nil),                                  // 1: spy.v1.BatchFilter
+	(*BatchTransactionFilter)(nil),                       // 2: spy.v1.BatchTransactionFilter
+	(*SequenceRangeFilter)(nil),                          // 3: spy.v1.SequenceRangeFilter
+	(*ConsistencyLevelFilter)(nil),                       // 4: spy.v1.ConsistencyLevelFilter
+	(*GovernanceFilter)(nil),                             // 5: spy.v1.GovernanceFilter
+	(*PayloadIdFilter)(nil),                              // 6: spy.v1.PayloadIdFilter
+	(*TokenTransferFilter)(nil),                          // 7: spy.v1.TokenTransferFilter
+	(*FilterEntry)(nil),                                  // 8: spy.v1.FilterEntry
+	(*SubscribeSignedVAARequest)(nil),                    // 9: spy.v1.SubscribeSignedVAARequest
+	(*SubscribeSignedVAAResponse)(nil),                   // 10: spy.v1.SubscribeSignedVAAResponse
+	(*GuardianFilter)(nil),                               // 11: spy.v1.GuardianFilter
+	(*ObservationFilterEntry)(nil),                       // 12: spy.v1.ObservationFilterEntry
+	(*SubscribeSignedObservationsRequest)(nil),           // 13: spy.v1.SubscribeSignedObservationsRequest
+	(*SubscribeSignedObservationsResponse)(nil),          // 14: spy.v1.SubscribeSignedObservationsResponse
+	(*SubscribeHeartbeatsRequest)(nil),                   // 15: spy.v1.SubscribeHeartbeatsRequest
+	(*SubscribeHeartbeatsResponse)(nil),                  // 16: spy.v1.SubscribeHeartbeatsResponse
+	(*SubscribeSignedChainGovernorConfigsRequest)(nil),   // 17: spy.v1.SubscribeSignedChainGovernorConfigsRequest
+	(*SubscribeSignedChainGovernorConfigsResponse)(nil),  // 18: spy.v1.SubscribeSignedChainGovernorConfigsResponse
+	(*SubscribeSignedChainGovernorStatusesRequest)(nil),  // 19: spy.v1.SubscribeSignedChainGovernorStatusesRequest
+	(*SubscribeSignedChainGovernorStatusesResponse)(nil), // 20: spy.v1.SubscribeSignedChainGovernorStatusesResponse
+	(v1.ChainID)(0),                                      // 21: publicrpc.v1.ChainID
+	(*v11.Observation)(nil),                              // 22: gossip.v1.Observation
+	(*v11.Heartbeat)(nil),                                // 23: gossip.v1.Heartbeat
+	(*v11.SignedChainGovernorConfig)(nil),                // 24: gossip.v1.SignedChainGovernorConfig
+	(*v11.SignedChainGovernorStatus)(nil),                // 25: gossip.v1.SignedChainGovernorStatus
 }
 var file_spy_v1_spy_proto_depIdxs = []int32{
-	6, // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	6, // 1: spy.v1.BatchFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	6, // 2: spy.v1.BatchTransactionFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	0, // 3: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
-	1, // 4: spy.v1.FilterEntry.batch_filter:type_name -> spy.v1.BatchFilter
-	2, // 5: spy.v1.FilterEntry.batch_transaction_filter:type_name -> spy.v1.BatchTransactionFilter
-	3, // 6: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
-	4, // 7: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
-	5, // 8: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
-	8, // [8:9] is the sub-list for method output_type
-	7, // [7:8] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	21, // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	21, // 1: spy.v1.BatchFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	21, // 2: spy.v1.BatchTransactionFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	0,  // 3: spy.v1.SequenceRangeFilter.emitter:type_name -> spy.v1.EmitterFilter
+	21, // 4: spy.v1.TokenTransferFilter.target_chain_id:type_name -> publicrpc.v1.ChainID
+	0,  // 5: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
+	1,  // 6: spy.v1.FilterEntry.batch_filter:type_name -> spy.v1.BatchFilter
+	2,  // 7: spy.v1.FilterEntry.batch_transaction_filter:type_name -> spy.v1.BatchTransactionFilter
+	3,  // 8: spy.v1.FilterEntry.sequence_range_filter:type_name -> spy.v1.SequenceRangeFilter
+	4,  // 9: spy.v1.FilterEntry.consistency_level_filter:type_name -> spy.v1.ConsistencyLevelFilter
+	5,  // 10: spy.v1.FilterEntry.governance_filter:type_name -> spy.v1.GovernanceFilter
+	6,  // 11: spy.v1.FilterEntry.payload_id_filter:type_name -> spy.v1.PayloadIdFilter
+	7,  // 12: spy.v1.FilterEntry.token_transfer_filter:type_name -> spy.v1.TokenTransferFilter
+	8,  // 13: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
+	0,  // 14: spy.v1.ObservationFilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
+	11, // 15: spy.v1.ObservationFilterEntry.guardian_filter:type_name -> spy.v1.GuardianFilter
+	12, // 16: spy.v1.SubscribeSignedObservationsRequest.filters:type_name -> spy.v1.ObservationFilterEntry
+	22, // 17: spy.v1.SubscribeSignedObservationsResponse.observation:type_name -> gossip.v1.Observation
+	11, // 18: spy.v1.SubscribeHeartbeatsRequest.filters:type_name -> spy.v1.GuardianFilter
+	23, // 19: spy.v1.SubscribeHeartbeatsResponse.heartbeat:type_name -> gossip.v1.Heartbeat
+	11, // 20: spy.v1.SubscribeSignedChainGovernorConfigsRequest.filters:type_name -> spy.v1.GuardianFilter
+	24, // 21: spy.v1.SubscribeSignedChainGovernorConfigsResponse.config:type_name -> gossip.v1.SignedChainGovernorConfig
+	11, // 22: spy.v1.SubscribeSignedChainGovernorStatusesRequest.filters:type_name -> spy.v1.GuardianFilter
+	25, // 23: spy.v1.SubscribeSignedChainGovernorStatusesResponse.status:type_name -> gossip.v1.SignedChainGovernorStatus
+	9,  // 24: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
+	13, // 25: spy.v1.SpyRPCService.SubscribeSignedObservations:input_type -> spy.v1.SubscribeSignedObservationsRequest
+	15, // 26: spy.v1.SpyRPCService.SubscribeHeartbeats:input_type -> spy.v1.SubscribeHeartbeatsRequest
+	17, // 27: spy.v1.SpyRPCService.SubscribeSignedChainGovernorConfigs:input_type -> spy.v1.SubscribeSignedChainGovernorConfigsRequest
+	19, // 28: spy.v1.SpyRPCService.SubscribeSignedChainGovernorStatuses:input_type -> spy.v1.SubscribeSignedChainGovernorStatusesRequest
+	10, // 29: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
+	14, // 30: spy.v1.SpyRPCService.SubscribeSignedObservations:output_type -> spy.v1.SubscribeSignedObservationsResponse
+	16, // 31: spy.v1.SpyRPCService.SubscribeHeartbeats:output_type -> spy.v1.SubscribeHeartbeatsResponse
+	18, // 32: spy.v1.SpyRPCService.SubscribeSignedChainGovernorConfigs:output_type -> spy.v1.SubscribeSignedChainGovernorConfigsResponse
+	20, // 33: spy.v1.SpyRPCService.SubscribeSignedChainGovernorStatuses:output_type -> spy.v1.SubscribeSignedChainGovernorStatusesResponse
+	29, // [29:34] is the sub-list for method output_type
+	24, // [24:29] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_spy_v1_spy_proto_init() }
@@ -547,7 +1647,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterEntry); i {
+			switch v := v.(*SequenceRangeFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -559,7 +1659,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSignedVAARequest); i {
+			switch v := v.(*ConsistencyLevelFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -571,6 +1671,66 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GovernanceFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayloadIdFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenTransferFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedVAARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeSignedVAAResponse); i {
 			case 0:
 				return &v.state
@@ -582,11 +1742,144 @@ func file_spy_v1_spy_proto_init() {
 				return nil
 			}
 		}
+		file_spy_v1_spy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardianFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObservationFilterEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedObservationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedObservationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeHeartbeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeHeartbeatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedChainGovernorConfigsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedChainGovernorConfigsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedChainGovernorStatusesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedChainGovernorStatusesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_spy_v1_spy_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_spy_v1_spy_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*FilterEntry_EmitterFilter)(nil),
 		(*FilterEntry_BatchFilter)(nil),
 		(*FilterEntry_BatchTransactionFilter)(nil),
+		(*FilterEntry_SequenceRangeFilter)(nil),
+		(*FilterEntry_ConsistencyLevelFilter)(nil),
+		(*FilterEntry_GovernanceFilter)(nil),
+		(*FilterEntry_PayloadIdFilter)(nil),
+		(*FilterEntry_TokenTransferFilter)(nil),
+	}
+	file_spy_v1_spy_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*SubscribeSignedVAARequest_LastVaaId)(nil),
+		(*SubscribeSignedVAARequest_ReceivedAfter)(nil),
+	}
+	file_spy_v1_spy_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*ObservationFilterEntry_EmitterFilter)(nil),
+		(*ObservationFilterEntry_GuardianFilter)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -594,7 +1887,7 @@ func file_spy_v1_spy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spy_v1_spy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,15 @@ const _ = grpc.SupportPackageIsVersion7
 type SpyRPCServiceClient interface {
 	// SubscribeSignedVAA returns a stream of signed VAA messages received on the network.
 	SubscribeSignedVAA(ctx context.Context, in *SubscribeSignedVAARequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedVAAClient, error)
+	// SubscribeSignedObservations returns a stream of the individual signed observations contained in the observation
+	// batches received on the network.
+	SubscribeSignedObservations(ctx context.Context, in *SubscribeSignedObservationsRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedObservationsClient, error)
+	// SubscribeHeartbeats returns a stream of the verified heartbeats received on the network.
+	SubscribeHeartbeats(ctx context.Context, in *SubscribeHeartbeatsRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeHeartbeatsClient, error)
+	// SubscribeSignedChainGovernorConfigs returns a stream of the signed governor configs received on the network.
+	SubscribeSignedChainGovernorConfigs(ctx context.Context, in *SubscribeSignedChainGovernorConfigsRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedChainGovernorConfigsClient, error)
+	// SubscribeSignedChainGovernorStatuses returns a stream of the signed governor statuses received on the network.
+	SubscribeSignedChainGovernorStatuses(ctx context.Context, in *SubscribeSignedChainGovernorStatusesRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedChainGovernorStatusesClient, error)
 }
 
 type spyRPCServiceClient struct {
@@ -62,12 +71,149 @@ func (x *spyRPCServiceSubscribeSignedVAAClient) Recv() (*SubscribeSignedVAARespo
 	return m, nil
 }
 
+func (c *spyRPCServiceClient) SubscribeSignedObservations(ctx context.Context, in *SubscribeSignedObservationsRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedObservationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SpyRPCService_ServiceDesc.Streams[1], "/spy.v1.SpyRPCService/SubscribeSignedObservations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spyRPCServiceSubscribeSignedObservationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpyRPCService_SubscribeSignedObservationsClient interface {
+	Recv() (*SubscribeSignedObservationsResponse, error)
+	grpc.ClientStream
+}
+
+type spyRPCServiceSubscribeSignedObservationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *spyRPCServiceSubscribeSignedObservationsClient) Recv() (*SubscribeSignedObservationsResponse, error) {
+	m := new(SubscribeSignedObservationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spyRPCServiceClient) SubscribeHeartbeats(ctx context.Context, in *SubscribeHeartbeatsRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeHeartbeatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SpyRPCService_ServiceDesc.Streams[2], "/spy.v1.SpyRPCService/SubscribeHeartbeats", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spyRPCServiceSubscribeHeartbeatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpyRPCService_SubscribeHeartbeatsClient interface {
+	Recv() (*SubscribeHeartbeatsResponse, error)
+	grpc.ClientStream
+}
+
+type spyRPCServiceSubscribeHeartbeatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *spyRPCServiceSubscribeHeartbeatsClient) Recv() (*SubscribeHeartbeatsResponse, error) {
+	m := new(SubscribeHeartbeatsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spyRPCServiceClient) SubscribeSignedChainGovernorConfigs(ctx context.Context, in *SubscribeSignedChainGovernorConfigsRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedChainGovernorConfigsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SpyRPCService_ServiceDesc.Streams[3], "/spy.v1.SpyRPCService/SubscribeSignedChainGovernorConfigs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spyRPCServiceSubscribeSignedChainGovernorConfigsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpyRPCService_SubscribeSignedChainGovernorConfigsClient interface {
+	Recv() (*SubscribeSignedChainGovernorConfigsResponse, error)
+	grpc.ClientStream
+}
+
+type spyRPCServiceSubscribeSignedChainGovernorConfigsClient struct {
+	grpc.ClientStream
+}
+
+func (x *spyRPCServiceSubscribeSignedChainGovernorConfigsClient) Recv() (*SubscribeSignedChainGovernorConfigsResponse, error) {
+	m := new(SubscribeSignedChainGovernorConfigsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *spyRPCServiceClient) SubscribeSignedChainGovernorStatuses(ctx context.Context, in *SubscribeSignedChainGovernorStatusesRequest, opts ...grpc.CallOption) (SpyRPCService_SubscribeSignedChainGovernorStatusesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SpyRPCService_ServiceDesc.Streams[4], "/spy.v1.SpyRPCService/SubscribeSignedChainGovernorStatuses", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &spyRPCServiceSubscribeSignedChainGovernorStatusesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SpyRPCService_SubscribeSignedChainGovernorStatusesClient interface {
+	Recv() (*SubscribeSignedChainGovernorStatusesResponse, error)
+	grpc.ClientStream
+}
+
+type spyRPCServiceSubscribeSignedChainGovernorStatusesClient struct {
+	grpc.ClientStream
+}
+
+func (x *spyRPCServiceSubscribeSignedChainGovernorStatusesClient) Recv() (*SubscribeSignedChainGovernorStatusesResponse, error) {
+	m := new(SubscribeSignedChainGovernorStatusesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SpyRPCServiceServer is the server API for SpyRPCService service.
 // All implementations must embed UnimplementedSpyRPCServiceServer
 // for forward compatibility
 type SpyRPCServiceServer interface {
 	// SubscribeSignedVAA returns a stream of signed VAA messages received on the network.
 	SubscribeSignedVAA(*SubscribeSignedVAARequest, SpyRPCService_SubscribeSignedVAAServer) error
+	// SubscribeSignedObservations returns a stream of the individual signed observations contained in the observation
+	// batches received on the network.
+	SubscribeSignedObservations(*SubscribeSignedObservationsRequest, SpyRPCService_SubscribeSignedObservationsServer) error
+	// SubscribeHeartbeats returns a stream of the verified heartbeats received on the network.
+	SubscribeHeartbeats(*SubscribeHeartbeatsRequest, SpyRPCService_SubscribeHeartbeatsServer) error
+	// SubscribeSignedChainGovernorConfigs returns a stream of the signed governor configs received on the network.
+	SubscribeSignedChainGovernorConfigs(*SubscribeSignedChainGovernorConfigsRequest, SpyRPCService_SubscribeSignedChainGovernorConfigsServer) error
+	// SubscribeSignedChainGovernorStatuses returns a stream of the signed governor statuses received on the network.
+	SubscribeSignedChainGovernorStatuses(*SubscribeSignedChainGovernorStatusesRequest, SpyRPCService_SubscribeSignedChainGovernorStatusesServer) error
 	mustEmbedUnimplementedSpyRPCServiceServer()
 }
 
@@ -78,6 +224,18 @@ type UnimplementedSpyRPCServiceServer struct {
 func (UnimplementedSpyRPCServiceServer) SubscribeSignedVAA(*SubscribeSignedVAARequest, SpyRPCService_SubscribeSignedVAAServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeSignedVAA not implemented")
 }
+func (UnimplementedSpyRPCServiceServer) SubscribeSignedObservations(*SubscribeSignedObservationsRequest, SpyRPCService_SubscribeSignedObservationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSignedObservations not implemented")
+}
+func (UnimplementedSpyRPCServiceServer) SubscribeHeartbeats(*SubscribeHeartbeatsRequest, SpyRPCService_SubscribeHeartbeatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeHeartbeats not implemented")
+}
+func (UnimplementedSpyRPCServiceServer) SubscribeSignedChainGovernorConfigs(*SubscribeSignedChainGovernorConfigsRequest, SpyRPCService_SubscribeSignedChainGovernorConfigsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSignedChainGovernorConfigs not implemented")
+}
+func (UnimplementedSpyRPCServiceServer) SubscribeSignedChainGovernorStatuses(*SubscribeSignedChainGovernorStatusesRequest, SpyRPCService_SubscribeSignedChainGovernorStatusesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSignedChainGovernorStatuses not implemented")
+}
 func (UnimplementedSpyRPCServiceServer) mustEmbedUnimplementedSpyRPCServiceServer() {}
 
 // UnsafeSpyRPCServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -112,6 +270,90 @@ func (x *spyRPCServiceSubscribeSignedVAAServer) Send(m *SubscribeSignedVAARespon
 	return x.ServerStream.SendMsg(m)
 }
 
+func _SpyRPCService_SubscribeSignedObservations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSignedObservationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpyRPCServiceServer).SubscribeSignedObservations(m, &spyRPCServiceSubscribeSignedObservationsServer{stream})
+}
+
+type SpyRPCService_SubscribeSignedObservationsServer interface {
+	Send(*SubscribeSignedObservationsResponse) error
+	grpc.ServerStream
+}
+
+type spyRPCServiceSubscribeSignedObservationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *spyRPCServiceSubscribeSignedObservationsServer) Send(m *SubscribeSignedObservationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpyRPCService_SubscribeHeartbeats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHeartbeatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpyRPCServiceServer).SubscribeHeartbeats(m, &spyRPCServiceSubscribeHeartbeatsServer{stream})
+}
+
+type SpyRPCService_SubscribeHeartbeatsServer interface {
+	Send(*SubscribeHeartbeatsResponse) error
+	grpc.ServerStream
+}
+
+type spyRPCServiceSubscribeHeartbeatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *spyRPCServiceSubscribeHeartbeatsServer) Send(m *SubscribeHeartbeatsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpyRPCService_SubscribeSignedChainGovernorConfigs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSignedChainGovernorConfigsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpyRPCServiceServer).SubscribeSignedChainGovernorConfigs(m, &spyRPCServiceSubscribeSignedChainGovernorConfigsServer{stream})
+}
+
+type SpyRPCService_SubscribeSignedChainGovernorConfigsServer interface {
+	Send(*SubscribeSignedChainGovernorConfigsResponse) error
+	grpc.ServerStream
+}
+
+type spyRPCServiceSubscribeSignedChainGovernorConfigsServer struct {
+	grpc.ServerStream
+}
+
+func (x *spyRPCServiceSubscribeSignedChainGovernorConfigsServer) Send(m *SubscribeSignedChainGovernorConfigsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpyRPCService_SubscribeSignedChainGovernorStatuses_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSignedChainGovernorStatusesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpyRPCServiceServer).SubscribeSignedChainGovernorStatuses(m, &spyRPCServiceSubscribeSignedChainGovernorStatusesServer{stream})
+}
+
+type SpyRPCService_SubscribeSignedChainGovernorStatusesServer interface {
+	Send(*SubscribeSignedChainGovernorStatusesResponse) error
+	grpc.ServerStream
+}
+
+type spyRPCServiceSubscribeSignedChainGovernorStatusesServer struct {
+	grpc.ServerStream
+}
+
+func (x *spyRPCServiceSubscribeSignedChainGovernorStatusesServer) Send(m *SubscribeSignedChainGovernorStatusesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // SpyRPCService_ServiceDesc is the grpc.ServiceDesc for SpyRPCService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -125,6 +367,26 @@ var SpyRPCService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _SpyRPCService_SubscribeSignedVAA_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeSignedObservations",
+			Handler:       _SpyRPCService_SubscribeSignedObservations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHeartbeats",
+			Handler:       _SpyRPCService_SubscribeHeartbeats_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeSignedChainGovernorConfigs",
+			Handler:       _SpyRPCService_SubscribeSignedChainGovernorConfigs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeSignedChainGovernorStatuses",
+			Handler:       _SpyRPCService_SubscribeSignedChainGovernorStatuses_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "spy/v1/spy.proto",
 }
@@ -6,12 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	guardianDB "github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/manager"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/mr-tron/base58"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -115,6 +117,153 @@ func (s *PublicrpcServer) GetSignedVAA(_ context.Context, req *publicrpcv1.GetSi
 	}, nil
 }
 
+func (s *PublicrpcServer) GetSignedVAAsByTxHash(_ context.Context, req *publicrpcv1.GetSignedVAAsByTxHashRequest) (*publicrpcv1.GetSignedVAAsByTxHashResponse, error) {
+	if !s.db.TxHashIndexEnabled() {
+		return nil, status.Error(codes.Unimplemented, "transaction hash index not enabled")
+	}
+
+	txHash, err := decodeTxHash(req.TxHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode transaction hash: %v", err))
+	}
+
+	ids, err := s.db.GetVAAIDsByTxHash(txHash)
+	if err != nil {
+		s.logger.Error("failed to look up transaction hash", zap.Error(err), zap.String("txHash", req.TxHash))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	resp := &publicrpcv1.GetSignedVAAsByTxHashResponse{
+		Entries: make([]*publicrpcv1.GetSignedVAAsByTxHashResponse_Entry, 0, len(ids)),
+	}
+
+	for _, id := range ids {
+		b, err := s.db.GetSignedVAABytes(id)
+		if err != nil {
+			// The VAA may have been purged since it was indexed.
+			if errors.Is(err, guardianDB.ErrVAANotFound) {
+				continue
+			}
+			s.logger.Error("failed to fetch VAA", zap.Error(err), zap.String("vaaID", string(id.Bytes())))
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		resp.Entries = append(resp.Entries, &publicrpcv1.GetSignedVAAsByTxHashResponse_Entry{
+			MessageId: &publicrpcv1.MessageID{
+				EmitterChain:   publicrpcv1.ChainID(id.EmitterChain),
+				EmitterAddress: hex.EncodeToString(id.EmitterAddress.Bytes()),
+				Sequence:       id.Sequence,
+			},
+			VaaBytes: b,
+		})
+	}
+
+	if len(resp.Entries) == 0 {
+		return nil, status.Error(codes.NotFound, "no VAAs found for transaction hash")
+	}
+
+	return resp, nil
+}
+
+// decodeTxHash decodes a hex-encoded transaction hash, with or without leading 0x, or a base58-encoded Solana
+// transaction signature.
+func decodeTxHash(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty transaction hash")
+	}
+
+	if b, err := hex.DecodeString(strings.TrimPrefix(s, "0x")); err == nil {
+		return b, nil
+	}
+
+	return base58.Decode(s)
+}
+
+const (
+	// maxSignedVAAsPageSize is the maximum number of VAAs returned by GetSignedVAAsByEmitter.
+	maxSignedVAAsPageSize = 100
+
+	// maxSequenceRange is the maximum number of sequence numbers scanned by GetSignedVAAsByEmitter, which bounds the
+	// work done for an emitter with sparse sequence numbers.
+	maxSequenceRange = 1000
+)
+
+func (s *PublicrpcServer) GetSignedVAAsByEmitter(_ context.Context, req *publicrpcv1.GetSignedVAAsByEmitterRequest) (*publicrpcv1.GetSignedVAAsByEmitterResponse, error) {
+	if req.GetEmitterChain() > math.MaxUint16 {
+		return nil, status.Error(codes.InvalidArgument, "emitter chain id must be no greater than 16 bits")
+	}
+
+	chainID := vaa.ChainID(req.GetEmitterChain()) // #nosec G115 -- This conversion is checked above
+
+	// This interface is not supported for PythNet messages because those VAAs are not stored in the database.
+	if chainID == vaa.ChainIDPythNet {
+		return nil, status.Error(codes.InvalidArgument, "not supported for PythNet")
+	}
+
+	address, err := hex.DecodeString(req.EmitterAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode address: %v", err))
+	}
+	if len(address) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "address must be 32 bytes")
+	}
+
+	addr := vaa.Address{}
+	copy(addr[:], address)
+	emitter := guardianDB.VAAID{
+		EmitterChain:   chainID,
+		EmitterAddress: addr,
+	}
+
+	last := req.ToSequence
+	unrecorded := false
+	if last == 0 {
+		// An unbounded range ends at the highest sequence number stored for the emitter.
+		var found bool
+		last, found, err = s.db.GetLastSignedVAASequence(emitter)
+		if err != nil {
+			s.logger.Error("failed to fetch the last sequence number", zap.Error(err), zap.Any("request", req))
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+		if !found {
+			// The VAAs of the emitter were stored before the last sequence number was recorded. The range goes on
+			// as long as the scanned sequence numbers have VAAs.
+			last, unrecorded = math.MaxUint64, true
+		} else if req.FromSequence > last {
+			return &publicrpcv1.GetSignedVAAsByEmitterResponse{VaaBytes: [][]byte{}}, nil
+		}
+	} else if req.FromSequence > last {
+		return nil, status.Error(codes.InvalidArgument, "from_sequence must not be greater than to_sequence")
+	}
+
+	// Scan at most maxSequenceRange sequence numbers, and report the rest of the range as the next page.
+	scanLast := last
+	if last-req.FromSequence >= maxSequenceRange {
+		scanLast = req.FromSequence + maxSequenceRange - 1
+	}
+
+	pageSize := maxSignedVAAsPageSize
+	if req.PageSize != 0 && req.PageSize < maxSignedVAAsPageSize {
+		pageSize = int(req.PageSize)
+	}
+
+	vaas, next, more, err := s.db.GetSignedVAABytesBySequenceRange(emitter, req.FromSequence, scanLast, pageSize)
+	if err != nil {
+		s.logger.Error("failed to fetch VAAs", zap.Error(err), zap.Any("request", req))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if !more && scanLast != last && (!unrecorded || len(vaas) != 0) {
+		next, more = scanLast+1, true
+	}
+
+	return &publicrpcv1.GetSignedVAAsByEmitterResponse{
+		VaaBytes:     vaas,
+		HasMore:      more,
+		NextSequence: next,
+	}, nil
+}
+
 func (s *PublicrpcServer) GetCurrentGuardianSet(_ context.Context, _ *publicrpcv1.GetCurrentGuardianSetRequest) (*publicrpcv1.GetCurrentGuardianSetResponse, error) {
 	gs := s.gst.Get()
 	if gs == nil {
@@ -2,12 +2,20 @@ package publicrpc
 
 import (
 	"context"
+	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	guardianDB "github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mr-tron/base58"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -81,3 +89,161 @@ func TestGovernorIsVAAEnqueuedNoMessage(t *testing.T) {
 		assert.Equal(t, expected_err, err)
 	})
 }
+
+// storeTestVAAs stores a VAA of the emitter for each sequence number, indexed by the transaction hash, and returns
+// the VAA bytes by sequence number.
+func storeTestVAAs(t *testing.T, db *guardianDB.Database, emitter vaa.Address, txHash []byte, seqs ...uint64) map[uint64][]byte {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	vaaBytes := map[uint64][]byte{}
+	entries := []guardianDB.TxHashIndexEntry{}
+	for _, seq := range seqs {
+		v := &vaa.VAA{
+			Version:          vaa.SupportedVAAVersion,
+			Timestamp:        time.Unix(0, 0),
+			Sequence:         seq,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   emitter,
+			ConsistencyLevel: 1,
+		}
+		v.AddSignature(key, 0)
+		require.NoError(t, db.StoreSignedVAA(v))
+		vaaBytes[seq], err = v.Marshal()
+		require.NoError(t, err)
+		entries = append(entries, guardianDB.TxHashIndexEntry{TxHash: txHash, VaaID: *guardianDB.VaaIDFromVAA(v)})
+	}
+	require.NoError(t, db.StoreTxHashIndexBatch(entries))
+	return vaaBytes
+}
+
+func TestGetSignedVAAsByTxHash(t *testing.T) {
+	ctx := context.Background()
+	db := guardianDB.OpenDb(zap.NewNop(), nil)
+	defer db.Close()
+	server := &PublicrpcServer{logger: zap.NewNop(), db: db}
+
+	emitter := vaa.Address{0x01}
+	txHash := []byte{0xab, 0xcd}
+
+	// The lookup is not available unless the index is enabled.
+	_, err := server.GetSignedVAAsByTxHash(ctx, &publicrpcv1.GetSignedVAAsByTxHashRequest{TxHash: "abcd"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	db.EnableTxHashIndex()
+	vaaBytes := storeTestVAAs(t, db, emitter, txHash, 7, 8)
+
+	for _, encoded := range []string{"abcd", "0xabcd", base58.Encode(txHash)} {
+		resp, err := server.GetSignedVAAsByTxHash(ctx, &publicrpcv1.GetSignedVAAsByTxHashRequest{TxHash: encoded})
+		require.NoError(t, err, encoded)
+		require.Len(t, resp.Entries, 2)
+		assert.Equal(t, &publicrpcv1.MessageID{
+			EmitterChain:   publicrpcv1.ChainID_CHAIN_ID_ETHEREUM,
+			EmitterAddress: hex.EncodeToString(emitter.Bytes()),
+			Sequence:       7,
+		}, resp.Entries[0].MessageId)
+		assert.Equal(t, vaaBytes[7], resp.Entries[0].VaaBytes)
+		assert.Equal(t, vaaBytes[8], resp.Entries[1].VaaBytes)
+	}
+
+	_, err = server.GetSignedVAAsByTxHash(ctx, &publicrpcv1.GetSignedVAAsByTxHashRequest{TxHash: "0xabce"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.GetSignedVAAsByTxHash(ctx, &publicrpcv1.GetSignedVAAsByTxHashRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = server.GetSignedVAAsByTxHash(ctx, &publicrpcv1.GetSignedVAAsByTxHashRequest{TxHash: "0xnot-hex"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetSignedVAAsByEmitter(t *testing.T) {
+	ctx := context.Background()
+	db := guardianDB.OpenDb(zap.NewNop(), nil)
+	defer db.Close()
+	server := &PublicrpcServer{logger: zap.NewNop(), db: db}
+
+	emitter := vaa.Address{0x01}
+	vaaBytes := storeTestVAAs(t, db, emitter, nil, 1, 2, 3, 1500)
+
+	req := &publicrpcv1.GetSignedVAAsByEmitterRequest{
+		EmitterChain:   publicrpcv1.ChainID_CHAIN_ID_ETHEREUM,
+		EmitterAddress: hex.EncodeToString(emitter.Bytes()),
+		FromSequence:   1,
+		ToSequence:     3,
+		PageSize:       2,
+	}
+
+	resp, err := server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{vaaBytes[1], vaaBytes[2]}, resp.VaaBytes)
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, uint64(3), resp.NextSequence)
+
+	req.FromSequence = resp.NextSequence
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{vaaBytes[3]}, resp.VaaBytes)
+	assert.False(t, resp.HasMore)
+
+	// An unbounded range is scanned in steps of maxSequenceRange sequence numbers.
+	req.FromSequence, req.ToSequence, req.PageSize = 4, 0, 0
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.VaaBytes)
+	assert.True(t, resp.HasMore)
+	assert.Equal(t, uint64(4+maxSequenceRange), resp.NextSequence)
+
+	req.FromSequence = resp.NextSequence
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{vaaBytes[1500]}, resp.VaaBytes)
+	assert.False(t, resp.HasMore)
+
+	// An unbounded range ends at the last stored VAA of the emitter.
+	req.FromSequence = 1501
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.VaaBytes)
+	assert.False(t, resp.HasMore)
+
+	req.FromSequence, req.EmitterAddress = 1, hex.EncodeToString(vaa.Address{0x02}.Bytes())
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.VaaBytes)
+	assert.False(t, resp.HasMore)
+	req.EmitterAddress = hex.EncodeToString(emitter.Bytes())
+
+	// Without a recorded last sequence number, as for VAAs stored by an older release, an unbounded range goes on as
+	// long as the scanned sequence numbers have VAAs.
+	legacy := vaa.Address{0x03}
+	legacyBytes := storeTestVAAs(t, db, legacy, nil, 1, 1500)
+	require.NoError(t, db.Conn().Update(func(txn *badger.Txn) error {
+		return txn.Delete([]byte("LASTSEQ:V1:2/" + legacy.String()))
+	}))
+	req.FromSequence, req.EmitterAddress = 1, hex.EncodeToString(legacy.Bytes())
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{legacyBytes[1]}, resp.VaaBytes)
+	assert.True(t, resp.HasMore)
+
+	req.FromSequence = resp.NextSequence
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{legacyBytes[1500]}, resp.VaaBytes)
+	assert.True(t, resp.HasMore)
+
+	req.FromSequence = resp.NextSequence
+	resp, err = server.GetSignedVAAsByEmitter(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, resp.VaaBytes)
+	assert.False(t, resp.HasMore)
+	req.EmitterAddress = hex.EncodeToString(emitter.Bytes())
+
+	req.FromSequence, req.ToSequence = 3, 2
+	_, err = server.GetSignedVAAsByEmitter(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.FromSequence, req.EmitterAddress = 1, "AAAA"
+	_, err = server.GetSignedVAAsByEmitter(ctx, req)
+	assert.Equal(t, status.Error(codes.InvalidArgument, "address must be 32 bytes"), err)
+}
@@ -109,6 +109,8 @@ type (
 // Every chain listed here must have at least one worker specified.
 var perChainConfig = map[vaa.ChainID]PerChainConfig{
 	vaa.ChainIDSolana:          {NumWorkers: 10, TimestampCacheSupported: false},
+	vaa.ChainIDSui:             {NumWorkers: 2, TimestampCacheSupported: false},
+	vaa.ChainIDAptos:           {NumWorkers: 2, TimestampCacheSupported: false},
 	vaa.ChainIDEthereum:        {NumWorkers: 5, TimestampCacheSupported: true},
 	vaa.ChainIDBSC:             {NumWorkers: 1, TimestampCacheSupported: true},
 	vaa.ChainIDPolygon:         {NumWorkers: 5, TimestampCacheSupported: true},
@@ -3,8 +3,10 @@ package query
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/certusone/wormhole/node/pkg/common"
@@ -116,6 +118,64 @@ type EthCallData struct {
 
 const EvmContractAddressLength = 20
 
+// EthGetLogsQueryRequestType is the type of an EVM eth_get_logs query request.
+const EthGetLogsQueryRequestType ChainSpecificQueryType = 10
+
+// EthGetLogsQueryRequest implements ChainSpecificQuery for an EVM eth_get_logs query request.
+type EthGetLogsQueryRequest struct {
+	// FromBlock is the first block in the range to be queried. It must be a block number as a hex string starting with 0x.
+	FromBlock string
+
+	// ToBlock is the last block in the range to be queried, inclusive. It must be a block number as a hex string starting with 0x.
+	ToBlock string
+
+	// Addresses is the list of contracts whose logs should be returned. At least one is required.
+	Addresses [][]byte
+
+	// Topics filters the logs by topic position, using the same semantics as eth_getLogs. Each entry lists the acceptable values
+	// for that position, and an empty entry matches any value.
+	Topics [][]ethCommon.Hash
+}
+
+// EthGetLogsMaxBlockRange is the maximum number of blocks that may be covered by a single eth_get_logs query.
+const EthGetLogsMaxBlockRange = 1000
+
+// EthGetLogsMaxAddresses is the maximum number of contract addresses in a single eth_get_logs query.
+const EthGetLogsMaxAddresses = 32
+
+// EthGetLogsMaxTopicPositions is the maximum number of topic positions in an EVM log.
+const EthGetLogsMaxTopicPositions = 4
+
+// EthGetLogsMaxTopicsPerPosition is the maximum number of alternative values for a single topic position.
+const EthGetLogsMaxTopicsPerPosition = 32
+
+// EthGetLogsMaxLogs is the maximum number of logs that may be returned in an eth_get_logs response.
+const EthGetLogsMaxLogs = 1000
+
+// EthGetLogsMaxDataSize is the maximum total size of the log data that may be returned in an eth_get_logs response.
+const EthGetLogsMaxDataSize = 512 * 1024
+
+// EthGetStorageAtQueryRequestType is the type of an EVM eth_get_storage_at query request.
+const EthGetStorageAtQueryRequestType ChainSpecificQueryType = 11
+
+// EthGetStorageAtQueryRequest implements ChainSpecificQuery for an EVM eth_get_storage_at query request.
+type EthGetStorageAtQueryRequest struct {
+	// BlockId identifies the block to be queried. It must be a hex string starting with 0x. It may be a block number or a block hash.
+	BlockId string
+
+	// Slots is an array of storage slots to be read from the specified block, in a single RPC call.
+	Slots []*EthStorageSlot
+}
+
+// EthStorageSlot specifies a single storage slot to be read.
+type EthStorageSlot struct {
+	// Address specifies the contract whose storage is to be read.
+	Address []byte
+
+	// Slot is the storage position to be read.
+	Slot ethCommon.Hash
+}
+
 ////////////////////////////////// Solana Queries ////////////////////////////////////////////////
 
 // SolanaAccountQueryRequestType is the type of a Solana sol_account query request.
@@ -199,6 +259,211 @@ func (spda *SolanaPdaQueryRequest) PDAList() []SolanaPDAEntry {
 	return spda.PDAs
 }
 
+////////////////////////////////// Sui Queries ////////////////////////////////////////////////
+
+// SuiObjectQueryRequestType is the type of a Sui sui_object query request.
+const SuiObjectQueryRequestType ChainSpecificQueryType = 6
+
+// SuiObjectQueryRequest implements ChainSpecificQuery for a Sui sui_object query request.
+type SuiObjectQueryRequest struct {
+	// Checkpoint is the sequence number of the checkpoint at which the objects should be read. It is required so that
+	// all guardians read the same object versions, regardless of how far their nodes have progressed.
+	Checkpoint uint64
+
+	// ObjectIDs is an array of objects to be queried.
+	ObjectIDs [][SuiObjectIDLength]byte
+}
+
+// Sui object IDs and addresses are fixed length.
+const SuiObjectIDLength = 32
+
+// SuiDigestLength is the length of a Sui object, transaction or checkpoint digest.
+const SuiDigestLength = 32
+
+// SuiMaxObjectsPerQuery limits the number of objects in a sui_object query. Since each object may require
+// walking back through its version history, this is kept well below what a single batch read supports.
+const SuiMaxObjectsPerQuery = 50
+
+func (soq *SuiObjectQueryRequest) ObjectIDList() [][SuiObjectIDLength]byte {
+	return soq.ObjectIDs
+}
+
+// SuiMoveViewQueryRequestType is the type of a Sui sui_move_view query request.
+const SuiMoveViewQueryRequestType ChainSpecificQueryType = 7
+
+// SuiMoveViewQueryRequest implements ChainSpecificQuery for a Sui sui_move_view query request. The calls are
+// evaluated as a single dev-inspect programmable transaction, so they are never executed on chain.
+type SuiMoveViewQueryRequest struct {
+	// Checkpoint is the sequence number of the checkpoint at which the calls should be evaluated. It is required so that
+	// all guardians evaluate the calls against the same object versions. Object arguments that changed after the
+	// checkpoint cannot be used, since the calls can only be simulated against the latest state.
+	Checkpoint uint64
+
+	// CallData is an array of Move calls to be evaluated in a single simulated transaction.
+	CallData []*SuiMoveCallData
+}
+
+// SuiMoveCallData specifies the parameters to a single Move function call.
+type SuiMoveCallData struct {
+	// Package is the ID of the package containing the function.
+	Package [SuiObjectIDLength]byte
+
+	// Module is the name of the module containing the function.
+	Module string
+
+	// Function is the name of the function to be called.
+	Function string
+
+	// TypeArguments is an optional list of Move type tags, such as "0x2::sui::SUI".
+	TypeArguments []string
+
+	// Arguments is the list of arguments passed to the function.
+	Arguments []SuiMoveCallArgument
+}
+
+// SuiMoveCallArgumentKind identifies how a Move call argument is encoded.
+type SuiMoveCallArgumentKind uint8
+
+const (
+	// SuiMoveCallArgumentPure is a BCS encoded primitive value.
+	SuiMoveCallArgumentPure SuiMoveCallArgumentKind = 1
+
+	// SuiMoveCallArgumentObject is an object, referenced by ID. The guardian's node resolves the version and ownership.
+	SuiMoveCallArgumentObject SuiMoveCallArgumentKind = 2
+)
+
+// SuiMoveCallArgument is a single argument to a Move call.
+type SuiMoveCallArgument struct {
+	Kind SuiMoveCallArgumentKind
+
+	// Pure is the BCS encoded value. It is only used for SuiMoveCallArgumentPure.
+	Pure []byte
+
+	// ObjectID is the object being passed. It is only used for SuiMoveCallArgumentObject.
+	ObjectID [SuiObjectIDLength]byte
+}
+
+// SuiMaxIdentifierLength limits the length of module and function names. Move identifiers are limited to 128 characters.
+const SuiMaxIdentifierLength = 128
+
+// SuiMaxTypeArgumentLength limits the length of a single type argument string.
+const SuiMaxTypeArgumentLength = 1024
+
+// SuiMaxPureArgumentLength limits the size of a pure argument. This matches the Sui protocol limit.
+const SuiMaxPureArgumentLength = 16 * 1024
+
+func (smv *SuiMoveViewQueryRequest) CallDataList() []*SuiMoveCallData {
+	return smv.CallData
+}
+
+////////////////////////////////// Aptos Queries ////////////////////////////////////////////////
+
+// AptosViewQueryRequestType is the type of an Aptos aptos_view query request.
+const AptosViewQueryRequestType ChainSpecificQueryType = 8
+
+// AptosViewQueryRequest implements ChainSpecificQuery for an Aptos aptos_view query request.
+// Exactly one of LedgerVersion and TargetTimestamp must be specified.
+type AptosViewQueryRequest struct {
+	// LedgerVersion is the ledger version at which the view functions should be evaluated.
+	LedgerVersion uint64
+
+	// TargetTimestamp, if specified, requests evaluation as of the last block with a timestamp at or before this time, in microseconds.
+	TargetTimestamp uint64
+
+	// CallData is an array of view functions to be evaluated at the selected ledger version.
+	CallData []*AptosViewCallData
+}
+
+// AptosViewCallData specifies the parameters to a single view function call.
+type AptosViewCallData struct {
+	// Function is the fully qualified function name, such as "0x1::coin::balance".
+	Function string
+
+	// TypeArguments is an optional list of Move type tags, such as "0x1::aptos_coin::AptosCoin".
+	TypeArguments []string
+
+	// Arguments is the list of JSON encoded arguments passed to the function, as expected by the Aptos view API.
+	Arguments [][]byte
+}
+
+// AptosAddressLength is the length of an Aptos account address.
+const AptosAddressLength = 32
+
+// AptosBlockHashLength is the length of an Aptos block hash.
+const AptosBlockHashLength = 32
+
+// AptosMaxCallsPerQuery limits the number of calls or resources in a single Aptos query. Each one is a separate
+// request to the guardian's node, so this is kept well below what the encoding supports.
+const AptosMaxCallsPerQuery = 32
+
+// AptosMaxFunctionLength limits the length of a fully qualified function name.
+const AptosMaxFunctionLength = 512
+
+// AptosMaxTypeLength limits the length of a type argument or resource type.
+const AptosMaxTypeLength = 1024
+
+// AptosMaxArgumentLength limits the size of a single JSON encoded argument.
+const AptosMaxArgumentLength = 16 * 1024
+
+func (avq *AptosViewQueryRequest) CallDataList() []*AptosViewCallData {
+	return avq.CallData
+}
+
+// AptosResourceQueryRequestType is the type of an Aptos aptos_resource query request.
+const AptosResourceQueryRequestType ChainSpecificQueryType = 9
+
+// AptosResourceQueryRequest implements ChainSpecificQuery for an Aptos aptos_resource query request.
+// Exactly one of LedgerVersion and TargetTimestamp must be specified.
+type AptosResourceQueryRequest struct {
+	// LedgerVersion is the ledger version at which the resources should be read.
+	LedgerVersion uint64
+
+	// TargetTimestamp, if specified, requests the resources as of the last block with a timestamp at or before this time, in microseconds.
+	TargetTimestamp uint64
+
+	// Resources is an array of resources to be read at the selected ledger version.
+	Resources []AptosResourceEntry
+}
+
+// AptosResourceEntry identifies a single resource stored under an account.
+type AptosResourceEntry struct {
+	// Account is the address of the account holding the resource.
+	Account [AptosAddressLength]byte
+
+	// ResourceType is the fully qualified Move type of the resource, such as "0x1::account::Account".
+	ResourceType string
+}
+
+func (arq *AptosResourceQueryRequest) ResourceList() []AptosResourceEntry {
+	return arq.Resources
+}
+
+// validateAptosLedgerSelector checks that exactly one of the ledger version and target timestamp is specified.
+func validateAptosLedgerSelector(ledgerVersion uint64, targetTimestamp uint64) error {
+	if ledgerVersion == 0 && targetTimestamp == 0 {
+		return fmt.Errorf("either ledger version or target timestamp must be specified")
+	}
+	if ledgerVersion != 0 && targetTimestamp != 0 {
+		return fmt.Errorf("ledger version and target timestamp may not both be specified")
+	}
+	return nil
+}
+
+// validateAptosFunction checks that a function name has the form <address>::<module>::<function>.
+func validateAptosFunction(function string) error {
+	if function == "" {
+		return fmt.Errorf("function is required")
+	}
+	if len(function) > AptosMaxFunctionLength {
+		return fmt.Errorf("function name too long")
+	}
+	parts := strings.Split(function, "::")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return fmt.Errorf(`function must be of the form "<address>::<module>::<function>"`)
+	}
+	return nil
+}
+
 // PerChainQueryInternal is an internal representation of a query request that is passed to the watcher.
 type PerChainQueryInternal struct {
 	RequestID  string
@@ -428,6 +693,18 @@ func (perChainQuery *PerChainQueryRequest) UnmarshalFromReader(reader *bytes.Rea
 			return fmt.Errorf("failed to unmarshal eth call with finality request: %w", err)
 		}
 		perChainQuery.Query = &q
+	case EthGetLogsQueryRequestType:
+		q := EthGetLogsQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal eth get logs request: %w", err)
+		}
+		perChainQuery.Query = &q
+	case EthGetStorageAtQueryRequestType:
+		q := EthGetStorageAtQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal eth get storage at request: %w", err)
+		}
+		perChainQuery.Query = &q
 	case SolanaAccountQueryRequestType:
 		q := SolanaAccountQueryRequest{}
 		if err := q.UnmarshalFromReader(reader); err != nil {
@@ -440,6 +717,30 @@ func (perChainQuery *PerChainQueryRequest) UnmarshalFromReader(reader *bytes.Rea
 			return fmt.Errorf("failed to unmarshal solana PDA query request: %w", err)
 		}
 		perChainQuery.Query = &q
+	case SuiObjectQueryRequestType:
+		q := SuiObjectQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal sui object query request: %w", err)
+		}
+		perChainQuery.Query = &q
+	case SuiMoveViewQueryRequestType:
+		q := SuiMoveViewQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal sui move view query request: %w", err)
+		}
+		perChainQuery.Query = &q
+	case AptosViewQueryRequestType:
+		q := AptosViewQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal aptos view query request: %w", err)
+		}
+		perChainQuery.Query = &q
+	case AptosResourceQueryRequestType:
+		q := AptosResourceQueryRequest{}
+		if err := q.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal aptos resource query request: %w", err)
+		}
+		perChainQuery.Query = &q
 	default:
 		return fmt.Errorf("unsupported query type: %d", queryType)
 	}
@@ -471,7 +772,10 @@ func (perChainQuery *PerChainQueryRequest) Validate() error {
 
 func ValidatePerChainQueryRequestType(qt ChainSpecificQueryType) error {
 	if qt != EthCallQueryRequestType && qt != EthCallByTimestampQueryRequestType && qt != EthCallWithFinalityQueryRequestType &&
-		qt != SolanaAccountQueryRequestType && qt != SolanaPdaQueryRequestType {
+		qt != EthGetLogsQueryRequestType && qt != EthGetStorageAtQueryRequestType &&
+		qt != SolanaAccountQueryRequestType && qt != SolanaPdaQueryRequestType &&
+		qt != SuiObjectQueryRequestType && qt != SuiMoveViewQueryRequestType &&
+		qt != AptosViewQueryRequestType && qt != AptosResourceQueryRequestType {
 		return fmt.Errorf("invalid query request type: %d", qt)
 	}
 	return nil
@@ -517,6 +821,20 @@ func (left *PerChainQueryRequest) Equal(right *PerChainQueryRequest) bool {
 		default:
 			panic("unsupported query type on right, must be eth_call_with_finality")
 		}
+	case *EthGetLogsQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *EthGetLogsQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be eth_get_logs")
+		}
+	case *EthGetStorageAtQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *EthGetStorageAtQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be eth_get_storage_at")
+		}
 	case *SolanaAccountQueryRequest:
 		switch rightQuery := right.Query.(type) {
 		case *SolanaAccountQueryRequest:
@@ -531,6 +849,34 @@ func (left *PerChainQueryRequest) Equal(right *PerChainQueryRequest) bool {
 		default:
 			panic("unsupported query type on right, must be sol_pda")
 		}
+	case *SuiObjectQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *SuiObjectQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be sui_object")
+		}
+	case *SuiMoveViewQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *SuiMoveViewQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be sui_move_view")
+		}
+	case *AptosViewQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *AptosViewQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be aptos_view")
+		}
+	case *AptosResourceQueryRequest:
+		switch rightQuery := right.Query.(type) {
+		case *AptosResourceQueryRequest:
+			return leftQuery.Equal(rightQuery)
+		default:
+			panic("unsupported query type on right, must be aptos_resource")
+		}
 	default:
 		panic("unsupported query type on left")
 	}
@@ -1001,160 +1347,468 @@ func (left *EthCallWithFinalityQueryRequest) Equal(right *EthCallWithFinalityQue
 }
 
 //
-// Implementation of SolanaAccountQueryRequest, which implements the ChainSpecificQuery interface.
+// Implementation of EthGetLogsQueryRequest, which implements the ChainSpecificQuery interface.
 //
 
-func (e *SolanaAccountQueryRequest) Type() ChainSpecificQueryType {
-	return SolanaAccountQueryRequestType
+func (e *EthGetLogsQueryRequest) Type() ChainSpecificQueryType {
+	return EthGetLogsQueryRequestType
 }
 
-// Marshal serializes the binary representation of a Solana sol_account request.
+// Marshal serializes the binary representation of an EVM eth_get_logs request.
 // This method calls Validate() and relies on it to range checks lengths, etc.
-func (saq *SolanaAccountQueryRequest) Marshal() ([]byte, error) {
-	if err := saq.Validate(); err != nil {
+func (eglq *EthGetLogsQueryRequest) Marshal() ([]byte, error) {
+	if err := eglq.Validate(); err != nil {
 		return nil, err
 	}
 
 	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, uint32(len(eglq.FromBlock))) // #nosec G115 -- This is validated in `Validate`
+	buf.Write([]byte(eglq.FromBlock))
 
-	vaa.MustWrite(buf, binary.BigEndian, uint32(len(saq.Commitment))) // #nosec G115 -- This is validated in `Validate`
-	buf.Write([]byte(saq.Commitment))
+	vaa.MustWrite(buf, binary.BigEndian, uint32(len(eglq.ToBlock))) // #nosec G115 -- This is validated in `Validate`
+	buf.Write([]byte(eglq.ToBlock))
 
-	vaa.MustWrite(buf, binary.BigEndian, saq.MinContextSlot)
-	vaa.MustWrite(buf, binary.BigEndian, saq.DataSliceOffset)
-	vaa.MustWrite(buf, binary.BigEndian, saq.DataSliceLength)
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(eglq.Addresses))) // #nosec G115 -- This is validated in `Validate`
+	for _, addr := range eglq.Addresses {
+		buf.Write(addr)
+	}
 
-	vaa.MustWrite(buf, binary.BigEndian, uint8(len(saq.Accounts))) // #nosec G115 -- This is validated in `Validate`
-	for _, acct := range saq.Accounts {
-		buf.Write(acct[:])
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(eglq.Topics))) // #nosec G115 -- This is validated in `Validate`
+	for _, topics := range eglq.Topics {
+		vaa.MustWrite(buf, binary.BigEndian, uint8(len(topics))) // #nosec G115 -- This is validated in `Validate`
+		for _, topic := range topics {
+			buf.Write(topic[:])
+		}
 	}
 	return buf.Bytes(), nil
 }
 
-// Unmarshal deserializes a Solana sol_account query from a byte array
-func (saq *SolanaAccountQueryRequest) Unmarshal(data []byte) error {
+// Unmarshal deserializes an EVM eth_get_logs query from a byte array
+func (eglq *EthGetLogsQueryRequest) Unmarshal(data []byte) error {
 	reader := bytes.NewReader(data[:])
-	return saq.UnmarshalFromReader(reader)
+	return eglq.UnmarshalFromReader(reader)
 }
 
-// UnmarshalFromReader  deserializes a Solana sol_account query from a byte array
-func (saq *SolanaAccountQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
-	length := uint32(0)
-	if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
-		return fmt.Errorf("failed to read commitment len: %w", err)
+// UnmarshalFromReader  deserializes an EVM eth_get_logs query from a byte array
+func (eglq *EthGetLogsQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	blockLen := uint32(0)
+	if err := binary.Read(reader, binary.BigEndian, &blockLen); err != nil {
+		return fmt.Errorf("failed to read from block len: %w", err)
 	}
-
-	if length > SolanaMaxCommitmentLength {
-		return fmt.Errorf("commitment string is too long, may not be more than %d characters", SolanaMaxCommitmentLength)
+	fromBlock := make([]byte, blockLen)
+	if n, err := reader.Read(fromBlock[:]); err != nil || n != int(blockLen) {
+		return fmt.Errorf("failed to read from block [%d]: %w", n, err)
 	}
+	eglq.FromBlock = string(fromBlock[:])
 
-	commitment := make([]byte, length)
-	if n, err := reader.Read(commitment[:]); err != nil || n != int(length) {
-		return fmt.Errorf("failed to read commitment [%d]: %w", n, err)
+	if err := binary.Read(reader, binary.BigEndian, &blockLen); err != nil {
+		return fmt.Errorf("failed to read to block len: %w", err)
 	}
-	saq.Commitment = string(commitment)
-
-	if err := binary.Read(reader, binary.BigEndian, &saq.MinContextSlot); err != nil {
-		return fmt.Errorf("failed to read min slot: %w", err)
+	toBlock := make([]byte, blockLen)
+	if n, err := reader.Read(toBlock[:]); err != nil || n != int(blockLen) {
+		return fmt.Errorf("failed to read to block [%d]: %w", n, err)
 	}
+	eglq.ToBlock = string(toBlock[:])
 
-	if err := binary.Read(reader, binary.BigEndian, &saq.DataSliceOffset); err != nil {
-		return fmt.Errorf("failed to read data slice offset: %w", err)
+	numAddresses := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numAddresses); err != nil {
+		return fmt.Errorf("failed to read number of addresses: %w", err)
 	}
-
-	if err := binary.Read(reader, binary.BigEndian, &saq.DataSliceLength); err != nil {
-		return fmt.Errorf("failed to read data slice length: %w", err)
+	for count := 0; count < int(numAddresses); count++ {
+		addr := [EvmContractAddressLength]byte{}
+		if n, err := reader.Read(addr[:]); err != nil || n != EvmContractAddressLength {
+			return fmt.Errorf("failed to read address [%d]: %w", n, err)
+		}
+		eglq.Addresses = append(eglq.Addresses, addr[:])
 	}
 
-	numAccounts := uint8(0)
-	if err := binary.Read(reader, binary.BigEndian, &numAccounts); err != nil {
-		return fmt.Errorf("failed to read number of account entries: %w", err)
+	numTopicPositions := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numTopicPositions); err != nil {
+		return fmt.Errorf("failed to read number of topic positions: %w", err)
 	}
-
-	for count := 0; count < int(numAccounts); count++ {
-		account := [SolanaPublicKeyLength]byte{}
-		if n, err := reader.Read(account[:]); err != nil || n != SolanaPublicKeyLength {
-			return fmt.Errorf("failed to read account [%d]: %w", n, err)
+	for count := 0; count < int(numTopicPositions); count++ {
+		numTopics := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &numTopics); err != nil {
+			return fmt.Errorf("failed to read number of topics: %w", err)
 		}
-		saq.Accounts = append(saq.Accounts, account)
+		topics := []ethCommon.Hash{}
+		for count2 := 0; count2 < int(numTopics); count2++ {
+			topic := ethCommon.Hash{}
+			if n, err := reader.Read(topic[:]); err != nil || n != ethCommon.HashLength {
+				return fmt.Errorf("failed to read topic [%d]: %w", n, err)
+			}
+			topics = append(topics, topic)
+		}
+		eglq.Topics = append(eglq.Topics, topics)
 	}
 
 	return nil
 }
 
-// Validate does basic validation on a Solana sol_account query.
-func (saq *SolanaAccountQueryRequest) Validate() error {
-	if len(saq.Commitment) > SolanaMaxCommitmentLength {
-		return fmt.Errorf("commitment too long")
+// parseEvmBlockNumber parses a block number of the form "0x1234".
+func parseEvmBlockNumber(block string) (uint64, error) {
+	if !strings.HasPrefix(block, "0x") {
+		return 0, fmt.Errorf("must be a hex number starting with 0x")
 	}
-	if saq.Commitment != "finalized" {
-		return fmt.Errorf(`commitment must be "finalized"`)
+	num, err := strconv.ParseUint(strings.TrimPrefix(block, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("must be a hex number starting with 0x")
 	}
+	return num, nil
+}
 
-	if saq.DataSliceLength == 0 && saq.DataSliceOffset != 0 {
-		return fmt.Errorf("data slice offset may not be set if data slice length is zero")
+// BlockRange returns the numeric block range covered by an eth_get_logs query. The query should be validated first.
+func (eglq *EthGetLogsQueryRequest) BlockRange() (uint64, uint64, error) {
+	fromBlock, err := parseEvmBlockNumber(eglq.FromBlock)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid from block: %w", err)
+	}
+	toBlock, err := parseEvmBlockNumber(eglq.ToBlock)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid to block: %w", err)
 	}
+	return fromBlock, toBlock, nil
+}
 
-	if len(saq.Accounts) <= 0 {
-		return fmt.Errorf("does not contain any account entries")
+// Validate does basic validation on an EVM eth_get_logs query.
+func (eglq *EthGetLogsQueryRequest) Validate() error {
+	if len(eglq.FromBlock) > math.MaxUint32 || len(eglq.ToBlock) > math.MaxUint32 {
+		return fmt.Errorf("block id too long")
 	}
-	if len(saq.Accounts) > SolanaMaxAccountsPerQuery {
-		return fmt.Errorf("too many account entries, may not be more than %d", SolanaMaxAccountsPerQuery)
+	fromBlock, toBlock, err := eglq.BlockRange()
+	if err != nil {
+		return err
 	}
-	for _, acct := range saq.Accounts {
-		// The account is fixed length, so don't need to check for nil.
-		if len(acct) != SolanaPublicKeyLength {
-			return fmt.Errorf("invalid account length")
+	if fromBlock > toBlock {
+		return fmt.Errorf("from block may not be after to block")
+	}
+	if toBlock-fromBlock >= EthGetLogsMaxBlockRange {
+		return fmt.Errorf("block range too large, may not be more than %d blocks", EthGetLogsMaxBlockRange)
+	}
+
+	if len(eglq.Addresses) <= 0 {
+		return fmt.Errorf("does not contain any addresses")
+	}
+	if len(eglq.Addresses) > EthGetLogsMaxAddresses {
+		return fmt.Errorf("too many addresses, may not be more than %d", EthGetLogsMaxAddresses)
+	}
+	for _, addr := range eglq.Addresses {
+		if len(addr) != EvmContractAddressLength {
+			return fmt.Errorf("invalid length for address")
+		}
+	}
+
+	if len(eglq.Topics) > EthGetLogsMaxTopicPositions {
+		return fmt.Errorf("too many topic positions, may not be more than %d", EthGetLogsMaxTopicPositions)
+	}
+	for _, topics := range eglq.Topics {
+		if len(topics) > EthGetLogsMaxTopicsPerPosition {
+			return fmt.Errorf("too many topics in a position, may not be more than %d", EthGetLogsMaxTopicsPerPosition)
 		}
 	}
 
 	return nil
 }
 
-// Equal verifies that two Solana sol_account queries are equal.
-func (left *SolanaAccountQueryRequest) Equal(right *SolanaAccountQueryRequest) bool {
-	if left.Commitment != right.Commitment ||
-		left.MinContextSlot != right.MinContextSlot ||
-		left.DataSliceOffset != right.DataSliceOffset ||
-		left.DataSliceLength != right.DataSliceLength {
+// Equal verifies that two EVM eth_get_logs queries are equal.
+func (left *EthGetLogsQueryRequest) Equal(right *EthGetLogsQueryRequest) bool {
+	if left.FromBlock != right.FromBlock || left.ToBlock != right.ToBlock {
 		return false
 	}
-
-	if len(left.Accounts) != len(right.Accounts) {
+	if len(left.Addresses) != len(right.Addresses) {
 		return false
 	}
-	for idx := range left.Accounts {
-		if !bytes.Equal(left.Accounts[idx][:], right.Accounts[idx][:]) {
+	for idx := range left.Addresses {
+		if !bytes.Equal(left.Addresses[idx], right.Addresses[idx]) {
 			return false
 		}
 	}
+	if len(left.Topics) != len(right.Topics) {
+		return false
+	}
+	for idx := range left.Topics {
+		if len(left.Topics[idx]) != len(right.Topics[idx]) {
+			return false
+		}
+		for idx2 := range left.Topics[idx] {
+			if left.Topics[idx][idx2] != right.Topics[idx][idx2] {
+				return false
+			}
+		}
+	}
 
 	return true
 }
 
 //
-// Implementation of SolanaPdaQueryRequest, which implements the ChainSpecificQuery interface.
+// Implementation of EthGetStorageAtQueryRequest, which implements the ChainSpecificQuery interface.
 //
 
-func (e *SolanaPdaQueryRequest) Type() ChainSpecificQueryType {
-	return SolanaPdaQueryRequestType
+func (e *EthGetStorageAtQueryRequest) Type() ChainSpecificQueryType {
+	return EthGetStorageAtQueryRequestType
 }
 
-// Marshal serializes the binary representation of a Solana sol_pda request.
+// Marshal serializes the binary representation of an EVM eth_get_storage_at request.
 // This method calls Validate() and relies on it to range checks lengths, etc.
-func (spda *SolanaPdaQueryRequest) Marshal() ([]byte, error) {
-	if err := spda.Validate(); err != nil {
+func (egsq *EthGetStorageAtQueryRequest) Marshal() ([]byte, error) {
+	if err := egsq.Validate(); err != nil {
 		return nil, err
 	}
 
 	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, uint32(len(egsq.BlockId))) // #nosec G115 -- This is validated in `Validate`
+	buf.Write([]byte(egsq.BlockId))
 
-	vaa.MustWrite(buf, binary.BigEndian, uint32(len(spda.Commitment))) // #nosec G115 -- This is validated in `Validate`
-	buf.Write([]byte(spda.Commitment))
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(egsq.Slots))) // #nosec G115 -- This is validated in `Validate`
+	for _, slot := range egsq.Slots {
+		buf.Write(slot.Address)
+		buf.Write(slot.Slot[:])
+	}
+	return buf.Bytes(), nil
+}
 
-	vaa.MustWrite(buf, binary.BigEndian, spda.MinContextSlot)
-	vaa.MustWrite(buf, binary.BigEndian, spda.DataSliceOffset)
-	vaa.MustWrite(buf, binary.BigEndian, spda.DataSliceLength)
+// Unmarshal deserializes an EVM eth_get_storage_at query from a byte array
+func (egsq *EthGetStorageAtQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return egsq.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an EVM eth_get_storage_at query from a byte array
+func (egsq *EthGetStorageAtQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	blockIdLen := uint32(0)
+	if err := binary.Read(reader, binary.BigEndian, &blockIdLen); err != nil {
+		return fmt.Errorf("failed to read block id len: %w", err)
+	}
+
+	blockId := make([]byte, blockIdLen)
+	if n, err := reader.Read(blockId[:]); err != nil || n != int(blockIdLen) {
+		return fmt.Errorf("failed to read block id [%d]: %w", n, err)
+	}
+	egsq.BlockId = string(blockId[:])
+
+	numSlots := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numSlots); err != nil {
+		return fmt.Errorf("failed to read number of slots: %w", err)
+	}
+
+	for count := 0; count < int(numSlots); count++ {
+		addr := [EvmContractAddressLength]byte{}
+		if n, err := reader.Read(addr[:]); err != nil || n != EvmContractAddressLength {
+			return fmt.Errorf("failed to read slot address [%d]: %w", n, err)
+		}
+
+		slot := &EthStorageSlot{Address: addr[:]}
+		if n, err := reader.Read(slot.Slot[:]); err != nil || n != ethCommon.HashLength {
+			return fmt.Errorf("failed to read slot [%d]: %w", n, err)
+		}
+
+		egsq.Slots = append(egsq.Slots, slot)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an EVM eth_get_storage_at query.
+func (egsq *EthGetStorageAtQueryRequest) Validate() error {
+	if len(egsq.BlockId) > math.MaxUint32 {
+		return fmt.Errorf("block id too long")
+	}
+	if !strings.HasPrefix(egsq.BlockId, "0x") {
+		return fmt.Errorf("block id must be a hex number or hash starting with 0x")
+	}
+	if len(egsq.Slots) <= 0 {
+		return fmt.Errorf("does not contain any slots")
+	}
+	if len(egsq.Slots) > math.MaxUint8 {
+		return fmt.Errorf("too many slots")
+	}
+	for _, slot := range egsq.Slots {
+		if slot == nil || len(slot.Address) <= 0 {
+			return fmt.Errorf("no slot address")
+		}
+		if len(slot.Address) != EvmContractAddressLength {
+			return fmt.Errorf("invalid length for slot address")
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two EVM eth_get_storage_at queries are equal.
+func (left *EthGetStorageAtQueryRequest) Equal(right *EthGetStorageAtQueryRequest) bool {
+	if left.BlockId != right.BlockId {
+		return false
+	}
+	if len(left.Slots) != len(right.Slots) {
+		return false
+	}
+	for idx := range left.Slots {
+		if !bytes.Equal(left.Slots[idx].Address, right.Slots[idx].Address) {
+			return false
+		}
+		if left.Slots[idx].Slot != right.Slots[idx].Slot {
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of SolanaAccountQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *SolanaAccountQueryRequest) Type() ChainSpecificQueryType {
+	return SolanaAccountQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Solana sol_account request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (saq *SolanaAccountQueryRequest) Marshal() ([]byte, error) {
+	if err := saq.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint32(len(saq.Commitment))) // #nosec G115 -- This is validated in `Validate`
+	buf.Write([]byte(saq.Commitment))
+
+	vaa.MustWrite(buf, binary.BigEndian, saq.MinContextSlot)
+	vaa.MustWrite(buf, binary.BigEndian, saq.DataSliceOffset)
+	vaa.MustWrite(buf, binary.BigEndian, saq.DataSliceLength)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(saq.Accounts))) // #nosec G115 -- This is validated in `Validate`
+	for _, acct := range saq.Accounts {
+		buf.Write(acct[:])
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes a Solana sol_account query from a byte array
+func (saq *SolanaAccountQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return saq.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes a Solana sol_account query from a byte array
+func (saq *SolanaAccountQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	length := uint32(0)
+	if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+		return fmt.Errorf("failed to read commitment len: %w", err)
+	}
+
+	if length > SolanaMaxCommitmentLength {
+		return fmt.Errorf("commitment string is too long, may not be more than %d characters", SolanaMaxCommitmentLength)
+	}
+
+	commitment := make([]byte, length)
+	if n, err := reader.Read(commitment[:]); err != nil || n != int(length) {
+		return fmt.Errorf("failed to read commitment [%d]: %w", n, err)
+	}
+	saq.Commitment = string(commitment)
+
+	if err := binary.Read(reader, binary.BigEndian, &saq.MinContextSlot); err != nil {
+		return fmt.Errorf("failed to read min slot: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &saq.DataSliceOffset); err != nil {
+		return fmt.Errorf("failed to read data slice offset: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &saq.DataSliceLength); err != nil {
+		return fmt.Errorf("failed to read data slice length: %w", err)
+	}
+
+	numAccounts := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numAccounts); err != nil {
+		return fmt.Errorf("failed to read number of account entries: %w", err)
+	}
+
+	for count := 0; count < int(numAccounts); count++ {
+		account := [SolanaPublicKeyLength]byte{}
+		if n, err := reader.Read(account[:]); err != nil || n != SolanaPublicKeyLength {
+			return fmt.Errorf("failed to read account [%d]: %w", n, err)
+		}
+		saq.Accounts = append(saq.Accounts, account)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on a Solana sol_account query.
+func (saq *SolanaAccountQueryRequest) Validate() error {
+	if len(saq.Commitment) > SolanaMaxCommitmentLength {
+		return fmt.Errorf("commitment too long")
+	}
+	if saq.Commitment != "finalized" {
+		return fmt.Errorf(`commitment must be "finalized"`)
+	}
+
+	if saq.DataSliceLength == 0 && saq.DataSliceOffset != 0 {
+		return fmt.Errorf("data slice offset may not be set if data slice length is zero")
+	}
+
+	if len(saq.Accounts) <= 0 {
+		return fmt.Errorf("does not contain any account entries")
+	}
+	if len(saq.Accounts) > SolanaMaxAccountsPerQuery {
+		return fmt.Errorf("too many account entries, may not be more than %d", SolanaMaxAccountsPerQuery)
+	}
+	for _, acct := range saq.Accounts {
+		// The account is fixed length, so don't need to check for nil.
+		if len(acct) != SolanaPublicKeyLength {
+			return fmt.Errorf("invalid account length")
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Solana sol_account queries are equal.
+func (left *SolanaAccountQueryRequest) Equal(right *SolanaAccountQueryRequest) bool {
+	if left.Commitment != right.Commitment ||
+		left.MinContextSlot != right.MinContextSlot ||
+		left.DataSliceOffset != right.DataSliceOffset ||
+		left.DataSliceLength != right.DataSliceLength {
+		return false
+	}
+
+	if len(left.Accounts) != len(right.Accounts) {
+		return false
+	}
+	for idx := range left.Accounts {
+		if !bytes.Equal(left.Accounts[idx][:], right.Accounts[idx][:]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of SolanaPdaQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *SolanaPdaQueryRequest) Type() ChainSpecificQueryType {
+	return SolanaPdaQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Solana sol_pda request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (spda *SolanaPdaQueryRequest) Marshal() ([]byte, error) {
+	if err := spda.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint32(len(spda.Commitment))) // #nosec G115 -- This is validated in `Validate`
+	buf.Write([]byte(spda.Commitment))
+
+	vaa.MustWrite(buf, binary.BigEndian, spda.MinContextSlot)
+	vaa.MustWrite(buf, binary.BigEndian, spda.DataSliceOffset)
+	vaa.MustWrite(buf, binary.BigEndian, spda.DataSliceLength)
 
 	vaa.MustWrite(buf, binary.BigEndian, uint8(len(spda.PDAs))) // #nosec G115 -- This is validated in `Validate`
 	for _, pda := range spda.PDAs {
@@ -1316,3 +1970,662 @@ func (left *SolanaPdaQueryRequest) Equal(right *SolanaPdaQueryRequest) bool {
 
 	return true
 }
+
+//
+// Implementation of SuiObjectQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *SuiObjectQueryRequest) Type() ChainSpecificQueryType {
+	return SuiObjectQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Sui sui_object request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (soq *SuiObjectQueryRequest) Marshal() ([]byte, error) {
+	if err := soq.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, soq.Checkpoint)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(soq.ObjectIDs))) // #nosec G115 -- This is validated in `Validate`
+	for _, objectID := range soq.ObjectIDs {
+		buf.Write(objectID[:])
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes a Sui sui_object query from a byte array
+func (soq *SuiObjectQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return soq.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes a Sui sui_object query from a byte array
+func (soq *SuiObjectQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &soq.Checkpoint); err != nil {
+		return fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	numObjects := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numObjects); err != nil {
+		return fmt.Errorf("failed to read number of object entries: %w", err)
+	}
+
+	for count := 0; count < int(numObjects); count++ {
+		objectID := [SuiObjectIDLength]byte{}
+		if n, err := reader.Read(objectID[:]); err != nil || n != SuiObjectIDLength {
+			return fmt.Errorf("failed to read object id [%d]: %w", n, err)
+		}
+		soq.ObjectIDs = append(soq.ObjectIDs, objectID)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on a Sui sui_object query.
+func (soq *SuiObjectQueryRequest) Validate() error {
+	if soq.Checkpoint == 0 {
+		return fmt.Errorf("checkpoint is required")
+	}
+
+	if len(soq.ObjectIDs) <= 0 {
+		return fmt.Errorf("does not contain any object entries")
+	}
+	if len(soq.ObjectIDs) > SuiMaxObjectsPerQuery {
+		return fmt.Errorf("too many object entries, may not be more than %d", SuiMaxObjectsPerQuery)
+	}
+
+	return nil
+}
+
+// Equal verifies that two Sui sui_object queries are equal.
+func (left *SuiObjectQueryRequest) Equal(right *SuiObjectQueryRequest) bool {
+	if left.Checkpoint != right.Checkpoint {
+		return false
+	}
+
+	if len(left.ObjectIDs) != len(right.ObjectIDs) {
+		return false
+	}
+	for idx := range left.ObjectIDs {
+		if !bytes.Equal(left.ObjectIDs[idx][:], right.ObjectIDs[idx][:]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of SuiMoveViewQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *SuiMoveViewQueryRequest) Type() ChainSpecificQueryType {
+	return SuiMoveViewQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Sui sui_move_view request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (smv *SuiMoveViewQueryRequest) Marshal() ([]byte, error) {
+	if err := smv.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, smv.Checkpoint)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(smv.CallData))) // #nosec G115 -- This is validated in `Validate`
+	for _, callData := range smv.CallData {
+		buf.Write(callData.Package[:])
+
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(callData.Module))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write([]byte(callData.Module))
+
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(callData.Function))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write([]byte(callData.Function))
+
+		vaa.MustWrite(buf, binary.BigEndian, uint8(len(callData.TypeArguments))) // #nosec G115 -- This is validated in `Validate`
+		for _, typeArg := range callData.TypeArguments {
+			vaa.MustWrite(buf, binary.BigEndian, uint32(len(typeArg))) // #nosec G115 -- This is validated in `Validate`
+			buf.Write([]byte(typeArg))
+		}
+
+		vaa.MustWrite(buf, binary.BigEndian, uint8(len(callData.Arguments))) // #nosec G115 -- This is validated in `Validate`
+		for _, arg := range callData.Arguments {
+			vaa.MustWrite(buf, binary.BigEndian, uint8(arg.Kind))
+			switch arg.Kind {
+			case SuiMoveCallArgumentPure:
+				vaa.MustWrite(buf, binary.BigEndian, uint32(len(arg.Pure))) // #nosec G115 -- This is validated in `Validate`
+				buf.Write(arg.Pure)
+			case SuiMoveCallArgumentObject:
+				buf.Write(arg.ObjectID[:])
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes a Sui sui_move_view query from a byte array
+func (smv *SuiMoveViewQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return smv.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes a Sui sui_move_view query from a byte array
+func (smv *SuiMoveViewQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &smv.Checkpoint); err != nil {
+		return fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	numCallData := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numCallData); err != nil {
+		return fmt.Errorf("failed to read number of call data entries: %w", err)
+	}
+
+	for count := 0; count < int(numCallData); count++ {
+		callData := &SuiMoveCallData{}
+		if n, err := reader.Read(callData.Package[:]); err != nil || n != SuiObjectIDLength {
+			return fmt.Errorf("failed to read package [%d]: %w", n, err)
+		}
+
+		length := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read module len: %w", err)
+		}
+		if length > SuiMaxIdentifierLength {
+			return fmt.Errorf("module name is too long, may not be more than %d characters", SuiMaxIdentifierLength)
+		}
+		module := make([]byte, length)
+		if n, err := reader.Read(module[:]); err != nil || n != int(length) {
+			return fmt.Errorf("failed to read module [%d]: %w", n, err)
+		}
+		callData.Module = string(module)
+
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read function len: %w", err)
+		}
+		if length > SuiMaxIdentifierLength {
+			return fmt.Errorf("function name is too long, may not be more than %d characters", SuiMaxIdentifierLength)
+		}
+		function := make([]byte, length)
+		if n, err := reader.Read(function[:]); err != nil || n != int(length) {
+			return fmt.Errorf("failed to read function [%d]: %w", n, err)
+		}
+		callData.Function = string(function)
+
+		numTypeArgs := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &numTypeArgs); err != nil {
+			return fmt.Errorf("failed to read number of type arguments: %w", err)
+		}
+		for count := 0; count < int(numTypeArgs); count++ {
+			if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+				return fmt.Errorf("failed to read type argument len: %w", err)
+			}
+			if length > SuiMaxTypeArgumentLength {
+				return fmt.Errorf("type argument is too long, may not be more than %d characters", SuiMaxTypeArgumentLength)
+			}
+			typeArg := make([]byte, length)
+			if n, err := reader.Read(typeArg[:]); err != nil || n != int(length) {
+				return fmt.Errorf("failed to read type argument [%d]: %w", n, err)
+			}
+			callData.TypeArguments = append(callData.TypeArguments, string(typeArg))
+		}
+
+		numArgs := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &numArgs); err != nil {
+			return fmt.Errorf("failed to read number of arguments: %w", err)
+		}
+		for count := 0; count < int(numArgs); count++ {
+			var arg SuiMoveCallArgument
+			if err := binary.Read(reader, binary.BigEndian, &arg.Kind); err != nil {
+				return fmt.Errorf("failed to read argument kind: %w", err)
+			}
+			switch arg.Kind {
+			case SuiMoveCallArgumentPure:
+				if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+					return fmt.Errorf("failed to read pure argument len: %w", err)
+				}
+				if length > SuiMaxPureArgumentLength {
+					return fmt.Errorf("pure argument is too long, may not be more than %d bytes", SuiMaxPureArgumentLength)
+				}
+				arg.Pure = make([]byte, length)
+				if n, err := reader.Read(arg.Pure[:]); err != nil || n != int(length) {
+					return fmt.Errorf("failed to read pure argument [%d]: %w", n, err)
+				}
+			case SuiMoveCallArgumentObject:
+				if n, err := reader.Read(arg.ObjectID[:]); err != nil || n != SuiObjectIDLength {
+					return fmt.Errorf("failed to read object argument [%d]: %w", n, err)
+				}
+			default:
+				return fmt.Errorf("invalid argument kind: %d", arg.Kind)
+			}
+			callData.Arguments = append(callData.Arguments, arg)
+		}
+
+		smv.CallData = append(smv.CallData, callData)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on a Sui sui_move_view query.
+func (smv *SuiMoveViewQueryRequest) Validate() error {
+	if smv.Checkpoint == 0 {
+		return fmt.Errorf("checkpoint is required")
+	}
+
+	if len(smv.CallData) <= 0 {
+		return fmt.Errorf("does not contain any call data")
+	}
+	if len(smv.CallData) > math.MaxUint8 {
+		return fmt.Errorf("too many call data entries")
+	}
+	for _, callData := range smv.CallData {
+		if callData == nil {
+			return fmt.Errorf("call data entry is nil")
+		}
+		// The package is fixed length, so don't need to check for nil.
+		if callData.Module == "" {
+			return fmt.Errorf("module is required")
+		}
+		if len(callData.Module) > SuiMaxIdentifierLength {
+			return fmt.Errorf("module name too long")
+		}
+		if callData.Function == "" {
+			return fmt.Errorf("function is required")
+		}
+		if len(callData.Function) > SuiMaxIdentifierLength {
+			return fmt.Errorf("function name too long")
+		}
+		if len(callData.TypeArguments) > math.MaxUint8 {
+			return fmt.Errorf("too many type arguments")
+		}
+		for _, typeArg := range callData.TypeArguments {
+			if typeArg == "" {
+				return fmt.Errorf("type argument is empty")
+			}
+			if len(typeArg) > SuiMaxTypeArgumentLength {
+				return fmt.Errorf("type argument too long")
+			}
+		}
+		if len(callData.Arguments) > math.MaxUint8 {
+			return fmt.Errorf("too many arguments")
+		}
+		for _, arg := range callData.Arguments {
+			switch arg.Kind {
+			case SuiMoveCallArgumentPure:
+				if len(arg.Pure) == 0 {
+					return fmt.Errorf("pure argument is empty")
+				}
+				if len(arg.Pure) > SuiMaxPureArgumentLength {
+					return fmt.Errorf("pure argument too long")
+				}
+			case SuiMoveCallArgumentObject:
+				if len(arg.Pure) != 0 {
+					return fmt.Errorf("object argument may not contain a pure value")
+				}
+			default:
+				return fmt.Errorf("invalid argument kind: %d", arg.Kind)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Sui sui_move_view queries are equal.
+func (left *SuiMoveViewQueryRequest) Equal(right *SuiMoveViewQueryRequest) bool {
+	if left.Checkpoint != right.Checkpoint {
+		return false
+	}
+	if len(left.CallData) != len(right.CallData) {
+		return false
+	}
+	for idx := range left.CallData {
+		lcd, rcd := left.CallData[idx], right.CallData[idx]
+		if !bytes.Equal(lcd.Package[:], rcd.Package[:]) ||
+			lcd.Module != rcd.Module ||
+			lcd.Function != rcd.Function {
+			return false
+		}
+		if len(lcd.TypeArguments) != len(rcd.TypeArguments) {
+			return false
+		}
+		for idx2 := range lcd.TypeArguments {
+			if lcd.TypeArguments[idx2] != rcd.TypeArguments[idx2] {
+				return false
+			}
+		}
+		if len(lcd.Arguments) != len(rcd.Arguments) {
+			return false
+		}
+		for idx2 := range lcd.Arguments {
+			if lcd.Arguments[idx2].Kind != rcd.Arguments[idx2].Kind ||
+				!bytes.Equal(lcd.Arguments[idx2].Pure, rcd.Arguments[idx2].Pure) ||
+				!bytes.Equal(lcd.Arguments[idx2].ObjectID[:], rcd.Arguments[idx2].ObjectID[:]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of AptosViewQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *AptosViewQueryRequest) Type() ChainSpecificQueryType {
+	return AptosViewQueryRequestType
+}
+
+// Marshal serializes the binary representation of an Aptos aptos_view request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (avq *AptosViewQueryRequest) Marshal() ([]byte, error) {
+	if err := avq.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, avq.LedgerVersion)
+	vaa.MustWrite(buf, binary.BigEndian, avq.TargetTimestamp)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(avq.CallData))) // #nosec G115 -- This is validated in `Validate`
+	for _, callData := range avq.CallData {
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(callData.Function))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write([]byte(callData.Function))
+
+		vaa.MustWrite(buf, binary.BigEndian, uint8(len(callData.TypeArguments))) // #nosec G115 -- This is validated in `Validate`
+		for _, typeArg := range callData.TypeArguments {
+			vaa.MustWrite(buf, binary.BigEndian, uint32(len(typeArg))) // #nosec G115 -- This is validated in `Validate`
+			buf.Write([]byte(typeArg))
+		}
+
+		vaa.MustWrite(buf, binary.BigEndian, uint8(len(callData.Arguments))) // #nosec G115 -- This is validated in `Validate`
+		for _, arg := range callData.Arguments {
+			vaa.MustWrite(buf, binary.BigEndian, uint32(len(arg))) // #nosec G115 -- This is validated in `Validate`
+			buf.Write(arg)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an Aptos aptos_view query from a byte array
+func (avq *AptosViewQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return avq.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an Aptos aptos_view query from a byte array
+func (avq *AptosViewQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &avq.LedgerVersion); err != nil {
+		return fmt.Errorf("failed to read ledger version: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &avq.TargetTimestamp); err != nil {
+		return fmt.Errorf("failed to read target timestamp: %w", err)
+	}
+
+	numCallData := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numCallData); err != nil {
+		return fmt.Errorf("failed to read number of call data entries: %w", err)
+	}
+
+	for count := 0; count < int(numCallData); count++ {
+		callData := &AptosViewCallData{}
+
+		length := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read function len: %w", err)
+		}
+		if length > AptosMaxFunctionLength {
+			return fmt.Errorf("function name is too long, may not be more than %d characters", AptosMaxFunctionLength)
+		}
+		function := make([]byte, length)
+		if n, err := reader.Read(function[:]); err != nil || n != int(length) {
+			return fmt.Errorf("failed to read function [%d]: %w", n, err)
+		}
+		callData.Function = string(function)
+
+		numTypeArgs := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &numTypeArgs); err != nil {
+			return fmt.Errorf("failed to read number of type arguments: %w", err)
+		}
+		for count := 0; count < int(numTypeArgs); count++ {
+			if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+				return fmt.Errorf("failed to read type argument len: %w", err)
+			}
+			if length > AptosMaxTypeLength {
+				return fmt.Errorf("type argument is too long, may not be more than %d characters", AptosMaxTypeLength)
+			}
+			typeArg := make([]byte, length)
+			if n, err := reader.Read(typeArg[:]); err != nil || n != int(length) {
+				return fmt.Errorf("failed to read type argument [%d]: %w", n, err)
+			}
+			callData.TypeArguments = append(callData.TypeArguments, string(typeArg))
+		}
+
+		numArgs := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &numArgs); err != nil {
+			return fmt.Errorf("failed to read number of arguments: %w", err)
+		}
+		for count := 0; count < int(numArgs); count++ {
+			if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+				return fmt.Errorf("failed to read argument len: %w", err)
+			}
+			if length > AptosMaxArgumentLength {
+				return fmt.Errorf("argument is too long, may not be more than %d bytes", AptosMaxArgumentLength)
+			}
+			arg := make([]byte, length)
+			if n, err := reader.Read(arg[:]); err != nil || n != int(length) {
+				return fmt.Errorf("failed to read argument [%d]: %w", n, err)
+			}
+			callData.Arguments = append(callData.Arguments, arg)
+		}
+
+		avq.CallData = append(avq.CallData, callData)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an Aptos aptos_view query.
+func (avq *AptosViewQueryRequest) Validate() error {
+	if err := validateAptosLedgerSelector(avq.LedgerVersion, avq.TargetTimestamp); err != nil {
+		return err
+	}
+
+	if len(avq.CallData) <= 0 {
+		return fmt.Errorf("does not contain any call data")
+	}
+	if len(avq.CallData) > AptosMaxCallsPerQuery {
+		return fmt.Errorf("too many call data entries, may not be more than %d", AptosMaxCallsPerQuery)
+	}
+	for _, callData := range avq.CallData {
+		if callData == nil {
+			return fmt.Errorf("call data entry is nil")
+		}
+		if err := validateAptosFunction(callData.Function); err != nil {
+			return err
+		}
+		if len(callData.TypeArguments) > math.MaxUint8 {
+			return fmt.Errorf("too many type arguments")
+		}
+		for _, typeArg := range callData.TypeArguments {
+			if typeArg == "" {
+				return fmt.Errorf("type argument is empty")
+			}
+			if len(typeArg) > AptosMaxTypeLength {
+				return fmt.Errorf("type argument too long")
+			}
+		}
+		if len(callData.Arguments) > math.MaxUint8 {
+			return fmt.Errorf("too many arguments")
+		}
+		for _, arg := range callData.Arguments {
+			if len(arg) > AptosMaxArgumentLength {
+				return fmt.Errorf("argument too long")
+			}
+			if !json.Valid(arg) {
+				return fmt.Errorf("argument is not valid JSON")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Aptos aptos_view queries are equal.
+func (left *AptosViewQueryRequest) Equal(right *AptosViewQueryRequest) bool {
+	if left.LedgerVersion != right.LedgerVersion ||
+		left.TargetTimestamp != right.TargetTimestamp {
+		return false
+	}
+	if len(left.CallData) != len(right.CallData) {
+		return false
+	}
+	for idx := range left.CallData {
+		lcd, rcd := left.CallData[idx], right.CallData[idx]
+		if lcd.Function != rcd.Function {
+			return false
+		}
+		if len(lcd.TypeArguments) != len(rcd.TypeArguments) {
+			return false
+		}
+		for idx2 := range lcd.TypeArguments {
+			if lcd.TypeArguments[idx2] != rcd.TypeArguments[idx2] {
+				return false
+			}
+		}
+		if len(lcd.Arguments) != len(rcd.Arguments) {
+			return false
+		}
+		for idx2 := range lcd.Arguments {
+			if !bytes.Equal(lcd.Arguments[idx2], rcd.Arguments[idx2]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of AptosResourceQueryRequest, which implements the ChainSpecificQuery interface.
+//
+
+func (e *AptosResourceQueryRequest) Type() ChainSpecificQueryType {
+	return AptosResourceQueryRequestType
+}
+
+// Marshal serializes the binary representation of an Aptos aptos_resource request.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (arq *AptosResourceQueryRequest) Marshal() ([]byte, error) {
+	if err := arq.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, arq.LedgerVersion)
+	vaa.MustWrite(buf, binary.BigEndian, arq.TargetTimestamp)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(arq.Resources))) // #nosec G115 -- This is validated in `Validate`
+	for _, resource := range arq.Resources {
+		buf.Write(resource.Account[:])
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(resource.ResourceType))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write([]byte(resource.ResourceType))
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an Aptos aptos_resource query from a byte array
+func (arq *AptosResourceQueryRequest) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return arq.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an Aptos aptos_resource query from a byte array
+func (arq *AptosResourceQueryRequest) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &arq.LedgerVersion); err != nil {
+		return fmt.Errorf("failed to read ledger version: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &arq.TargetTimestamp); err != nil {
+		return fmt.Errorf("failed to read target timestamp: %w", err)
+	}
+
+	numResources := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResources); err != nil {
+		return fmt.Errorf("failed to read number of resource entries: %w", err)
+	}
+
+	for count := 0; count < int(numResources); count++ {
+		resource := AptosResourceEntry{}
+		if n, err := reader.Read(resource.Account[:]); err != nil || n != AptosAddressLength {
+			return fmt.Errorf("failed to read account [%d]: %w", n, err)
+		}
+
+		length := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read resource type len: %w", err)
+		}
+		if length > AptosMaxTypeLength {
+			return fmt.Errorf("resource type is too long, may not be more than %d characters", AptosMaxTypeLength)
+		}
+		resourceType := make([]byte, length)
+		if n, err := reader.Read(resourceType[:]); err != nil || n != int(length) {
+			return fmt.Errorf("failed to read resource type [%d]: %w", n, err)
+		}
+		resource.ResourceType = string(resourceType)
+
+		arq.Resources = append(arq.Resources, resource)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an Aptos aptos_resource query.
+func (arq *AptosResourceQueryRequest) Validate() error {
+	if err := validateAptosLedgerSelector(arq.LedgerVersion, arq.TargetTimestamp); err != nil {
+		return err
+	}
+
+	if len(arq.Resources) <= 0 {
+		return fmt.Errorf("does not contain any resource entries")
+	}
+	if len(arq.Resources) > AptosMaxCallsPerQuery {
+		return fmt.Errorf("too many resource entries, may not be more than %d", AptosMaxCallsPerQuery)
+	}
+	for _, resource := range arq.Resources {
+		// The account is fixed length, so don't need to check for nil.
+		if resource.ResourceType == "" {
+			return fmt.Errorf("resource type is required")
+		}
+		if len(resource.ResourceType) > AptosMaxTypeLength {
+			return fmt.Errorf("resource type too long")
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Aptos aptos_resource queries are equal.
+func (left *AptosResourceQueryRequest) Equal(right *AptosResourceQueryRequest) bool {
+	if left.LedgerVersion != right.LedgerVersion ||
+		left.TargetTimestamp != right.TargetTimestamp {
+		return false
+	}
+	if len(left.Resources) != len(right.Resources) {
+		return false
+	}
+	for idx := range left.Resources {
+		if !bytes.Equal(left.Resources[idx].Account[:], right.Resources[idx].Account[:]) ||
+			left.Resources[idx].ResourceType != right.Resources[idx].ResourceType {
+			return false
+		}
+	}
+
+	return true
+}
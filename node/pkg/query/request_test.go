@@ -726,6 +726,158 @@ func TestMarshalOfEthCallWithFinalityQueryWithSafeShouldSucceed(t *testing.T) {
 	require.NoError(t, err)
 }
 
+///////////// EthGetLogs tests ////////////////////////////////////////
+
+func createEthGetLogsQueryRequestForTesting(t *testing.T) *QueryRequest {
+	t.Helper()
+
+	callRequest1 := &EthGetLogsQueryRequest{
+		FromBlock: "0x28d9630",
+		ToBlock:   "0x28d9640",
+		Addresses: [][]byte{
+			ethCommon.HexToAddress("0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B").Bytes(),
+		},
+		Topics: [][]ethCommon.Hash{
+			{ethCommon.HexToHash("0x6eb224fb001ed210e379b335e35efe88672a8ce935d981a6896b27ffdf52a3b2")},
+			{},
+			{
+				ethCommon.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+				ethCommon.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+			},
+		},
+	}
+
+	perChainQuery1 := &PerChainQueryRequest{
+		ChainId: vaa.ChainIDPolygon,
+		Query:   callRequest1,
+	}
+
+	queryRequest := &QueryRequest{
+		Nonce:           1,
+		PerChainQueries: []*PerChainQueryRequest{perChainQuery1},
+	}
+
+	return queryRequest
+}
+
+func TestEthGetLogsQueryRequestMarshalUnmarshal(t *testing.T) {
+	queryRequest := createEthGetLogsQueryRequestForTesting(t)
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+}
+
+func TestEthGetLogsQueryRequestWithInvalidFieldsShouldFail(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *EthGetLogsQueryRequest)
+		errMsg string
+	}{
+		{name: "from block not hex", modify: func(req *EthGetLogsQueryRequest) { req.FromBlock = "12345" }, errMsg: "invalid from block"},
+		{name: "to block is a hash", modify: func(req *EthGetLogsQueryRequest) {
+			req.ToBlock = "0x6eb224fb001ed210e379b335e35efe88672a8ce935d981a6896b27ffdf52a3b2"
+		}, errMsg: "invalid to block"},
+		{name: "from after to", modify: func(req *EthGetLogsQueryRequest) { req.FromBlock, req.ToBlock = req.ToBlock, req.FromBlock }, errMsg: "from block may not be after to block"},
+		{name: "range too large", modify: func(req *EthGetLogsQueryRequest) { req.FromBlock, req.ToBlock = "0x0", "0x3e8" }, errMsg: "block range too large"},
+		{name: "no addresses", modify: func(req *EthGetLogsQueryRequest) { req.Addresses = nil }, errMsg: "does not contain any addresses"},
+		{name: "bad address length", modify: func(req *EthGetLogsQueryRequest) { req.Addresses[0] = req.Addresses[0][1:] }, errMsg: "invalid length for address"},
+		{name: "too many topic positions", modify: func(req *EthGetLogsQueryRequest) {
+			req.Topics = make([][]ethCommon.Hash, EthGetLogsMaxTopicPositions+1)
+		}, errMsg: "too many topic positions"},
+		{name: "too many topics in a position", modify: func(req *EthGetLogsQueryRequest) {
+			req.Topics[0] = make([]ethCommon.Hash, EthGetLogsMaxTopicsPerPosition+1)
+		}, errMsg: "too many topics in a position"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queryRequest := createEthGetLogsQueryRequestForTesting(t)
+			tc.modify(queryRequest.PerChainQueries[0].Query.(*EthGetLogsQueryRequest))
+			_, err := queryRequest.Marshal()
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestEthGetLogsQueryRequestMaxBlockRangeShouldSucceed(t *testing.T) {
+	queryRequest := createEthGetLogsQueryRequestForTesting(t)
+	req := queryRequest.PerChainQueries[0].Query.(*EthGetLogsQueryRequest)
+	req.FromBlock, req.ToBlock = "0x1", "0x3e8" // Exactly EthGetLogsMaxBlockRange blocks
+	_, err := queryRequest.Marshal()
+	require.NoError(t, err)
+}
+
+///////////// EthGetStorageAt tests ////////////////////////////////////////
+
+func createEthGetStorageAtQueryRequestForTesting(t *testing.T) *QueryRequest {
+	t.Helper()
+
+	callRequest1 := &EthGetStorageAtQueryRequest{
+		BlockId: "0x28d9630",
+		Slots: []*EthStorageSlot{
+			{
+				Address: ethCommon.HexToAddress("0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B").Bytes(),
+				Slot:    ethCommon.HexToHash("0x0"),
+			},
+			{
+				Address: ethCommon.HexToAddress("0x98f3c9e6E3fAce36bAAd05FE09d375Ef1464288B").Bytes(),
+				Slot:    ethCommon.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"),
+			},
+		},
+	}
+
+	perChainQuery1 := &PerChainQueryRequest{
+		ChainId: vaa.ChainIDPolygon,
+		Query:   callRequest1,
+	}
+
+	queryRequest := &QueryRequest{
+		Nonce:           1,
+		PerChainQueries: []*PerChainQueryRequest{perChainQuery1},
+	}
+
+	return queryRequest
+}
+
+func TestEthGetStorageAtQueryRequestMarshalUnmarshal(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+}
+
+func TestEthGetStorageAtQueryRequestWithInvalidFieldsShouldFail(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *EthGetStorageAtQueryRequest)
+		errMsg string
+	}{
+		{name: "block id not hex", modify: func(req *EthGetStorageAtQueryRequest) { req.BlockId = "latest" }, errMsg: "block id must be a hex number or hash starting with 0x"},
+		{name: "no slots", modify: func(req *EthGetStorageAtQueryRequest) { req.Slots = nil }, errMsg: "does not contain any slots"},
+		{name: "nil slot", modify: func(req *EthGetStorageAtQueryRequest) { req.Slots[0] = nil }, errMsg: "no slot address"},
+		{name: "bad address length", modify: func(req *EthGetStorageAtQueryRequest) { req.Slots[0].Address = []byte{0x01} }, errMsg: "invalid length for slot address"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+			tc.modify(queryRequest.PerChainQueries[0].Query.(*EthGetStorageAtQueryRequest))
+			_, err := queryRequest.Marshal()
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
 ///////////// Solana Account Query tests /////////////////////////////////
 
 func createSolanaAccountQueryRequestForTesting(t *testing.T) *QueryRequest {
@@ -847,6 +999,358 @@ func TestSolanaPdaQueryUnmarshalFromSDK(t *testing.T) {
 
 ///////////// End of Solana PDA Query tests ///////////////////////////
 
+///////////// Sui Object Query tests /////////////////////////////////
+
+func createSuiObjectQueryRequestForTesting(t *testing.T) *QueryRequest {
+	t.Helper()
+
+	callRequest1 := &SuiObjectQueryRequest{
+		Checkpoint: 145024142,
+		ObjectIDs: [][SuiObjectIDLength]byte{
+			ethCommon.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000006"),
+			ethCommon.HexToHash("0xaeab97f96cf9877fee2883315d459552b2b921edc16d7ceac6eab944dd88919c"),
+		},
+	}
+
+	perChainQuery1 := &PerChainQueryRequest{
+		ChainId: vaa.ChainIDSui,
+		Query:   callRequest1,
+	}
+
+	queryRequest := &QueryRequest{
+		Nonce:           1,
+		PerChainQueries: []*PerChainQueryRequest{perChainQuery1},
+	}
+
+	return queryRequest
+}
+
+func TestSuiObjectQueryRequestMarshalUnmarshal(t *testing.T) {
+	queryRequest := createSuiObjectQueryRequestForTesting(t)
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+}
+
+func TestSuiObjectQueryRequestWithNoCheckpointShouldFail(t *testing.T) {
+	queryRequest := createSuiObjectQueryRequestForTesting(t)
+	queryRequest.PerChainQueries[0].Query.(*SuiObjectQueryRequest).Checkpoint = 0
+	_, err := queryRequest.Marshal()
+	require.ErrorContains(t, err, "checkpoint is required")
+}
+
+func TestSuiObjectQueryRequestWithTooManyObjectsShouldFail(t *testing.T) {
+	queryRequest := createSuiObjectQueryRequestForTesting(t)
+	req := queryRequest.PerChainQueries[0].Query.(*SuiObjectQueryRequest)
+	for len(req.ObjectIDs) <= SuiMaxObjectsPerQuery {
+		req.ObjectIDs = append(req.ObjectIDs, req.ObjectIDs[0])
+	}
+	_, err := queryRequest.Marshal()
+	require.ErrorContains(t, err, "too many object entries")
+}
+
+func TestSuiLengthsAreAsExpected(t *testing.T) {
+	// It will break the spec if these ever change!
+	require.Equal(t, 32, SuiObjectIDLength)
+	require.Equal(t, 32, SuiDigestLength)
+}
+
+///////////// Sui Move View Query tests /////////////////////////////////
+
+func createSuiMoveViewQueryRequestForTesting(t *testing.T) *QueryRequest {
+	t.Helper()
+
+	callRequest1 := &SuiMoveViewQueryRequest{
+		Checkpoint: 145024142,
+		CallData: []*SuiMoveCallData{
+			{
+				Package:  ethCommon.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+				Module:   "clock",
+				Function: "timestamp_ms",
+				Arguments: []SuiMoveCallArgument{
+					{Kind: SuiMoveCallArgumentObject, ObjectID: ethCommon.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000006")},
+				},
+			},
+			{
+				Package:       ethCommon.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000002"),
+				Module:        "balance",
+				Function:      "zero",
+				TypeArguments: []string{"0x2::sui::SUI"},
+			},
+			{
+				Package:  ethCommon.HexToHash("0x5306f64e312b581766351c07af79c72fcb1cd25147157fdc2f8ad76de9a3fb6a"),
+				Module:   "state",
+				Function: "governance_chain",
+				Arguments: []SuiMoveCallArgument{
+					{Kind: SuiMoveCallArgumentObject, ObjectID: ethCommon.HexToHash("0xaeab97f96cf9877fee2883315d459552b2b921edc16d7ceac6eab944dd88919c")},
+					{Kind: SuiMoveCallArgumentPure, Pure: []byte{0x01, 0x00}},
+				},
+			},
+		},
+	}
+
+	perChainQuery1 := &PerChainQueryRequest{
+		ChainId: vaa.ChainIDSui,
+		Query:   callRequest1,
+	}
+
+	queryRequest := &QueryRequest{
+		Nonce:           1,
+		PerChainQueries: []*PerChainQueryRequest{perChainQuery1},
+	}
+
+	return queryRequest
+}
+
+func TestSuiMoveViewQueryRequestMarshalUnmarshal(t *testing.T) {
+	queryRequest := createSuiMoveViewQueryRequestForTesting(t)
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+}
+
+func TestSuiMoveViewQueryRequestWithInvalidFieldsShouldFail(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cd *SuiMoveCallData)
+		errMsg string
+	}{
+		{name: "missing module", modify: func(cd *SuiMoveCallData) { cd.Module = "" }, errMsg: "module is required"},
+		{name: "missing function", modify: func(cd *SuiMoveCallData) { cd.Function = "" }, errMsg: "function is required"},
+		{name: "module too long", modify: func(cd *SuiMoveCallData) { cd.Module = strings.Repeat("a", SuiMaxIdentifierLength+1) }, errMsg: "module name too long"},
+		{name: "empty type argument", modify: func(cd *SuiMoveCallData) { cd.TypeArguments = []string{""} }, errMsg: "type argument is empty"},
+		{name: "empty pure argument", modify: func(cd *SuiMoveCallData) {
+			cd.Arguments = []SuiMoveCallArgument{{Kind: SuiMoveCallArgumentPure}}
+		}, errMsg: "pure argument is empty"},
+		{name: "pure argument too long", modify: func(cd *SuiMoveCallData) {
+			cd.Arguments = []SuiMoveCallArgument{{Kind: SuiMoveCallArgumentPure, Pure: make([]byte, SuiMaxPureArgumentLength+1)}}
+		}, errMsg: "pure argument too long"},
+		{name: "invalid argument kind", modify: func(cd *SuiMoveCallData) {
+			cd.Arguments = []SuiMoveCallArgument{{Kind: 3}}
+		}, errMsg: "invalid argument kind"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queryRequest := createSuiMoveViewQueryRequestForTesting(t)
+			tc.modify(queryRequest.PerChainQueries[0].Query.(*SuiMoveViewQueryRequest).CallData[0])
+			_, err := queryRequest.Marshal()
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestSuiMoveViewQueryRequestWithNoCheckpointShouldFail(t *testing.T) {
+	queryRequest := createSuiMoveViewQueryRequestForTesting(t)
+	queryRequest.PerChainQueries[0].Query.(*SuiMoveViewQueryRequest).Checkpoint = 0
+	_, err := queryRequest.Marshal()
+	require.ErrorContains(t, err, "checkpoint is required")
+}
+
+func TestSuiMoveViewQueryRequestUnmarshalWithInvalidArgumentKindShouldFail(t *testing.T) {
+	req := &SuiMoveViewQueryRequest{
+		Checkpoint: 1,
+		CallData: []*SuiMoveCallData{
+			{
+				Package:   ethCommon.HexToHash("0x02"),
+				Module:    "clock",
+				Function:  "timestamp_ms",
+				Arguments: []SuiMoveCallArgument{{Kind: SuiMoveCallArgumentObject}},
+			},
+		},
+	}
+	b, err := req.Marshal()
+	require.NoError(t, err)
+
+	// The argument kind immediately precedes the 32 byte object ID at the end of the buffer.
+	b[len(b)-SuiObjectIDLength-1] = 0xff
+
+	var req2 SuiMoveViewQueryRequest
+	err = req2.Unmarshal(b)
+	require.ErrorContains(t, err, "invalid argument kind")
+}
+
+///////////// End of Sui Query tests ///////////////////////////
+
+///////////// Aptos View Query tests /////////////////////////////////
+
+func createAptosViewQueryRequestForTesting(t *testing.T) *QueryRequest {
+	t.Helper()
+
+	callRequest1 := &AptosViewQueryRequest{
+		LedgerVersion: 1878563456,
+		CallData: []*AptosViewCallData{
+			{
+				Function: "0x1::timestamp::now_microseconds",
+			},
+			{
+				Function:      "0x1::coin::balance",
+				TypeArguments: []string{"0x1::aptos_coin::AptosCoin"},
+				Arguments:     [][]byte{[]byte(`"0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625"`)},
+			},
+		},
+	}
+
+	perChainQuery1 := &PerChainQueryRequest{
+		ChainId: vaa.ChainIDAptos,
+		Query:   callRequest1,
+	}
+
+	queryRequest := &QueryRequest{
+		Nonce:           1,
+		PerChainQueries: []*PerChainQueryRequest{perChainQuery1},
+	}
+
+	return queryRequest
+}
+
+func TestAptosViewQueryRequestMarshalUnmarshal(t *testing.T) {
+	queryRequest := createAptosViewQueryRequestForTesting(t)
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+}
+
+func TestAptosViewQueryRequestByTimestampMarshalUnmarshal(t *testing.T) {
+	queryRequest := createAptosViewQueryRequestForTesting(t)
+	req := queryRequest.PerChainQueries[0].Query.(*AptosViewQueryRequest)
+	req.LedgerVersion = 0
+	req.TargetTimestamp = 1714520000000000
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+	assert.Equal(t, uint64(1714520000000000), queryRequest2.PerChainQueries[0].Query.(*AptosViewQueryRequest).TargetTimestamp)
+}
+
+func TestAptosViewQueryRequestWithInvalidFieldsShouldFail(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *AptosViewQueryRequest)
+		errMsg string
+	}{
+		{name: "no ledger selector", modify: func(req *AptosViewQueryRequest) { req.LedgerVersion = 0 }, errMsg: "either ledger version or target timestamp must be specified"},
+		{name: "both ledger selectors", modify: func(req *AptosViewQueryRequest) { req.TargetTimestamp = 1 }, errMsg: "may not both be specified"},
+		{name: "no call data", modify: func(req *AptosViewQueryRequest) { req.CallData = nil }, errMsg: "does not contain any call data"},
+		{name: "missing function", modify: func(req *AptosViewQueryRequest) { req.CallData[0].Function = "" }, errMsg: "function is required"},
+		{name: "malformed function", modify: func(req *AptosViewQueryRequest) { req.CallData[0].Function = "0x1::timestamp" }, errMsg: "function must be of the form"},
+		{name: "function too long", modify: func(req *AptosViewQueryRequest) {
+			req.CallData[0].Function = "0x1::m::" + strings.Repeat("a", AptosMaxFunctionLength)
+		}, errMsg: "function name too long"},
+		{name: "empty type argument", modify: func(req *AptosViewQueryRequest) { req.CallData[0].TypeArguments = []string{""} }, errMsg: "type argument is empty"},
+		{name: "invalid JSON argument", modify: func(req *AptosViewQueryRequest) { req.CallData[0].Arguments = [][]byte{[]byte("0x1")} }, errMsg: "argument is not valid JSON"},
+		{name: "too many calls", modify: func(req *AptosViewQueryRequest) {
+			for len(req.CallData) <= AptosMaxCallsPerQuery {
+				req.CallData = append(req.CallData, req.CallData[0])
+			}
+		}, errMsg: "too many call data entries"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queryRequest := createAptosViewQueryRequestForTesting(t)
+			tc.modify(queryRequest.PerChainQueries[0].Query.(*AptosViewQueryRequest))
+			_, err := queryRequest.Marshal()
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+///////////// Aptos Resource Query tests /////////////////////////////////
+
+func createAptosResourceQueryRequestForTesting(t *testing.T) *QueryRequest {
+	t.Helper()
+
+	callRequest1 := &AptosResourceQueryRequest{
+		LedgerVersion: 1878563456,
+		Resources: []AptosResourceEntry{
+			{
+				Account:      ethCommon.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001"),
+				ResourceType: "0x1::block::BlockResource",
+			},
+			{
+				Account:      ethCommon.HexToHash("0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625"),
+				ResourceType: "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>",
+			},
+		},
+	}
+
+	perChainQuery1 := &PerChainQueryRequest{
+		ChainId: vaa.ChainIDAptos,
+		Query:   callRequest1,
+	}
+
+	queryRequest := &QueryRequest{
+		Nonce:           1,
+		PerChainQueries: []*PerChainQueryRequest{perChainQuery1},
+	}
+
+	return queryRequest
+}
+
+func TestAptosResourceQueryRequestMarshalUnmarshal(t *testing.T) {
+	queryRequest := createAptosResourceQueryRequestForTesting(t)
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	var queryRequest2 QueryRequest
+	err = queryRequest2.Unmarshal(queryRequestBytes)
+	require.NoError(t, err)
+
+	assert.True(t, queryRequest.Equal(&queryRequest2))
+}
+
+func TestAptosResourceQueryRequestWithInvalidFieldsShouldFail(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(req *AptosResourceQueryRequest)
+		errMsg string
+	}{
+		{name: "no ledger selector", modify: func(req *AptosResourceQueryRequest) { req.LedgerVersion = 0 }, errMsg: "either ledger version or target timestamp must be specified"},
+		{name: "no resources", modify: func(req *AptosResourceQueryRequest) { req.Resources = nil }, errMsg: "does not contain any resource entries"},
+		{name: "missing resource type", modify: func(req *AptosResourceQueryRequest) { req.Resources[0].ResourceType = "" }, errMsg: "resource type is required"},
+		{name: "resource type too long", modify: func(req *AptosResourceQueryRequest) {
+			req.Resources[0].ResourceType = strings.Repeat("a", AptosMaxTypeLength+1)
+		}, errMsg: "resource type too long"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queryRequest := createAptosResourceQueryRequestForTesting(t)
+			tc.modify(queryRequest.PerChainQueries[0].Query.(*AptosResourceQueryRequest))
+			_, err := queryRequest.Marshal()
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestAptosLengthsAreAsExpected(t *testing.T) {
+	// It will break the spec if these ever change!
+	require.Equal(t, 32, AptosAddressLength)
+	require.Equal(t, 32, AptosBlockHashLength)
+}
+
+///////////// End of Aptos Query tests ///////////////////////////
+
 func TestPostSignedQueryRequestShouldFailIfNoOneIsListening(t *testing.T) {
 	queryRequest := createQueryRequestForTesting(t, vaa.ChainIDPolygon)
 	queryRequestBytes, err := queryRequest.Marshal()
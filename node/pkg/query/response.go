@@ -107,6 +107,39 @@ type EthCallWithFinalityQueryResponse struct {
 	Results [][]byte
 }
 
+// EthGetLogsQueryResponse implements ChainSpecificResponse for an EVM eth_get_logs query response.
+type EthGetLogsQueryResponse struct {
+	FromBlockNumber uint64
+	ToBlockNumber   uint64
+
+	// ToBlockHash and ToBlockTime identify the last block in the range, which anchors the response to a specific fork.
+	ToBlockHash common.Hash
+	ToBlockTime time.Time
+
+	// Logs is the array of logs matching the filter in EthGetLogsQueryRequest, in the order returned by the node.
+	Logs []EthLog
+}
+
+// EthLog is a single log returned by an eth_get_logs query.
+type EthLog struct {
+	BlockNumber uint64
+	TxHash      common.Hash
+	LogIndex    uint32
+	Address     common.Address
+	Topics      []common.Hash
+	Data        []byte
+}
+
+// EthGetStorageAtQueryResponse implements ChainSpecificResponse for an EVM eth_get_storage_at query response.
+type EthGetStorageAtQueryResponse struct {
+	BlockNumber uint64
+	Hash        common.Hash
+	Time        time.Time
+
+	// Results is the array of storage values matching Slots in EthGetStorageAtQueryRequest
+	Results []common.Hash
+}
+
 // SolanaAccountQueryResponse implements ChainSpecificResponse for a Solana sol_account query response.
 type SolanaAccountQueryResponse struct {
 	// SlotNumber is the slot number returned by the sol_account query
@@ -175,6 +208,94 @@ type SolanaPdaResult struct {
 	Data []byte
 }
 
+// SuiObjectQueryResponse implements ChainSpecificResponse for a Sui sui_object query response.
+type SuiObjectQueryResponse struct {
+	// CheckpointSequenceNumber is the checkpoint at which the objects were read.
+	CheckpointSequenceNumber uint64
+
+	// CheckpointDigest is the digest of the checkpoint.
+	CheckpointDigest [SuiDigestLength]byte
+
+	// CheckpointTime is the timestamp of the checkpoint.
+	CheckpointTime time.Time
+
+	Results []SuiObjectResult
+}
+
+type SuiObjectResult struct {
+	// ObjectID is the ID of the object.
+	ObjectID [SuiObjectIDLength]byte
+
+	// Version is the version of the object that was live as of the checkpoint.
+	Version uint64
+
+	// Digest is the digest of that version of the object.
+	Digest [SuiDigestLength]byte
+
+	// ObjectType is the fully qualified Move type of the object.
+	ObjectType string
+
+	// Data is the BCS encoded contents of the object.
+	Data []byte
+}
+
+// SuiMoveViewQueryResponse implements ChainSpecificResponse for a Sui sui_move_view query response.
+type SuiMoveViewQueryResponse struct {
+	// CheckpointSequenceNumber is the checkpoint at which the calls were evaluated.
+	CheckpointSequenceNumber uint64
+
+	// CheckpointDigest is the digest of the checkpoint.
+	CheckpointDigest [SuiDigestLength]byte
+
+	// CheckpointTime is the timestamp of the checkpoint.
+	CheckpointTime time.Time
+
+	// Results is the array of responses matching CallData in SuiMoveViewQueryRequest
+	Results []SuiMoveViewResult
+}
+
+type SuiMoveViewResult struct {
+	// ReturnValues are the BCS encoded values returned by the Move function.
+	ReturnValues [][]byte
+}
+
+// AptosViewQueryResponse implements ChainSpecificResponse for an Aptos aptos_view query response.
+type AptosViewQueryResponse struct {
+	// LedgerVersion is the ledger version at which the view functions were evaluated.
+	LedgerVersion uint64
+
+	// BlockHeight is the height of the block containing the ledger version.
+	BlockHeight uint64
+
+	// BlockHash is the hash of the block containing the ledger version.
+	BlockHash [AptosBlockHashLength]byte
+
+	// BlockTime is the timestamp of the block containing the ledger version.
+	BlockTime time.Time
+
+	// Results is the array of responses matching CallData in AptosViewQueryRequest. Each entry is the JSON encoded
+	// array of values returned by the view function.
+	Results [][]byte
+}
+
+// AptosResourceQueryResponse implements ChainSpecificResponse for an Aptos aptos_resource query response.
+type AptosResourceQueryResponse struct {
+	// LedgerVersion is the ledger version at which the resources were read.
+	LedgerVersion uint64
+
+	// BlockHeight is the height of the block containing the ledger version.
+	BlockHeight uint64
+
+	// BlockHash is the hash of the block containing the ledger version.
+	BlockHash [AptosBlockHashLength]byte
+
+	// BlockTime is the timestamp of the block containing the ledger version.
+	BlockTime time.Time
+
+	// Results is the array of BCS encoded resources matching Resources in AptosResourceQueryRequest.
+	Results [][]byte
+}
+
 //
 // Implementation of QueryResponsePublication.
 //
@@ -444,6 +565,18 @@ func (perChainResponse *PerChainQueryResponse) UnmarshalFromReader(reader *bytes
 			return fmt.Errorf("failed to unmarshal eth call with finality response: %w", err)
 		}
 		perChainResponse.Response = &r
+	case EthGetLogsQueryRequestType:
+		r := EthGetLogsQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal eth_get_logs response: %w", err)
+		}
+		perChainResponse.Response = &r
+	case EthGetStorageAtQueryRequestType:
+		r := EthGetStorageAtQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal eth_get_storage_at response: %w", err)
+		}
+		perChainResponse.Response = &r
 	case SolanaAccountQueryRequestType:
 		r := SolanaAccountQueryResponse{}
 		if err := r.UnmarshalFromReader(reader); err != nil {
@@ -456,6 +589,30 @@ func (perChainResponse *PerChainQueryResponse) UnmarshalFromReader(reader *bytes
 			return fmt.Errorf("failed to unmarshal sol_account response: %w", err)
 		}
 		perChainResponse.Response = &r
+	case SuiObjectQueryRequestType:
+		r := SuiObjectQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal sui_object response: %w", err)
+		}
+		perChainResponse.Response = &r
+	case SuiMoveViewQueryRequestType:
+		r := SuiMoveViewQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal sui_move_view response: %w", err)
+		}
+		perChainResponse.Response = &r
+	case AptosViewQueryRequestType:
+		r := AptosViewQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal aptos_view response: %w", err)
+		}
+		perChainResponse.Response = &r
+	case AptosResourceQueryRequestType:
+		r := AptosResourceQueryResponse{}
+		if err := r.UnmarshalFromReader(reader); err != nil {
+			return fmt.Errorf("failed to unmarshal aptos_resource response: %w", err)
+		}
+		perChainResponse.Response = &r
 	default:
 		return fmt.Errorf("unsupported query type: %d", queryType)
 	}
@@ -525,6 +682,20 @@ func (left *PerChainQueryResponse) Equal(right *PerChainQueryResponse) bool {
 		default:
 			panic("unsupported query type on right") // We checked this above!
 		}
+	case *EthGetLogsQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *EthGetLogsQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
+	case *EthGetStorageAtQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *EthGetStorageAtQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
 	case *SolanaAccountQueryResponse:
 		switch rightResp := right.Response.(type) {
 		case *SolanaAccountQueryResponse:
@@ -539,6 +710,34 @@ func (left *PerChainQueryResponse) Equal(right *PerChainQueryResponse) bool {
 		default:
 			panic("unsupported query type on right") // We checked this above!
 		}
+	case *SuiObjectQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *SuiObjectQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
+	case *SuiMoveViewQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *SuiMoveViewQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
+	case *AptosViewQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *AptosViewQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
+	case *AptosResourceQueryResponse:
+		switch rightResp := right.Response.(type) {
+		case *AptosResourceQueryResponse:
+			return leftResp.Equal(rightResp)
+		default:
+			panic("unsupported query type on right") // We checked this above!
+		}
 	default:
 		panic("unsupported query type on left") // We checked this above!
 	}
@@ -946,6 +1145,285 @@ func (left *EthCallWithFinalityQueryResponse) Equal(right *EthCallWithFinalityQu
 	return true
 }
 
+//
+// Implementation of EthGetLogsQueryResponse, which implements the ChainSpecificResponse for an EVM eth_get_logs query response.
+//
+
+func (e *EthGetLogsQueryResponse) Type() ChainSpecificQueryType {
+	return EthGetLogsQueryRequestType
+}
+
+// Marshal serializes the binary representation of an EVM eth_get_logs response.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (eglr *EthGetLogsQueryResponse) Marshal() ([]byte, error) {
+	if err := eglr.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, eglr.FromBlockNumber)
+	vaa.MustWrite(buf, binary.BigEndian, eglr.ToBlockNumber)
+	buf.Write(eglr.ToBlockHash[:])
+	vaa.MustWrite(buf, binary.BigEndian, eglr.ToBlockTime.UnixMicro())
+
+	vaa.MustWrite(buf, binary.BigEndian, uint16(len(eglr.Logs))) // #nosec G115 -- This is validated in `Validate`
+	for _, log := range eglr.Logs {
+		vaa.MustWrite(buf, binary.BigEndian, log.BlockNumber)
+		buf.Write(log.TxHash[:])
+		vaa.MustWrite(buf, binary.BigEndian, log.LogIndex)
+		buf.Write(log.Address[:])
+
+		vaa.MustWrite(buf, binary.BigEndian, uint8(len(log.Topics))) // #nosec G115 -- This is validated in `Validate`
+		for _, topic := range log.Topics {
+			buf.Write(topic[:])
+		}
+
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(log.Data))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write(log.Data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an EVM eth_get_logs response from a byte array
+func (eglr *EthGetLogsQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return eglr.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an EVM eth_get_logs response from a byte array
+func (eglr *EthGetLogsQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &eglr.FromBlockNumber); err != nil {
+		return fmt.Errorf("failed to read from block number: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &eglr.ToBlockNumber); err != nil {
+		return fmt.Errorf("failed to read to block number: %w", err)
+	}
+
+	if n, err := reader.Read(eglr.ToBlockHash[:]); err != nil || n != common.HashLength {
+		return fmt.Errorf("failed to read to block hash [%d]: %w", n, err)
+	}
+
+	unixMicros := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &unixMicros); err != nil {
+		return fmt.Errorf("failed to read to block timestamp: %w", err)
+	}
+	eglr.ToBlockTime = time.UnixMicro(unixMicros)
+
+	numLogs := uint16(0)
+	if err := binary.Read(reader, binary.BigEndian, &numLogs); err != nil {
+		return fmt.Errorf("failed to read number of logs: %w", err)
+	}
+
+	for count := 0; count < int(numLogs); count++ {
+		var log EthLog
+
+		if err := binary.Read(reader, binary.BigEndian, &log.BlockNumber); err != nil {
+			return fmt.Errorf("failed to read log block number: %w", err)
+		}
+
+		if n, err := reader.Read(log.TxHash[:]); err != nil || n != common.HashLength {
+			return fmt.Errorf("failed to read log tx hash [%d]: %w", n, err)
+		}
+
+		if err := binary.Read(reader, binary.BigEndian, &log.LogIndex); err != nil {
+			return fmt.Errorf("failed to read log index: %w", err)
+		}
+
+		if n, err := reader.Read(log.Address[:]); err != nil || n != common.AddressLength {
+			return fmt.Errorf("failed to read log address [%d]: %w", n, err)
+		}
+
+		numTopics := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &numTopics); err != nil {
+			return fmt.Errorf("failed to read number of log topics: %w", err)
+		}
+		for count2 := 0; count2 < int(numTopics); count2++ {
+			topic := common.Hash{}
+			if n, err := reader.Read(topic[:]); err != nil || n != common.HashLength {
+				return fmt.Errorf("failed to read log topic [%d]: %w", n, err)
+			}
+			log.Topics = append(log.Topics, topic)
+		}
+
+		dataLen := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &dataLen); err != nil {
+			return fmt.Errorf("failed to read log data len: %w", err)
+		}
+		log.Data = make([]byte, dataLen)
+		// Logs frequently have no data, so only read when there is something to read.
+		if dataLen > 0 {
+			if n, err := reader.Read(log.Data[:]); err != nil || n != int(dataLen) {
+				return fmt.Errorf("failed to read log data [%d]: %w", n, err)
+			}
+		}
+
+		eglr.Logs = append(eglr.Logs, log)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an EVM eth_get_logs response.
+func (eglr *EthGetLogsQueryResponse) Validate() error {
+	if eglr.FromBlockNumber > eglr.ToBlockNumber {
+		return fmt.Errorf("from block may not be after to block")
+	}
+
+	// An empty set of logs is a valid response.
+	if len(eglr.Logs) > EthGetLogsMaxLogs {
+		return fmt.Errorf("too many logs, may not be more than %d", EthGetLogsMaxLogs)
+	}
+
+	dataSize := 0
+	for _, log := range eglr.Logs {
+		if log.BlockNumber < eglr.FromBlockNumber || log.BlockNumber > eglr.ToBlockNumber {
+			return fmt.Errorf("log block number is outside of the block range")
+		}
+		if len(log.Topics) > EthGetLogsMaxTopicPositions {
+			return fmt.Errorf("too many log topics")
+		}
+		dataSize += len(log.Data)
+		if dataSize > EthGetLogsMaxDataSize {
+			return fmt.Errorf("log data too large, may not be more than %d bytes", EthGetLogsMaxDataSize)
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two EVM eth_get_logs responses are equal.
+func (left *EthGetLogsQueryResponse) Equal(right *EthGetLogsQueryResponse) bool {
+	if left.FromBlockNumber != right.FromBlockNumber ||
+		left.ToBlockNumber != right.ToBlockNumber ||
+		left.ToBlockHash != right.ToBlockHash ||
+		left.ToBlockTime != right.ToBlockTime {
+		return false
+	}
+
+	if len(left.Logs) != len(right.Logs) {
+		return false
+	}
+	for idx := range left.Logs {
+		l, r := left.Logs[idx], right.Logs[idx]
+		if l.BlockNumber != r.BlockNumber ||
+			l.TxHash != r.TxHash ||
+			l.LogIndex != r.LogIndex ||
+			l.Address != r.Address ||
+			!bytes.Equal(l.Data, r.Data) {
+			return false
+		}
+		if len(l.Topics) != len(r.Topics) {
+			return false
+		}
+		for idx2 := range l.Topics {
+			if l.Topics[idx2] != r.Topics[idx2] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of EthGetStorageAtQueryResponse, which implements the ChainSpecificResponse for an EVM eth_get_storage_at query response.
+//
+
+func (e *EthGetStorageAtQueryResponse) Type() ChainSpecificQueryType {
+	return EthGetStorageAtQueryRequestType
+}
+
+// Marshal serializes the binary representation of an EVM eth_get_storage_at response.
+// This method calls Validate() and relies on it to range checks lengths, etc.
+func (egsr *EthGetStorageAtQueryResponse) Marshal() ([]byte, error) {
+	if err := egsr.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, egsr.BlockNumber)
+	buf.Write(egsr.Hash[:])
+	vaa.MustWrite(buf, binary.BigEndian, egsr.Time.UnixMicro())
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(egsr.Results))) // #nosec G115 -- This is validated in `Validate`
+	for _, result := range egsr.Results {
+		buf.Write(result[:])
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an EVM eth_get_storage_at response from a byte array
+func (egsr *EthGetStorageAtQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return egsr.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an EVM eth_get_storage_at response from a byte array
+func (egsr *EthGetStorageAtQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &egsr.BlockNumber); err != nil {
+		return fmt.Errorf("failed to read response number: %w", err)
+	}
+
+	if n, err := reader.Read(egsr.Hash[:]); err != nil || n != common.HashLength {
+		return fmt.Errorf("failed to read response hash [%d]: %w", n, err)
+	}
+
+	unixMicros := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &unixMicros); err != nil {
+		return fmt.Errorf("failed to read response timestamp: %w", err)
+	}
+	egsr.Time = time.UnixMicro(unixMicros)
+
+	numResults := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResults); err != nil {
+		return fmt.Errorf("failed to read number of results: %w", err)
+	}
+
+	for count := 0; count < int(numResults); count++ {
+		result := common.Hash{}
+		if n, err := reader.Read(result[:]); err != nil || n != common.HashLength {
+			return fmt.Errorf("failed to read result [%d]: %w", n, err)
+		}
+		egsr.Results = append(egsr.Results, result)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an EVM eth_get_storage_at response.
+func (egsr *EthGetStorageAtQueryResponse) Validate() error {
+	if len(egsr.Results) <= 0 {
+		return fmt.Errorf("does not contain any results")
+	}
+	if len(egsr.Results) > math.MaxUint8 {
+		return fmt.Errorf("too many results")
+	}
+	return nil
+}
+
+// Equal verifies that two EVM eth_get_storage_at responses are equal.
+func (left *EthGetStorageAtQueryResponse) Equal(right *EthGetStorageAtQueryResponse) bool {
+	if left.BlockNumber != right.BlockNumber ||
+		left.Hash != right.Hash ||
+		left.Time != right.Time {
+		return false
+	}
+
+	if len(left.Results) != len(right.Results) {
+		return false
+	}
+	for idx := range left.Results {
+		if left.Results[idx] != right.Results[idx] {
+			return false
+		}
+	}
+
+	return true
+}
+
 //
 // Implementation of SolanaAccountQueryResponse, which implements the ChainSpecificResponse for a Solana sol_account query response.
 //
@@ -1255,3 +1733,536 @@ func (left *SolanaPdaQueryResponse) Equal(right *SolanaPdaQueryResponse) bool {
 
 	return true
 }
+
+//
+// Implementation of SuiObjectQueryResponse, which implements the ChainSpecificResponse for a Sui sui_object query response.
+//
+
+func (sor *SuiObjectQueryResponse) Type() ChainSpecificQueryType {
+	return SuiObjectQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Sui sui_object response.
+// This method calls Validate() and relies on it to range check lengths, etc.
+func (sor *SuiObjectQueryResponse) Marshal() ([]byte, error) {
+	if err := sor.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, sor.CheckpointSequenceNumber)
+	buf.Write(sor.CheckpointDigest[:])
+	vaa.MustWrite(buf, binary.BigEndian, sor.CheckpointTime.UnixMicro())
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(sor.Results))) // #nosec G115 -- This is validated in `Validate`
+	for _, res := range sor.Results {
+		buf.Write(res.ObjectID[:])
+		vaa.MustWrite(buf, binary.BigEndian, res.Version)
+		buf.Write(res.Digest[:])
+
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(res.ObjectType))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write([]byte(res.ObjectType))
+
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(res.Data))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write(res.Data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes a Sui sui_object response from a byte array
+func (sor *SuiObjectQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return sor.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes a Sui sui_object response from a byte array
+func (sor *SuiObjectQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &sor.CheckpointSequenceNumber); err != nil {
+		return fmt.Errorf("failed to read checkpoint sequence number: %w", err)
+	}
+
+	if n, err := reader.Read(sor.CheckpointDigest[:]); err != nil || n != SuiDigestLength {
+		return fmt.Errorf("failed to read checkpoint digest [%d]: %w", n, err)
+	}
+
+	checkpointTime := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &checkpointTime); err != nil {
+		return fmt.Errorf("failed to read checkpoint time: %w", err)
+	}
+	sor.CheckpointTime = time.UnixMicro(checkpointTime)
+
+	numResults := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResults); err != nil {
+		return fmt.Errorf("failed to read number of results: %w", err)
+	}
+
+	for count := 0; count < int(numResults); count++ {
+		var result SuiObjectResult
+
+		if n, err := reader.Read(result.ObjectID[:]); err != nil || n != SuiObjectIDLength {
+			return fmt.Errorf("failed to read object id [%d]: %w", n, err)
+		}
+
+		if err := binary.Read(reader, binary.BigEndian, &result.Version); err != nil {
+			return fmt.Errorf("failed to read version: %w", err)
+		}
+
+		if n, err := reader.Read(result.Digest[:]); err != nil || n != SuiDigestLength {
+			return fmt.Errorf("failed to read digest [%d]: %w", n, err)
+		}
+
+		length := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read object type len: %w", err)
+		}
+		objectType := make([]byte, length)
+		if n, err := reader.Read(objectType[:]); err != nil || n != int(length) {
+			return fmt.Errorf("failed to read object type [%d]: %w", n, err)
+		}
+		result.ObjectType = string(objectType)
+
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read data len: %w", err)
+		}
+		result.Data = make([]byte, length)
+		if n, err := reader.Read(result.Data[:]); err != nil || n != int(length) {
+			return fmt.Errorf("failed to read data [%d]: %w", n, err)
+		}
+
+		sor.Results = append(sor.Results, result)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on a Sui sui_object response.
+func (sor *SuiObjectQueryResponse) Validate() error {
+	// The checkpoint digest is fixed length, so don't need to check for nil.
+	if len(sor.CheckpointDigest) != SuiDigestLength {
+		return fmt.Errorf("invalid checkpoint digest length")
+	}
+
+	if len(sor.Results) <= 0 {
+		return fmt.Errorf("does not contain any results")
+	}
+	if len(sor.Results) > math.MaxUint8 {
+		return fmt.Errorf("too many results")
+	}
+	for _, result := range sor.Results {
+		if result.ObjectType == "" {
+			return fmt.Errorf("object type is required")
+		}
+		if len(result.ObjectType) > math.MaxUint32 {
+			return fmt.Errorf("object type too long")
+		}
+		if len(result.Data) > math.MaxUint32 {
+			return fmt.Errorf("data too long")
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Sui sui_object responses are equal.
+func (left *SuiObjectQueryResponse) Equal(right *SuiObjectQueryResponse) bool {
+	if left.CheckpointSequenceNumber != right.CheckpointSequenceNumber ||
+		!bytes.Equal(left.CheckpointDigest[:], right.CheckpointDigest[:]) ||
+		left.CheckpointTime != right.CheckpointTime {
+		return false
+	}
+
+	if len(left.Results) != len(right.Results) {
+		return false
+	}
+	for idx := range left.Results {
+		if !bytes.Equal(left.Results[idx].ObjectID[:], right.Results[idx].ObjectID[:]) ||
+			left.Results[idx].Version != right.Results[idx].Version ||
+			!bytes.Equal(left.Results[idx].Digest[:], right.Results[idx].Digest[:]) ||
+			left.Results[idx].ObjectType != right.Results[idx].ObjectType ||
+			!bytes.Equal(left.Results[idx].Data, right.Results[idx].Data) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of SuiMoveViewQueryResponse, which implements the ChainSpecificResponse for a Sui sui_move_view query response.
+//
+
+func (smr *SuiMoveViewQueryResponse) Type() ChainSpecificQueryType {
+	return SuiMoveViewQueryRequestType
+}
+
+// Marshal serializes the binary representation of a Sui sui_move_view response.
+// This method calls Validate() and relies on it to range check lengths, etc.
+func (smr *SuiMoveViewQueryResponse) Marshal() ([]byte, error) {
+	if err := smr.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, smr.CheckpointSequenceNumber)
+	buf.Write(smr.CheckpointDigest[:])
+	vaa.MustWrite(buf, binary.BigEndian, smr.CheckpointTime.UnixMicro())
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(smr.Results))) // #nosec G115 -- This is validated in `Validate`
+	for _, res := range smr.Results {
+		vaa.MustWrite(buf, binary.BigEndian, uint8(len(res.ReturnValues))) // #nosec G115 -- This is validated in `Validate`
+		for _, value := range res.ReturnValues {
+			vaa.MustWrite(buf, binary.BigEndian, uint32(len(value))) // #nosec G115 -- This is validated in `Validate`
+			buf.Write(value)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes a Sui sui_move_view response from a byte array
+func (smr *SuiMoveViewQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return smr.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes a Sui sui_move_view response from a byte array
+func (smr *SuiMoveViewQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &smr.CheckpointSequenceNumber); err != nil {
+		return fmt.Errorf("failed to read checkpoint sequence number: %w", err)
+	}
+
+	if n, err := reader.Read(smr.CheckpointDigest[:]); err != nil || n != SuiDigestLength {
+		return fmt.Errorf("failed to read checkpoint digest [%d]: %w", n, err)
+	}
+
+	checkpointTime := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &checkpointTime); err != nil {
+		return fmt.Errorf("failed to read checkpoint time: %w", err)
+	}
+	smr.CheckpointTime = time.UnixMicro(checkpointTime)
+
+	numResults := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResults); err != nil {
+		return fmt.Errorf("failed to read number of results: %w", err)
+	}
+
+	for count := 0; count < int(numResults); count++ {
+		var result SuiMoveViewResult
+
+		numValues := uint8(0)
+		if err := binary.Read(reader, binary.BigEndian, &numValues); err != nil {
+			return fmt.Errorf("failed to read number of return values: %w", err)
+		}
+
+		for count2 := 0; count2 < int(numValues); count2++ {
+			length := uint32(0)
+			if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+				return fmt.Errorf("failed to read return value len: %w", err)
+			}
+			value := make([]byte, length)
+			// A Move unit value serializes to zero bytes, so only read when there is something to read.
+			if length > 0 {
+				if n, err := reader.Read(value[:]); err != nil || n != int(length) {
+					return fmt.Errorf("failed to read return value [%d]: %w", n, err)
+				}
+			}
+			result.ReturnValues = append(result.ReturnValues, value)
+		}
+
+		smr.Results = append(smr.Results, result)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on a Sui sui_move_view response.
+func (smr *SuiMoveViewQueryResponse) Validate() error {
+	if len(smr.Results) <= 0 {
+		return fmt.Errorf("does not contain any results")
+	}
+	if len(smr.Results) > math.MaxUint8 {
+		return fmt.Errorf("too many results")
+	}
+	for _, result := range smr.Results {
+		if len(result.ReturnValues) > math.MaxUint8 {
+			return fmt.Errorf("too many return values")
+		}
+		for _, value := range result.ReturnValues {
+			if len(value) > math.MaxUint32 {
+				return fmt.Errorf("return value too long")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Sui sui_move_view responses are equal.
+func (left *SuiMoveViewQueryResponse) Equal(right *SuiMoveViewQueryResponse) bool {
+	if left.CheckpointSequenceNumber != right.CheckpointSequenceNumber ||
+		!bytes.Equal(left.CheckpointDigest[:], right.CheckpointDigest[:]) ||
+		left.CheckpointTime != right.CheckpointTime {
+		return false
+	}
+
+	if len(left.Results) != len(right.Results) {
+		return false
+	}
+	for idx := range left.Results {
+		if len(left.Results[idx].ReturnValues) != len(right.Results[idx].ReturnValues) {
+			return false
+		}
+		for idx2 := range left.Results[idx].ReturnValues {
+			if !bytes.Equal(left.Results[idx].ReturnValues[idx2], right.Results[idx].ReturnValues[idx2]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of AptosViewQueryResponse, which implements the ChainSpecificResponse for an Aptos aptos_view query response.
+//
+
+func (avr *AptosViewQueryResponse) Type() ChainSpecificQueryType {
+	return AptosViewQueryRequestType
+}
+
+// Marshal serializes the binary representation of an Aptos aptos_view response.
+// This method calls Validate() and relies on it to range check lengths, etc.
+func (avr *AptosViewQueryResponse) Marshal() ([]byte, error) {
+	if err := avr.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, avr.LedgerVersion)
+	vaa.MustWrite(buf, binary.BigEndian, avr.BlockHeight)
+	buf.Write(avr.BlockHash[:])
+	vaa.MustWrite(buf, binary.BigEndian, avr.BlockTime.UnixMicro())
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(avr.Results))) // #nosec G115 -- This is validated in `Validate`
+	for _, res := range avr.Results {
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(res))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write(res)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an Aptos aptos_view response from a byte array
+func (avr *AptosViewQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return avr.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an Aptos aptos_view response from a byte array
+func (avr *AptosViewQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &avr.LedgerVersion); err != nil {
+		return fmt.Errorf("failed to read ledger version: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &avr.BlockHeight); err != nil {
+		return fmt.Errorf("failed to read block height: %w", err)
+	}
+
+	if n, err := reader.Read(avr.BlockHash[:]); err != nil || n != AptosBlockHashLength {
+		return fmt.Errorf("failed to read block hash [%d]: %w", n, err)
+	}
+
+	blockTime := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &blockTime); err != nil {
+		return fmt.Errorf("failed to read block time: %w", err)
+	}
+	avr.BlockTime = time.UnixMicro(blockTime)
+
+	numResults := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResults); err != nil {
+		return fmt.Errorf("failed to read number of results: %w", err)
+	}
+
+	for count := 0; count < int(numResults); count++ {
+		length := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read result len: %w", err)
+		}
+		result := make([]byte, length)
+		if length > 0 {
+			if n, err := reader.Read(result[:]); err != nil || n != int(length) {
+				return fmt.Errorf("failed to read result [%d]: %w", n, err)
+			}
+		}
+
+		avr.Results = append(avr.Results, result)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an Aptos aptos_view response.
+func (avr *AptosViewQueryResponse) Validate() error {
+	// The block hash is fixed length, so don't need to check for nil.
+	if len(avr.BlockHash) != AptosBlockHashLength {
+		return fmt.Errorf("invalid block hash length")
+	}
+
+	if len(avr.Results) <= 0 {
+		return fmt.Errorf("does not contain any results")
+	}
+	if len(avr.Results) > math.MaxUint8 {
+		return fmt.Errorf("too many results")
+	}
+	for _, result := range avr.Results {
+		if len(result) > math.MaxUint32 {
+			return fmt.Errorf("result too long")
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Aptos aptos_view responses are equal.
+func (left *AptosViewQueryResponse) Equal(right *AptosViewQueryResponse) bool {
+	if left.LedgerVersion != right.LedgerVersion ||
+		left.BlockHeight != right.BlockHeight ||
+		!bytes.Equal(left.BlockHash[:], right.BlockHash[:]) ||
+		left.BlockTime != right.BlockTime {
+		return false
+	}
+
+	if len(left.Results) != len(right.Results) {
+		return false
+	}
+	for idx := range left.Results {
+		if !bytes.Equal(left.Results[idx], right.Results[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+//
+// Implementation of AptosResourceQueryResponse, which implements the ChainSpecificResponse for an Aptos aptos_resource query response.
+//
+
+func (arr *AptosResourceQueryResponse) Type() ChainSpecificQueryType {
+	return AptosResourceQueryRequestType
+}
+
+// Marshal serializes the binary representation of an Aptos aptos_resource response.
+// This method calls Validate() and relies on it to range check lengths, etc.
+func (arr *AptosResourceQueryResponse) Marshal() ([]byte, error) {
+	if err := arr.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	vaa.MustWrite(buf, binary.BigEndian, arr.LedgerVersion)
+	vaa.MustWrite(buf, binary.BigEndian, arr.BlockHeight)
+	buf.Write(arr.BlockHash[:])
+	vaa.MustWrite(buf, binary.BigEndian, arr.BlockTime.UnixMicro())
+
+	vaa.MustWrite(buf, binary.BigEndian, uint8(len(arr.Results))) // #nosec G115 -- This is validated in `Validate`
+	for _, res := range arr.Results {
+		vaa.MustWrite(buf, binary.BigEndian, uint32(len(res))) // #nosec G115 -- This is validated in `Validate`
+		buf.Write(res)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal deserializes an Aptos aptos_resource response from a byte array
+func (arr *AptosResourceQueryResponse) Unmarshal(data []byte) error {
+	reader := bytes.NewReader(data[:])
+	return arr.UnmarshalFromReader(reader)
+}
+
+// UnmarshalFromReader  deserializes an Aptos aptos_resource response from a byte array
+func (arr *AptosResourceQueryResponse) UnmarshalFromReader(reader *bytes.Reader) error {
+	if err := binary.Read(reader, binary.BigEndian, &arr.LedgerVersion); err != nil {
+		return fmt.Errorf("failed to read ledger version: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &arr.BlockHeight); err != nil {
+		return fmt.Errorf("failed to read block height: %w", err)
+	}
+
+	if n, err := reader.Read(arr.BlockHash[:]); err != nil || n != AptosBlockHashLength {
+		return fmt.Errorf("failed to read block hash [%d]: %w", n, err)
+	}
+
+	blockTime := int64(0)
+	if err := binary.Read(reader, binary.BigEndian, &blockTime); err != nil {
+		return fmt.Errorf("failed to read block time: %w", err)
+	}
+	arr.BlockTime = time.UnixMicro(blockTime)
+
+	numResults := uint8(0)
+	if err := binary.Read(reader, binary.BigEndian, &numResults); err != nil {
+		return fmt.Errorf("failed to read number of results: %w", err)
+	}
+
+	for count := 0; count < int(numResults); count++ {
+		length := uint32(0)
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return fmt.Errorf("failed to read result len: %w", err)
+		}
+		result := make([]byte, length)
+		if length > 0 {
+			if n, err := reader.Read(result[:]); err != nil || n != int(length) {
+				return fmt.Errorf("failed to read result [%d]: %w", n, err)
+			}
+		}
+
+		arr.Results = append(arr.Results, result)
+	}
+
+	return nil
+}
+
+// Validate does basic validation on an Aptos aptos_resource response.
+func (arr *AptosResourceQueryResponse) Validate() error {
+	// The block hash is fixed length, so don't need to check for nil.
+	if len(arr.BlockHash) != AptosBlockHashLength {
+		return fmt.Errorf("invalid block hash length")
+	}
+
+	if len(arr.Results) <= 0 {
+		return fmt.Errorf("does not contain any results")
+	}
+	if len(arr.Results) > math.MaxUint8 {
+		return fmt.Errorf("too many results")
+	}
+	for _, result := range arr.Results {
+		if len(result) > math.MaxUint32 {
+			return fmt.Errorf("result too long")
+		}
+	}
+
+	return nil
+}
+
+// Equal verifies that two Aptos aptos_resource responses are equal.
+func (left *AptosResourceQueryResponse) Equal(right *AptosResourceQueryResponse) bool {
+	if left.LedgerVersion != right.LedgerVersion ||
+		left.BlockHeight != right.BlockHeight ||
+		!bytes.Equal(left.BlockHash[:], right.BlockHash[:]) ||
+		left.BlockTime != right.BlockTime {
+		return false
+	}
+
+	if len(left.Results) != len(right.Results) {
+		return false
+	}
+	for idx := range left.Results {
+		if !bytes.Equal(left.Results[idx], right.Results[idx]) {
+			return false
+		}
+	}
+
+	return true
+}
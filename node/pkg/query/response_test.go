@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -263,6 +264,144 @@ func TestMarshalUnmarshalQueryResponseWithTooManyResultsShouldFail(t *testing.T)
 	require.Error(t, err)
 }
 
+///////////// EthGetLogs and EthGetStorageAt tests /////////////////////////////////
+
+func createEthStateQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest) *QueryResponsePublication {
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	sig := [65]byte{}
+	signedQueryRequest := &gossipv1.SignedQueryRequest{
+		QueryRequest: queryRequestBytes,
+		Signature:    sig[:],
+	}
+
+	blockHash := ethCommon.HexToHash("0x9999bac44d09a7f69ee7941819b0a19c59ccb1969640cc513be09ef95ed2d8e3")
+	perChainResponses := []*PerChainQueryResponse{}
+	for _, pcr := range queryRequest.PerChainQueries {
+		switch req := pcr.Query.(type) {
+		case *EthGetLogsQueryRequest:
+			fromBlock, toBlock, err := req.BlockRange()
+			require.NoError(t, err)
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &EthGetLogsQueryResponse{
+					FromBlockNumber: fromBlock,
+					ToBlockNumber:   toBlock,
+					ToBlockHash:     blockHash,
+					ToBlockTime:     timeForTest(t, time.Now()),
+					Logs: []EthLog{
+						{
+							BlockNumber: fromBlock,
+							TxHash:      ethCommon.HexToHash("0x4fa9188b339cfd573a0778c5deaeeee94d4bcfb12b345bf8e417e5119dae773e"),
+							LogIndex:    7,
+							Address:     ethCommon.BytesToAddress(req.Addresses[0]),
+							Topics:      []ethCommon.Hash{ethCommon.HexToHash("0x6eb224fb001ed210e379b335e35efe88672a8ce935d981a6896b27ffdf52a3b2")},
+							Data:        []byte("Log data"),
+						},
+						{
+							// Logs with no data should round trip.
+							BlockNumber: toBlock,
+							TxHash:      ethCommon.HexToHash("0x5fa9188b339cfd573a0778c5deaeeee94d4bcfb12b345bf8e417e5119dae773e"),
+							LogIndex:    0,
+							Address:     ethCommon.BytesToAddress(req.Addresses[0]),
+						},
+					},
+				},
+			})
+		case *EthGetStorageAtQueryRequest:
+			results := []ethCommon.Hash{}
+			for idx := range req.Slots {
+				results = append(results, ethCommon.BigToHash(big.NewInt(int64(idx+1))))
+			}
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &EthGetStorageAtQueryResponse{
+					BlockNumber: 42,
+					Hash:        blockHash,
+					Time:        timeForTest(t, time.Now()),
+					Results:     results,
+				},
+			})
+		default:
+			panic("invalid query type!")
+		}
+	}
+
+	return &QueryResponsePublication{
+		Request:           signedQueryRequest,
+		PerChainResponses: perChainResponses,
+	}
+}
+
+func TestEthGetLogsQueryResponseMarshalUnmarshal(t *testing.T) {
+	queryRequest := createEthGetLogsQueryRequestForTesting(t)
+	respPub := createEthStateQueryResponseFromRequest(t, queryRequest)
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	require.NotNil(t, respPub2)
+
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
+func TestEthGetLogsQueryResponseWithNoLogsShouldSucceed(t *testing.T) {
+	queryRequest := createEthGetLogsQueryRequestForTesting(t)
+	respPub := createEthStateQueryResponseFromRequest(t, queryRequest)
+	respPub.PerChainResponses[0].Response.(*EthGetLogsQueryResponse).Logs = nil
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
+func TestEthGetLogsQueryResponseExceedingCapsShouldFail(t *testing.T) {
+	queryRequest := createEthGetLogsQueryRequestForTesting(t)
+
+	respPub := createEthStateQueryResponseFromRequest(t, queryRequest)
+	resp := respPub.PerChainResponses[0].Response.(*EthGetLogsQueryResponse)
+	for len(resp.Logs) <= EthGetLogsMaxLogs {
+		resp.Logs = append(resp.Logs, resp.Logs[0])
+	}
+	_, err := respPub.Marshal()
+	require.ErrorContains(t, err, "too many logs")
+
+	respPub = createEthStateQueryResponseFromRequest(t, queryRequest)
+	resp = respPub.PerChainResponses[0].Response.(*EthGetLogsQueryResponse)
+	resp.Logs[0].Data = make([]byte, EthGetLogsMaxDataSize+1)
+	_, err = respPub.Marshal()
+	require.ErrorContains(t, err, "log data too large")
+
+	respPub = createEthStateQueryResponseFromRequest(t, queryRequest)
+	resp = respPub.PerChainResponses[0].Response.(*EthGetLogsQueryResponse)
+	resp.Logs[0].BlockNumber = resp.ToBlockNumber + 1
+	_, err = respPub.Marshal()
+	require.ErrorContains(t, err, "outside of the block range")
+}
+
+func TestEthGetStorageAtQueryResponseMarshalUnmarshal(t *testing.T) {
+	queryRequest := createEthGetStorageAtQueryRequestForTesting(t)
+	respPub := createEthStateQueryResponseFromRequest(t, queryRequest)
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	require.NotNil(t, respPub2)
+
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
 ///////////// Solana Account Query tests /////////////////////////////////
 
 func createSolanaAccountQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest) *QueryResponsePublication {
@@ -390,3 +529,205 @@ func TestSolanaPdaQueryResponseMarshalUnmarshal(t *testing.T) {
 }
 
 ///////////// End of Solana PDA Query tests ///////////////////////////
+
+///////////// Sui Query tests /////////////////////////////////
+
+func createSuiQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest) *QueryResponsePublication {
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	sig := [65]byte{}
+	signedQueryRequest := &gossipv1.SignedQueryRequest{
+		QueryRequest: queryRequestBytes,
+		Signature:    sig[:],
+	}
+
+	perChainResponses := []*PerChainQueryResponse{}
+	for _, pcr := range queryRequest.PerChainQueries {
+		switch req := pcr.Query.(type) {
+		case *SuiObjectQueryRequest:
+			results := []SuiObjectResult{}
+			for idx, objectID := range req.ObjectIDs {
+				results = append(results, SuiObjectResult{
+					ObjectID:   objectID,
+					Version:    uint64(500 + idx), // #nosec G115 -- This is safe in this test suite
+					Digest:     ethCommon.HexToHash("0x4fa9188b339cfd573a0778c5deaeeee94d4bcfb12b345bf8e417e5119dae773e"),
+					ObjectType: "0x2::clock::Clock",
+					Data:       []byte(fmt.Sprintf("Result %d", idx)),
+				})
+			}
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &SuiObjectQueryResponse{
+					CheckpointSequenceNumber: req.Checkpoint,
+					CheckpointDigest:         ethCommon.HexToHash("0x9999bac44d09a7f69ee7941819b0a19c59ccb1969640cc513be09ef95ed2d8e3"),
+					CheckpointTime:           timeForTest(t, time.Now()),
+					Results:                  results,
+				},
+			})
+		case *SuiMoveViewQueryRequest:
+			results := []SuiMoveViewResult{}
+			for idx := range req.CallData {
+				// Include an empty return value, which is what a Move unit value serializes to.
+				results = append(results, SuiMoveViewResult{
+					ReturnValues: [][]byte{[]byte(fmt.Sprintf("Result %d", idx)), {}},
+				})
+			}
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &SuiMoveViewQueryResponse{
+					CheckpointSequenceNumber: req.Checkpoint,
+					CheckpointDigest:         ethCommon.HexToHash("0x9999bac44d09a7f69ee7941819b0a19c59ccb1969640cc513be09ef95ed2d8e3"),
+					CheckpointTime:           timeForTest(t, time.Now()),
+					Results:                  results,
+				},
+			})
+		default:
+			panic("invalid query type!")
+		}
+	}
+
+	return &QueryResponsePublication{
+		Request:           signedQueryRequest,
+		PerChainResponses: perChainResponses,
+	}
+}
+
+func TestSuiObjectQueryResponseMarshalUnmarshal(t *testing.T) {
+	queryRequest := createSuiObjectQueryRequestForTesting(t)
+	respPub := createSuiQueryResponseFromRequest(t, queryRequest)
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	require.NotNil(t, respPub2)
+
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
+func TestSuiMoveViewQueryResponseMarshalUnmarshal(t *testing.T) {
+	queryRequest := createSuiMoveViewQueryRequestForTesting(t)
+	respPub := createSuiQueryResponseFromRequest(t, queryRequest)
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	require.NotNil(t, respPub2)
+
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
+func TestSuiObjectQueryResponseWithNoObjectTypeShouldFail(t *testing.T) {
+	queryRequest := createSuiObjectQueryRequestForTesting(t)
+	respPub := createSuiQueryResponseFromRequest(t, queryRequest)
+	respPub.PerChainResponses[0].Response.(*SuiObjectQueryResponse).Results[0].ObjectType = ""
+
+	_, err := respPub.Marshal()
+	require.ErrorContains(t, err, "object type is required")
+}
+
+///////////// End of Sui Query tests ///////////////////////////
+
+///////////// Aptos Query tests /////////////////////////////////
+
+func createAptosQueryResponseFromRequest(t *testing.T, queryRequest *QueryRequest) *QueryResponsePublication {
+	queryRequestBytes, err := queryRequest.Marshal()
+	require.NoError(t, err)
+
+	sig := [65]byte{}
+	signedQueryRequest := &gossipv1.SignedQueryRequest{
+		QueryRequest: queryRequestBytes,
+		Signature:    sig[:],
+	}
+
+	blockHash := ethCommon.HexToHash("0x2b6c3a4d6e0a1fdb7f4e44f3c5ad9bcd0ac0b1b52b3e1d29a18f6a3c4e2c1d0a")
+	perChainResponses := []*PerChainQueryResponse{}
+	for _, pcr := range queryRequest.PerChainQueries {
+		switch req := pcr.Query.(type) {
+		case *AptosViewQueryRequest:
+			results := [][]byte{}
+			for idx := range req.CallData {
+				results = append(results, []byte(fmt.Sprintf(`["%d"]`, idx)))
+			}
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &AptosViewQueryResponse{
+					LedgerVersion: req.LedgerVersion,
+					BlockHeight:   264785123,
+					BlockHash:     blockHash,
+					BlockTime:     timeForTest(t, time.Now()),
+					Results:       results,
+				},
+			})
+		case *AptosResourceQueryRequest:
+			results := [][]byte{}
+			for idx := range req.Resources {
+				results = append(results, []byte(fmt.Sprintf("Resource %d", idx)))
+			}
+			perChainResponses = append(perChainResponses, &PerChainQueryResponse{
+				ChainId: pcr.ChainId,
+				Response: &AptosResourceQueryResponse{
+					LedgerVersion: req.LedgerVersion,
+					BlockHeight:   264785123,
+					BlockHash:     blockHash,
+					BlockTime:     timeForTest(t, time.Now()),
+					Results:       results,
+				},
+			})
+		default:
+			panic("invalid query type!")
+		}
+	}
+
+	return &QueryResponsePublication{
+		Request:           signedQueryRequest,
+		PerChainResponses: perChainResponses,
+	}
+}
+
+func TestAptosViewQueryResponseMarshalUnmarshal(t *testing.T) {
+	queryRequest := createAptosViewQueryRequestForTesting(t)
+	respPub := createAptosQueryResponseFromRequest(t, queryRequest)
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	require.NotNil(t, respPub2)
+
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
+func TestAptosResourceQueryResponseMarshalUnmarshal(t *testing.T) {
+	queryRequest := createAptosResourceQueryRequestForTesting(t)
+	respPub := createAptosQueryResponseFromRequest(t, queryRequest)
+
+	respPubBytes, err := respPub.Marshal()
+	require.NoError(t, err)
+
+	var respPub2 QueryResponsePublication
+	err = respPub2.Unmarshal(respPubBytes)
+	require.NoError(t, err)
+	require.NotNil(t, respPub2)
+
+	assert.True(t, respPub.Equal(&respPub2))
+}
+
+func TestAptosResourceQueryResponseWithNoResultsShouldFail(t *testing.T) {
+	queryRequest := createAptosResourceQueryRequestForTesting(t)
+	respPub := createAptosQueryResponseFromRequest(t, queryRequest)
+	respPub.PerChainResponses[0].Response.(*AptosResourceQueryResponse).Results = nil
+
+	_, err := respPub.Marshal()
+	require.ErrorContains(t, err, "does not contain any results")
+}
+
+///////////// End of Aptos Query tests ///////////////////////////
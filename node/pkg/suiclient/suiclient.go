@@ -41,6 +41,7 @@ const (
 const (
 	CheckpointFieldSequenceNumber = "sequence_number"
 	CheckpointFieldDigest         = "digest"
+	CheckpointFieldTimestamp      = "summary.timestamp"
 )
 
 // SuiObject holds the flat-primitive fields of a Sui object. A field is
@@ -122,6 +123,28 @@ type SuiTransaction struct {
 type SuiCheckpoint struct {
 	SequenceNumber *uint64
 	Digest         *string
+	Timestamp      *time.Time
+}
+
+// SuiMoveCall describes a single Move function call to be simulated. Package
+// and object IDs are 0x-prefixed hex strings. Each argument must set exactly
+// one of Pure (a BCS encoded value) or ObjectID.
+type SuiMoveCall struct {
+	Package       string
+	Module        string
+	Function      string
+	TypeArguments []string
+	Arguments     []SuiMoveCallInput
+}
+
+type SuiMoveCallInput struct {
+	Pure     []byte
+	ObjectID *string
+}
+
+// SuiMoveCallResult holds the BCS encoded return values of a simulated Move call.
+type SuiMoveCallResult struct {
+	ReturnValues [][]byte
 }
 
 type SuiSubscription struct {
@@ -176,6 +199,26 @@ type SuiClient interface {
 	// read — this method does not enforce per-field presence.
 	GetLatestCheckpoint(ctx context.Context, fields []string) (SuiCheckpoint, error)
 
+	// GetCheckpoint fetches the checkpoint with the given sequence number.
+	// `fields` is the list of protobuf field paths to populate on the returned
+	// SuiCheckpoint; see the CheckpointField* constants. At least one field is
+	// required.
+	//
+	// Fields not requested (and fields requested but missing from the upstream
+	// response) come back nil/empty. Callers MUST nil-check every field they
+	// read — this method does not enforce per-field presence.
+	GetCheckpoint(ctx context.Context, sequenceNumber uint64, fields []string) (SuiCheckpoint, error)
+
+	// GetChainIdentifier returns the chain identifier of the node, which is the
+	// digest of the genesis checkpoint.
+	GetChainIdentifier(ctx context.Context) (string, error)
+
+	// SimulateMoveCalls executes `calls` as a single programmable transaction
+	// against the latest state, without committing it, and returns the return
+	// values of each call in order. Transaction checks are disabled, so only
+	// read-only (view) semantics should be relied upon.
+	SimulateMoveCalls(ctx context.Context, calls []SuiMoveCall) ([]SuiMoveCallResult, error)
+
 	// GetTransaction fetches the transaction identified by `digest`. `fields`
 	// is the list of protobuf field paths to populate on the returned
 	// SuiTransaction; see the TransactionField* constants. At least one field
@@ -28,13 +28,18 @@ type GrpcLedgerServiceClientInterface interface {
 	GetObject(ctx context.Context, req *pb.GetObjectRequest) (*pb.GetObjectResponse, error)
 	GetCheckpoint(ctx context.Context, req *pb.GetCheckpointRequest) (*pb.GetCheckpointResponse, error)
 	GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error)
+	GetServiceInfo(ctx context.Context, req *pb.GetServiceInfoRequest) (*pb.GetServiceInfoResponse, error)
 }
 
 type GrpcSubscriptionServiceClientInterface interface {
 	SubscribeCheckpoints(ctx context.Context, req *pb.SubscribeCheckpointsRequest) (pb.SubscriptionService_SubscribeCheckpointsClient, error)
 }
 
-// The Sui gRPC client accepts interfaces for the ledger and subscription services. This allows creating mocks
+type GrpcTransactionExecutionServiceClientInterface interface {
+	SimulateTransaction(ctx context.Context, req *pb.SimulateTransactionRequest) (*pb.SimulateTransactionResponse, error)
+}
+
+// The Sui gRPC client accepts interfaces for the ledger, subscription and transaction execution services. This allows creating mocks
 // of the gRPC server, to enable thorough testing of parsing logic. Note that the interfaces defined above have
 // the same signatures as the gRPC methods. This is because the interface is only meant for drop-in replacements
 // of mocks that return different data. Requiring the implementations of the interfaces to do additional parsing
@@ -42,8 +47,9 @@ type GrpcSubscriptionServiceClientInterface interface {
 type SuiGrpcClient struct {
 	conn                        *grpc.ClientConn
 	logger                      *zap.Logger
-	pbLedgerServiceClient       GrpcLedgerServiceClientInterface       //pb.LedgerServiceClient
-	pbSubscriptionServiceClient GrpcSubscriptionServiceClientInterface //pb.SubscriptionServiceClient
+	pbLedgerServiceClient       GrpcLedgerServiceClientInterface               //pb.LedgerServiceClient
+	pbSubscriptionServiceClient GrpcSubscriptionServiceClientInterface         //pb.SubscriptionServiceClient
+	pbExecutionServiceClient    GrpcTransactionExecutionServiceClientInterface //pb.TransactionExecutionServiceClient
 }
 
 // GetObject fetches the latest version of `objectID` populated with the
@@ -125,6 +131,51 @@ func (s *SuiGrpcClient) GetLatestCheckpoint(ctx context.Context, fields []string
 	return grpcCheckpointToSuiCheckpoint(resp.Checkpoint), nil
 }
 
+// GetChainIdentifier returns the chain identifier of the node, which is the
+// digest of the genesis checkpoint.
+func (s *SuiGrpcClient) GetChainIdentifier(ctx context.Context) (string, error) {
+	resp, err := s.pbLedgerServiceClient.GetServiceInfo(ctx, &pb.GetServiceInfoRequest{})
+	if err != nil {
+		return "", fmt.Errorf("sui gRPC GetServiceInfo failed: %w", err)
+	}
+
+	if resp == nil || resp.ChainId == nil {
+		return "", fmt.Errorf("sui gRPC GetServiceInfo returned no chain identifier")
+	}
+
+	return *resp.ChainId, nil
+}
+
+// GetCheckpoint fetches the checkpoint with sequence number `sequenceNumber`
+// populated with the requested `fields` (see CheckpointField* constants in
+// suiclient.go).
+//
+// Returned-field nil-checking is the caller's responsibility — any field
+// not requested OR not returned by the upstream node comes back nil/empty,
+// and this method does not enforce per-field presence.
+func (s *SuiGrpcClient) GetCheckpoint(ctx context.Context, sequenceNumber uint64, fields []string) (SuiCheckpoint, error) {
+	if len(fields) == 0 {
+		return SuiCheckpoint{}, fmt.Errorf("sui gRPC GetCheckpoint requires at least one field for sequenceNumber=%d", sequenceNumber)
+	}
+
+	getCheckpointRequest := pb.GetCheckpointRequest{
+		CheckpointId: &pb.GetCheckpointRequest_SequenceNumber{SequenceNumber: sequenceNumber},
+		ReadMask:     fieldMask(fields),
+	}
+
+	resp, err := s.pbLedgerServiceClient.GetCheckpoint(ctx, &getCheckpointRequest)
+
+	if err != nil {
+		return SuiCheckpoint{}, fmt.Errorf("sui gRPC GetCheckpoint failed for sequenceNumber=%d fields=%v: %w", sequenceNumber, fields, err)
+	}
+
+	if resp == nil || resp.Checkpoint == nil {
+		return SuiCheckpoint{}, fmt.Errorf("sui gRPC GetCheckpoint returned nil top-level properties for sequenceNumber=%d fields=%v", sequenceNumber, fields)
+	}
+
+	return grpcCheckpointToSuiCheckpoint(resp.Checkpoint), nil
+}
+
 // GetTransaction fetches the transaction identified by `digest` populated with
 // the requested `fields` (see TransactionField* constants in suiclient.go).
 // Replaces `sui_getTransactionBlock`.
@@ -181,6 +232,97 @@ func transactionSucceeded(tx *pb.ExecutedTransaction) bool {
 		*tx.Effects.Status.Success
 }
 
+// SimulateMoveCalls builds a programmable transaction containing one MoveCall
+// command per entry in `calls`, simulates it with transaction checks disabled
+// and returns the BCS encoded return values of each command. Object inputs are
+// passed by ID only and are resolved by the node against its latest state.
+// Docs: https://www.quicknode.com/docs/sui/sui-grpc/transaction-execution/simulate-transaction
+func (s *SuiGrpcClient) SimulateMoveCalls(ctx context.Context, calls []SuiMoveCall) ([]SuiMoveCallResult, error) {
+	if len(calls) == 0 {
+		return nil, fmt.Errorf("sui gRPC SimulateMoveCalls requires at least one call")
+	}
+
+	ptb := &pb.ProgrammableTransaction{}
+	for _, call := range calls {
+		moveCall := &pb.MoveCall{
+			Package:       &call.Package,
+			Module:        &call.Module,
+			Function:      &call.Function,
+			TypeArguments: call.TypeArguments,
+		}
+
+		for _, arg := range call.Arguments {
+			input := &pb.Input{}
+			if arg.ObjectID != nil {
+				input.ObjectId = arg.ObjectID
+			} else {
+				input.Kind = pb.Input_PURE.Enum()
+				input.Pure = arg.Pure
+			}
+
+			// #nosec G115 -- The number of inputs is bounded by the caller.
+			inputIdx := uint32(len(ptb.Inputs))
+			ptb.Inputs = append(ptb.Inputs, input)
+			moveCall.Arguments = append(moveCall.Arguments, &pb.Argument{
+				Kind:  pb.Argument_INPUT.Enum(),
+				Input: &inputIdx,
+			})
+		}
+
+		ptb.Commands = append(ptb.Commands, &pb.Command{
+			Command: &pb.Command_MoveCall{MoveCall: moveCall},
+		})
+	}
+
+	// Simulations with checks disabled do not require a funded sender, so use the zero address.
+	sender := "0x0000000000000000000000000000000000000000000000000000000000000000"
+	simulateRequest := pb.SimulateTransactionRequest{
+		Transaction: &pb.Transaction{
+			Kind: &pb.TransactionKind{
+				Kind: pb.TransactionKind_PROGRAMMABLE_TRANSACTION.Enum(),
+				Data: &pb.TransactionKind_ProgrammableTransaction{ProgrammableTransaction: ptb},
+			},
+			Sender: &sender,
+		},
+		ReadMask: fieldMask([]string{"command_outputs.return_values.value", "transaction.effects.status"}),
+		Checks:   pb.SimulateTransactionRequest_DISABLED.Enum(),
+	}
+
+	resp, err := s.pbExecutionServiceClient.SimulateTransaction(ctx, &simulateRequest)
+
+	if err != nil {
+		return nil, fmt.Errorf("sui gRPC SimulateTransaction failed for %d calls: %w", len(calls), err)
+	}
+
+	if resp == nil {
+		return nil, fmt.Errorf("sui gRPC SimulateTransaction returned nil response for %d calls", len(calls))
+	}
+
+	if !transactionSucceeded(resp.Transaction) {
+		return nil, fmt.Errorf("sui gRPC SimulateTransaction: simulated transaction did not execute successfully (or its execution status is missing)")
+	}
+
+	if len(resp.CommandOutputs) != len(calls) {
+		return nil, fmt.Errorf("sui gRPC SimulateTransaction returned an unexpected number of command outputs, expected %d, got %d", len(calls), len(resp.CommandOutputs))
+	}
+
+	results := make([]SuiMoveCallResult, 0, len(calls))
+	for idx, output := range resp.CommandOutputs {
+		result := SuiMoveCallResult{}
+		if output != nil {
+			for _, value := range output.ReturnValues {
+				if value == nil || value.Value == nil {
+					return nil, fmt.Errorf("sui gRPC SimulateTransaction returned a return value without BCS contents for command %d", idx)
+				}
+				result.ReturnValues = append(result.ReturnValues, value.Value.Value)
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 func (s *SuiGrpcClient) createCheckpointStream(ctx context.Context, fields []string) (pb.SubscriptionService_SubscribeCheckpointsClient, error) {
 
 	// Prepare SubscribeCheckpointsRequest
@@ -377,19 +519,24 @@ func NewSuiGrpcClient(rpcURL string, logger *zap.Logger, extraOpts ...grpc.DialO
 		pbSubscriptionServiceClient: pb.NewSubscriptionServiceClient(conn),
 	}
 
-	return newSuiGrpcClientWithServices(logger, conn, grpcLedgerServiceClient, grpcSubscriptionServiceClient), nil
+	grpcTransactionExecutionServiceClient := &GrpcTransactionExecutionServiceClient{
+		pbTransactionExecutionServiceClient: pb.NewTransactionExecutionServiceClient(conn),
+	}
+
+	return newSuiGrpcClientWithServices(logger, conn, grpcLedgerServiceClient, grpcSubscriptionServiceClient, grpcTransactionExecutionServiceClient), nil
 }
 
 // A private function to construct the gRPC client from its most basic components. This is kept private, since the intended use for production is
 // via NewSuiGrpcClient, which creates live service clients.  For testing, this function can be used to supply mock versions of the service clients.
-// There is no need to check that `ledgerServiceClient` or `subscriptionServiceClient` is nil, because the intended use is via `NewSuiGrpcClient`,
+// There is no need to check that `ledgerServiceClient`, `subscriptionServiceClient` or `executionServiceClient` is nil, because the intended use is via `NewSuiGrpcClient`,
 // which instantiates these objects.
-func newSuiGrpcClientWithServices(logger *zap.Logger, conn *grpc.ClientConn, ledgerServiceClient GrpcLedgerServiceClientInterface, subscriptionServiceClient GrpcSubscriptionServiceClientInterface) SuiClient {
+func newSuiGrpcClientWithServices(logger *zap.Logger, conn *grpc.ClientConn, ledgerServiceClient GrpcLedgerServiceClientInterface, subscriptionServiceClient GrpcSubscriptionServiceClientInterface, executionServiceClient GrpcTransactionExecutionServiceClientInterface) SuiClient {
 	return &SuiGrpcClient{
 		conn:                        conn,
 		logger:                      logger,
 		pbLedgerServiceClient:       ledgerServiceClient,
 		pbSubscriptionServiceClient: subscriptionServiceClient,
+		pbExecutionServiceClient:    executionServiceClient,
 	}
 }
 
@@ -519,5 +666,10 @@ func grpcCheckpointToSuiCheckpoint(grpcCheckpoint *pb.Checkpoint) SuiCheckpoint
 	out.SequenceNumber = grpcCheckpoint.SequenceNumber
 	out.Digest = grpcCheckpoint.Digest
 
+	if grpcCheckpoint.Summary != nil && grpcCheckpoint.Summary.Timestamp != nil {
+		ts := grpcCheckpoint.Summary.Timestamp.AsTime()
+		out.Timestamp = &ts
+	}
+
 	return out
 }
@@ -20,6 +20,9 @@ func (c *GrpcLedgerServiceClient) GetCheckpoint(ctx context.Context, req *pb.Get
 func (c *GrpcLedgerServiceClient) GetTransaction(ctx context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
 	return c.pbLedgerServiceClient.GetTransaction(ctx, req)
 }
+func (c *GrpcLedgerServiceClient) GetServiceInfo(ctx context.Context, req *pb.GetServiceInfoRequest) (*pb.GetServiceInfoResponse, error) {
+	return c.pbLedgerServiceClient.GetServiceInfo(ctx, req)
+}
 
 // Production implementation of GrpcSubscriptionServiceClientInterface
 type GrpcSubscriptionServiceClient struct {
@@ -29,3 +32,12 @@ type GrpcSubscriptionServiceClient struct {
 func (c *GrpcSubscriptionServiceClient) SubscribeCheckpoints(ctx context.Context, req *pb.SubscribeCheckpointsRequest) (pb.SubscriptionService_SubscribeCheckpointsClient, error) {
 	return c.pbSubscriptionServiceClient.SubscribeCheckpoints(ctx, req)
 }
+
+// Production implementation of GrpcTransactionExecutionServiceClientInterface
+type GrpcTransactionExecutionServiceClient struct {
+	pbTransactionExecutionServiceClient pb.TransactionExecutionServiceClient
+}
+
+func (c *GrpcTransactionExecutionServiceClient) SimulateTransaction(ctx context.Context, req *pb.SimulateTransactionRequest) (*pb.SimulateTransactionResponse, error) {
+	return c.pbTransactionExecutionServiceClient.SimulateTransaction(ctx, req)
+}
@@ -51,11 +51,15 @@ func (m *MockLedgerServiceClient) GetTransaction(ctx context.Context, req *pb.Ge
 	return m.nextGetTransactionResponse, nil
 }
 
+func (m *MockLedgerServiceClient) GetServiceInfo(ctx context.Context, req *pb.GetServiceInfoRequest) (*pb.GetServiceInfoResponse, error) {
+	return &pb.GetServiceInfoResponse{}, nil
+}
+
 func FuzzSuiGrpcClientGetObject(f *testing.F) {
 
 	ledgerService := &MockLedgerServiceClient{}
 
-	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil)
+	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil, nil)
 
 	corpusString := "random string"
 	corpusUint := uint64(0)
@@ -162,7 +166,7 @@ func FuzzSuiGrpcClientGetObject(f *testing.F) {
 func FuzzSuiGrpcClientGetCheckpoint(f *testing.F) {
 
 	ledgerService := &MockLedgerServiceClient{}
-	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil)
+	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil, nil)
 
 	// Add a seed input for each property that can be nil
 	f.Add(true, false, uint64(0))
@@ -199,6 +203,7 @@ func FuzzSuiGrpcClientGetCheckpoint(f *testing.F) {
 
 		ledgerService.SetNextGetCheckpointResponse(resp)
 		_, _ = grpcClient.GetLatestCheckpoint(context.Background(), []string{CheckpointFieldSequenceNumber})
+		_, _ = grpcClient.GetCheckpoint(context.Background(), sequenceNumber, []string{CheckpointFieldSequenceNumber, CheckpointFieldTimestamp})
 
 		ledgerService.SetNextGetCheckpointResponse(nil)
 
@@ -211,7 +216,7 @@ func FuzzSuiGrpcClientGetTransactionNoEvents(f *testing.F) {
 	// done separately.
 
 	ledgerService := &MockLedgerServiceClient{}
-	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil)
+	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil, nil)
 
 	// Add a seed input for each property that can be nil
 	f.Add(true, false, false, "random string")
@@ -486,7 +491,7 @@ func FuzzSuiGrpcClientSubscribeToEvents(f *testing.F) {
 			}
 		}
 
-		grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, nil, subscriptionService)
+		grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, nil, subscriptionService, nil)
 
 		// Buffer the channel generously so the subscription goroutine never blocks while
 		// writing events. At most maxTransactions*maxEventsPerTx events can be produced.
@@ -545,7 +550,7 @@ func TestGetTransactionEnforcesExecutionStatus(t *testing.T) {
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
 			ledgerService := &MockLedgerServiceClient{}
-			grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil)
+			grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, ledgerService, nil, nil)
 
 			ledgerService.SetNextGetTransactionResponse(&pb.GetTransactionResponse{
 				Transaction: &pb.ExecutedTransaction{
@@ -617,7 +622,7 @@ func TestSubscribeDropsEventsFromFailedTransactions(t *testing.T) {
 			recvErr:   io.EOF,
 		},
 	}
-	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, nil, subscriptionService)
+	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, nil, subscriptionService, nil)
 
 	eventChan := make(chan SuiTransactionEvent, 4)
 	subscription, err := grpcClient.SubscribeToTransactionEvent(context.Background(), eventType, eventChan)
@@ -653,3 +658,88 @@ func FuzzNewSuiGrpcClient(f *testing.F) {
 		client.Close() //nolint:errcheck // The Close error is not relevant for the fuzz harness
 	})
 }
+
+// A mock TransactionExecutionService client for testing. The last request is retained so tests can
+// verify how the programmable transaction was constructed.
+type MockTransactionExecutionServiceClient struct {
+	lastRequest *pb.SimulateTransactionRequest
+	nextResp    *pb.SimulateTransactionResponse
+	nextErr     error
+}
+
+func (m *MockTransactionExecutionServiceClient) SimulateTransaction(ctx context.Context, req *pb.SimulateTransactionRequest) (*pb.SimulateTransactionResponse, error) {
+	m.lastRequest = req
+	return m.nextResp, m.nextErr
+}
+
+func TestSuiGrpcClientSimulateMoveCalls(t *testing.T) {
+	executionService := &MockTransactionExecutionServiceClient{}
+	grpcClient := newSuiGrpcClientWithServices(zap.NewNop(), nil, nil, nil, executionService)
+
+	objectID := "0x5306f64e312b581766351c07af79c72fcb1cd25147157fdc2f8ad76de9a3fb6a"
+	calls := []SuiMoveCall{
+		{
+			Package:  "0x2",
+			Module:   "clock",
+			Function: "timestamp_ms",
+			Arguments: []SuiMoveCallInput{
+				{ObjectID: &objectID},
+			},
+		},
+		{
+			Package:       "0x2",
+			Module:        "coin",
+			Function:      "value",
+			TypeArguments: []string{"0x2::sui::SUI"},
+			Arguments: []SuiMoveCallInput{
+				{Pure: []byte{0x01, 0x02}},
+			},
+		},
+	}
+
+	_, err := grpcClient.SimulateMoveCalls(context.Background(), nil)
+	require.Error(t, err)
+
+	// A failed simulation should be rejected.
+	executionService.nextResp = &pb.SimulateTransactionResponse{}
+	_, err = grpcClient.SimulateMoveCalls(context.Background(), calls)
+	require.ErrorContains(t, err, "did not execute successfully")
+
+	// A mismatched number of outputs should be rejected.
+	executionService.nextResp = &pb.SimulateTransactionResponse{
+		Transaction:    &pb.ExecutedTransaction{Effects: successfulEffects()},
+		CommandOutputs: []*pb.CommandResult{{}},
+	}
+	_, err = grpcClient.SimulateMoveCalls(context.Background(), calls)
+	require.ErrorContains(t, err, "unexpected number of command outputs")
+
+	executionService.nextResp = &pb.SimulateTransactionResponse{
+		Transaction: &pb.ExecutedTransaction{Effects: successfulEffects()},
+		CommandOutputs: []*pb.CommandResult{
+			{ReturnValues: []*pb.CommandOutput{{Value: &pb.Bcs{Value: []byte{0x0a, 0x0b}}}}},
+			{ReturnValues: []*pb.CommandOutput{{Value: &pb.Bcs{Value: []byte{0x0c}}}, {Value: &pb.Bcs{Value: []byte{0x0d}}}}},
+		},
+	}
+	results, err := grpcClient.SimulateMoveCalls(context.Background(), calls)
+	require.NoError(t, err)
+	require.Equal(t, []SuiMoveCallResult{
+		{ReturnValues: [][]byte{{0x0a, 0x0b}}},
+		{ReturnValues: [][]byte{{0x0c}, {0x0d}}},
+	}, results)
+
+	// Verify the programmable transaction that was submitted.
+	req := executionService.lastRequest
+	require.NotNil(t, req)
+	require.Equal(t, pb.SimulateTransactionRequest_DISABLED, req.GetChecks())
+	ptb := req.GetTransaction().GetKind().GetProgrammableTransaction()
+	require.NotNil(t, ptb)
+	require.Len(t, ptb.Inputs, 2)
+	require.Equal(t, objectID, ptb.Inputs[0].GetObjectId())
+	require.Equal(t, pb.Input_PURE, ptb.Inputs[1].GetKind())
+	require.Equal(t, []byte{0x01, 0x02}, ptb.Inputs[1].GetPure())
+	require.Len(t, ptb.Commands, 2)
+	require.Equal(t, "timestamp_ms", ptb.Commands[0].GetMoveCall().GetFunction())
+	require.Equal(t, uint32(0), ptb.Commands[0].GetMoveCall().Arguments[0].GetInput())
+	require.Equal(t, []string{"0x2::sui::SUI"}, ptb.Commands[1].GetMoveCall().GetTypeArguments())
+	require.Equal(t, uint32(1), ptb.Commands[1].GetMoveCall().Arguments[0].GetInput())
+}
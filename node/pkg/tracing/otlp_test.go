@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestOtlpTracerProvider(t *testing.T) {
+	var requests []otlpTraces
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var req otlpTraces
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+	}))
+	defer collector.Close()
+
+	tp, err := NewOtlpTracerProvider(strings.TrimPrefix(collector.URL, "http://"), true, 1, "guardian-0", "0x01")
+	require.NoError(t, err)
+
+	ctx, parent := tp.Tracer(tracerName).Start(context.Background(), "message", trace.WithAttributes(
+		attribute.String("wormhole.message_id", "2/0001/3"),
+		attribute.Int64("wormhole.sequence", 3),
+		attribute.Bool("wormhole.reobservation", false),
+		attribute.StringSlice("wormhole.signers", []string{"a", "b"}),
+	))
+	_, child := tp.Tracer(tracerName).Start(ctx, StageQueue)
+	child.AddEvent("message dropped")
+	child.SetStatus(codes.Error, "message dropped")
+	child.End()
+	parent.End()
+	require.NoError(t, tp.Shutdown(context.Background()))
+
+	require.Len(t, requests, 1)
+	require.Len(t, requests[0].ResourceSpans, 1)
+	rs := requests[0].ResourceSpans[0]
+	assert.Contains(t, rs.Resource.Attributes, otlpKeyValue{Key: "service.instance.id", Value: otlpValue(attribute.StringValue("guardian-0"))})
+	require.Len(t, rs.ScopeSpans, 1)
+	assert.Equal(t, tracerName, rs.ScopeSpans[0].Scope.Name)
+
+	spans := rs.ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	queue, message := spans[0], spans[1]
+
+	assert.Equal(t, StageQueue, queue.Name)
+	assert.Equal(t, child.SpanContext().TraceID().String(), queue.TraceID)
+	assert.Equal(t, child.SpanContext().SpanID().String(), queue.SpanID)
+	assert.Equal(t, parent.SpanContext().SpanID().String(), queue.ParentSpanID)
+	assert.Equal(t, otlpStatus{Code: otlpStatusCodeError, Message: "message dropped"}, queue.Status)
+	require.Len(t, queue.Events, 1)
+	assert.Equal(t, "message dropped", queue.Events[0].Name)
+
+	assert.Equal(t, "message", message.Name)
+	assert.Empty(t, message.ParentSpanID)
+	assert.Equal(t, otlpStatus{}, message.Status)
+
+	// The JSON encoding of OTLP uses strings for 64 bit integers.
+	encoded, err := json.Marshal(message.Attributes)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"key": "wormhole.message_id", "value": {"stringValue": "2/0001/3"}},
+		{"key": "wormhole.sequence", "value": {"intValue": "3"}},
+		{"key": "wormhole.reobservation", "value": {"boolValue": false}},
+		{"key": "wormhole.signers", "value": {"arrayValue": {"values": [{"stringValue": "a"}, {"stringValue": "b"}]}}}
+	]`, string(encoded))
+}
+
+func TestOtlpExporterCollectorError(t *testing.T) {
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "overloaded", http.StatusServiceUnavailable)
+	}))
+	defer collector.Close()
+
+	exporter := newOtlpExporter(strings.TrimPrefix(collector.URL, "http://"), true)
+	spans := tracetest.SpanStubs{{Name: "message"}}.Snapshots()
+
+	err := exporter.ExportSpans(context.Background(), []sdktrace.ReadOnlySpan{spans[0]})
+	require.ErrorContains(t, err, "503 Service Unavailable: overloaded")
+}
+
+func TestOtlpTracerProviderInvalidSampleRatio(t *testing.T) {
+	_, err := NewOtlpTracerProvider("localhost:4318", true, 1.5, "guardian-0", "0x01")
+	require.ErrorContains(t, err, "trace sample ratio must be between 0 and 1")
+}
@@ -0,0 +1,203 @@
+// Package tracing follows messages through the guardian with OpenTelemetry, so that the time a VAA spends in each
+// component can be measured.
+//
+// A trace is started for every message publication emitted by a watcher, and keyed by the message ID. The components
+// that process the message do not pass a context to each other, since messages cross channels and may wait in the
+// governor, the notary or the accountant for hours. Instead, each component looks up the trace by message ID and
+// starts a new stage, which ends the previous one. The trace ends when the VAA reaches quorum, when the message is
+// dropped, or when it expires.
+//
+// The stages of a message are:
+//   - queue: from the watcher to the processor.
+//   - notary, governor, accountant: the decisions of each component, which last until the message is released.
+//   - sign: signing the observation and broadcasting it.
+//   - quorum: waiting for the observations of the other guardians.
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// tracerName is the name of the instrumentation library.
+	tracerName = "github.com/certusone/wormhole/node/pkg/tracing"
+
+	// DefaultTraceTTL is how long a trace stays open. It must exceed the longest governor delay, which is a day.
+	DefaultTraceTTL = 25 * time.Hour
+)
+
+// The stages of a message.
+const (
+	StageQueue      = "queue"
+	StageNotary     = "notary"
+	StageGovernor   = "governor"
+	StageAccountant = "accountant"
+	StageSign       = "sign"
+	StageQuorum     = "quorum"
+)
+
+// messageTrace is the open trace of a message.
+type messageTrace struct {
+	ctx     context.Context
+	root    trace.Span
+	stage   trace.Span
+	started time.Time
+}
+
+// MessageTracer traces messages by message ID. It is safe for concurrent use. When tracing is disabled, or when a
+// message is not sampled, no trace is kept and its methods do nothing. The methods of a nil MessageTracer do nothing
+// either.
+type MessageTracer struct {
+	mu     sync.Mutex
+	tracer trace.Tracer
+	traces map[string]*messageTrace
+	ttl    time.Duration
+}
+
+// NewMessageTracer creates a tracer that exports its traces with the provider.
+func NewMessageTracer(tp trace.TracerProvider) *MessageTracer {
+	return &MessageTracer{
+		tracer: tp.Tracer(tracerName),
+		traces: make(map[string]*messageTrace),
+		ttl:    DefaultTraceTTL,
+	}
+}
+
+// NewDisabledMessageTracer creates a tracer that does not trace anything, until SetTracerProvider is called.
+func NewDisabledMessageTracer() *MessageTracer {
+	return NewMessageTracer(noop.NewTracerProvider())
+}
+
+// SetTracerProvider sets the provider that exports the traces. It only applies to the messages emitted afterwards.
+func (t *MessageTracer) SetTracerProvider(tp trace.TracerProvider) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tracer = tp.Tracer(tracerName)
+}
+
+// Emitted starts the trace of a message that a watcher emitted. A message that is already traced, such as a
+// reobservation of a pending message, is only recorded as an event of the current stage.
+func (t *MessageTracer) Emitted(k *common.MessagePublication) {
+	if t == nil {
+		return
+	}
+
+	msgID := k.MessageIDString()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if mt, exists := t.traces[msgID]; exists {
+		mt.stage.AddEvent("emitted again", trace.WithAttributes(attribute.Bool("wormhole.reobservation", k.IsReobservation)))
+		return
+	}
+
+	ctx, root := t.tracer.Start(context.Background(), "message",
+		trace.WithNewRoot(),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attribute.String("wormhole.message_id", msgID),
+			attribute.String("wormhole.emitter_chain", k.EmitterChain.String()),
+			attribute.String("wormhole.tx_id", k.TxIDString()),
+			attribute.Bool("wormhole.reobservation", k.IsReobservation),
+			attribute.Bool("wormhole.unreliable", k.Unreliable),
+			attribute.Int("wormhole.consistency_level", int(k.ConsistencyLevel)),
+			attribute.String("wormhole.block_time", k.Timestamp.UTC().Format(time.RFC3339)),
+		),
+	)
+	if !root.IsRecording() {
+		return
+	}
+
+	_, stage := t.tracer.Start(ctx, StageQueue)
+	t.traces[msgID] = &messageTrace{ctx: ctx, root: root, stage: stage, started: time.Now()}
+}
+
+// StartStage ends the current stage of the message and starts the next one.
+func (t *MessageTracer) StartStage(msgID string, stage string, attrs ...attribute.KeyValue) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	mt, exists := t.traces[msgID]
+	if !exists {
+		return
+	}
+
+	mt.stage.End()
+	_, mt.stage = t.tracer.Start(mt.ctx, stage, trace.WithAttributes(attrs...))
+}
+
+// Event records an event in the current stage of the message, such as a decision of a component.
+func (t *MessageTracer) Event(msgID string, name string, attrs ...attribute.KeyValue) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if mt, exists := t.traces[msgID]; exists {
+		mt.stage.AddEvent(name, trace.WithAttributes(attrs...))
+	}
+}
+
+// Completed ends the trace of a message that reached quorum.
+func (t *MessageTracer) Completed(msgID string) {
+	t.end(msgID, codes.Ok, "")
+}
+
+// Dropped ends the trace of a message that will not be signed, such as a message black-holed by the notary.
+func (t *MessageTracer) Dropped(msgID string, reason string) {
+	t.end(msgID, codes.Error, reason)
+}
+
+func (t *MessageTracer) end(msgID string, code codes.Code, description string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if mt, exists := t.traces[msgID]; exists {
+		endTrace(mt, code, description)
+		delete(t.traces, msgID)
+	}
+}
+
+// ExpireTraces ends the traces that were started more than the TTL before now, so that the messages that never reach
+// quorum are exported and do not leak.
+func (t *MessageTracer) ExpireTraces(now time.Time) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for msgID, mt := range t.traces {
+		if now.Sub(mt.started) > t.ttl {
+			endTrace(mt, codes.Error, "expired")
+			delete(t.traces, msgID)
+		}
+	}
+}
+
+// endTrace ends the current stage and the root span of the trace.
+func endTrace(mt *messageTrace, code codes.Code, description string) {
+	mt.stage.End()
+	mt.root.SetStatus(code, description)
+	mt.root.End()
+}
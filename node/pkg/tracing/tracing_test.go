@@ -0,0 +1,167 @@
+package tracing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTestTracer() (*MessageTracer, *tracetest.InMemoryExporter) {
+	exporter := tracetest.NewInMemoryExporter()
+	return NewMessageTracer(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))), exporter
+}
+
+func newTestMessage(sequence uint64) *common.MessagePublication {
+	return &common.MessagePublication{
+		TxID:             []byte{0x01, 0x02},
+		Timestamp:        time.Unix(1700000000, 0),
+		Sequence:         sequence,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   vaa.Address{0x01},
+		ConsistencyLevel: 1,
+	}
+}
+
+// findSpan returns the span with the given name, or nil.
+func findSpan(spans tracetest.SpanStubs, name string) *tracetest.SpanStub {
+	for i := range spans {
+		if spans[i].Name == name {
+			return &spans[i]
+		}
+	}
+	return nil
+}
+
+func TestMessageTracerStages(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	k := newTestMessage(1)
+	msgID := k.MessageIDString()
+
+	tracer.Emitted(k)
+	tracer.StartStage(msgID, StageGovernor)
+	tracer.Event(msgID, "governor delayed message")
+	tracer.StartStage(msgID, StageSign)
+	tracer.StartStage(msgID, StageQuorum, attribute.String("wormhole.digest", "abc"))
+
+	// Nothing is exported until the message reaches quorum.
+	assert.Len(t, exporter.GetSpans(), 3)
+	assert.Nil(t, findSpan(exporter.GetSpans(), "message"))
+
+	tracer.Completed(msgID)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 5)
+	root := findSpan(spans, "message")
+	require.NotNil(t, root)
+	assert.Equal(t, codes.Ok, root.Status.Code)
+	assert.Contains(t, root.Attributes, attribute.String("wormhole.message_id", msgID))
+	assert.Contains(t, root.Attributes, attribute.String("wormhole.emitter_chain", "ethereum"))
+
+	for i, name := range []string{StageQueue, StageGovernor, StageSign, StageQuorum} {
+		assert.Equal(t, name, spans[i].Name)
+		assert.Equal(t, root.SpanContext.TraceID(), spans[i].SpanContext.TraceID())
+		assert.Equal(t, root.SpanContext.SpanID(), spans[i].Parent.SpanID())
+	}
+
+	governor := findSpan(spans, StageGovernor)
+	require.Len(t, governor.Events, 1)
+	assert.Equal(t, "governor delayed message", governor.Events[0].Name)
+	assert.Contains(t, findSpan(spans, StageQuorum).Attributes, attribute.String("wormhole.digest", "abc"))
+
+	// The trace is closed, so later calls do nothing.
+	tracer.StartStage(msgID, StageSign)
+	tracer.Completed(msgID)
+	assert.Len(t, exporter.GetSpans(), 5)
+}
+
+func TestMessageTracerDropped(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	k := newTestMessage(1)
+
+	tracer.Emitted(k)
+	tracer.StartStage(k.MessageIDString(), StageNotary)
+	tracer.Dropped(k.MessageIDString(), "black-holed by the notary")
+
+	root := findSpan(exporter.GetSpans(), "message")
+	require.NotNil(t, root)
+	assert.Equal(t, codes.Error, root.Status.Code)
+	assert.Equal(t, "black-holed by the notary", root.Status.Description)
+}
+
+func TestMessageTracerEmittedAgain(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	k := newTestMessage(1)
+
+	tracer.Emitted(k)
+	reobservation := *k
+	reobservation.IsReobservation = true
+	tracer.Emitted(&reobservation)
+	tracer.Completed(k.MessageIDString())
+
+	// The reobservation does not start a new trace.
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	queue := findSpan(spans, StageQueue)
+	require.Len(t, queue.Events, 1)
+	assert.Equal(t, "emitted again", queue.Events[0].Name)
+}
+
+func TestMessageTracerExpireTraces(t *testing.T) {
+	tracer, exporter := newTestTracer()
+	tracer.Emitted(newTestMessage(1))
+	tracer.Emitted(newTestMessage(2))
+
+	tracer.ExpireTraces(time.Now())
+	assert.Empty(t, exporter.GetSpans())
+
+	tracer.ExpireTraces(time.Now().Add(DefaultTraceTTL + time.Minute))
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 4)
+	root := findSpan(spans, "message")
+	assert.Equal(t, codes.Error, root.Status.Code)
+	assert.Equal(t, "expired", root.Status.Description)
+	assert.Empty(t, tracer.traces)
+}
+
+func TestMessageTracerSampling(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := NewMessageTracer(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter), sdktrace.WithSampler(sdktrace.NeverSample())))
+
+	k := newTestMessage(1)
+	tracer.Emitted(k)
+	tracer.Completed(k.MessageIDString())
+
+	// A message that is not sampled is not kept.
+	assert.Empty(t, tracer.traces)
+	assert.Empty(t, exporter.GetSpans())
+}
+
+func TestDisabledMessageTracer(t *testing.T) {
+	tracer := NewDisabledMessageTracer()
+	k := newTestMessage(1)
+	tracer.Emitted(k)
+	assert.Empty(t, tracer.traces)
+
+	// Messages emitted after the provider is set are traced.
+	exporter := tracetest.NewInMemoryExporter()
+	tracer.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	tracer.Emitted(newTestMessage(2))
+	assert.Len(t, tracer.traces, 1)
+
+	// The methods of a nil tracer do nothing.
+	var nilTracer *MessageTracer
+	nilTracer.Emitted(k)
+	nilTracer.StartStage(k.MessageIDString(), StageSign)
+	nilTracer.Event(k.MessageIDString(), "event")
+	nilTracer.Completed(k.MessageIDString())
+	nilTracer.Dropped(k.MessageIDString(), "reason")
+	nilTracer.ExpireTraces(time.Now())
+}
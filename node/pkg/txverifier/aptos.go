@@ -0,0 +1,496 @@
+package txverifier
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Errors
+var (
+	// Internal errors that can occur in the verifier.
+	ErrFailedToRetrieveAptosTx = errors.New("failed to retrieve Aptos transaction")
+	ErrInvalidAptosTx          = errors.New("invalid Aptos transaction")
+)
+
+// Global variables
+var (
+	// The token bridge account on each environment. It owns the custody of native assets and the `OriginInfo`
+	// resources that identify wrapped assets.
+	AptosTokenBridgeAddresses = map[common.Environment]string{
+		common.MainNet: "0x576410486a2da45eee6c949c995670112ddf2fbeedab20350d506328eefc9d4f",
+		common.TestNet: "0x576410486a2da45eee6c949c995670112ddf2fbeedab20350d506328eefc9d4f",
+		// Defined in aptos/token_bridge/Move.toml
+		common.UnsafeDevNet:   "0x84a5f374d29fc77e370014dce4fd6a55b58ad608de8074b0be5571701724da31",
+		common.GoTest:         "0x84a5f374d29fc77e370014dce4fd6a55b58ad608de8074b0be5571701724da31",
+		common.AccountantMock: "0x84a5f374d29fc77e370014dce4fd6a55b58ad608de8074b0be5571701724da31",
+	}
+)
+
+type AptosTransferVerifier struct {
+	// Used to identify `WormholeMessage` events emitted by the core bridge.
+	aptosCoreBridge  vaa.Address
+	aptosMessageType string
+	// Used to identify the token bridge's custody accounts and wrapped assets.
+	aptosTokenBridge    vaa.Address
+	aptosOriginInfoType string
+	// Used to check the sender of the `WormholeMessage` event.
+	aptosTokenBridgeEmitter vaa.Address
+	// REST client used to fetch transactions and resources.
+	client AptosClient
+}
+
+func NewAptosTransferVerifier(aptosCoreBridge, aptosTokenBridge string, aptosTokenBridgeEmitter vaa.Address, client AptosClient) (*AptosTransferVerifier, error) {
+	coreBridge, err := parseAptosAddress(aptosCoreBridge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid core bridge address: %w", err)
+	}
+
+	tokenBridge, err := parseAptosAddress(aptosTokenBridge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token bridge address: %w", err)
+	}
+
+	coreBridgeAccount := canonicalAptosAddress(aptosCoreBridge)
+	tokenBridgeAccount := canonicalAptosAddress(aptosTokenBridge)
+
+	return &AptosTransferVerifier{
+		aptosCoreBridge:         coreBridge,
+		aptosMessageType:        fmt.Sprintf("%s::%s", coreBridgeAccount, aptosWormholeMessageEvent),
+		aptosTokenBridge:        tokenBridge,
+		aptosOriginInfoType:     fmt.Sprintf("%s::%s", tokenBridgeAccount, aptosOriginInfoResource),
+		aptosTokenBridgeEmitter: aptosTokenBridgeEmitter,
+		client:                  client,
+	}, nil
+}
+
+func (a *AptosTransferVerifier) GetTokenBridgeEmitter() vaa.Address {
+	return a.aptosTokenBridgeEmitter
+}
+
+// extractBridgeRequestsFromEvents iterates through all events, and tries to identify `WormholeMessage` events emitted by the token bridge.
+// These events are parsed and collected in a `MsgIdToRequestOutOfBridge` object, mapping message IDs to requests out of the bridge. Like
+// its Sui counterpart, this function skips events that cannot be processed instead of returning errors.
+func (a *AptosTransferVerifier) extractBridgeRequestsFromEvents(events []gjson.Result, logger *zap.Logger) MsgIdToRequestOutOfBridge {
+	requests := make(MsgIdToRequestOutOfBridge)
+
+	for _, event := range events {
+		// Only process `WormholeMessage` events emitted through the core bridge's event handle.
+		if canonicalAptosType(event.Get("type").String()) != a.aptosMessageType {
+			continue
+		}
+
+		handleAccount, err := parseAptosAddress(event.Get("guid.account_address").String())
+		if err != nil || handleAccount != a.aptosCoreBridge {
+			logger.Error("WormholeMessage event was not emitted by the core bridge",
+				zap.String("guidAccountAddress", event.Get("guid.account_address").String()))
+			continue
+		}
+
+		data := event.Get("data")
+
+		// The sender is the u64 ID of the emitter capability, left-padded to 32 bytes. This matches the emitter
+		// address reported by the Aptos watcher.
+		var sender vaa.Address
+		binary.BigEndian.PutUint64(sender[24:], data.Get("sender").Uint())
+
+		// Only process the event if it was emitted by the token bridge emitter.
+		if sender != a.aptosTokenBridgeEmitter {
+			logger.Debug("Event does not match the criteria",
+				zap.Stringer("event sender", sender),
+				zap.Stringer("expected event sender", a.aptosTokenBridgeEmitter),
+			)
+			continue
+		}
+
+		payload, err := hex.DecodeString(strings.TrimPrefix(data.Get("payload").String(), "0x"))
+		if err != nil {
+			logger.Error("Failed to decode WormholeMessage payload", zap.Error(err))
+			continue
+		}
+
+		// Parse the wormhole message. If the payload is not a transfer, e.g. because an attestation was
+		// requested, the event is skipped.
+		hdr, err := vaa.DecodeTransferPayloadHdr(payload)
+		if err != nil {
+			continue
+		}
+
+		msgIDStr := fmt.Sprintf("%d/%s/%d", vaa.ChainIDAptos, sender, data.Get("sequence").Uint())
+		assetKey := fmt.Sprintf(KEY_FORMAT, hdr.OriginAddress.String(), hdr.OriginChain)
+
+		logger.Debug("Found request out of bridge",
+			zap.String("msgID", msgIDStr),
+			zap.String("assetKey", assetKey),
+			zap.String("amount", hdr.Amount.String()),
+		)
+
+		requests[msgIDStr] = &RequestOutOfBridge{
+			AssetKey:       assetKey,
+			Amount:         hdr.Amount,
+			DepositMade:    false,
+			DepositSolvent: false,
+		}
+	}
+
+	return requests
+}
+
+// extractTransfersIntoBridgeFromChanges iterates through the resources written by a transaction, and tries to identify token transfers
+// into the bridge. Native assets are deposited into the token bridge's `CoinStore` or, for accounts that were migrated to fungible assets,
+// into a `FungibleStore` owned by the token bridge. Wrapped assets are burned, which lowers the supply tracked by their `CoinInfo` or by
+// the supply of their paired fungible asset. Each change is compared against the resource as it was before the transaction executed.
+// The default behaviour of this function is to fail-close, meaning that any errors that occur during processing result in the offending
+// change being ignored.
+func (a *AptosTransferVerifier) extractTransfersIntoBridgeFromChanges(ctx context.Context, version uint64, changes []gjson.Result, logger *zap.Logger) AssetKeyToTransferIntoBridge {
+	transfers := make(AssetKeyToTransferIntoBridge)
+
+	// Balance changes of the token bridge's custody and supply changes, keyed by coin type.
+	custodyChanges := make(map[string]*big.Int)
+	supplyChanges := make(map[string]*big.Int)
+	// Decimals of the coins whose `CoinInfo` was written by the transaction.
+	decimals := make(map[string]uint8)
+	// Owners of the objects whose `ObjectCore` was written by the transaction.
+	owners := make(map[vaa.Address]vaa.Address)
+
+	for _, change := range changes {
+		if change.Get("type").String() != aptosWriteResourceChange || canonicalAptosType(change.Get("data.type").String()) != aptosObjectCore {
+			continue
+		}
+		object, objectErr := parseAptosAddress(change.Get("address").String())
+		owner, ownerErr := parseAptosAddress(change.Get("data.data.owner").String())
+		if objectErr != nil || ownerErr != nil {
+			continue
+		}
+		owners[object] = owner
+	}
+
+	for _, change := range changes {
+		if change.Get("type").String() != aptosWriteResourceChange {
+			continue
+		}
+
+		address := change.Get("address").String()
+		rawType := change.Get("data.type").String()
+		resourceType := canonicalAptosType(rawType)
+		data := change.Get("data.data")
+
+		var (
+			coinType string
+			delta    *big.Int
+			err      error
+		)
+
+		switch {
+		case strings.HasPrefix(resourceType, aptosCoinStorePrefix):
+			if owner, parseErr := parseAptosAddress(address); parseErr != nil || owner != a.aptosTokenBridge {
+				continue
+			}
+			coinType, _ = aptosGenericParam(resourceType, aptosCoinStorePrefix)
+			delta, err = a.resourceDelta(ctx, version, address, rawType, data, func(r gjson.Result) (*big.Int, error) {
+				return aptosParseU128(r.Get("coin.value"))
+			})
+			if err == nil {
+				addAptosChange(custodyChanges, coinType, delta)
+			}
+
+		case strings.HasPrefix(resourceType, aptosCoinInfoPrefix):
+			coinType, _ = aptosGenericParam(resourceType, aptosCoinInfoPrefix)
+			if coinDecimals := data.Get("decimals").Uint(); coinDecimals <= math.MaxUint8 {
+				decimals[coinType] = uint8(coinDecimals)
+			}
+			delta, err = a.resourceDelta(ctx, version, address, rawType, data, aptosCoinSupply)
+			if err == nil {
+				addAptosChange(supplyChanges, coinType, delta)
+			}
+
+		case resourceType == aptosFungibleStore:
+			var owner vaa.Address
+			owner, err = a.objectOwner(ctx, version, address, owners)
+			if err != nil || owner != a.aptosTokenBridge {
+				break
+			}
+			coinType, err = a.pairedCoinType(ctx, version, data.Get("metadata.inner").String())
+			if err != nil {
+				break
+			}
+			delta, err = a.resourceDelta(ctx, version, address, rawType, data, func(r gjson.Result) (*big.Int, error) {
+				return aptosParseU128(r.Get("balance"))
+			})
+			if err == nil {
+				addAptosChange(custodyChanges, coinType, delta)
+			}
+
+		case resourceType == aptosFungibleSupply || resourceType == aptosFungibleConcSupply:
+			coinType, err = a.pairedCoinType(ctx, version, address)
+			if err != nil {
+				// Fungible assets that are not paired with a coin cannot be bridged.
+				if errors.Is(err, ErrAptosResourceNotFound) {
+					err = nil
+				}
+				break
+			}
+			delta, err = a.resourceDelta(ctx, version, address, rawType, data, func(r gjson.Result) (*big.Int, error) {
+				return aptosFungibleSupplyValue(resourceType, r)
+			})
+			if err == nil {
+				addAptosChange(supplyChanges, coinType, delta)
+			}
+
+		default:
+			continue
+		}
+
+		if err != nil {
+			logger.Error("Error processing resource change",
+				zap.String("address", address),
+				zap.String("resourceType", rawType),
+				zap.Error(err))
+		}
+	}
+
+	// Resolve the origin of every coin that moved. A coin is wrapped if the token bridge stored an `OriginInfo`
+	// resource under the account that defines it, in which case only burns count as transfers into the bridge.
+	// Otherwise it is native to Aptos, and only deposits into the token bridge's custody count.
+	coinTypes := make(map[string]struct{})
+	for coinType := range custodyChanges {
+		coinTypes[coinType] = struct{}{}
+	}
+	for coinType := range supplyChanges {
+		coinTypes[coinType] = struct{}{}
+	}
+
+	for coinType := range coinTypes {
+		originAddress, originChain, isWrapped, err := a.originInfo(ctx, version, coinType)
+		if err != nil {
+			logger.Error("Error getting origin info", zap.String("coinType", coinType), zap.Error(err))
+			continue
+		}
+
+		var amount *big.Int
+		if isWrapped {
+			if supplyChanges[coinType] == nil {
+				continue
+			}
+			// The supply decreases when wrapped tokens are burned, so the sign is inverted to represent
+			// the deposit as a positive amount.
+			amount = new(big.Int).Neg(supplyChanges[coinType])
+		} else {
+			if custodyChanges[coinType] == nil {
+				continue
+			}
+			amount = custodyChanges[coinType]
+		}
+
+		coinDecimals, exists := decimals[coinType]
+		if !exists {
+			coinDecimals, err = a.coinDecimals(ctx, version, coinType)
+			if err != nil {
+				logger.Error("Error getting coin decimals", zap.String("coinType", coinType), zap.Error(err))
+				continue
+			}
+		}
+
+		normalized := normalize(amount, coinDecimals)
+
+		// Add the key if it does not exist yet
+		assetKey := fmt.Sprintf(KEY_FORMAT, originAddress.String(), originChain)
+
+		if _, exists := transfers[assetKey]; !exists {
+			transfers[assetKey] = &TransferIntoBridge{
+				Amount:  big.NewInt(0),
+				Solvent: false,
+			}
+		}
+
+		// Add the amount transferred into the bridge
+		logger.Debug("Adding transfer into bridge", zap.String("assetKey", assetKey), zap.String("amount", normalized.String()))
+		transfers[assetKey].Amount = new(big.Int).Add(transfers[assetKey].Amount, normalized)
+	}
+
+	return transfers
+}
+
+// resourceDelta returns the difference between the value extracted from a resource after the transaction at `version`
+// executed and the value extracted from the same resource before it executed. A resource that did not exist before the
+// transaction, e.g. a `CoinStore` registered by the token bridge as part of the transfer, is treated as zero.
+func (a *AptosTransferVerifier) resourceDelta(
+	ctx context.Context,
+	version uint64,
+	address string,
+	resourceType string,
+	current gjson.Result,
+	extract func(gjson.Result) (*big.Int, error),
+) (*big.Int, error) {
+	currentValue, err := extract(current)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract current value: %w", err)
+	}
+
+	if version == 0 {
+		return currentValue, nil
+	}
+
+	previousValue := big.NewInt(0)
+	previous, err := a.client.GetAccountResource(ctx, address, resourceType, version-1)
+	switch {
+	case errors.Is(err, ErrAptosResourceNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get previous resource version: %w", err)
+	default:
+		previousValue, err = extract(gjson.GetBytes(previous, "data"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract previous value: %w", err)
+		}
+	}
+
+	return new(big.Int).Sub(currentValue, previousValue), nil
+}
+
+// objectOwner returns the owner of an object, preferring the `ObjectCore` written by the transaction over a lookup.
+func (a *AptosTransferVerifier) objectOwner(ctx context.Context, version uint64, object string, owners map[vaa.Address]vaa.Address) (vaa.Address, error) {
+	objectAddress, err := parseAptosAddress(object)
+	if err != nil {
+		return vaa.Address{}, err
+	}
+
+	if owner, exists := owners[objectAddress]; exists {
+		return owner, nil
+	}
+
+	core, err := a.client.GetAccountResource(ctx, object, aptosObjectCore, version)
+	if err != nil {
+		return vaa.Address{}, fmt.Errorf("failed to get object core: %w", err)
+	}
+
+	return parseAptosAddress(gjson.GetBytes(core, "data.owner").String())
+}
+
+// pairedCoinType returns the coin type paired with the fungible asset described by the metadata object `metadata`.
+func (a *AptosTransferVerifier) pairedCoinType(ctx context.Context, version uint64, metadata string) (string, error) {
+	paired, err := a.client.GetAccountResource(ctx, metadata, aptosPairedCoinType, version)
+	if err != nil {
+		return "", fmt.Errorf("failed to get paired coin type: %w", err)
+	}
+
+	return aptosPairedCoin(gjson.GetBytes(paired, "data"))
+}
+
+// originInfo returns the token bridge's origin chain and address for a coin type, and whether or not the coin is
+// wrapped by the token bridge. This mirrors `token_bridge::state::origin_info`.
+func (a *AptosTransferVerifier) originInfo(ctx context.Context, version uint64, coinType string) (vaa.Address, vaa.ChainID, bool, error) {
+	info, err := a.client.GetAccountResource(ctx, aptosTypeAddress(coinType), a.aptosOriginInfoType, version)
+	if errors.Is(err, ErrAptosResourceNotFound) {
+		return aptosTokenHash(coinType), vaa.ChainIDAptos, false, nil
+	}
+	if err != nil {
+		return vaa.Address{}, vaa.ChainIDUnset, false, fmt.Errorf("failed to get origin info: %w", err)
+	}
+
+	tokenChain := gjson.GetBytes(info, "data.token_chain.number").Uint()
+	if tokenChain > math.MaxUint16 {
+		return vaa.Address{}, vaa.ChainIDUnset, false, fmt.Errorf("token chain %d is larger than MaxUint16", tokenChain)
+	}
+
+	tokenAddress, err := hex.DecodeString(strings.TrimPrefix(gjson.GetBytes(info, "data.token_address.external_address").String(), "0x"))
+	if err != nil || len(tokenAddress) != 32 {
+		return vaa.Address{}, vaa.ChainIDUnset, false, fmt.Errorf("invalid token address in origin info")
+	}
+
+	return vaa.Address(tokenAddress), vaa.ChainID(tokenChain), true, nil
+}
+
+// coinDecimals looks up the decimals of a coin in its `CoinInfo`, which is stored under the account that defines it.
+func (a *AptosTransferVerifier) coinDecimals(ctx context.Context, version uint64, coinType string) (uint8, error) {
+	coinInfo, err := a.client.GetAccountResource(ctx, aptosTypeAddress(coinType), fmt.Sprintf("%s%s>", aptosCoinInfoPrefix, coinType), version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get coin info: %w", err)
+	}
+
+	coinDecimals := gjson.GetBytes(coinInfo, "data.decimals").Uint()
+	if coinDecimals > math.MaxUint8 {
+		return 0, fmt.Errorf("decimals %d are larger than MaxUint8", coinDecimals)
+	}
+
+	return uint8(coinDecimals), nil
+}
+
+// addAptosChange accumulates a balance or supply change for a coin type.
+func addAptosChange(changes map[string]*big.Int, coinType string, delta *big.Int) {
+	if existing, exists := changes[coinType]; exists {
+		changes[coinType] = new(big.Int).Add(existing, delta)
+	} else {
+		changes[coinType] = delta
+	}
+}
+
+func (a *AptosTransferVerifier) ProcessVersion(ctx context.Context, version uint64, msgIdStr string, logger *zap.Logger) (bool, error) {
+	verified, err := a.processVersionInternal(ctx, version, msgIdStr, logger)
+
+	// check if the error is an invariant violation
+	var invariantError *InvariantError
+	if errors.As(err, &invariantError) {
+		logger.Error("Aptos txverifier invariant violated", zap.Uint64("version", version), zap.String("invariant", invariantError.Msg))
+		return false, nil
+	} else {
+		return verified, err
+	}
+}
+
+// Return conditions:
+//
+//	true, nil - verification succeeded
+//	false, nil - verification failed
+//	false, err - verification failed due to an internal error or invariant violation
+//
+// NOTE: it is up to the caller to check if the error is an invariant violation, and handle it accordingly.
+func (a *AptosTransferVerifier) processVersionInternal(ctx context.Context, version uint64, msgIdStr string, logger *zap.Logger) (bool, error) {
+	logger.Debug("processing transaction", zap.Uint64("version", version), zap.String("msgId", msgIdStr))
+
+	body, err := a.client.GetTransactionByVersion(ctx, version)
+	if err != nil {
+		logger.Error("failed to retrieve transaction",
+			zap.Uint64("version", version),
+			zap.Error(err),
+		)
+		return false, ErrFailedToRetrieveAptosTx
+	}
+
+	if !gjson.ValidBytes(body) {
+		return false, ErrInvalidAptosTx
+	}
+
+	txn := gjson.ParseBytes(body)
+
+	// Extract bridge requests from events
+	bridgeOutRequests := a.extractBridgeRequestsFromEvents(txn.Get("events").Array(), logger)
+
+	if len(bridgeOutRequests) == 0 {
+		logger.Debug("No relevant events found in transaction", zap.Uint64("version", version))
+		// No valid events were identified, so the transaction does not require further processing.
+		return true, nil
+	}
+
+	// Process all resource changes, specifically looking for transfers into the token bridge
+	transfersIntoBridge := a.extractTransfersIntoBridgeFromChanges(ctx, version, txn.Get("changes").Array(), logger)
+
+	// Validate solvency using the requests out of the bridge vs the transfers into the bridge.
+	resolved, err := validateSolvency(bridgeOutRequests, transfersIntoBridge)
+
+	if err != nil {
+		logger.Error("Error validating solvency", zap.Error(err))
+		return false, err
+	}
+
+	return checkResolvedRequests(resolved, msgIdStr, logger)
+}
@@ -0,0 +1,331 @@
+package txverifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Accounts referenced by the transactions in testdata/aptos, which use the format of the REST API's
+// /v1/transactions/by_version endpoint. The core and token bridge accounts are the mainnet ones.
+const (
+	aptosTestCoreBridge     = "0x5bc11445584a763c1fa7ed39081f1b920954da14e04b32440cba863d03e19625"
+	aptosTestTokenBridge    = "0x576410486a2da45eee6c949c995670112ddf2fbeedab20350d506328eefc9d4f"
+	aptosTestWrappedAccount = "0xa2eda21a58856fda86451436513b867c97eecb4ba099da5775520e0f7492e852"
+	aptosTestFungibleStore  = "0x7c3f5d1e9a2b4c6d8e0f1a3b5c7d9e1f2a4b6c8d0e2f4a6b8c0d2e4f6a8b0c2d"
+	aptosTestAptosCoin      = "0x1::aptos_coin::AptosCoin"
+)
+
+// mockAptosClient is an AptosClient that serves transactions loaded from testdata/aptos and a set of resources keyed by
+// (address, type, ledger version).
+type mockAptosClient struct {
+	transactions map[uint64][]byte
+	resources    map[string][]byte
+	// If set, returned by every resource lookup.
+	resourceErr error
+}
+
+func newMockAptosClient() *mockAptosClient {
+	return &mockAptosClient{
+		transactions: make(map[uint64][]byte),
+		resources:    make(map[string][]byte),
+	}
+}
+
+func aptosResourceKey(address string, resourceType string, version uint64) string {
+	return fmt.Sprintf("%s/%s@%d", canonicalAptosAddress(address), canonicalAptosType(resourceType), version)
+}
+
+func (m *mockAptosClient) GetTransactionByVersion(ctx context.Context, version uint64) ([]byte, error) {
+	tx, ok := m.transactions[version]
+	if !ok {
+		return nil, fmt.Errorf("transaction %d not found", version)
+	}
+	return tx, nil
+}
+
+func (m *mockAptosClient) GetAccountResource(ctx context.Context, address string, resourceType string, ledgerVersion uint64) ([]byte, error) {
+	if m.resourceErr != nil {
+		return nil, m.resourceErr
+	}
+	resource, ok := m.resources[aptosResourceKey(address, resourceType, ledgerVersion)]
+	if !ok {
+		return nil, ErrAptosResourceNotFound
+	}
+	return resource, nil
+}
+
+func (m *mockAptosClient) loadTransaction(t *testing.T, version uint64, name string) {
+	t.Helper()
+	tx, err := os.ReadFile(filepath.Join("testdata", "aptos", name))
+	require.NoError(t, err)
+	m.transactions[version] = tx
+}
+
+func (m *mockAptosClient) setResource(address string, resourceType string, version uint64, data string) {
+	m.resources[aptosResourceKey(address, resourceType, version)] = []byte(fmt.Sprintf(`{"type":%q,"data":%s}`, resourceType, data))
+}
+
+// Ledger versions of the recorded transactions.
+const (
+	aptosNativeCoinTransferVersion    = 2157924018
+	aptosWrappedCoinTransferVersion   = 2157930551
+	aptosFungibleAssetTransferVersion = 2158001377
+	aptosAttestationVersion           = 2158004420
+)
+
+// newTestAptosClient returns a client serving all recorded transactions, together with the resources needed to
+// verify them: the state of the token bridge's custody and of the wrapped coin's supply before each transaction, the
+// origin of the wrapped coin, and the coin paired with the APT fungible asset.
+func newTestAptosClient(t *testing.T) *mockAptosClient {
+	client := newMockAptosClient()
+	client.loadTransaction(t, aptosNativeCoinTransferVersion, "native_coin_transfer.json")
+	client.loadTransaction(t, aptosWrappedCoinTransferVersion, "wrapped_coin_transfer.json")
+	client.loadTransaction(t, aptosFungibleAssetTransferVersion, "fungible_asset_transfer.json")
+	client.loadTransaction(t, aptosAttestationVersion, "attestation.json")
+
+	// Native coin transfer: the token bridge held 312 APT before the transfer of 0.5 APT.
+	client.setResource(aptosTestTokenBridge, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", aptosNativeCoinTransferVersion-1,
+		`{"coin":{"value":"31155000000"},"frozen":false}`)
+	client.setResource("0x1", "0x1::coin::CoinInfo<0x1::aptos_coin::AptosCoin>", aptosNativeCoinTransferVersion,
+		`{"decimals":8,"name":"Aptos Coin","symbol":"APT","supply":{"vec":[]}}`)
+
+	// Wrapped coin transfer: 0.025 WETH were burned.
+	client.setResource(aptosTestWrappedAccount, "0x1::coin::CoinInfo<"+aptosTestWrappedAccount+"::coin::T>", aptosWrappedCoinTransferVersion-1,
+		`{"decimals":8,"supply":{"vec":[{"aggregator":{"vec":[]},"integer":{"vec":[{"limit":"340282366920938463463374607431768211455","value":"1535309012"}]}}]}}`)
+	client.setResource(aptosTestWrappedAccount, aptosTestTokenBridge+"::state::OriginInfo", aptosWrappedCoinTransferVersion,
+		`{"token_address":{"external_address":"0x000000000000000000000000c02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"},"token_chain":{"number":"2"}}`)
+
+	// Fungible asset transfer: the token bridge's primary store held 312.05 APT before the transfer of 0.5 APT.
+	client.setResource(aptosTestFungibleStore, "0x1::fungible_asset::FungibleStore", aptosFungibleAssetTransferVersion-1,
+		`{"balance":"31205000000","frozen":false,"metadata":{"inner":"0xa"}}`)
+	client.setResource("0xa", "0x1::coin::PairedCoinType", aptosFungibleAssetTransferVersion,
+		`{"type":{"account_address":"0x1","module_name":"0x6170746f735f636f696e","struct_name":"0x4170746f73436f696e"}}`)
+	client.setResource("0x1", "0x1::coin::CoinInfo<0x1::aptos_coin::AptosCoin>", aptosFungibleAssetTransferVersion,
+		`{"decimals":8,"name":"Aptos Coin","symbol":"APT","supply":{"vec":[]}}`)
+
+	return client
+}
+
+func newTestAptosTransferVerifier(t *testing.T, client AptosClient) *AptosTransferVerifier {
+	emitter, err := vaa.BytesToAddress([]byte{0x01})
+	require.NoError(t, err)
+
+	verifier, err := NewAptosTransferVerifier(aptosTestCoreBridge, aptosTestTokenBridge, emitter, client)
+	require.NoError(t, err)
+	return verifier
+}
+
+func TestAptosProcessVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  uint64
+		msgID    string
+		modify   func(*mockAptosClient)
+		expected bool
+		wantErr  error
+	}{
+		{
+			name:     "native coin deposited into the coin store",
+			version:  aptosNativeCoinTransferVersion,
+			expected: true,
+		},
+		{
+			name:     "native coin deposited into the coin store, matching message ID",
+			version:  aptosNativeCoinTransferVersion,
+			msgID:    "22/0000000000000000000000000000000000000000000000000000000000000001/184212",
+			expected: true,
+		},
+		{
+			name:    "native coin deposit smaller than the requested amount",
+			version: aptosNativeCoinTransferVersion,
+			modify: func(m *mockAptosClient) {
+				m.setResource(aptosTestTokenBridge, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", aptosNativeCoinTransferVersion-1,
+					`{"coin":{"value":"31204999999"},"frozen":false}`)
+			},
+			expected: false,
+		},
+		{
+			name:    "native coin not deposited",
+			version: aptosNativeCoinTransferVersion,
+			modify: func(m *mockAptosClient) {
+				m.setResource(aptosTestTokenBridge, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", aptosNativeCoinTransferVersion-1,
+					`{"coin":{"value":"31205000000"},"frozen":false}`)
+			},
+			expected: false,
+		},
+		{
+			name:    "native coin store registered by the transfer",
+			version: aptosNativeCoinTransferVersion,
+			modify: func(m *mockAptosClient) {
+				delete(m.resources, aptosResourceKey(aptosTestTokenBridge, "0x1::coin::CoinStore<0x1::aptos_coin::AptosCoin>", aptosNativeCoinTransferVersion-1))
+			},
+			// The whole balance of the new store counts as a deposit.
+			expected: true,
+		},
+		{
+			name:     "wrapped coin burned",
+			version:  aptosWrappedCoinTransferVersion,
+			expected: true,
+		},
+		{
+			name:    "wrapped coin burn smaller than the requested amount",
+			version: aptosWrappedCoinTransferVersion,
+			modify: func(m *mockAptosClient) {
+				m.setResource(aptosTestWrappedAccount, "0x1::coin::CoinInfo<"+aptosTestWrappedAccount+"::coin::T>", aptosWrappedCoinTransferVersion-1,
+					`{"decimals":8,"supply":{"vec":[{"aggregator":{"vec":[]},"integer":{"vec":[{"limit":"340282366920938463463374607431768211455","value":"1532809013"}]}}]}}`)
+			},
+			expected: false,
+		},
+		{
+			name:    "coin without origin info is not a wrapped asset",
+			version: aptosWrappedCoinTransferVersion,
+			modify: func(m *mockAptosClient) {
+				delete(m.resources, aptosResourceKey(aptosTestWrappedAccount, aptosTestTokenBridge+"::state::OriginInfo", aptosWrappedCoinTransferVersion))
+			},
+			expected: false,
+		},
+		{
+			name:    "wrapped coin with a different origin",
+			version: aptosWrappedCoinTransferVersion,
+			modify: func(m *mockAptosClient) {
+				m.setResource(aptosTestWrappedAccount, aptosTestTokenBridge+"::state::OriginInfo", aptosWrappedCoinTransferVersion,
+					`{"token_address":{"external_address":"0x000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"},"token_chain":{"number":"2"}}`)
+			},
+			expected: false,
+		},
+		{
+			name:     "native coin deposited into a fungible store",
+			version:  aptosFungibleAssetTransferVersion,
+			expected: true,
+		},
+		{
+			name:    "fungible asset not paired with the transferred coin",
+			version: aptosFungibleAssetTransferVersion,
+			modify: func(m *mockAptosClient) {
+				m.setResource("0xa", "0x1::coin::PairedCoinType", aptosFungibleAssetTransferVersion,
+					`{"type":{"account_address":"0x1","module_name":"0x6f74686572","struct_name":"0x436f696e"}}`)
+			},
+			expected: false,
+		},
+		{
+			name:     "attestation",
+			version:  aptosAttestationVersion,
+			expected: true,
+		},
+		{
+			name:    "previous resource versions cannot be fetched",
+			version: aptosNativeCoinTransferVersion,
+			modify: func(m *mockAptosClient) {
+				m.resourceErr = errors.New("rpc unavailable")
+			},
+			expected: false,
+		},
+		{
+			name:     "transaction cannot be fetched",
+			version:  1,
+			expected: false,
+			wantErr:  ErrFailedToRetrieveAptosTx,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newTestAptosClient(t)
+			if tc.modify != nil {
+				tc.modify(client)
+			}
+			verifier := newTestAptosTransferVerifier(t, client)
+
+			valid, err := verifier.ProcessVersion(context.Background(), tc.version, tc.msgID, zap.NewNop())
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, valid)
+		})
+	}
+}
+
+func TestAptosExtractBridgeRequests(t *testing.T) {
+	client := newTestAptosClient(t)
+	verifier := newTestAptosTransferVerifier(t, client)
+
+	tx, err := client.GetTransactionByVersion(context.Background(), aptosNativeCoinTransferVersion)
+	require.NoError(t, err)
+
+	events := gjson.GetBytes(tx, "events").Array()
+	requests := verifier.extractBridgeRequestsFromEvents(events, zap.NewNop())
+	require.Len(t, requests, 1)
+
+	request := requests["22/0000000000000000000000000000000000000000000000000000000000000001/184212"]
+	require.NotNil(t, request)
+	assert.Equal(t, fmt.Sprintf(KEY_FORMAT, aptosTokenHash(aptosTestAptosCoin).String(), vaa.ChainIDAptos), request.AssetKey)
+	assert.Equal(t, "50000000", request.Amount.String())
+
+	// Messages from other emitters are ignored.
+	otherEmitter, err := vaa.BytesToAddress([]byte{0x02})
+	require.NoError(t, err)
+	verifier.aptosTokenBridgeEmitter = otherEmitter
+	assert.Empty(t, verifier.extractBridgeRequestsFromEvents(events, zap.NewNop()))
+
+	// So are messages from a different core bridge.
+	verifier = newTestAptosTransferVerifier(t, client)
+	verifier.aptosCoreBridge = vaa.Address{0x01}
+	assert.Empty(t, verifier.extractBridgeRequestsFromEvents(events, zap.NewNop()))
+}
+
+func TestAptosTokenHash(t *testing.T) {
+	// Test vectors from aptos/token_bridge/sources/token_hash.move
+	tests := []struct {
+		coinType string
+		expected string
+	}{
+		{
+			coinType: "0x84a5f374d29fc77e370014dce4fd6a55b58ad608de8074b0be5571701724da31::token_hash_test::MyCoin",
+			expected: "4f69c5d0be57aee780277b1179e4833d61f0563869145e971d24a4e49fcd9302",
+		},
+		{
+			coinType: "0xf4f53cc591e5190eddbc43940746e2b5deea6e0e1562b2bba765d488504842c7::coin::T",
+			expected: "f0dcbf26a2d59b2196630ed6d5fb5c5bc4fd33996c9f31f19d29389d0c8e7ec2",
+		},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, aptosTokenHash(tc.coinType).String())
+	}
+}
+
+func TestCanonicalAptosType(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"0x1::aptos_coin::AptosCoin", "0x1::aptos_coin::AptosCoin"},
+		{"0x0000000000000000000000000000000000000000000000000000000000000001::aptos_coin::AptosCoin", "0x1::aptos_coin::AptosCoin"},
+		{"0x1::coin::CoinStore<0x0A::Foo::Bar>", "0x1::coin::CoinStore<0xa::Foo::Bar>"},
+		{"0x1::pair::Pair<0x1::a::A, 0x2::b::B>", "0x1::pair::Pair<0x1::a::A,0x2::b::B>"},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.expected, canonicalAptosType(tc.input))
+	}
+}
+
+func TestAptosTokenBridgeAddresses(t *testing.T) {
+	for _, env := range []common.Environment{common.MainNet, common.TestNet, common.UnsafeDevNet, common.GoTest, common.AccountantMock} {
+		_, err := parseAptosAddress(AptosTokenBridgeAddresses[env])
+		assert.NoError(t, err, env)
+	}
+}
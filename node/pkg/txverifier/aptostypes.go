@@ -0,0 +1,201 @@
+package txverifier
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"golang.org/x/crypto/sha3"
+)
+
+// Resource and event types read by the Aptos transfer verifier. All framework types live at 0x1, which is always
+// rendered in its short form once a type string has been passed through canonicalAptosType.
+const (
+	aptosCoinStorePrefix      = "0x1::coin::CoinStore<"
+	aptosCoinInfoPrefix       = "0x1::coin::CoinInfo<"
+	aptosPairedCoinType       = "0x1::coin::PairedCoinType"
+	aptosFungibleStore        = "0x1::fungible_asset::FungibleStore"
+	aptosFungibleSupply       = "0x1::fungible_asset::Supply"
+	aptosFungibleConcSupply   = "0x1::fungible_asset::ConcurrentSupply"
+	aptosObjectCore           = "0x1::object::ObjectCore"
+	aptosWriteResourceChange  = "write_resource"
+	aptosWormholeMessageEvent = "state::WormholeMessage"
+	aptosOriginInfoResource   = "state::OriginInfo"
+)
+
+var (
+	// ErrAptosResourceNotFound is returned by an AptosClient when the requested resource does not exist at the
+	// requested ledger version.
+	ErrAptosResourceNotFound = errors.New("aptos resource not found")
+
+	// aptosAddressRegex matches every account address embedded in a Move type string.
+	aptosAddressRegex = regexp.MustCompile(`0x[0-9a-fA-F]+`)
+)
+
+// AptosClient is the subset of the Aptos REST API used by the transfer verifier. Responses are returned as raw JSON.
+type AptosClient interface {
+	// GetTransactionByVersion returns the transaction committed at the given ledger version.
+	GetTransactionByVersion(ctx context.Context, version uint64) ([]byte, error)
+	// GetAccountResource returns the resource of the given type stored under `address`, as it was at the given
+	// ledger version. It returns ErrAptosResourceNotFound if the resource did not exist at that version.
+	GetAccountResource(ctx context.Context, address string, resourceType string, ledgerVersion uint64) ([]byte, error)
+}
+
+// aptosRestClient implements AptosClient on top of an Aptos fullnode REST endpoint.
+type aptosRestClient struct {
+	url    string
+	client *http.Client
+}
+
+// NewAptosRestClient returns an AptosClient that queries the REST API served at `rpc` (without the /v1 suffix).
+func NewAptosRestClient(rpc string) AptosClient {
+	return &aptosRestClient{
+		url:    strings.TrimSuffix(rpc, "/"),
+		client: &http.Client{},
+	}
+}
+
+func (c *aptosRestClient) GetTransactionByVersion(ctx context.Context, version uint64) ([]byte, error) {
+	return c.get(ctx, fmt.Sprintf("%s/v1/transactions/by_version/%d", c.url, version))
+}
+
+func (c *aptosRestClient) GetAccountResource(ctx context.Context, address string, resourceType string, ledgerVersion uint64) ([]byte, error) {
+	return c.get(ctx, fmt.Sprintf("%s/v1/accounts/%s/resource/%s?ledger_version=%d", c.url, address, url.PathEscape(resourceType), ledgerVersion))
+}
+
+func (c *aptosRestClient) get(ctx context.Context, endpoint string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := common.SafeRead(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return nil, ErrAptosResourceNotFound
+	default:
+		return nil, fmt.Errorf("unexpected status code %d from %s: %s", res.StatusCode, endpoint, string(body))
+	}
+}
+
+// parseAptosAddress decodes a hex Aptos address, with or without the "0x" prefix and with or without leading zeros,
+// into its 32-byte form.
+func parseAptosAddress(addr string) (vaa.Address, error) {
+	var out vaa.Address
+	trimmed := strings.TrimPrefix(strings.ToLower(addr), "0x")
+	if len(trimmed) == 0 || len(trimmed) > 64 {
+		return out, fmt.Errorf("invalid Aptos address %q", addr)
+	}
+	if len(trimmed)%2 == 1 {
+		trimmed = "0" + trimmed
+	}
+	decoded, err := hex.DecodeString(trimmed)
+	if err != nil {
+		return out, fmt.Errorf("invalid Aptos address %q: %w", addr, err)
+	}
+	copy(out[32-len(decoded):], decoded)
+	return out, nil
+}
+
+// canonicalAptosAddress renders an address the way Move's `type_info::type_name` does: lowercase, "0x"-prefixed and
+// without leading zeros.
+func canonicalAptosAddress(addr string) string {
+	trimmed := strings.TrimLeft(strings.TrimPrefix(strings.ToLower(addr), "0x"), "0")
+	if trimmed == "" {
+		trimmed = "0"
+	}
+	return "0x" + trimmed
+}
+
+// canonicalAptosType rewrites every address in a Move type string into its canonical form. Depending on the API
+// version, the REST API renders addresses either in their short or their long form, whereas the token bridge derives
+// the origin address of native assets from the short form.
+func canonicalAptosType(t string) string {
+	return aptosAddressRegex.ReplaceAllStringFunc(strings.ReplaceAll(t, " ", ""), canonicalAptosAddress)
+}
+
+// aptosGenericParam returns the type parameter of a resource type of the form `<prefix><T>`.
+func aptosGenericParam(resourceType string, prefix string) (string, bool) {
+	if !strings.HasPrefix(resourceType, prefix) || !strings.HasSuffix(resourceType, ">") {
+		return "", false
+	}
+	return resourceType[len(prefix) : len(resourceType)-1], true
+}
+
+// aptosTypeAddress returns the address of the account that defines the outermost struct of a Move type.
+func aptosTypeAddress(coinType string) string {
+	addr, _, _ := strings.Cut(coinType, "::")
+	return addr
+}
+
+// aptosTokenHash returns the token bridge's origin address for a coin native to Aptos, which is the SHA3-256 hash of
+// its fully qualified type name. This mirrors `token_bridge::token_hash::derive`.
+func aptosTokenHash(coinType string) vaa.Address {
+	return vaa.Address(sha3.Sum256([]byte(coinType)))
+}
+
+// aptosCoinSupply extracts the total supply tracked by a `0x1::coin::CoinInfo` resource. Only coins whose supply is
+// stored as a plain integer are supported, which is the case for all coins created by the token bridge.
+func aptosCoinSupply(coinInfo gjson.Result) (*big.Int, error) {
+	value := coinInfo.Get("supply.vec.0.integer.vec.0.value")
+	if !value.Exists() {
+		return nil, errors.New("coin supply is not tracked as an integer")
+	}
+	return aptosParseU128(value)
+}
+
+// aptosFungibleSupplyValue extracts the current supply of a fungible asset from either a `Supply` or a
+// `ConcurrentSupply` resource.
+func aptosFungibleSupplyValue(resourceType string, supply gjson.Result) (*big.Int, error) {
+	if resourceType == aptosFungibleConcSupply {
+		return aptosParseU128(supply.Get("current.value"))
+	}
+	return aptosParseU128(supply.Get("current"))
+}
+
+// aptosPairedCoin decodes a `0x1::coin::PairedCoinType` resource into the coin type it refers to. The module and
+// struct names are stored as hex-encoded bytes.
+func aptosPairedCoin(paired gjson.Result) (string, error) {
+	module, err := hex.DecodeString(strings.TrimPrefix(paired.Get("type.module_name").String(), "0x"))
+	if err != nil {
+		return "", fmt.Errorf("invalid module name: %w", err)
+	}
+	structName, err := hex.DecodeString(strings.TrimPrefix(paired.Get("type.struct_name").String(), "0x"))
+	if err != nil {
+		return "", fmt.Errorf("invalid struct name: %w", err)
+	}
+	return canonicalAptosType(fmt.Sprintf("%s::%s::%s", paired.Get("type.account_address").String(), module, structName)), nil
+}
+
+// aptosParseU128 parses a Move integer, which the REST API encodes as a decimal string.
+func aptosParseU128(value gjson.Result) (*big.Int, error) {
+	if !value.Exists() {
+		return nil, errors.New("value is missing")
+	}
+	parsed, ok := new(big.Int).SetString(value.String(), 10)
+	if !ok || parsed.Sign() < 0 {
+		return nil, fmt.Errorf("invalid integer %q", value.String())
+	}
+	return parsed, nil
+}
@@ -0,0 +1,415 @@
+package txverifier
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// Errors
+var (
+	ErrFailedToRetrieveSolanaTx = errors.New("failed to retrieve transaction")
+	ErrSolanaTxMissingMeta      = errors.New("transaction has no meta")
+	ErrSolanaTxFailed           = errors.New("transaction failed")
+	ErrSolanaNoMatchingRequest  = errors.New("no token bridge transfer found for message account")
+)
+
+// Global variables
+var (
+	// The Solana transfer verifier needs the token bridge program ID to derive the custody signer and the
+	// wrapped mints, and to recognize the token bridge transfer instructions.
+	SolanaTokenBridgeProgramIds = map[common.Environment]string{
+		common.MainNet: "wormDTUJ6AWPNvk59vGQbDvGJmqbDTdgWgAqcLBCgUb",
+		common.TestNet: "DZnkkTmCiFWfYTfT41X3Rd1kDgozqzxWaHqsw6W4x2oe",
+		// Deployed by tilt, see solana/devnet_setup.sh
+		common.UnsafeDevNet:   "B6RHG3mfcckmrYN1UhmJzyS1XX3fZKbkeUcpJe9Sy3FE",
+		common.GoTest:         "B6RHG3mfcckmrYN1UhmJzyS1XX3fZKbkeUcpJe9Sy3FE",
+		common.AccountantMock: "B6RHG3mfcckmrYN1UhmJzyS1XX3fZKbkeUcpJe9Sy3FE",
+	}
+)
+
+type SolanaTransferVerifier struct {
+	// Used to recognize PostMessage instructions.
+	coreBridge solana.PublicKey
+	// Used to recognize transfer instructions and to derive wrapped mints.
+	tokenBridge solana.PublicKey
+	// The token bridge's "emitter" PDA, which signs every message posted by the token bridge.
+	tokenBridgeEmitter solana.PublicKey
+	// The token bridge's "custody_signer" PDA, which owns the custody accounts of native assets.
+	custodySigner solana.PublicKey
+	// RPC client used to fetch transactions.
+	client SolanaClient
+}
+
+func NewSolanaTransferVerifier(coreBridge, tokenBridge solana.PublicKey, client SolanaClient) (*SolanaTransferVerifier, error) {
+	emitter, _, err := solana.FindProgramAddress([][]byte{[]byte("emitter")}, tokenBridge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive token bridge emitter: %w", err)
+	}
+
+	custodySigner, _, err := solana.FindProgramAddress([][]byte{[]byte("custody_signer")}, tokenBridge)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive token bridge custody signer: %w", err)
+	}
+
+	return &SolanaTransferVerifier{
+		coreBridge:         coreBridge,
+		tokenBridge:        tokenBridge,
+		tokenBridgeEmitter: emitter,
+		custodySigner:      custodySigner,
+		client:             client,
+	}, nil
+}
+
+func (s *SolanaTransferVerifier) GetTokenBridgeEmitter() solana.PublicKey {
+	return s.tokenBridgeEmitter
+}
+
+// wrappedMint derives the address of the token bridge's wrapped mint for a foreign asset.
+func (s *SolanaTransferVerifier) wrappedMint(originChain vaa.ChainID, originAddress vaa.Address) (solana.PublicKey, error) {
+	chain := make([]byte, 2)
+	binary.BigEndian.PutUint16(chain, uint16(originChain))
+	mint, _, err := solana.FindProgramAddress([][]byte{[]byte("wrapped"), chain, originAddress[:]}, s.tokenBridge)
+	return mint, err
+}
+
+// ProcessSignature fetches the transaction identified by `signature` and verifies the token bridge transfers in it.
+// If `messageAccount` is the zero key, every token bridge transfer in the transaction is checked. Otherwise only
+// the transfer whose message was posted to `messageAccount` is checked.
+//
+// Return values:
+//
+//	Valid, nil: the transfer is backed by a deposit into the token bridge and matches its instruction.
+//	Rejected, nil: the transfer violates an invariant, i.e. the tokens were never deposited or the deposit was insufficient.
+//	Anomalous, nil: the message payload does not match the token bridge instruction that posted it.
+//	CouldNotVerify, err: the transaction could not be fetched or processed.
+func (s *SolanaTransferVerifier) ProcessSignature(
+	ctx context.Context,
+	signature solana.Signature,
+	messageAccount solana.PublicKey,
+	logger *zap.Logger,
+) (common.VerificationState, error) {
+	logger.Debug("processing signature", zap.Stringer("signature", signature), zap.Stringer("messageAccount", messageAccount))
+
+	version := uint64(0)
+	result, err := s.client.GetTransaction(ctx, signature, &rpc.GetTransactionOpts{
+		MaxSupportedTransactionVersion: &version,
+		Commitment:                     rpc.CommitmentConfirmed,
+		Encoding:                       solana.EncodingBase64,
+	})
+	if err != nil {
+		logger.Error("failed to retrieve transaction", zap.Stringer("signature", signature), zap.Error(err))
+		return common.CouldNotVerify, ErrFailedToRetrieveSolanaTx
+	}
+
+	if result == nil || result.Transaction == nil {
+		return common.CouldNotVerify, ErrFailedToRetrieveSolanaTx
+	}
+
+	tx, err := result.Transaction.GetTransaction()
+	if err != nil {
+		return common.CouldNotVerify, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+
+	return s.ProcessTransaction(tx, result.Meta, messageAccount, logger)
+}
+
+// ProcessTransaction verifies the token bridge transfers in an already fetched transaction. See ProcessSignature.
+func (s *SolanaTransferVerifier) ProcessTransaction(
+	tx *solana.Transaction,
+	meta *rpc.TransactionMeta,
+	messageAccount solana.PublicKey,
+	logger *zap.Logger,
+) (common.VerificationState, error) {
+	if tx == nil || len(tx.Signatures) == 0 {
+		return common.CouldNotVerify, errors.New("transaction is nil or unsigned")
+	}
+	signature := tx.Signatures[0]
+
+	if meta == nil {
+		return common.CouldNotVerify, ErrSolanaTxMissingMeta
+	}
+
+	// A failed transaction cannot have posted a message, so there is nothing to verify it against.
+	if meta.Err != nil {
+		return common.CouldNotVerify, ErrSolanaTxFailed
+	}
+
+	instructions, err := flattenSolanaInstructions(tx, meta)
+	if err != nil {
+		return common.CouldNotVerify, err
+	}
+
+	balanceChanges, err := solanaTokenBalanceChanges(meta)
+	if err != nil {
+		return common.CouldNotVerify, err
+	}
+
+	requests, anomalies, wrappedMints, err := s.extractBridgeRequests(instructions, balanceChanges, logger)
+	if err != nil {
+		return common.CouldNotVerify, err
+	}
+
+	if len(requests) == 0 {
+		logger.Debug("no token bridge transfers found in transaction", zap.Stringer("signature", signature))
+		// Nothing in the transaction requires verification.
+		if messageAccount.IsZero() {
+			return common.Valid, nil
+		}
+		return common.CouldNotVerify, ErrSolanaNoMatchingRequest
+	}
+
+	transfers := s.extractTransfersIntoBridge(instructions, balanceChanges, wrappedMints, logger)
+
+	resolved, err := validateSolvency(requests, transfers)
+	if err != nil {
+		logger.Error("error validating solvency", zap.Error(err))
+		return common.CouldNotVerify, err
+	}
+
+	// Narrow the evaluation down to a single message if one was requested.
+	if !messageAccount.IsZero() {
+		key := messageAccount.String()
+		request, exists := resolved[key]
+		if !exists || request == nil {
+			return common.CouldNotVerify, fmt.Errorf("%w %s", ErrSolanaNoMatchingRequest, key)
+		}
+		resolved = MsgIdToRequestOutOfBridge{key: request}
+	}
+
+	// An invariant violation takes precedence over an anomaly.
+	state := common.Valid
+	for key, request := range resolved {
+		if !request.DepositMade || !request.DepositSolvent {
+			invariant := INVARIANT_INSUFFICIENT_DEPOSIT
+			if !request.DepositMade {
+				invariant = INVARIANT_NO_DEPOSIT
+			}
+			logger.Error("Solana txverifier invariant violated",
+				zap.Stringer("signature", signature),
+				zap.String("messageAccount", key),
+				zap.String("assetKey", request.AssetKey),
+				zap.String("amount", request.Amount.String()),
+				zap.String("invariant", invariant))
+			state = common.Rejected
+			continue
+		}
+
+		if reason, anomalous := anomalies[key]; anomalous {
+			logger.Warn("Solana txverifier found an anomalous transfer",
+				zap.Stringer("signature", signature),
+				zap.String("messageAccount", key),
+				zap.String("reason", reason))
+			if state != common.Rejected {
+				state = common.Anomalous
+			}
+			continue
+		}
+
+		logger.Debug("request for message account is valid", zap.String("messageAccount", key))
+	}
+
+	return state, nil
+}
+
+// extractBridgeRequests walks the instructions in execution order and collects the transfer messages posted by the
+// token bridge. Every token bridge transfer instruction posts exactly one message, so each message is paired with the
+// most recent transfer instruction and cross-checked against it. Requests are keyed by message account.
+//
+// It also returns the reasons for any mismatches between messages and instructions, and the wrapped mints of the
+// foreign assets that are being transferred, mapped to their asset keys.
+func (s *SolanaTransferVerifier) extractBridgeRequests(
+	instructions []solanaInstruction,
+	balanceChanges []solanaTokenBalanceChange,
+	logger *zap.Logger,
+) (MsgIdToRequestOutOfBridge, map[string]string, map[solana.PublicKey]string, error) {
+	requests := make(MsgIdToRequestOutOfBridge)
+	anomalies := make(map[string]string)
+	wrappedMints := make(map[solana.PublicKey]string)
+
+	mintDecimals := make(map[solana.PublicKey]uint8, len(balanceChanges))
+	for _, change := range balanceChanges {
+		mintDecimals[change.mint] = change.decimals
+	}
+
+	var pending *solanaTokenBridgeTransfer
+	for _, inst := range instructions {
+		switch {
+		case inst.programID.Equals(s.tokenBridge) && isSolanaTokenBridgeTransfer(inst.data):
+			transfer, err := parseSolanaTokenBridgeTransfer(inst)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse token bridge transfer: %w", err)
+			}
+			pending = transfer
+
+		case inst.programID.Equals(s.coreBridge) && isSolanaPostMessage(inst.data):
+			post, err := parseSolanaPostMessage(inst)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse PostMessage: %w", err)
+			}
+
+			// Only messages signed by the token bridge emitter are of interest.
+			if !post.emitter.Equals(s.tokenBridgeEmitter) {
+				continue
+			}
+
+			transfer := pending
+			pending = nil
+
+			// Attestations are also posted by the token bridge, but do not move any funds.
+			hdr, err := vaa.DecodeTransferPayloadHdr(post.payload)
+			if err != nil {
+				continue
+			}
+
+			key := post.messageAccount.String()
+			assetKey := fmt.Sprintf(KEY_FORMAT, hdr.OriginAddress.String(), hdr.OriginChain)
+
+			logger.Debug("found request out of bridge",
+				zap.String("messageAccount", key),
+				zap.String("assetKey", assetKey),
+				zap.String("amount", hdr.Amount.String()),
+			)
+
+			requests[key] = &RequestOutOfBridge{
+				AssetKey:       assetKey,
+				Amount:         hdr.Amount,
+				DepositMade:    false,
+				DepositSolvent: false,
+			}
+
+			if hdr.OriginChain != vaa.ChainIDSolana {
+				mint, err := s.wrappedMint(hdr.OriginChain, hdr.OriginAddress)
+				if err != nil {
+					return nil, nil, nil, fmt.Errorf("failed to derive wrapped mint for %s: %w", assetKey, err)
+				}
+				wrappedMints[mint] = assetKey
+			}
+
+			if err := s.checkTransferMatchesPayload(transfer, post, hdr, mintDecimals); err != nil {
+				anomalies[key] = err.Error()
+			}
+		}
+	}
+
+	return requests, anomalies, wrappedMints, nil
+}
+
+// checkTransferMatchesPayload cross-checks a message posted by the token bridge against the transfer
+// instruction that caused it to be posted.
+func (s *SolanaTransferVerifier) checkTransferMatchesPayload(
+	transfer *solanaTokenBridgeTransfer,
+	post *solanaPostMessage,
+	hdr *vaa.TransferPayloadHdr,
+	mintDecimals map[solana.PublicKey]uint8,
+) error {
+	if transfer == nil {
+		return errors.New("transfer message was not preceded by a token bridge transfer instruction")
+	}
+
+	if !transfer.messageAccount.Equals(post.messageAccount) {
+		return fmt.Errorf("message account %s does not match the transfer instruction's %s", post.messageAccount, transfer.messageAccount)
+	}
+
+	if hdr.TargetAddress != transfer.targetAddress {
+		return fmt.Errorf("target address %s does not match the transfer instruction's %s", hdr.TargetAddress, transfer.targetAddress)
+	}
+
+	if hdr.TargetChain != transfer.targetChain {
+		return fmt.Errorf("target chain %d does not match the transfer instruction's %d", hdr.TargetChain, transfer.targetChain)
+	}
+
+	if transfer.wrapped {
+		if hdr.OriginChain == vaa.ChainIDSolana {
+			return errors.New("wrapped transfer instruction posted a message for a native asset")
+		}
+
+		mint, err := s.wrappedMint(hdr.OriginChain, hdr.OriginAddress)
+		if err != nil {
+			return err
+		}
+		if !mint.Equals(transfer.mint) {
+			return fmt.Errorf("wrapped mint %s does not match the transfer instruction's %s", mint, transfer.mint)
+		}
+
+		// Wrapped mints are created with at most 8 decimals, so their amounts are already normalized.
+		if hdr.Amount.Cmp(new(big.Int).SetUint64(transfer.amount)) != 0 {
+			return fmt.Errorf("amount %s does not match the transfer instruction's %d", hdr.Amount, transfer.amount)
+		}
+
+		return nil
+	}
+
+	if hdr.OriginChain != vaa.ChainIDSolana || hdr.OriginAddress != vaa.Address(transfer.mint) {
+		return fmt.Errorf("origin %s does not match the transfer instruction's mint %s", fmt.Sprintf(KEY_FORMAT, hdr.OriginAddress, hdr.OriginChain), transfer.mint)
+	}
+
+	// The decimals are only known if the mint's balances changed in the transaction. If they did not, nothing was
+	// deposited and the request is rejected by the solvency check instead.
+	if decimals, known := mintDecimals[transfer.mint]; known {
+		expected := normalize(new(big.Int).SetUint64(transfer.amount), decimals)
+		if hdr.Amount.Cmp(expected) != 0 {
+			return fmt.Errorf("amount %s does not match the transfer instruction's normalized amount %s", hdr.Amount, expected)
+		}
+	}
+
+	return nil
+}
+
+// extractTransfersIntoBridge collects the funds that moved into the token bridge in the transaction:
+//   - native assets are deposited into custody accounts owned by the custody signer, which shows up in the
+//     token balances of the transaction meta.
+//   - wrapped assets are burned, which shows up as SPL token Burn instructions against the wrapped mints.
+func (s *SolanaTransferVerifier) extractTransfersIntoBridge(
+	instructions []solanaInstruction,
+	balanceChanges []solanaTokenBalanceChange,
+	wrappedMints map[solana.PublicKey]string,
+	logger *zap.Logger,
+) AssetKeyToTransferIntoBridge {
+	transfers := make(AssetKeyToTransferIntoBridge)
+
+	add := func(assetKey string, amount *big.Int) {
+		if _, exists := transfers[assetKey]; !exists {
+			transfers[assetKey] = &TransferIntoBridge{
+				Amount:  big.NewInt(0),
+				Solvent: false,
+			}
+		}
+
+		logger.Debug("adding transfer into bridge", zap.String("assetKey", assetKey), zap.String("amount", amount.String()))
+		transfers[assetKey].Amount = new(big.Int).Add(transfers[assetKey].Amount, amount)
+	}
+
+	for _, change := range balanceChanges {
+		if !change.owner.Equals(s.custodySigner) {
+			continue
+		}
+
+		assetKey := fmt.Sprintf(KEY_FORMAT, vaa.Address(change.mint).String(), vaa.ChainIDSolana)
+		add(assetKey, normalize(change.delta, change.decimals))
+	}
+
+	for _, inst := range instructions {
+		mint, amount, isBurn := parseSplTokenBurn(inst)
+		if !isBurn {
+			continue
+		}
+
+		assetKey, isWrapped := wrappedMints[mint]
+		if !isWrapped {
+			continue
+		}
+
+		add(assetKey, new(big.Int).SetUint64(amount))
+	}
+
+	return transfers
+}
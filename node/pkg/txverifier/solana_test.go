@@ -0,0 +1,601 @@
+package txverifier
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	solanaTestCoreBridge  = solana.MustPublicKeyFromBase58("worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth")
+	solanaTestTokenBridge = solana.MustPublicKeyFromBase58(SolanaTokenBridgeProgramIds[common.MainNet])
+
+	// Arbitrary keys used as accounts in the test transactions.
+	solanaTestNativeMint = solana.MustPublicKeyFromBase58("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	solanaTestSender     = solana.MustPublicKeyFromBase58("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+	solanaTestCustody    = solana.MustPublicKeyFromBase58("GbkEzDa2pSPzGnHZtEEJ3cBKhVqHf6oF8BQJZdxBCnRH")
+	solanaTestMessage1   = solana.MustPublicKeyFromBase58("4vJ9JU1bJJE96FWSJKvHsmmFADCg4gpZQff4P3bkLKi")
+	solanaTestMessage2   = solana.MustPublicKeyFromBase58("8opHzTAnfzRpPEx21XtnrVTX28YQuCpAjcn1PczScKh")
+	solanaTestOther      = solana.MustPublicKeyFromBase58("3gUMFTmJ8YBd4dZhBqZW2nrR9WHakxjPmRAvsj7eaVYx")
+)
+
+const (
+	solanaTestTargetChain = vaa.ChainIDEthereum
+	solanaTestEthUsdc     = "000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+)
+
+func newTestSolanaTransferVerifier(t *testing.T, client SolanaClient) *SolanaTransferVerifier {
+	t.Helper()
+	verifier, err := NewSolanaTransferVerifier(solanaTestCoreBridge, solanaTestTokenBridge, client)
+	require.NoError(t, err)
+	return verifier
+}
+
+// solanaTxBuilder assembles a transaction and its meta from a list of top-level instructions, each with the
+// inner instructions that it invoked. Account keys are added to the transaction as they are referenced.
+type solanaTxBuilder struct {
+	tx   *solana.Transaction
+	meta *rpc.TransactionMeta
+}
+
+func newSolanaTxBuilder() *solanaTxBuilder {
+	return &solanaTxBuilder{
+		tx: &solana.Transaction{
+			Signatures: []solana.Signature{{0x01}},
+		},
+		meta: &rpc.TransactionMeta{},
+	}
+}
+
+func (b *solanaTxBuilder) keyIndex(key solana.PublicKey) uint16 {
+	for i, k := range b.tx.Message.AccountKeys {
+		if k.Equals(key) {
+			// #nosec G115 -- Test transactions only have a handful of keys.
+			return uint16(i)
+		}
+	}
+	b.tx.Message.AccountKeys = append(b.tx.Message.AccountKeys, key)
+	// #nosec G115 -- Test transactions only have a handful of keys.
+	return uint16(len(b.tx.Message.AccountKeys) - 1)
+}
+
+func (b *solanaTxBuilder) compile(inst solanaInstruction) solana.CompiledInstruction {
+	accounts := make([]uint16, len(inst.accounts))
+	for i, acc := range inst.accounts {
+		accounts[i] = b.keyIndex(acc)
+	}
+	return solana.CompiledInstruction{
+		ProgramIDIndex: b.keyIndex(inst.programID),
+		Accounts:       accounts,
+		Data:           inst.data,
+	}
+}
+
+// addInstruction adds a top-level instruction and the inner instructions it invoked.
+func (b *solanaTxBuilder) addInstruction(outer solanaInstruction, inner ...solanaInstruction) *solanaTxBuilder {
+	b.tx.Message.Instructions = append(b.tx.Message.Instructions, b.compile(outer))
+	if len(inner) > 0 {
+		compiled := make([]solana.CompiledInstruction, len(inner))
+		for i, inst := range inner {
+			compiled[i] = b.compile(inst)
+		}
+		b.meta.InnerInstructions = append(b.meta.InnerInstructions, rpc.InnerInstruction{
+			// #nosec G115 -- Test transactions only have a handful of instructions.
+			Index:        uint16(len(b.tx.Message.Instructions) - 1),
+			Instructions: compiled,
+		})
+	}
+	return b
+}
+
+// addTokenBalance records the pre- and post-balances of a token account. An empty pre-balance means the account
+// was created by the transaction.
+func (b *solanaTxBuilder) addTokenBalance(account, owner, mint solana.PublicKey, decimals uint8, pre, post string) *solanaTxBuilder {
+	idx := b.keyIndex(account)
+	if pre != "" {
+		b.meta.PreTokenBalances = append(b.meta.PreTokenBalances, rpc.TokenBalance{
+			AccountIndex:  idx,
+			Owner:         &owner,
+			Mint:          mint,
+			UiTokenAmount: &rpc.UiTokenAmount{Amount: pre, Decimals: decimals},
+		})
+	}
+	b.meta.PostTokenBalances = append(b.meta.PostTokenBalances, rpc.TokenBalance{
+		AccountIndex:  idx,
+		Owner:         &owner,
+		Mint:          mint,
+		UiTokenAmount: &rpc.UiTokenAmount{Amount: post, Decimals: decimals},
+	})
+	return b
+}
+
+func solanaTestTarget() vaa.Address {
+	return vaa.Address{0xaa, 0xbb}
+}
+
+// tokenBridgeTransferInstruction builds a token bridge transfer instruction with the account layout expected by the
+// verifier. Only the mint and message accounts are meaningful.
+func tokenBridgeTransferInstruction(discriminator byte, mint, message solana.PublicKey, amount uint64, targetChain vaa.ChainID) solanaInstruction {
+	data := []byte{discriminator}
+	data = binary.LittleEndian.AppendUint32(data, 42)
+	data = binary.LittleEndian.AppendUint64(data, amount)
+	withPayload := discriminator == solanaTransferWrappedWithPayloadID || discriminator == solanaTransferNativeWithPayloadID
+	if !withPayload {
+		data = binary.LittleEndian.AppendUint64(data, 0)
+	}
+	target := solanaTestTarget()
+	data = append(data, target[:]...)
+	data = binary.LittleEndian.AppendUint16(data, uint16(targetChain))
+	if withPayload {
+		data = binary.LittleEndian.AppendUint32(data, 3)
+		data = append(data, 0x01, 0x02, 0x03, 0x00)
+	}
+
+	accounts := make([]solana.PublicKey, 13)
+	for i := range accounts {
+		accounts[i] = solanaTestOther
+	}
+	if discriminator == solanaTransferNativeID || discriminator == solanaTransferNativeWithPayloadID {
+		accounts[solanaTransferNativeMintIdx] = mint
+	} else {
+		accounts[solanaTransferWrappedMintIdx] = mint
+	}
+	accounts[solanaTransferMessageIdx] = message
+
+	return solanaInstruction{programID: solanaTestTokenBridge, accounts: accounts, data: data}
+}
+
+func postMessageInstruction(emitter, message solana.PublicKey, payload []byte) solanaInstruction {
+	data := []byte{solanaPostMessageID}
+	data = binary.LittleEndian.AppendUint32(data, 42)
+	// #nosec G115 -- Test payloads are small.
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(payload)))
+	data = append(data, payload...)
+	data = append(data, 32)
+
+	return solanaInstruction{
+		programID: solanaTestCoreBridge,
+		accounts:  []solana.PublicKey{solanaTestOther, message, emitter, solanaTestOther, solanaTestSender},
+		data:      data,
+	}
+}
+
+func splBurnInstruction(mint solana.PublicKey, amount uint64) solanaInstruction {
+	data := binary.LittleEndian.AppendUint64([]byte{splTokenBurnID}, amount)
+	return solanaInstruction{
+		programID: solana.TokenProgramID,
+		accounts:  []solana.PublicKey{solanaTestOther, mint, solanaTestOther},
+		data:      data,
+	}
+}
+
+func solanaTransferPayload(amount int64, originAddress vaa.Address, originChain vaa.ChainID, targetChain vaa.ChainID) []byte {
+	payload := generatePayload(1, big.NewInt(amount), hex.EncodeToString(originAddress[:]), uint16(originChain))
+	target := solanaTestTarget()
+	copy(payload[67:99], target[:])
+	binary.BigEndian.PutUint16(payload[99:101], uint16(targetChain))
+	return payload
+}
+
+func TestSolanaTokenBridgeProgramIds(t *testing.T) {
+	tests := []struct {
+		env      common.Environment
+		emitters map[vaa.ChainID][]byte
+	}{
+		{common.MainNet, sdk.KnownTokenbridgeEmitters},
+		{common.TestNet, sdk.KnownTestnetTokenbridgeEmitters},
+		{common.UnsafeDevNet, sdk.KnownDevnetTokenbridgeEmitters},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.env), func(t *testing.T) {
+			tokenBridge, err := solana.PublicKeyFromBase58(SolanaTokenBridgeProgramIds[tc.env])
+			require.NoError(t, err)
+			verifier, err := NewSolanaTransferVerifier(solanaTestCoreBridge, tokenBridge, nil)
+			require.NoError(t, err)
+			emitter := verifier.GetTokenBridgeEmitter()
+			assert.Equal(t, tc.emitters[vaa.ChainIDSolana], emitter[:])
+		})
+	}
+}
+
+func TestSolanaProcessTransaction(t *testing.T) {
+	verifier := newTestSolanaTransferVerifier(t, nil)
+	emitter := verifier.GetTokenBridgeEmitter()
+	logger := zap.NewNop()
+
+	nativeAddr := vaa.Address(solanaTestNativeMint)
+	ethUsdc, err := vaa.StringToAddress(solanaTestEthUsdc)
+	require.NoError(t, err)
+	wrappedMint, err := verifier.wrappedMint(vaa.ChainIDEthereum, ethUsdc)
+	require.NoError(t, err)
+
+	// A native transfer of 1.5 tokens with 9 decimals, which is normalized to 8 decimals in the payload.
+	nativeTransfer := func(b *solanaTxBuilder, message solana.PublicKey, instructionAmount uint64, payloadAmount int64, pre, post string) {
+		b.addInstruction(
+			tokenBridgeTransferInstruction(solanaTransferNativeID, solanaTestNativeMint, message, instructionAmount, solanaTestTargetChain),
+			postMessageInstruction(emitter, message, solanaTransferPayload(payloadAmount, nativeAddr, vaa.ChainIDSolana, solanaTestTargetChain)),
+		)
+		b.addTokenBalance(solanaTestCustody, verifier.custodySigner, solanaTestNativeMint, 9, pre, post)
+	}
+
+	tests := []struct {
+		name           string
+		build          func() *solanaTxBuilder
+		messageAccount solana.PublicKey
+		expectedState  common.VerificationState
+		expectedErr    error
+	}{
+		{
+			name: "native transfer",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				nativeTransfer(b, solanaTestMessage1, 1_500_000_000, 150_000_000, "1000", "1500001000")
+				return b
+			},
+			expectedState: common.Valid,
+		},
+		{
+			name: "native transfer into a new custody account",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				nativeTransfer(b, solanaTestMessage1, 1_500_000_000, 150_000_000, "", "1500000000")
+				return b
+			},
+			messageAccount: solanaTestMessage1,
+			expectedState:  common.Valid,
+		},
+		{
+			name: "native transfer with payload",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferNativeWithPayloadID, solanaTestNativeMint, solanaTestMessage1, 1_500_000_000, solanaTestTargetChain),
+					postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(150_000_000, nativeAddr, vaa.ChainIDSolana, solanaTestTargetChain)),
+				)
+				b.addTokenBalance(solanaTestCustody, verifier.custodySigner, solanaTestNativeMint, 9, "0", "1500000000")
+				return b
+			},
+			expectedState: common.Valid,
+		},
+		{
+			name: "native transfer with insufficient deposit",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				nativeTransfer(b, solanaTestMessage1, 1_500_000_000, 150_000_000, "1000", "1000001000")
+				return b
+			},
+			expectedState: common.Rejected,
+		},
+		{
+			name: "native transfer without deposit",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferNativeID, solanaTestNativeMint, solanaTestMessage1, 1_500_000_000, solanaTestTargetChain),
+					postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(150_000_000, nativeAddr, vaa.ChainIDSolana, solanaTestTargetChain)),
+				)
+				// A deposit into an account that is not owned by the custody signer does not count.
+				b.addTokenBalance(solanaTestCustody, solanaTestSender, solanaTestNativeMint, 9, "0", "1500000000")
+				return b
+			},
+			expectedState: common.Rejected,
+		},
+		{
+			name: "payload amount does not match instruction",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				nativeTransfer(b, solanaTestMessage1, 1_500_000_000, 100_000_000, "0", "1500000000")
+				return b
+			},
+			expectedState: common.Anomalous,
+		},
+		{
+			name: "payload target chain does not match instruction",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferNativeID, solanaTestNativeMint, solanaTestMessage1, 1_500_000_000, vaa.ChainIDBSC),
+					postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(150_000_000, nativeAddr, vaa.ChainIDSolana, solanaTestTargetChain)),
+				)
+				b.addTokenBalance(solanaTestCustody, verifier.custodySigner, solanaTestNativeMint, 9, "0", "1500000000")
+				return b
+			},
+			expectedState: common.Anomalous,
+		},
+		{
+			name: "message without transfer instruction",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(150_000_000, nativeAddr, vaa.ChainIDSolana, solanaTestTargetChain)))
+				b.addTokenBalance(solanaTestCustody, verifier.custodySigner, solanaTestNativeMint, 9, "0", "1500000000")
+				return b
+			},
+			expectedState: common.Anomalous,
+		},
+		{
+			name: "wrapped transfer",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferWrappedID, wrappedMint, solanaTestMessage1, 2_000_000, solanaTestTargetChain),
+					splBurnInstruction(wrappedMint, 2_000_000),
+					postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(2_000_000, ethUsdc, vaa.ChainIDEthereum, solanaTestTargetChain)),
+				)
+				return b
+			},
+			expectedState: common.Valid,
+		},
+		{
+			name: "wrapped transfer with insufficient burn",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferWrappedID, wrappedMint, solanaTestMessage1, 2_000_000, solanaTestTargetChain),
+					splBurnInstruction(wrappedMint, 1_000_000),
+					postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(2_000_000, ethUsdc, vaa.ChainIDEthereum, solanaTestTargetChain)),
+				)
+				return b
+			},
+			expectedState: common.Rejected,
+		},
+		{
+			name: "wrapped transfer burning a different mint",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferWrappedID, wrappedMint, solanaTestMessage1, 2_000_000, solanaTestTargetChain),
+					splBurnInstruction(solanaTestNativeMint, 2_000_000),
+					postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(2_000_000, ethUsdc, vaa.ChainIDEthereum, solanaTestTargetChain)),
+				)
+				return b
+			},
+			expectedState: common.Rejected,
+		},
+		{
+			name: "wrapped transfer invoked through another program",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(
+					solanaInstruction{programID: solanaTestOther, data: []byte{0x99}},
+					tokenBridgeTransferInstruction(solanaTransferWrappedWithPayloadID, wrappedMint, solanaTestMessage1, 2_000_000, solanaTestTargetChain),
+					splBurnInstruction(wrappedMint, 2_000_000),
+					postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(2_000_000, ethUsdc, vaa.ChainIDEthereum, solanaTestTargetChain)),
+				)
+				return b
+			},
+			expectedState: common.Valid,
+		},
+		{
+			name: "only the requested message is checked",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				nativeTransfer(b, solanaTestMessage1, 1_500_000_000, 150_000_000, "0", "1500000000")
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferWrappedID, wrappedMint, solanaTestMessage2, 2_000_000, solanaTestTargetChain),
+					postMessageInstruction(emitter, solanaTestMessage2, solanaTransferPayload(2_000_000, ethUsdc, vaa.ChainIDEthereum, solanaTestTargetChain)),
+				)
+				return b
+			},
+			messageAccount: solanaTestMessage1,
+			expectedState:  common.Valid,
+		},
+		{
+			name: "an invalid message in the transaction fails the whole transaction",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				nativeTransfer(b, solanaTestMessage1, 1_500_000_000, 150_000_000, "0", "1500000000")
+				b.addInstruction(
+					tokenBridgeTransferInstruction(solanaTransferWrappedID, wrappedMint, solanaTestMessage2, 2_000_000, solanaTestTargetChain),
+					postMessageInstruction(emitter, solanaTestMessage2, solanaTransferPayload(2_000_000, ethUsdc, vaa.ChainIDEthereum, solanaTestTargetChain)),
+				)
+				return b
+			},
+			expectedState: common.Rejected,
+		},
+		{
+			name: "messages from other emitters are ignored",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(postMessageInstruction(solanaTestSender, solanaTestMessage1, solanaTransferPayload(150_000_000, nativeAddr, vaa.ChainIDSolana, solanaTestTargetChain)))
+				return b
+			},
+			expectedState: common.Valid,
+		},
+		{
+			name: "requested message is not a token bridge transfer",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				b.addInstruction(postMessageInstruction(solanaTestSender, solanaTestMessage1, solanaTransferPayload(150_000_000, nativeAddr, vaa.ChainIDSolana, solanaTestTargetChain)))
+				return b
+			},
+			messageAccount: solanaTestMessage1,
+			expectedState:  common.CouldNotVerify,
+			expectedErr:    ErrSolanaNoMatchingRequest,
+		},
+		{
+			name: "attestations are ignored",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				attestation := make([]byte, 100)
+				attestation[0] = 2
+				b.addInstruction(
+					solanaInstruction{programID: solanaTestTokenBridge, accounts: []solana.PublicKey{solanaTestSender}, data: []byte{0x01}},
+					postMessageInstruction(emitter, solanaTestMessage1, attestation),
+				)
+				return b
+			},
+			expectedState: common.Valid,
+		},
+		{
+			name: "failed transaction",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				nativeTransfer(b, solanaTestMessage1, 1_500_000_000, 150_000_000, "0", "1500000000")
+				b.meta.Err = map[string]any{"InstructionError": []any{0, "Custom"}}
+				return b
+			},
+			expectedState: common.CouldNotVerify,
+			expectedErr:   ErrSolanaTxFailed,
+		},
+		{
+			name: "truncated transfer instruction",
+			build: func() *solanaTxBuilder {
+				b := newSolanaTxBuilder()
+				inst := tokenBridgeTransferInstruction(solanaTransferNativeID, solanaTestNativeMint, solanaTestMessage1, 1_500_000_000, solanaTestTargetChain)
+				inst.data = inst.data[:20]
+				b.addInstruction(inst)
+				return b
+			},
+			expectedState: common.CouldNotVerify,
+			expectedErr:   ErrSolanaInstructionTooShort,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b := tc.build()
+			state, err := verifier.ProcessTransaction(b.tx, b.meta, tc.messageAccount, logger)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedState, state)
+		})
+	}
+}
+
+func TestSolanaAddressLookupTables(t *testing.T) {
+	verifier := newTestSolanaTransferVerifier(t, nil)
+	emitter := verifier.GetTokenBridgeEmitter()
+
+	b := newSolanaTxBuilder()
+	b.addInstruction(
+		tokenBridgeTransferInstruction(solanaTransferNativeID, solanaTestNativeMint, solanaTestMessage1, 1_500_000_000, solanaTestTargetChain),
+		postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(150_000_000, vaa.Address(solanaTestNativeMint), vaa.ChainIDSolana, solanaTestTargetChain)),
+	)
+	b.addTokenBalance(solanaTestCustody, verifier.custodySigner, solanaTestNativeMint, 9, "0", "1500000000")
+
+	// Move the custody account and the mint into a lookup table. Both are referenced by index only, so the
+	// verifier has to resolve them through the loaded addresses.
+	keys := b.tx.Message.AccountKeys
+	var static, writable []solana.PublicKey
+	for _, key := range keys {
+		if key.Equals(solanaTestCustody) || key.Equals(solanaTestNativeMint) {
+			writable = append(writable, key)
+		} else {
+			static = append(static, key)
+		}
+	}
+	remap := make(map[uint16]uint16, len(keys))
+	for oldIdx, key := range keys {
+		for newIdx, k := range append(append([]solana.PublicKey{}, static...), writable...) {
+			if k.Equals(key) {
+				// #nosec G115 -- Test transactions only have a handful of keys.
+				remap[uint16(oldIdx)] = uint16(newIdx)
+			}
+		}
+	}
+	remapInst := func(inst *solana.CompiledInstruction) {
+		inst.ProgramIDIndex = remap[inst.ProgramIDIndex]
+		for i := range inst.Accounts {
+			inst.Accounts[i] = remap[inst.Accounts[i]]
+		}
+	}
+	for i := range b.tx.Message.Instructions {
+		remapInst(&b.tx.Message.Instructions[i])
+	}
+	for i := range b.meta.InnerInstructions {
+		for j := range b.meta.InnerInstructions[i].Instructions {
+			remapInst(&b.meta.InnerInstructions[i].Instructions[j])
+		}
+	}
+	for i := range b.meta.PostTokenBalances {
+		b.meta.PostTokenBalances[i].AccountIndex = remap[b.meta.PostTokenBalances[i].AccountIndex]
+	}
+	for i := range b.meta.PreTokenBalances {
+		b.meta.PreTokenBalances[i].AccountIndex = remap[b.meta.PreTokenBalances[i].AccountIndex]
+	}
+	b.tx.Message.AccountKeys = static
+	b.meta.LoadedAddresses.Writable = writable
+
+	state, err := verifier.ProcessTransaction(b.tx, b.meta, solanaTestMessage1, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, common.Valid, state)
+}
+
+// mockSolanaClient serves a single prepared transaction.
+type mockSolanaClient struct {
+	result *rpc.GetTransactionResult
+	err    error
+}
+
+func (m *mockSolanaClient) GetTransaction(ctx context.Context, txSig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error) {
+	return m.result, m.err
+}
+
+func TestSolanaProcessSignature(t *testing.T) {
+	client := &mockSolanaClient{}
+	verifier := newTestSolanaTransferVerifier(t, client)
+	emitter := verifier.GetTokenBridgeEmitter()
+
+	b := newSolanaTxBuilder()
+	b.addInstruction(
+		tokenBridgeTransferInstruction(solanaTransferNativeID, solanaTestNativeMint, solanaTestMessage1, 1_500_000_000, solanaTestTargetChain),
+		postMessageInstruction(emitter, solanaTestMessage1, solanaTransferPayload(150_000_000, vaa.Address(solanaTestNativeMint), vaa.ChainIDSolana, solanaTestTargetChain)),
+	)
+	b.addTokenBalance(solanaTestCustody, verifier.custodySigner, solanaTestNativeMint, 9, "0", "1000000000")
+
+	// The RPC returns the transaction base64-encoded.
+	txBytes, err := b.tx.MarshalBinary()
+	require.NoError(t, err)
+	envelope := new(rpc.TransactionResultEnvelope)
+	require.NoError(t, json.Unmarshal([]byte(fmt.Sprintf(`["%s","base64"]`, base64.StdEncoding.EncodeToString(txBytes))), envelope))
+	client.result = &rpc.GetTransactionResult{
+		Transaction: envelope,
+		Meta:        b.meta,
+	}
+
+	state, err := verifier.ProcessSignature(context.Background(), b.tx.Signatures[0], solanaTestMessage1, zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, common.Rejected, state)
+
+	client.err = errors.New("rpc unavailable")
+	state, err = verifier.ProcessSignature(context.Background(), b.tx.Signatures[0], solanaTestMessage1, zap.NewNop())
+	require.ErrorIs(t, err, ErrFailedToRetrieveSolanaTx)
+	assert.Equal(t, common.CouldNotVerify, state)
+}
+
+func TestParseSolanaPostMessage(t *testing.T) {
+	inst := postMessageInstruction(solanaTestSender, solanaTestMessage1, []byte{0x01, 0x02, 0x03})
+	post, err := parseSolanaPostMessage(inst)
+	require.NoError(t, err)
+	assert.Equal(t, solanaTestMessage1, post.messageAccount)
+	assert.Equal(t, solanaTestSender, post.emitter)
+	assert.Equal(t, []byte{0x01, 0x02, 0x03}, post.payload)
+
+	// The payload length must not exceed the instruction data.
+	binary.LittleEndian.PutUint32(inst.data[5:], 100)
+	_, err = parseSolanaPostMessage(inst)
+	require.ErrorIs(t, err, ErrSolanaInstructionTooShort)
+
+	inst.accounts = inst.accounts[:2]
+	_, err = parseSolanaPostMessage(inst)
+	require.ErrorIs(t, err, ErrSolanaNotEnoughAccounts)
+}
@@ -0,0 +1,349 @@
+package txverifier
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// The instruction layouts below mirror the Solana core bridge and token bridge programs. The token
+// bridge is a solitaire program, so every instruction is a single discriminator byte followed by the
+// borsh-encoded instruction data. See solana/modules/token_bridge/program/src/lib.rs for the
+// discriminator ordering and api/transfer.rs and api/transfer_payload.rs for the data layouts.
+
+// Token bridge instruction discriminators.
+const (
+	solanaTransferWrappedID            = 0x04
+	solanaTransferNativeID             = 0x05
+	solanaTransferWrappedWithPayloadID = 0x0b
+	solanaTransferNativeWithPayloadID  = 0x0c
+)
+
+// Core bridge instruction discriminators.
+const (
+	solanaPostMessageID           = 0x01
+	solanaPostMessageUnreliableID = 0x08
+)
+
+// SPL token instruction discriminators.
+const (
+	splTokenBurnID        = 0x08
+	splTokenBurnCheckedID = 0x0f
+)
+
+// Account positions within the instructions that are parsed by the verifier.
+const (
+	// TransferNative and TransferNativeWithPayload:
+	//	payer, config, from, mint, custody, authority_signer, custody_signer, bridge, message, emitter, ...
+	solanaTransferNativeMintIdx = 3
+	// TransferWrapped and TransferWrappedWithPayload:
+	//	payer, config, from, from_owner, mint, wrapped_meta, authority_signer, bridge, message, emitter, ...
+	solanaTransferWrappedMintIdx = 4
+	// Shared by all four transfer instructions.
+	solanaTransferMessageIdx = 8
+	// Minimum number of accounts for all four transfer instructions, up to and including the emitter.
+	solanaTransferMinNumAccounts = 10
+
+	// PostMessage and PostMessageUnreliable:
+	//	bridge, message, emitter, sequence, payer, fee_collector, clock, ...
+	solanaPostMessageMessageIdx     = 1
+	solanaPostMessageEmitterIdx     = 2
+	solanaPostMessageMinNumAccounts = 3
+
+	// Burn and BurnChecked:
+	//	account, mint, authority, ...
+	splTokenBurnMintIdx        = 1
+	splTokenBurnMinNumAccounts = 2
+)
+
+// Instruction data lengths, including the discriminator byte.
+const (
+	// discriminator, nonce u32, amount u64, fee u64, target_address [32]u8, target_chain u16
+	solanaTransferDataLen = 1 + 4 + 8 + 8 + 32 + 2
+	// discriminator, nonce u32, amount u64, target_address [32]u8, target_chain u16, followed by the
+	// payload and an optional CPI program ID, which are not needed by the verifier.
+	solanaTransferWithPayloadDataLen = 1 + 4 + 8 + 32 + 2
+	// discriminator, nonce u32, payload length u32
+	solanaPostMessageHeaderLen = 1 + 4 + 4
+	// discriminator, amount u64
+	splTokenBurnDataLen = 1 + 8
+)
+
+var (
+	ErrSolanaInstructionTooShort    = errors.New("instruction data too short")
+	ErrSolanaNotEnoughAccounts      = errors.New("instruction has too few accounts")
+	ErrSolanaAccountIndexOutOfRange = errors.New("account index out of range")
+)
+
+// SolanaClient is the subset of the Solana RPC client used by the transfer verifier. It is satisfied by
+// *rpc.Client and allows the verifier to be tested against a mock.
+type SolanaClient interface {
+	GetTransaction(ctx context.Context, txSig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error)
+}
+
+// solanaInstruction is a top-level or inner instruction with its program and account indices resolved
+// against the full list of account keys of the transaction.
+type solanaInstruction struct {
+	programID solana.PublicKey
+	accounts  []solana.PublicKey
+	data      []byte
+}
+
+// solanaTokenBridgeTransfer is a token bridge transfer instruction. The fields are the untrusted,
+// user-supplied instruction data, which the verifier cross-checks against the message payload.
+type solanaTokenBridgeTransfer struct {
+	wrapped        bool
+	amount         uint64
+	targetAddress  vaa.Address
+	targetChain    vaa.ChainID
+	mint           solana.PublicKey
+	messageAccount solana.PublicKey
+}
+
+// solanaPostMessage is a core bridge PostMessage instruction.
+type solanaPostMessage struct {
+	messageAccount solana.PublicKey
+	emitter        solana.PublicKey
+	payload        []byte
+}
+
+// solanaAccountKeys returns the full list of account keys of a transaction: the static keys followed
+// by the writable and then read-only keys loaded from address lookup tables, which is the order that
+// compiled instructions index into.
+func solanaAccountKeys(tx *solana.Transaction, meta *rpc.TransactionMeta) []solana.PublicKey {
+	keys := make([]solana.PublicKey, 0, len(tx.Message.AccountKeys)+len(meta.LoadedAddresses.Writable)+len(meta.LoadedAddresses.ReadOnly))
+	keys = append(keys, tx.Message.AccountKeys...)
+	keys = append(keys, meta.LoadedAddresses.Writable...)
+	keys = append(keys, meta.LoadedAddresses.ReadOnly...)
+	return keys
+}
+
+// resolveSolanaInstruction resolves the program and account indices of a compiled instruction.
+func resolveSolanaInstruction(keys []solana.PublicKey, inst solana.CompiledInstruction) (solanaInstruction, error) {
+	if int(inst.ProgramIDIndex) >= len(keys) {
+		return solanaInstruction{}, fmt.Errorf("%w: program index %d", ErrSolanaAccountIndexOutOfRange, inst.ProgramIDIndex)
+	}
+
+	accounts := make([]solana.PublicKey, len(inst.Accounts))
+	for i, idx := range inst.Accounts {
+		if int(idx) >= len(keys) {
+			return solanaInstruction{}, fmt.Errorf("%w: account index %d", ErrSolanaAccountIndexOutOfRange, idx)
+		}
+		accounts[i] = keys[idx]
+	}
+
+	return solanaInstruction{
+		programID: keys[inst.ProgramIDIndex],
+		accounts:  accounts,
+		data:      inst.Data,
+	}, nil
+}
+
+// flattenSolanaInstructions returns all instructions of a transaction in execution order: each
+// top-level instruction is followed by the inner instructions it invoked.
+func flattenSolanaInstructions(tx *solana.Transaction, meta *rpc.TransactionMeta) ([]solanaInstruction, error) {
+	keys := solanaAccountKeys(tx, meta)
+
+	inner := make(map[uint16][]solana.CompiledInstruction, len(meta.InnerInstructions))
+	for _, in := range meta.InnerInstructions {
+		inner[in.Index] = append(inner[in.Index], in.Instructions...)
+	}
+
+	var out []solanaInstruction
+	for i, outerInst := range tx.Message.Instructions {
+		resolved, err := resolveSolanaInstruction(keys, outerInst)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resolved)
+
+		// #nosec G115 -- A transaction cannot hold more than MaxUint16 instructions.
+		for _, innerInst := range inner[uint16(i)] {
+			resolved, err := resolveSolanaInstruction(keys, innerInst)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, resolved)
+		}
+	}
+
+	return out, nil
+}
+
+// isSolanaTokenBridgeTransfer returns true if the instruction data starts with one of the token bridge
+// transfer discriminators.
+func isSolanaTokenBridgeTransfer(data []byte) bool {
+	if len(data) == 0 {
+		return false
+	}
+	switch data[0] {
+	case solanaTransferWrappedID, solanaTransferNativeID, solanaTransferWrappedWithPayloadID, solanaTransferNativeWithPayloadID:
+		return true
+	}
+	return false
+}
+
+// parseSolanaTokenBridgeTransfer decodes a token bridge transfer instruction.
+func parseSolanaTokenBridgeTransfer(inst solanaInstruction) (*solanaTokenBridgeTransfer, error) {
+	if !isSolanaTokenBridgeTransfer(inst.data) {
+		return nil, errors.New("not a token bridge transfer instruction")
+	}
+
+	if len(inst.accounts) < solanaTransferMinNumAccounts {
+		return nil, fmt.Errorf("%w: %d", ErrSolanaNotEnoughAccounts, len(inst.accounts))
+	}
+
+	discriminator := inst.data[0]
+	withPayload := discriminator == solanaTransferWrappedWithPayloadID || discriminator == solanaTransferNativeWithPayloadID
+
+	minLen := solanaTransferDataLen
+	if withPayload {
+		minLen = solanaTransferWithPayloadDataLen
+	}
+	if len(inst.data) < minLen {
+		return nil, fmt.Errorf("%w: %d bytes", ErrSolanaInstructionTooShort, len(inst.data))
+	}
+
+	// Skip the discriminator and the nonce.
+	offset := 1 + 4
+	transfer := &solanaTokenBridgeTransfer{
+		wrapped:        discriminator == solanaTransferWrappedID || discriminator == solanaTransferWrappedWithPayloadID,
+		amount:         binary.LittleEndian.Uint64(inst.data[offset:]),
+		messageAccount: inst.accounts[solanaTransferMessageIdx],
+	}
+	offset += 8
+
+	// The fee is only present in the transfers without a payload.
+	if !withPayload {
+		offset += 8
+	}
+
+	copy(transfer.targetAddress[:], inst.data[offset:offset+32])
+	offset += 32
+	transfer.targetChain = vaa.ChainID(binary.LittleEndian.Uint16(inst.data[offset:]))
+
+	if transfer.wrapped {
+		transfer.mint = inst.accounts[solanaTransferWrappedMintIdx]
+	} else {
+		transfer.mint = inst.accounts[solanaTransferNativeMintIdx]
+	}
+
+	return transfer, nil
+}
+
+// isSolanaPostMessage returns true if the instruction data starts with one of the core bridge
+// PostMessage discriminators.
+func isSolanaPostMessage(data []byte) bool {
+	return len(data) > 0 && (data[0] == solanaPostMessageID || data[0] == solanaPostMessageUnreliableID)
+}
+
+// parseSolanaPostMessage decodes a core bridge PostMessage instruction.
+func parseSolanaPostMessage(inst solanaInstruction) (*solanaPostMessage, error) {
+	if !isSolanaPostMessage(inst.data) {
+		return nil, errors.New("not a PostMessage instruction")
+	}
+
+	if len(inst.accounts) < solanaPostMessageMinNumAccounts {
+		return nil, fmt.Errorf("%w: %d", ErrSolanaNotEnoughAccounts, len(inst.accounts))
+	}
+
+	if len(inst.data) < solanaPostMessageHeaderLen {
+		return nil, fmt.Errorf("%w: %d bytes", ErrSolanaInstructionTooShort, len(inst.data))
+	}
+
+	payloadLen := binary.LittleEndian.Uint32(inst.data[1+4:])
+	if uint64(len(inst.data)-solanaPostMessageHeaderLen) < uint64(payloadLen) {
+		return nil, fmt.Errorf("%w: payload length %d exceeds instruction data", ErrSolanaInstructionTooShort, payloadLen)
+	}
+
+	return &solanaPostMessage{
+		messageAccount: inst.accounts[solanaPostMessageMessageIdx],
+		emitter:        inst.accounts[solanaPostMessageEmitterIdx],
+		payload:        inst.data[solanaPostMessageHeaderLen : solanaPostMessageHeaderLen+int(payloadLen)],
+	}, nil
+}
+
+// parseSplTokenBurn decodes an SPL token Burn or BurnChecked instruction, returning the mint and the
+// amount burned. The boolean is false if the instruction is not a burn.
+func parseSplTokenBurn(inst solanaInstruction) (solana.PublicKey, uint64, bool) {
+	if !inst.programID.Equals(solana.TokenProgramID) || len(inst.data) < splTokenBurnDataLen {
+		return solana.PublicKey{}, 0, false
+	}
+
+	if inst.data[0] != splTokenBurnID && inst.data[0] != splTokenBurnCheckedID {
+		return solana.PublicKey{}, 0, false
+	}
+
+	if len(inst.accounts) < splTokenBurnMinNumAccounts {
+		return solana.PublicKey{}, 0, false
+	}
+
+	return inst.accounts[splTokenBurnMintIdx], binary.LittleEndian.Uint64(inst.data[1:]), true
+}
+
+// solanaTokenBalanceChange is the change of a single token account's balance over a transaction.
+type solanaTokenBalanceChange struct {
+	owner    solana.PublicKey
+	mint     solana.PublicKey
+	decimals uint8
+	delta    *big.Int
+}
+
+// solanaTokenBalanceChanges computes the balance change of every token account listed in the pre- and
+// post-token balances of a transaction. An account missing from the pre-token balances was created by
+// the transaction and is treated as having started at zero.
+func solanaTokenBalanceChanges(meta *rpc.TransactionMeta) ([]solanaTokenBalanceChange, error) {
+	pre := make(map[uint16]*big.Int, len(meta.PreTokenBalances))
+	for _, balance := range meta.PreTokenBalances {
+		amount, err := solanaTokenBalanceAmount(balance)
+		if err != nil {
+			return nil, err
+		}
+		pre[balance.AccountIndex] = amount
+	}
+
+	changes := make([]solanaTokenBalanceChange, 0, len(meta.PostTokenBalances))
+	for _, balance := range meta.PostTokenBalances {
+		if balance.Owner == nil {
+			continue
+		}
+
+		post, err := solanaTokenBalanceAmount(balance)
+		if err != nil {
+			return nil, err
+		}
+
+		delta := new(big.Int).Set(post)
+		if preAmount, exists := pre[balance.AccountIndex]; exists {
+			delta.Sub(delta, preAmount)
+		}
+
+		changes = append(changes, solanaTokenBalanceChange{
+			owner:    *balance.Owner,
+			mint:     balance.Mint,
+			decimals: balance.UiTokenAmount.Decimals,
+			delta:    delta,
+		})
+	}
+
+	return changes, nil
+}
+
+func solanaTokenBalanceAmount(balance rpc.TokenBalance) (*big.Int, error) {
+	if balance.UiTokenAmount == nil {
+		return nil, fmt.Errorf("token balance for account index %d has no amount", balance.AccountIndex)
+	}
+
+	amount, ok := new(big.Int).SetString(balance.UiTokenAmount.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid token balance amount %q for account index %d", balance.UiTokenAmount.Amount, balance.AccountIndex)
+	}
+
+	return amount, nil
+}
@@ -291,57 +291,5 @@ func (s *SuiTransferVerifier) processDigestInternal(ctx context.Context, digest
 		return false, err
 	}
 
-	// If msgIdStr is found in the resolved map, check only that request. Otherwise, check all requests.
-	if request, exists := resolved[msgIdStr]; exists {
-
-		// Checking for nil, since the map value is a pointer.
-		if request == nil {
-			logger.Debug("No matching request found for message ID", zap.String("msgId", msgIdStr))
-			// No matching request was found for the given message ID.
-			return false, fmt.Errorf("no matching request found for message ID %s", msgIdStr)
-		}
-
-		if !request.DepositMade {
-			logger.Debug("No deposit made for request out of bridge",
-				zap.String("msgId", msgIdStr),
-				zap.String("assetKey", request.AssetKey),
-				zap.String("amount", request.Amount.String()))
-			// A deposit was not made for the given message ID.
-			return false, &InvariantError{Msg: INVARIANT_NO_DEPOSIT}
-		}
-
-		if !request.DepositSolvent {
-			logger.Debug("Deposit for request out of bridge was insolvent",
-				zap.String("msgId", msgIdStr),
-				zap.String("assetKey", request.AssetKey),
-				zap.String("amount", request.Amount.String()))
-			// A deposit was not solvent for the given message ID.
-			return false, &InvariantError{Msg: INVARIANT_INSUFFICIENT_DEPOSIT}
-		}
-
-		logger.Debug("Request for message ID is valid", zap.String("msgId", msgIdStr))
-	} else {
-		// Any request that is not valid causes the entire transaction to be considered invalid.
-		for msgIdStrLoc, request := range resolved {
-			if !request.DepositMade {
-				logger.Debug("No deposit made for request out of bridge",
-					zap.String("assetKey", request.AssetKey),
-					zap.String("amount", request.Amount.String()))
-				// A request was not fulfilled by a deposit into the bridge.
-				return false, &InvariantError{Msg: INVARIANT_NO_DEPOSIT}
-			}
-
-			if !request.DepositSolvent {
-				logger.Debug("Deposit for request out of bridge was insolvent",
-					zap.String("assetKey", request.AssetKey),
-					zap.String("amount", request.Amount.String()))
-				// A request was not solvent.
-				return false, &InvariantError{Msg: INVARIANT_INSUFFICIENT_DEPOSIT}
-			}
-
-			logger.Debug("Request for message ID is valid", zap.String("msgId", msgIdStrLoc))
-		}
-	}
-
-	return true, nil
+	return checkResolvedRequests(resolved, msgIdStr, logger)
 }
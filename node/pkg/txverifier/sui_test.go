@@ -89,6 +89,18 @@ func (m *mockSuiClient) GetLatestCheckpoint(ctx context.Context, fields []string
 	return suiclient.SuiCheckpoint{}, nil
 }
 
+func (m *mockSuiClient) GetCheckpoint(ctx context.Context, sequenceNumber uint64, fields []string) (suiclient.SuiCheckpoint, error) {
+	return suiclient.SuiCheckpoint{}, nil
+}
+
+func (m *mockSuiClient) GetChainIdentifier(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (m *mockSuiClient) SimulateMoveCalls(ctx context.Context, calls []suiclient.SuiMoveCall) ([]suiclient.SuiMoveCallResult, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (m *mockSuiClient) SubscribeToTransactionEvent(ctx context.Context, eventType string, eventWriteChannel chan<- suiclient.SuiTransactionEvent) (suiclient.SuiSubscription, error) {
 	return suiclient.SuiSubscription{}, nil
 }
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
 )
 
 // Constants
@@ -196,8 +197,10 @@ func denormalize(
 func SupportedChains() []vaa.ChainID {
 	return []vaa.ChainID{
 		// Mainnets
+		vaa.ChainIDSolana,
 		vaa.ChainIDEthereum,
 		vaa.ChainIDSui,
+		vaa.ChainIDAptos,
 		// Testnets
 		vaa.ChainIDSepolia,
 		vaa.ChainIDHolesky,
@@ -311,7 +314,7 @@ func upsert(
 
 type MsgIdToRequestOutOfBridge map[string]*RequestOutOfBridge
 
-// Represents a request to move assets out of the Sui token bridge
+// Represents a request to move assets out of the Sui or Aptos token bridge
 type RequestOutOfBridge struct {
 	AssetKey string
 	Amount   *big.Int
@@ -323,7 +326,7 @@ type RequestOutOfBridge struct {
 
 type AssetKeyToTransferIntoBridge map[string]*TransferIntoBridge
 
-// Represents a transfer of assets into the Sui token bridge
+// Represents a transfer of assets into the Sui or Aptos token bridge
 type TransferIntoBridge struct {
 	Amount  *big.Int
 	Solvent bool
@@ -403,3 +406,66 @@ func validateSolvency(
 
 	return resolved, nil
 }
+
+// checkResolvedRequests inspects the requests returned by validateSolvency. If `msgIdStr` identifies one of them, only
+// that request is checked. Otherwise, every request must be backed by a solvent deposit.
+//
+// Return conditions:
+//
+//	true, nil - all checked requests are valid
+//	false, err - a request is invalid; err is an InvariantError if a core invariant was violated
+func checkResolvedRequests(resolved MsgIdToRequestOutOfBridge, msgIdStr string, logger *zap.Logger) (bool, error) {
+	// If msgIdStr is found in the resolved map, check only that request. Otherwise, check all requests.
+	if request, exists := resolved[msgIdStr]; exists {
+
+		// Checking for nil, since the map value is a pointer.
+		if request == nil {
+			logger.Debug("No matching request found for message ID", zap.String("msgId", msgIdStr))
+			// No matching request was found for the given message ID.
+			return false, fmt.Errorf("no matching request found for message ID %s", msgIdStr)
+		}
+
+		if !request.DepositMade {
+			logger.Debug("No deposit made for request out of bridge",
+				zap.String("msgId", msgIdStr),
+				zap.String("assetKey", request.AssetKey),
+				zap.String("amount", request.Amount.String()))
+			// A deposit was not made for the given message ID.
+			return false, &InvariantError{Msg: INVARIANT_NO_DEPOSIT}
+		}
+
+		if !request.DepositSolvent {
+			logger.Debug("Deposit for request out of bridge was insolvent",
+				zap.String("msgId", msgIdStr),
+				zap.String("assetKey", request.AssetKey),
+				zap.String("amount", request.Amount.String()))
+			// A deposit was not solvent for the given message ID.
+			return false, &InvariantError{Msg: INVARIANT_INSUFFICIENT_DEPOSIT}
+		}
+
+		logger.Debug("Request for message ID is valid", zap.String("msgId", msgIdStr))
+	} else {
+		// Any request that is not valid causes the entire transaction to be considered invalid.
+		for msgIdStrLoc, request := range resolved {
+			if !request.DepositMade {
+				logger.Debug("No deposit made for request out of bridge",
+					zap.String("assetKey", request.AssetKey),
+					zap.String("amount", request.Amount.String()))
+				// A request was not fulfilled by a deposit into the bridge.
+				return false, &InvariantError{Msg: INVARIANT_NO_DEPOSIT}
+			}
+
+			if !request.DepositSolvent {
+				logger.Debug("Deposit for request out of bridge was insolvent",
+					zap.String("assetKey", request.AssetKey),
+					zap.String("amount", request.Amount.String()))
+				// A request was not solvent.
+				return false, &InvariantError{Msg: INVARIANT_INSUFFICIENT_DEPOSIT}
+			}
+
+			logger.Debug("Request for message ID is valid", zap.String("msgId", msgIdStrLoc))
+		}
+	}
+
+	return true, nil
+}
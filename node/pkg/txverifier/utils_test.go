@@ -255,7 +255,7 @@ func TestValidateChains(t *testing.T) {
 		{
 			name: "unsupported chainId",
 			args: args{
-				input: []uint{22},
+				input: []uint{4},
 			},
 			want:    nil,
 			wantErr: true,
@@ -276,6 +276,22 @@ func TestValidateChains(t *testing.T) {
 			want:    []vaa.ChainID{vaa.ChainIDEthereum},
 			wantErr: false,
 		},
+		{
+			name: "solana",
+			args: args{
+				input: []uint{1, 21},
+			},
+			want:    []vaa.ChainID{vaa.ChainIDSolana, vaa.ChainIDSui},
+			wantErr: false,
+		},
+		{
+			name: "aptos",
+			args: args{
+				input: []uint{22},
+			},
+			want:    []vaa.ChainID{vaa.ChainIDAptos},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,456 @@
+package aptos
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+const (
+	// ccqRpcTimeout is the timeout applied to all of the RPCs made while handling a single query.
+	ccqRpcTimeout = 15 * time.Second
+
+	// ccqBcsContentType is the content type used to request BCS encoded data from the Aptos API.
+	ccqBcsContentType = "application/x-bcs"
+)
+
+// ccqRpcError is returned when the Aptos node responds with a non-success HTTP status.
+type ccqRpcError struct {
+	statusCode int
+	body       string
+}
+
+func (e *ccqRpcError) Error() string {
+	return fmt.Sprintf("aptos rpc returned status %d: %s", e.statusCode, e.body)
+}
+
+// ccqStatusForError maps an error from the Aptos node to a query status. Client errors (4xx) mean the request itself is bad,
+// such as a view function that aborts or a resource that does not exist, so retrying will not help.
+func ccqStatusForError(err error) query.QueryStatus {
+	var rpcErr *ccqRpcError
+	if errors.As(err, &rpcErr) && rpcErr.statusCode >= 400 && rpcErr.statusCode < 500 {
+		return query.QueryFatalError
+	}
+	return query.QueryRetryNeeded
+}
+
+// ccqLedgerInfo is the subset of the Aptos ledger info needed to process queries.
+type ccqLedgerInfo struct {
+	ledgerVersion     uint64
+	blockHeight       uint64
+	oldestBlockHeight uint64
+}
+
+// ccqBlockInfo is the subset of an Aptos block needed to process queries.
+type ccqBlockInfo struct {
+	height      uint64
+	hash        [32]byte
+	timestamp   uint64 // microseconds
+	lastVersion uint64
+}
+
+// ccqStart starts up CCQ query processing.
+func (e *Watcher) ccqStart(ctx context.Context, errC chan error) {
+	query.StartWorkers(ctx, e.ccqLogger, errC, e, e.queryReqC, e.ccqConfig, e.chainID.String())
+}
+
+// ccqSendQueryResponse sends a response back to the query handler.
+func (e *Watcher) ccqSendQueryResponse(queryResponse *query.PerChainQueryResponseInternal) {
+	select {
+	case e.queryResponseC <- queryResponse:
+		e.ccqLogger.Debug("published query response to handler")
+	default:
+		e.ccqLogger.Error("failed to published query response error to handler")
+	}
+}
+
+// ccqSendErrorResponse creates an error query response and sends it back to the query handler. It sets the response field to nil.
+func (e *Watcher) ccqSendErrorResponse(req *query.PerChainQueryInternal, status query.QueryStatus) {
+	queryResponse := query.CreatePerChainQueryResponseInternal(req.RequestID, req.RequestIdx, req.Request.ChainId, status, nil)
+	e.ccqSendQueryResponse(queryResponse)
+}
+
+// QueryHandler is the top-level query handler. It breaks out the requests based on the type and calls the appropriate handler.
+func (e *Watcher) QueryHandler(ctx context.Context, queryRequest *query.PerChainQueryInternal) {
+	// This can't happen unless there is a programming error - the caller
+	// is expected to send us only requests for our chainID.
+	if queryRequest.Request.ChainId != e.chainID {
+		panic("ccqaptos: invalid chain ID")
+	}
+
+	start := time.Now()
+
+	switch req := queryRequest.Request.Query.(type) {
+	case *query.AptosViewQueryRequest:
+		e.ccqHandleAptosViewQueryRequest(ctx, queryRequest, req)
+	case *query.AptosResourceQueryRequest:
+		e.ccqHandleAptosResourceQueryRequest(ctx, queryRequest, req)
+	default:
+		e.ccqLogger.Warn("received unsupported request type",
+			zap.Uint8("payload", uint8(queryRequest.Request.Query.Type())),
+		)
+		e.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+	}
+
+	query.TotalWatcherTime.WithLabelValues(e.chainID.String()).Observe(float64(time.Since(start).Milliseconds()))
+}
+
+// ccqHandleAptosViewQueryRequest is the query handler for an aptos_view request. It evaluates each view function at the selected ledger version.
+func (e *Watcher) ccqHandleAptosViewQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.AptosViewQueryRequest) {
+	requestId := "aptos_view:" + queryRequest.ID()
+	e.ccqLogger.Info("received an aptos_view query",
+		zap.String("requestId", requestId),
+		zap.Uint64("ledgerVersion", req.LedgerVersion),
+		zap.Uint64("targetTimestamp", req.TargetTimestamp),
+		zap.Int("numCalls", len(req.CallData)),
+	)
+
+	rCtx, cancel := context.WithTimeout(ctx, ccqRpcTimeout)
+	defer cancel()
+
+	block, ledgerVersion, status := e.ccqResolveLedgerVersion(rCtx, requestId, req.LedgerVersion, req.TargetTimestamp)
+	if status != query.QuerySuccess {
+		e.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+
+	results := make([][]byte, 0, len(req.CallData))
+	for idx, cd := range req.CallData {
+		body, err := e.ccqBuildViewRequestBody(cd)
+		if err != nil {
+			e.ccqLogger.Error("failed to build view request body", zap.String("requestId", requestId), zap.Int("idx", idx), zap.Error(err))
+			e.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+			return
+		}
+
+		resp, err := e.ccqPost(rCtx, fmt.Sprintf("%s/v1/view?ledger_version=%d", e.aptosRPC, ledgerVersion), body)
+		if err != nil {
+			status := ccqStatusForError(err)
+			e.ccqLogger.Error("view function call failed",
+				zap.String("requestId", requestId),
+				zap.Int("idx", idx),
+				zap.String("function", cd.Function),
+				zap.Uint64("ledgerVersion", ledgerVersion),
+				zap.Int("status", int(status)),
+				zap.Error(err),
+			)
+			e.ccqSendErrorResponse(queryRequest, status)
+			return
+		}
+
+		// Compact the JSON so that the response does not depend on the formatting used by our node.
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, resp); err != nil || !gjson.ParseBytes(resp).IsArray() {
+			e.ccqLogger.Error("view function returned an invalid result",
+				zap.String("requestId", requestId),
+				zap.Int("idx", idx),
+				zap.String("result", string(resp)),
+				zap.Error(err),
+			)
+			e.ccqSendErrorResponse(queryRequest, query.QueryRetryNeeded)
+			return
+		}
+
+		results = append(results, compacted.Bytes())
+	}
+
+	resp := &query.AptosViewQueryResponse{
+		LedgerVersion: ledgerVersion,
+		BlockHeight:   block.height,
+		BlockHash:     block.hash,
+		BlockTime:     time.UnixMicro(int64(block.timestamp)), // #nosec G115 -- Block timestamps will not overflow an int64 for a very long time
+		Results:       results,
+	}
+
+	e.ccqLogger.Info("aptos_view query complete",
+		zap.String("requestId", requestId),
+		zap.Uint64("ledgerVersion", ledgerVersion),
+		zap.Uint64("blockHeight", block.height),
+		zap.Int("numResults", len(results)),
+	)
+
+	queryResponse := query.CreatePerChainQueryResponseInternal(queryRequest.RequestID, queryRequest.RequestIdx, queryRequest.Request.ChainId, query.QuerySuccess, resp)
+	e.ccqSendQueryResponse(queryResponse)
+}
+
+// ccqHandleAptosResourceQueryRequest is the query handler for an aptos_resource request. It reads each resource at the selected ledger version.
+func (e *Watcher) ccqHandleAptosResourceQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.AptosResourceQueryRequest) {
+	requestId := "aptos_resource:" + queryRequest.ID()
+	e.ccqLogger.Info("received an aptos_resource query",
+		zap.String("requestId", requestId),
+		zap.Uint64("ledgerVersion", req.LedgerVersion),
+		zap.Uint64("targetTimestamp", req.TargetTimestamp),
+		zap.Int("numResources", len(req.Resources)),
+	)
+
+	rCtx, cancel := context.WithTimeout(ctx, ccqRpcTimeout)
+	defer cancel()
+
+	block, ledgerVersion, status := e.ccqResolveLedgerVersion(rCtx, requestId, req.LedgerVersion, req.TargetTimestamp)
+	if status != query.QuerySuccess {
+		e.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+
+	results := make([][]byte, 0, len(req.Resources))
+	for idx, resource := range req.Resources {
+		account := "0x" + hex.EncodeToString(resource.Account[:])
+		u := fmt.Sprintf("%s/v1/accounts/%s/resource/%s?ledger_version=%d", e.aptosRPC, account, url.PathEscape(resource.ResourceType), ledgerVersion)
+		data, err := e.ccqGet(rCtx, u, ccqBcsContentType)
+		if err != nil {
+			status := ccqStatusForError(err)
+			e.ccqLogger.Error("failed to read resource",
+				zap.String("requestId", requestId),
+				zap.Int("idx", idx),
+				zap.String("account", account),
+				zap.String("resourceType", resource.ResourceType),
+				zap.Uint64("ledgerVersion", ledgerVersion),
+				zap.Int("status", int(status)),
+				zap.Error(err),
+			)
+			e.ccqSendErrorResponse(queryRequest, status)
+			return
+		}
+
+		results = append(results, data)
+	}
+
+	resp := &query.AptosResourceQueryResponse{
+		LedgerVersion: ledgerVersion,
+		BlockHeight:   block.height,
+		BlockHash:     block.hash,
+		BlockTime:     time.UnixMicro(int64(block.timestamp)), // #nosec G115 -- Block timestamps will not overflow an int64 for a very long time
+		Results:       results,
+	}
+
+	e.ccqLogger.Info("aptos_resource query complete",
+		zap.String("requestId", requestId),
+		zap.Uint64("ledgerVersion", ledgerVersion),
+		zap.Uint64("blockHeight", block.height),
+		zap.Int("numResults", len(results)),
+	)
+
+	queryResponse := query.CreatePerChainQueryResponseInternal(queryRequest.RequestID, queryRequest.RequestIdx, queryRequest.Request.ChainId, query.QuerySuccess, resp)
+	e.ccqSendQueryResponse(queryResponse)
+}
+
+// ccqResolveLedgerVersion determines the ledger version and containing block to be used for a query. If a ledger version is
+// specified, it is used directly. Otherwise the query is evaluated at the last version of the last block with a timestamp at or
+// before the target timestamp. A status other than QuerySuccess is returned if the query cannot be processed now.
+func (e *Watcher) ccqResolveLedgerVersion(ctx context.Context, requestId string, ledgerVersion uint64, targetTimestamp uint64) (*ccqBlockInfo, uint64, query.QueryStatus) {
+	info, err := e.ccqGetLedgerInfo(ctx)
+	if err != nil {
+		e.ccqLogger.Error("failed to read ledger info", zap.String("requestId", requestId), zap.Error(err))
+		return nil, 0, query.QueryRetryNeeded
+	}
+
+	if ledgerVersion != 0 {
+		if ledgerVersion > info.ledgerVersion {
+			e.ccqLogger.Info("requested ledger version has not been reached yet, will retry",
+				zap.String("requestId", requestId),
+				zap.Uint64("requestedVersion", ledgerVersion),
+				zap.Uint64("currentVersion", info.ledgerVersion),
+			)
+			return nil, 0, query.QueryRetryNeeded
+		}
+
+		block, err := e.ccqGetBlock(ctx, fmt.Sprintf("%s/v1/blocks/by_version/%d", e.aptosRPC, ledgerVersion))
+		if err != nil {
+			status := ccqStatusForError(err)
+			e.ccqLogger.Error("failed to read block by version",
+				zap.String("requestId", requestId),
+				zap.Uint64("ledgerVersion", ledgerVersion),
+				zap.Int("status", int(status)),
+				zap.Error(err),
+			)
+			return nil, 0, status
+		}
+		return block, ledgerVersion, query.QuerySuccess
+	}
+
+	block, status := e.ccqFindBlockByTimestamp(ctx, requestId, info, targetTimestamp)
+	if status != query.QuerySuccess {
+		return nil, 0, status
+	}
+	return block, block.lastVersion, query.QuerySuccess
+}
+
+// ccqFindBlockByTimestamp does a binary search of the blocks available on our node for the last block with a timestamp at or
+// before the target. Since a later block could still show up with a matching timestamp, the target must be before the latest block.
+func (e *Watcher) ccqFindBlockByTimestamp(ctx context.Context, requestId string, info *ccqLedgerInfo, targetTimestamp uint64) (*ccqBlockInfo, query.QueryStatus) {
+	getBlock := func(height uint64) (*ccqBlockInfo, error) {
+		return e.ccqGetBlock(ctx, fmt.Sprintf("%s/v1/blocks/by_height/%d", e.aptosRPC, height))
+	}
+
+	hiBlock, err := getBlock(info.blockHeight)
+	if err != nil {
+		e.ccqLogger.Error("failed to read latest block", zap.String("requestId", requestId), zap.Uint64("height", info.blockHeight), zap.Error(err))
+		return nil, query.QueryRetryNeeded
+	}
+	if hiBlock.timestamp <= targetTimestamp {
+		e.ccqLogger.Info("target timestamp has not been passed yet, will retry",
+			zap.String("requestId", requestId),
+			zap.Uint64("targetTimestamp", targetTimestamp),
+			zap.Uint64("latestBlockTimestamp", hiBlock.timestamp),
+		)
+		return nil, query.QueryRetryNeeded
+	}
+
+	loBlock, err := getBlock(info.oldestBlockHeight)
+	if err != nil {
+		e.ccqLogger.Error("failed to read oldest block", zap.String("requestId", requestId), zap.Uint64("height", info.oldestBlockHeight), zap.Error(err))
+		return nil, query.QueryRetryNeeded
+	}
+	if loBlock.timestamp > targetTimestamp {
+		e.ccqLogger.Error("target timestamp is before the oldest block available",
+			zap.String("requestId", requestId),
+			zap.Uint64("targetTimestamp", targetTimestamp),
+			zap.Uint64("oldestBlockTimestamp", loBlock.timestamp),
+		)
+		return nil, query.QueryFatalError
+	}
+
+	// Invariant: loBlock.timestamp <= targetTimestamp < hiBlock.timestamp
+	for hiBlock.height-loBlock.height > 1 {
+		mid := loBlock.height + (hiBlock.height-loBlock.height)/2
+		block, err := getBlock(mid)
+		if err != nil {
+			e.ccqLogger.Error("failed to read block during search", zap.String("requestId", requestId), zap.Uint64("height", mid), zap.Error(err))
+			return nil, query.QueryRetryNeeded
+		}
+		if block.timestamp <= targetTimestamp {
+			loBlock = block
+		} else {
+			hiBlock = block
+		}
+	}
+
+	return loBlock, query.QuerySuccess
+}
+
+// ccqGetLedgerInfo reads the current ledger info from our node.
+func (e *Watcher) ccqGetLedgerInfo(ctx context.Context) (*ccqLedgerInfo, error) {
+	body, err := e.ccqGet(ctx, fmt.Sprintf("%s/v1", e.aptosRPC), "")
+	if err != nil {
+		return nil, err
+	}
+	if !gjson.ValidBytes(body) {
+		return nil, fmt.Errorf("invalid JSON in ledger info")
+	}
+	info := gjson.ParseBytes(body)
+	ledgerVersion := info.Get("ledger_version")
+	blockHeight := info.Get("block_height")
+	oldestBlockHeight := info.Get("oldest_block_height")
+	if !ledgerVersion.Exists() || !blockHeight.Exists() || !oldestBlockHeight.Exists() {
+		return nil, fmt.Errorf("ledger info is missing required fields")
+	}
+	return &ccqLedgerInfo{
+		ledgerVersion:     ledgerVersion.Uint(),
+		blockHeight:       blockHeight.Uint(),
+		oldestBlockHeight: oldestBlockHeight.Uint(),
+	}, nil
+}
+
+// ccqGetBlock reads a block, without transactions, from the specified URL.
+func (e *Watcher) ccqGetBlock(ctx context.Context, u string) (*ccqBlockInfo, error) {
+	body, err := e.ccqGet(ctx, u, "")
+	if err != nil {
+		return nil, err
+	}
+	if !gjson.ValidBytes(body) {
+		return nil, fmt.Errorf("invalid JSON in block")
+	}
+	b := gjson.ParseBytes(body)
+	height := b.Get("block_height")
+	hash := b.Get("block_hash")
+	timestamp := b.Get("block_timestamp")
+	lastVersion := b.Get("last_version")
+	if !height.Exists() || !hash.Exists() || !timestamp.Exists() || !lastVersion.Exists() {
+		return nil, fmt.Errorf("block is missing required fields")
+	}
+
+	hashBytes, err := hex.DecodeString(stripHexadecimalPrefix(hash.String()))
+	if err != nil || len(hashBytes) != 32 {
+		return nil, fmt.Errorf("invalid block hash %q", hash.String())
+	}
+
+	block := &ccqBlockInfo{
+		height:      height.Uint(),
+		timestamp:   timestamp.Uint(),
+		lastVersion: lastVersion.Uint(),
+	}
+	copy(block.hash[:], hashBytes)
+	return block, nil
+}
+
+// ccqBuildViewRequestBody builds the JSON body for a call to the view endpoint.
+func (e *Watcher) ccqBuildViewRequestBody(cd *query.AptosViewCallData) ([]byte, error) {
+	typeArgs := cd.TypeArguments
+	if typeArgs == nil {
+		typeArgs = []string{}
+	}
+	args := make([]json.RawMessage, 0, len(cd.Arguments))
+	for _, arg := range cd.Arguments {
+		args = append(args, json.RawMessage(arg))
+	}
+	return json.Marshal(struct {
+		Function      string            `json:"function"`
+		TypeArguments []string          `json:"type_arguments"`
+		Arguments     []json.RawMessage `json:"arguments"`
+	}{
+		Function:      cd.Function,
+		TypeArguments: typeArgs,
+		Arguments:     args,
+	})
+}
+
+// ccqGet issues a GET request to our node, optionally specifying the accepted content type.
+func (e *Watcher) ccqGet(ctx context.Context, u string, accept string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	return e.ccqDo(req)
+}
+
+// ccqPost issues a POST request with a JSON body to our node.
+func (e *Watcher) ccqPost(ctx context.Context, u string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return e.ccqDo(req)
+}
+
+// ccqDo executes an HTTP request and returns the body, converting non-success statuses into a ccqRpcError.
+func (e *Watcher) ccqDo(req *http.Request) ([]byte, error) {
+	//nolint:gosec // the URL is built from the configured Aptos RPC endpoint.
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := common.SafeRead(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, &ccqRpcError{statusCode: res.StatusCode, body: string(body)}
+	}
+	return body, nil
+}
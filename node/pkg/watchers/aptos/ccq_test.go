@@ -0,0 +1,241 @@
+package aptos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/query"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// The fake node has blocks ccqTestOldestBlock through ccqTestLatestBlock. Block h has a timestamp of h seconds and contains
+	// versions h*10 through h*10+9.
+	ccqTestOldestBlock = uint64(100)
+	ccqTestLatestBlock = uint64(200)
+)
+
+func ccqTestBlockJson(height uint64) string {
+	return fmt.Sprintf(`{"block_height":"%d","block_hash":"0x%064x","block_timestamp":"%d","first_version":"%d","last_version":"%d","transactions":null}`,
+		height, height, height*1_000_000, height*10, height*10+9)
+}
+
+// ccqFakeAptosNode serves the subset of the Aptos REST API used by the query handler.
+type ccqFakeAptosNode struct {
+	t              *testing.T
+	viewBodies     []string
+	viewVersions   []string
+	resourceAccept string
+}
+
+func (f *ccqFakeAptosNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	switch {
+	case path == "/v1":
+		fmt.Fprintf(w, `{"chain_id":1,"ledger_version":"%d","oldest_block_height":"%d","block_height":"%d"}`,
+			ccqTestLatestBlock*10+9, ccqTestOldestBlock, ccqTestLatestBlock)
+	case strings.HasPrefix(path, "/v1/blocks/by_height/"):
+		height, err := strconv.ParseUint(strings.TrimPrefix(path, "/v1/blocks/by_height/"), 10, 64)
+		require.NoError(f.t, err)
+		if height < ccqTestOldestBlock || height > ccqTestLatestBlock {
+			http.Error(w, `{"error_code":"block_not_found"}`, http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, ccqTestBlockJson(height))
+	case strings.HasPrefix(path, "/v1/blocks/by_version/"):
+		version, err := strconv.ParseUint(strings.TrimPrefix(path, "/v1/blocks/by_version/"), 10, 64)
+		require.NoError(f.t, err)
+		fmt.Fprint(w, ccqTestBlockJson(version/10))
+	case path == "/v1/view":
+		require.Equal(f.t, http.MethodPost, r.Method)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(f.t, err)
+		f.viewBodies = append(f.viewBodies, string(body))
+		f.viewVersions = append(f.viewVersions, r.URL.Query().Get("ledger_version"))
+		if strings.Contains(string(body), "abort") {
+			http.Error(w, `{"error_code":"invalid_input","message":"Move abort"}`, http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, "[ \"12345\",\n  true ]")
+	case strings.HasPrefix(path, "/v1/accounts/"):
+		f.resourceAccept = r.Header.Get("Accept")
+		if !strings.HasSuffix(path, "/resource/0x1::block::BlockResource") {
+			http.Error(w, `{"error_code":"resource_not_found"}`, http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte{0x01, 0x02, 0x03})
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func newCcqTestWatcher(t *testing.T) (*Watcher, *ccqFakeAptosNode, chan *query.PerChainQueryResponseInternal) {
+	node := &ccqFakeAptosNode{t: t}
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	queryResponseC := make(chan *query.PerChainQueryResponseInternal, 10)
+	w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", server.URL, testAptosAccount, testAptosHandle, nil, nil, nil, queryResponseC)
+	require.NoError(t, err)
+	w.ccqLogger = zap.NewNop()
+	return w, node, queryResponseC
+}
+
+func newCcqTestRequest(q query.ChainSpecificQuery) *query.PerChainQueryInternal {
+	return &query.PerChainQueryInternal{
+		RequestID:  "123456",
+		RequestIdx: 0,
+		Request:    &query.PerChainQueryRequest{ChainId: vaa.ChainIDAptos, Query: q},
+	}
+}
+
+func TestCcqAptosViewQueryByLedgerVersion(t *testing.T) {
+	w, node, queryResponseC := newCcqTestWatcher(t)
+
+	req := &query.AptosViewQueryRequest{
+		LedgerVersion: 1503,
+		CallData: []*query.AptosViewCallData{
+			{
+				Function:      "0x1::coin::balance",
+				TypeArguments: []string{"0x1::aptos_coin::AptosCoin"},
+				Arguments:     [][]byte{[]byte(`"0x1"`)},
+			},
+		},
+	}
+	w.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+	require.Len(t, queryResponseC, 1)
+	resp := <-queryResponseC
+	require.Equal(t, query.QuerySuccess, resp.Status)
+	viewResp, ok := resp.Response.(*query.AptosViewQueryResponse)
+	require.True(t, ok)
+	require.Equal(t, uint64(1503), viewResp.LedgerVersion)
+	require.Equal(t, uint64(150), viewResp.BlockHeight)
+	require.Equal(t, ethCommon.HexToHash(fmt.Sprintf("0x%064x", 150)), ethCommon.Hash(viewResp.BlockHash))
+	require.Equal(t, time.Unix(150, 0), viewResp.BlockTime)
+	require.Equal(t, [][]byte{[]byte(`["12345",true]`)}, viewResp.Results)
+
+	require.Equal(t, []string{"1503"}, node.viewVersions)
+	require.Len(t, node.viewBodies, 1)
+	var body map[string]any
+	require.NoError(t, json.Unmarshal([]byte(node.viewBodies[0]), &body))
+	require.Equal(t, "0x1::coin::balance", body["function"])
+	require.Equal(t, []any{"0x1::aptos_coin::AptosCoin"}, body["type_arguments"])
+	require.Equal(t, []any{"0x1"}, body["arguments"])
+
+	// The response must be marshalable.
+	_, err := viewResp.Marshal()
+	require.NoError(t, err)
+}
+
+func TestCcqAptosViewQueryByTimestamp(t *testing.T) {
+	tests := []struct {
+		name            string
+		targetTimestamp uint64
+		expectedStatus  query.QueryStatus
+		expectedBlock   uint64
+	}{
+		{name: "exactly at a block", targetTimestamp: 137_000_000, expectedStatus: query.QuerySuccess, expectedBlock: 137},
+		{name: "between blocks", targetTimestamp: 137_500_000, expectedStatus: query.QuerySuccess, expectedBlock: 137},
+		{name: "oldest block", targetTimestamp: 100_000_000, expectedStatus: query.QuerySuccess, expectedBlock: 100},
+		{name: "just before latest block", targetTimestamp: 199_999_999, expectedStatus: query.QuerySuccess, expectedBlock: 199},
+		{name: "before oldest block", targetTimestamp: 99_000_000, expectedStatus: query.QueryFatalError},
+		{name: "not passed yet", targetTimestamp: 200_000_000, expectedStatus: query.QueryRetryNeeded},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w, node, queryResponseC := newCcqTestWatcher(t)
+
+			req := &query.AptosViewQueryRequest{
+				TargetTimestamp: tc.targetTimestamp,
+				CallData:        []*query.AptosViewCallData{{Function: "0x1::timestamp::now_microseconds"}},
+			}
+			w.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+			require.Len(t, queryResponseC, 1)
+			resp := <-queryResponseC
+			require.Equal(t, tc.expectedStatus, resp.Status)
+			if tc.expectedStatus != query.QuerySuccess {
+				require.Nil(t, resp.Response)
+				require.Empty(t, node.viewBodies)
+				return
+			}
+
+			viewResp, ok := resp.Response.(*query.AptosViewQueryResponse)
+			require.True(t, ok)
+			require.Equal(t, tc.expectedBlock, viewResp.BlockHeight)
+			require.Equal(t, tc.expectedBlock*10+9, viewResp.LedgerVersion)
+			require.Equal(t, []string{strconv.FormatUint(tc.expectedBlock*10+9, 10)}, node.viewVersions)
+		})
+	}
+}
+
+func TestCcqAptosViewQueryErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		ledgerVersion  uint64
+		function       string
+		expectedStatus query.QueryStatus
+	}{
+		{name: "version not reached", ledgerVersion: 5000, function: "0x1::timestamp::now_microseconds", expectedStatus: query.QueryRetryNeeded},
+		{name: "function aborts", ledgerVersion: 1500, function: "0x1::test::abort", expectedStatus: query.QueryFatalError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w, _, queryResponseC := newCcqTestWatcher(t)
+
+			req := &query.AptosViewQueryRequest{
+				LedgerVersion: tc.ledgerVersion,
+				CallData:      []*query.AptosViewCallData{{Function: tc.function}},
+			}
+			w.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+			require.Len(t, queryResponseC, 1)
+			resp := <-queryResponseC
+			require.Equal(t, tc.expectedStatus, resp.Status)
+			require.Nil(t, resp.Response)
+		})
+	}
+}
+
+func TestCcqAptosResourceQuery(t *testing.T) {
+	w, node, queryResponseC := newCcqTestWatcher(t)
+
+	req := &query.AptosResourceQueryRequest{
+		LedgerVersion: 1234,
+		Resources: []query.AptosResourceEntry{
+			{Account: ethCommon.HexToHash("0x1"), ResourceType: "0x1::block::BlockResource"},
+		},
+	}
+	w.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+	require.Len(t, queryResponseC, 1)
+	resp := <-queryResponseC
+	require.Equal(t, query.QuerySuccess, resp.Status)
+	resResp, ok := resp.Response.(*query.AptosResourceQueryResponse)
+	require.True(t, ok)
+	require.Equal(t, uint64(1234), resResp.LedgerVersion)
+	require.Equal(t, uint64(123), resResp.BlockHeight)
+	require.Equal(t, [][]byte{{0x01, 0x02, 0x03}}, resResp.Results)
+	require.Equal(t, ccqBcsContentType, node.resourceAccept)
+
+	// A resource that does not exist is a fatal error.
+	req.Resources[0].ResourceType = "0x1::account::Account"
+	w.QueryHandler(context.Background(), newCcqTestRequest(req))
+	require.Len(t, queryResponseC, 1)
+	resp = <-queryResponseC
+	require.Equal(t, query.QueryFatalError, resp.Status)
+}
@@ -1,12 +1,16 @@
 package aptos
 
 import (
+	"fmt"
+
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/txverifier"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
+	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -16,6 +20,8 @@ type WatcherConfig struct {
 	Rpc       string
 	Account   string
 	Handle    string
+	// TxVerifierEnabled enables the transfer verifier for token bridge messages. Only supported for Aptos.
+	TxVerifierEnabled bool
 }
 
 func (wc *WatcherConfig) GetNetworkID() watchers.NetworkID {
@@ -26,18 +32,44 @@ func (wc *WatcherConfig) GetChainID() vaa.ChainID {
 	return wc.ChainID
 }
 
-//nolint:unparam // error is always nil here but the return type is required to satisfy the interface.
 func (wc *WatcherConfig) Create(
 	msgC chan<- *common.MessagePublication,
 	obsvReqC <-chan *gossipv1.ObservationRequest,
-	_ <-chan *query.PerChainQueryInternal,
-	_ chan<- *query.PerChainQueryResponseInternal,
+	queryReqC <-chan *query.PerChainQueryInternal,
+	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 	_ chan<- *common.GuardianSet,
-	_ common.Environment,
+	env common.Environment,
 ) (supervisor.Runnable, interfaces.Reobserver, error) {
-	w, err := NewWatcher(wc.ChainID, wc.NetworkID, wc.Rpc, wc.Account, wc.Handle, msgC, obsvReqC)
+	w, err := NewWatcher(wc.ChainID, wc.NetworkID, wc.Rpc, wc.Account, wc.Handle, msgC, obsvReqC, queryReqC, queryResponseC)
 	if err != nil {
 		return nil, nil, err
 	}
-	return w.Run, nil, nil
+
+	if wc.TxVerifierEnabled {
+		if wc.ChainID != vaa.ChainIDAptos {
+			return nil, nil, fmt.Errorf("transfer verification is not supported for chain %s", wc.ChainID)
+		}
+
+		tokenBridge, exists := txverifier.AptosTokenBridgeAddresses[env]
+		if !exists {
+			return nil, nil, fmt.Errorf("no Aptos token bridge address known for environment %s", env)
+		}
+
+		emitters := sdk.KnownTokenbridgeEmitters
+		if env == common.TestNet {
+			emitters = sdk.KnownTestnetTokenbridgeEmitters
+		}
+
+		emitter, err := vaa.BytesToAddress(emitters[vaa.ChainIDAptos])
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid Aptos token bridge emitter: %w", err)
+		}
+
+		w.txVerifier, err = txverifier.NewAptosTransferVerifier(wc.Account, tokenBridge, emitter, txverifier.NewAptosRestClient(wc.Rpc))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create Aptos transfer verifier: %w", err)
+		}
+	}
+
+	return w.Run, w, nil
 }
@@ -0,0 +1,69 @@
+package aptos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// verify evaluates a MessagePublication using the Aptos transfer verifier and returns a copy of it with its
+// verification state set. `version` is the ledger version of the transaction that emitted the message, or zero if it
+// is not known.
+func (e *Watcher) verify(
+	ctx context.Context,
+	msg *common.MessagePublication,
+	version uint64,
+	logger *zap.Logger,
+) (common.MessagePublication, error) {
+
+	if msg == nil {
+		return common.MessagePublication{}, fmt.Errorf("MessagePublication is nil")
+	}
+
+	if msg.VerificationState() != common.NotVerified {
+		return common.MessagePublication{}, fmt.Errorf("MessagePublication already has a non-default verification state")
+	}
+
+	if e.txVerifier == nil {
+		return common.MessagePublication{}, fmt.Errorf("transfer verifier is nil")
+	}
+
+	localMsg := *msg
+
+	var verificationState common.VerificationState
+
+	// If the payload does not represent a transfer, or if the emitter address of the message does
+	// not match the token bridge emitter, mark the message's verification state as NotApplicable.
+	if !vaa.IsTransfer(msg.Payload) || localMsg.EmitterAddress != e.txVerifier.GetTokenBridgeEmitter() {
+		verificationState = common.NotApplicable
+	} else if version == 0 {
+		logger.Debug("cannot verify transfer without the version of the transaction that emitted it", zap.Uint64("sequence", localMsg.Sequence))
+		verificationState = common.CouldNotVerify
+	} else {
+		// Validate the transfers in the transaction at the given ledger version.
+		valid, err := e.txVerifier.ProcessVersion(ctx, version, localMsg.MessageIDString(), logger)
+
+		if err != nil {
+			logger.Error("an internal Aptos tx verifier error occurred: ", zap.Error(err))
+			verificationState = common.CouldNotVerify
+		} else if valid {
+			verificationState = common.Valid
+		} else {
+			// As for Sui, Anomalous is used instead of Rejected until the verifier has been exercised against
+			// a wider range of token bridge integrations.
+			verificationState = common.Anomalous
+		}
+	}
+
+	// Update the state of the message.
+	updateErr := localMsg.SetVerificationState(verificationState)
+	if updateErr != nil {
+		errMsg := fmt.Sprintf("could not set verification state for message with txID %s", localMsg.TxIDString())
+		return common.MessagePublication{}, fmt.Errorf("%s %w", errMsg, updateErr)
+	}
+
+	return localMsg, nil
+}
@@ -0,0 +1,97 @@
+package aptos
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/txverifier"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// failingAptosClient is a txverifier.AptosClient that cannot fetch anything.
+type failingAptosClient struct{}
+
+func (failingAptosClient) GetTransactionByVersion(context.Context, uint64) ([]byte, error) {
+	return nil, errors.New("rpc unavailable")
+}
+
+func (failingAptosClient) GetAccountResource(context.Context, string, string, uint64) ([]byte, error) {
+	return nil, errors.New("rpc unavailable")
+}
+
+func TestVerify(t *testing.T) {
+	tokenBridgeEmitter, err := vaa.BytesToAddress([]byte{0x01})
+	require.NoError(t, err)
+
+	verifier, err := txverifier.NewAptosTransferVerifier(testAptosAccount, txverifier.AptosTokenBridgeAddresses[common.MainNet], tokenBridgeEmitter, failingAptosClient{})
+	require.NoError(t, err)
+
+	w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", testAptosAccount, testAptosHandle, nil, nil, nil, nil)
+	require.NoError(t, err)
+	w.txVerifier = verifier
+
+	transferPayload := make([]byte, 133)
+	transferPayload[0] = 1
+
+	tests := []struct {
+		name          string
+		emitter       vaa.Address
+		payload       []byte
+		version       uint64
+		expectedState common.VerificationState
+	}{
+		{
+			name:          "not from the token bridge",
+			emitter:       vaa.Address{0x01},
+			payload:       transferPayload,
+			version:       1,
+			expectedState: common.NotApplicable,
+		},
+		{
+			name:          "not a transfer",
+			emitter:       tokenBridgeEmitter,
+			payload:       []byte{0x02, 0x00},
+			version:       1,
+			expectedState: common.NotApplicable,
+		},
+		{
+			name:          "transaction version unknown",
+			emitter:       tokenBridgeEmitter,
+			payload:       transferPayload,
+			expectedState: common.CouldNotVerify,
+		},
+		{
+			name:          "transaction cannot be fetched",
+			emitter:       tokenBridgeEmitter,
+			payload:       transferPayload,
+			version:       1,
+			expectedState: common.CouldNotVerify,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &common.MessagePublication{
+				EmitterChain:   vaa.ChainIDAptos,
+				EmitterAddress: tc.emitter,
+				Payload:        tc.payload,
+			}
+
+			verified, err := w.verify(context.Background(), msg, tc.version, zap.NewNop())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, verified.VerificationState())
+			// The original message is left untouched.
+			require.Equal(t, common.NotVerified, msg.VerificationState())
+		})
+	}
+
+	// A message that has already been verified is an error.
+	msg := &common.MessagePublication{EmitterAddress: tokenBridgeEmitter, Payload: transferPayload}
+	require.NoError(t, msg.SetVerificationState(common.Valid))
+	_, err = w.verify(context.Background(), msg, 1, zap.NewNop())
+	require.Error(t, err)
+}
@@ -0,0 +1,99 @@
+package aptos
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// reobserve looks up the event identified by a reobservation request using the specified RPC endpoint and publishes it.
+// It returns the number of messages published.
+func (e *Watcher) reobserve(ctx context.Context, logger *zap.Logger, aptosRPC string, txHash []byte) (uint32, error) {
+	// Aptos's TxID is a uint64. Historically, all TxIDs used a fixed 32-byte hash type.
+	// This parsing is leftover from that time period. It should be possible to refactor
+	// this code such that the TxID received from p2p is exactly 8 bytes, which would
+	// obviate the need for the below bounds check and parsing.
+	//
+	// SECURITY: This acts as a bounds check for the BigEndian.Unint64 call below.
+	const AptosTxIDExpectedLen = 32
+	if len(txHash) < AptosTxIDExpectedLen {
+		return 0, errors.New("invalid TxID: too short")
+	}
+
+	// uint64 will read the *first* 8 bytes, but the sequence is stored in the *last* 8.
+	nativeSeq := binary.BigEndian.Uint64(txHash[24:])
+
+	logger.Info("Received obsv request", zap.Uint64("tx_hash", nativeSeq))
+
+	// SECURITY: the API guarantees that we only get the events from the right contract.
+	// The event type is also verified in processReobservationBatch.
+	s := fmt.Sprintf(`%s/v1/accounts/%s/events/%s/event?start=%d&limit=1`, aptosRPC, e.aptosAccount, e.aptosHandle, nativeSeq)
+
+	body, err := e.retrievePayload(s)
+	if err != nil {
+		return 0, fmt.Errorf("retrievePayload: %w", err)
+	}
+
+	if !gjson.Valid(string(body)) {
+		return 0, fmt.Errorf("InvalidJson: %s", string(body))
+	}
+
+	return e.processReobservationBatch(ctx, logger, gjson.ParseBytes(body), nativeSeq), nil
+}
+
+// getChainID reads the chain ID from the ledger info of the specified RPC endpoint.
+func (e *Watcher) getChainID(ctx context.Context, aptosRPC string) (uint64, error) {
+	body, err := e.ccqGet(ctx, fmt.Sprintf("%s/v1", aptosRPC), "")
+	if err != nil {
+		return 0, err
+	}
+	chainID := gjson.GetBytes(body, "chain_id")
+	if !chainID.Exists() {
+		return 0, fmt.Errorf("ledger info does not have a chain id: %s", string(body))
+	}
+	return chainID.Uint(), nil
+}
+
+// verifyChainID checks that the endpoint is on the same chain as the configured one, so that messages are never
+// reobserved from another network.
+func (e *Watcher) verifyChainID(ctx context.Context, aptosRPC string) error {
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	expected, err := e.getChainID(timeout, e.aptosRPC)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain id of the configured endpoint: %w", err)
+	}
+	actual, err := e.getChainID(timeout, aptosRPC)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain id of the endpoint: %w", err)
+	}
+
+	e.logger.Info("queried aptos chain id", zap.Uint64("expected", expected), zap.Uint64("actual", actual))
+	if actual != expected {
+		return fmt.Errorf("aptos chain id mismatch, expected %d, received %d", expected, actual)
+	}
+	return nil
+}
+
+// Reobserve is the interface for reobserving using a custom URL. It checks that the URL is on the same chain as the configured one
+// and does the reobservation against it instead of the configured one.
+func (e *Watcher) Reobserve(ctx context.Context, chainID vaa.ChainID, txID []byte, customEndpoint string) (uint32, error) {
+	e.logger.Info("received a request to reobserve using a custom endpoint", zap.Stringer("chainID", chainID), zap.Any("txID", txID), zap.String("url", customEndpoint))
+
+	if chainID != e.chainID {
+		return 0, fmt.Errorf("unexpected chain id: %v", chainID)
+	}
+
+	if err := e.verifyChainID(ctx, customEndpoint); err != nil {
+		return 0, err
+	}
+
+	return e.reobserve(ctx, e.logger, customEndpoint, txID)
+}
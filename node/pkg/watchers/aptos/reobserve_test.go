@@ -0,0 +1,127 @@
+package aptos
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// newFakeAptosEventsNode serves the ledger info of the chain with the specified chain ID and the Wormhole event handle of
+// testAptosAccount. Each event is keyed by its sequence number.
+func newFakeAptosEventsNode(t *testing.T, chainID uint64, events map[uint64]map[string]any) *httptest.Server {
+	eventsPath := fmt.Sprintf("/v1/accounts/%s/events/%s/event", testAptosAccount, testAptosHandle)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1" {
+			fmt.Fprintf(w, `{"chain_id":%d}`, chainID)
+			return
+		}
+		if r.URL.Path != eventsPath {
+			http.Error(w, `{"error_code":"resource_not_found"}`, http.StatusNotFound)
+			return
+		}
+		require.Equal(t, "1", r.URL.Query().Get("limit"))
+		start, err := strconv.ParseUint(r.URL.Query().Get("start"), 10, 64)
+		require.NoError(t, err)
+
+		batch := []map[string]any{}
+		if event, exists := events[start]; exists {
+			batch = append(batch, event)
+		}
+		fmt.Fprint(w, marshalJSON(t, batch))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// aptosReobservationTxID builds a reobservation TxID in the 32 byte format used by the watcher, with the event sequence in the last eight bytes.
+func aptosReobservationTxID(seq uint64) []byte {
+	txID := make([]byte, 32)
+	binary.BigEndian.PutUint64(txID[24:], seq)
+	return txID
+}
+
+// newTestReobserveWatcher creates a watcher whose configured endpoint is a fake node on mainnet.
+func newTestReobserveWatcher(t *testing.T) (*Watcher, chan *common.MessagePublication) {
+	msgC := make(chan *common.MessagePublication, 10)
+	configured := newFakeAptosEventsNode(t, 1, nil)
+	w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", configured.URL, testAptosAccount, testAptosHandle, msgC, nil, nil, nil)
+	require.NoError(t, err)
+	w.logger = zap.NewNop()
+	return w, msgC
+}
+
+func TestReobserveWithEndpoint(t *testing.T) {
+	server := newFakeAptosEventsNode(t, 1, map[uint64]map[string]any{100: pollEvent()})
+	w, msgC := newTestReobserveWatcher(t)
+
+	numObs, err := w.Reobserve(context.Background(), vaa.ChainIDAptos, aptosReobservationTxID(100), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), numObs)
+	require.Len(t, msgC, 1)
+
+	msg := <-msgC
+	require.True(t, msg.IsReobservation)
+	require.Equal(t, aptosReobservationTxID(100), msg.TxID)
+	require.Equal(t, uint64(7), msg.Sequence)
+}
+
+func TestReobserveWithEndpointNoMessage(t *testing.T) {
+	badType := pollEvent()
+	badType["type"] = testAptosAccount + "::state::NotAWormholeMessage"
+
+	server := newFakeAptosEventsNode(t, 1, map[uint64]map[string]any{100: badType})
+
+	tests := []struct {
+		name string
+		seq  uint64
+	}{
+		{name: "unknown event", seq: 101},
+		{name: "wrong event type", seq: 100},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w, msgC := newTestReobserveWatcher(t)
+			numObs, err := w.Reobserve(context.Background(), vaa.ChainIDAptos, aptosReobservationTxID(tc.seq), server.URL)
+			require.NoError(t, err)
+			require.Equal(t, uint32(0), numObs)
+			require.Empty(t, msgC)
+		})
+	}
+}
+
+func TestReobserveWithEndpointErrors(t *testing.T) {
+	server := newFakeAptosEventsNode(t, 1, map[uint64]map[string]any{100: pollEvent()})
+	testnet := newFakeAptosEventsNode(t, 2, map[uint64]map[string]any{100: pollEvent()})
+
+	tests := []struct {
+		name     string
+		chainID  vaa.ChainID
+		txID     []byte
+		endpoint string
+	}{
+		{name: "wrong chain", chainID: vaa.ChainIDSui, txID: aptosReobservationTxID(100), endpoint: server.URL},
+		{name: "wrong network", chainID: vaa.ChainIDAptos, txID: aptosReobservationTxID(100), endpoint: testnet.URL},
+		{name: "tx id too short", chainID: vaa.ChainIDAptos, txID: aptosReobservationTxID(100)[24:], endpoint: server.URL},
+		{name: "endpoint unreachable", chainID: vaa.ChainIDAptos, txID: aptosReobservationTxID(100), endpoint: "http://127.0.0.1:1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w, msgC := newTestReobserveWatcher(t)
+			numObs, err := w.Reobserve(context.Background(), tc.chainID, tc.txID, tc.endpoint)
+			require.Error(t, err)
+			require.Equal(t, uint32(0), numObs)
+			require.Empty(t, msgC)
+		})
+	}
+}
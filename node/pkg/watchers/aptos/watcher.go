@@ -13,8 +13,10 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/txverifier"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	eth_common "github.com/ethereum/go-ethereum/common"
 	"github.com/prometheus/client_golang/prometheus"
@@ -43,6 +45,15 @@ type (
 		msgC          chan<- *common.MessagePublication
 		obsvReqC      <-chan *gossipv1.ObservationRequest
 		readinessSync readiness.Component
+		logger        *zap.Logger
+
+		queryReqC      <-chan *query.PerChainQueryInternal
+		queryResponseC chan<- *query.PerChainQueryResponseInternal
+		ccqConfig      query.PerChainConfig
+		ccqLogger      *zap.Logger
+
+		// txVerifier checks token bridge transfers against the transaction that emitted them. Nil if disabled.
+		txVerifier *txverifier.AptosTransferVerifier
 	}
 )
 
@@ -58,6 +69,12 @@ var (
 			Name: "wormhole_aptos_current_height",
 			Help: "Current block height for the chain",
 		}, []string{"chain_name"})
+
+	aptosTransferVerifierFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_aptos_txverifier_failures",
+			Help: "Total number of messages that failed transfer verification",
+		}, []string{"chain_name"})
 )
 
 // NewWatcher creates a new Aptos appid watcher
@@ -69,6 +86,8 @@ func NewWatcher(
 	aptosHandle string,
 	msgC chan<- *common.MessagePublication,
 	obsvReqC <-chan *gossipv1.ObservationRequest,
+	queryReqC <-chan *query.PerChainQueryInternal,
+	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 ) (*Watcher, error) {
 
 	/*
@@ -120,6 +139,9 @@ func NewWatcher(
 		msgC:            msgC,
 		obsvReqC:        obsvReqC,
 		readinessSync:   common.MustConvertChainIdToReadinessSyncing(chainID),
+		queryReqC:       queryReqC,
+		queryResponseC:  queryResponseC,
+		ccqConfig:       query.GetPerChainConfig(chainID),
 	}, nil
 }
 
@@ -129,6 +151,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 	})
 
 	logger := supervisor.Logger(ctx)
+	e.logger = logger
 
 	logger.Info("Starting watcher",
 		zap.String("watcher_name", e.networkID),
@@ -160,12 +183,20 @@ func (e *Watcher) Run(ctx context.Context) error {
 	timer := time.NewTicker(time.Second * 1)
 	defer timer.Stop()
 
+	errC := make(chan error)
+	if e.ccqConfig.QueriesSupported() {
+		e.ccqLogger = logger.With(zap.String("component", "ccqaptos"))
+		e.ccqStart(ctx, errC)
+	}
+
 	supervisor.Signal(ctx, supervisor.SignalHealthy)
 
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case err := <-errC:
+			return fmt.Errorf("aptos watcher failed: %w", err)
 		case r := <-e.obsvReqC:
 			// node/pkg/node/reobserve.go already enforces the chain id is a valid uint16
 			// and only writes to the channel for this chain id.
@@ -174,44 +205,11 @@ func (e *Watcher) Run(ctx context.Context) error {
 				panic("invalid chain ID")
 			}
 
-			// Aptos's TxID is a uint64. Historically, all TxIDs used a fixed 32-byte hash type.
-			// This parsing is leftover from that time period. It should be possible to refactor
-			// this code such that the TxID received from p2p is exactly 8 bytes, which would
-			// obviate the need for the below bounds check and parsing.
-			//
-			// SECURITY: This acts as a bounds check for the BigEndian.Unint64 call below.
-			const AptosTxIDExpectedLen = 32
-			if len(r.TxHash) < AptosTxIDExpectedLen {
-				logger.Error("invalid TxID: too short")
+			if _, err := e.reobserve(ctx, logger, e.aptosRPC, r.TxHash); err != nil {
+				logger.Error("failed to process observation request", zap.Error(err))
 				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
-				continue
 			}
 
-			// uint64 will read the *first* 8 bytes, but the sequence is stored in the *last* 8.
-			nativeSeq := binary.BigEndian.Uint64(r.TxHash[24:])
-
-			logger.Info("Received obsv request", zap.Uint64("tx_hash", nativeSeq))
-
-			s := fmt.Sprintf(`%s?start=%d&limit=1`, eventsEndpoint, nativeSeq)
-
-			body, err := e.retrievePayload(s)
-			if err != nil {
-				logger.Error("retrievePayload", zap.Error(err))
-				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
-				continue
-			}
-
-			if !gjson.Valid(string(body)) {
-				logger.Error("InvalidJson: " + string(body))
-				p2p.DefaultRegistry.AddErrorCount(e.chainID, 1)
-				break
-
-			}
-
-			outcomes := gjson.ParseBytes(body)
-
-			e.processReobservationBatch(logger, outcomes, nativeSeq)
-
 		case <-timer.C:
 			s := ""
 
@@ -248,7 +246,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 			events := gjson.ParseBytes(eventsJson)
 
-			e.processPollingBatch(logger, events, &nextSequence)
+			e.processPollingBatch(ctx, logger, events, &nextSequence)
 
 			health, err := e.retrievePayload(aptosHealth)
 			if err != nil {
@@ -307,7 +305,7 @@ func (e *Watcher) retrievePayload(s string) ([]byte, error) {
 
 // processPollingBatch iterates the events returned by the polling endpoint,
 // advancing nextSequence as events are consumed.
-func (e *Watcher) processPollingBatch(logger *zap.Logger, events gjson.Result, nextSequence *uint64) {
+func (e *Watcher) processPollingBatch(ctx context.Context, logger *zap.Logger, events gjson.Result, nextSequence *uint64) {
 	// the endpoint returns an array of events, ordered by sequence id (ASC)
 	for _, event := range events.Array() {
 		eventSequence := event.Get("sequence_number")
@@ -349,13 +347,14 @@ func (e *Watcher) processPollingBatch(logger *zap.Logger, events gjson.Result, n
 		}
 
 		// Validates and publishes the message to the processor
-		e.observeData(logger, data, eventSeq, false)
+		e.observeData(ctx, logger, data, eventSeq, event.Get("version").Uint(), false)
 	}
 }
 
 // processReobsBatch handles the response to a reobservation lookup. The query
 // uses limit=1 so outcomes is expected to be a zero- or one-element array.
-func (e *Watcher) processReobservationBatch(logger *zap.Logger, outcomes gjson.Result, nativeSeq uint64) {
+// It returns the number of messages published.
+func (e *Watcher) processReobservationBatch(ctx context.Context, logger *zap.Logger, outcomes gjson.Result, nativeSeq uint64) (numObservations uint32) {
 	for _, aptosEvent := range outcomes.Array() {
 		newSeq := aptosEvent.Get("sequence_number")
 		if !newSeq.Exists() {
@@ -388,8 +387,11 @@ func (e *Watcher) processReobservationBatch(logger *zap.Logger, outcomes gjson.R
 		}
 
 		// Validates and publishes the message to the processor
-		e.observeData(logger, data, nativeSeq, true)
+		if e.observeData(ctx, logger, data, nativeSeq, aptosEvent.Get("version").Uint(), true) {
+			numObservations++
+		}
 	}
+	return
 }
 
 // stripHexadecimalPrefix strips an optional leading "0x" prefix so a configured value without it still
@@ -482,11 +484,13 @@ func (e *Watcher) verifyEventType(event gjson.Result) error {
 	return nil
 }
 
-func (e *Watcher) observeData(logger *zap.Logger, data gjson.Result, nativeSeq uint64, isReobservation bool) {
+// observeData validates a WormholeMessage event and publishes it. `version` is the ledger version of the transaction that
+// emitted the event, which is used by the transfer verifier. It returns true if a message was published.
+func (e *Watcher) observeData(ctx context.Context, logger *zap.Logger, data gjson.Result, nativeSeq uint64, version uint64, isReobservation bool) bool {
 	em := data.Get("sender")
 	if !em.Exists() {
 		logger.Error("sender field missing")
-		return
+		return false
 	}
 
 	emitter := make([]byte, 8)
@@ -506,53 +510,53 @@ func (e *Watcher) observeData(logger *zap.Logger, data gjson.Result, nativeSeq u
 	v := data.Get("payload")
 	if !v.Exists() {
 		logger.Error("payload field missing")
-		return
+		return false
 	}
 
 	s := v.String()
 	if !strings.HasPrefix(s, "0x") {
 		logger.Error("payload missing 0x prefix", zap.String("payload", s))
-		return
+		return false
 	}
 
 	pl, err := hex.DecodeString(stripHexadecimalPrefix(s))
 	if err != nil {
 		logger.Error("payload decode", zap.Error(err))
-		return
+		return false
 	}
 
 	ts := data.Get("timestamp")
 	if !ts.Exists() {
 		logger.Error("timestamp field missing")
-		return
+		return false
 	}
 
 	nonce := data.Get("nonce")
 	if !nonce.Exists() {
 		logger.Error("nonce field missing")
-		return
+		return false
 	}
 
 	sequence := data.Get("sequence")
 	if !sequence.Exists() {
 		logger.Error("sequence field missing")
-		return
+		return false
 	}
 
 	consistencyLevel := data.Get("consistency_level")
 	if !consistencyLevel.Exists() {
 		logger.Error("consistencyLevel field missing")
-		return
+		return false
 	}
 
 	if nonce.Uint() > math.MaxUint32 {
 		logger.Error("nonce is larger than expected MaxUint32")
-		return
+		return false
 	}
 
 	if consistencyLevel.Uint() > math.MaxUint8 {
 		logger.Error("consistency level is larger than expected MaxUint8")
-		return
+		return false
 	}
 
 	observation := &common.MessagePublication{
@@ -568,6 +572,18 @@ func (e *Watcher) observeData(logger *zap.Logger, data gjson.Result, nativeSeq u
 		Unreliable:       false,
 	}
 
+	if e.txVerifier != nil {
+		verifiedMsg, err := e.verify(ctx, observation, version, logger)
+		if err != nil {
+			aptosTransferVerifierFailures.WithLabelValues(e.networkID).Inc()
+			logger.Error("Message publication error",
+				zap.Uint64("version", version),
+				zap.Error(err))
+			return false
+		}
+		observation = &verifiedMsg
+	}
+
 	aptosMessagesConfirmed.WithLabelValues(e.networkID).Inc()
 	if isReobservation {
 		watchers.ReobservationsByChain.WithLabelValues(e.chainID.String(), "std").Inc()
@@ -585,6 +601,7 @@ func (e *Watcher) observeData(logger *zap.Logger, data gjson.Result, nativeSeq u
 	)
 
 	e.msgC <- observation // Note on channel capacity: The channel to the processor is buffered and shared across chains, if it backs up we should stop processing new observations
+	return true
 }
 
 // logVersion retrieves the Aptos node version and logs it
@@ -1,6 +1,7 @@
 package aptos
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -170,7 +171,7 @@ func TestObserveData(t *testing.T) {
 
 			// Must not panic for any input.
 			require.NotPanics(t, func() {
-				w.observeData(logger, gjson.Parse(tc.json), tc.nativeSeq, false)
+				w.observeData(context.Background(), logger, gjson.Parse(tc.json), tc.nativeSeq, 0, false)
 			})
 
 			if tc.expectError != "" {
@@ -207,7 +208,7 @@ func TestObserveDataFields(t *testing.T) {
 		"consistency_level": "15"
 	}`
 
-	w.observeData(logger, gjson.Parse(json), 123, true)
+	w.observeData(context.Background(), logger, gjson.Parse(json), 123, 0, true)
 	require.Len(t, msgC, 1)
 	msg := <-msgC
 
@@ -252,12 +253,12 @@ func TestObserveDataNonceTooLargeFails(t *testing.T) {
 		"consistency_level": "15"
 	}`
 
-	w.observeData(logger, gjson.Parse(json), 123, true)
+	w.observeData(context.Background(), logger, gjson.Parse(json), 123, 0, true)
 	require.Len(t, msgC, 0)
 }
 
 func TestVerifyEventType(t *testing.T) {
-	w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", testAptosAccount, testAptosHandle, nil, nil)
+	w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", testAptosAccount, testAptosHandle, nil, nil, nil, nil)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -376,7 +377,7 @@ func TestVerifyEventTypeShortFormAddress(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", tc.fullAddr, tc.fullAddr+"::module::EventHandle", nil, nil)
+			w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", tc.fullAddr, tc.fullAddr+"::module::EventHandle", nil, nil, nil, nil)
 			require.NoError(t, err)
 
 			event := map[string]any{
@@ -474,7 +475,7 @@ func TestNewWatcher(t *testing.T) {
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			w, err := NewWatcher(vaa.ChainIDAptos, "aptos", "http://localhost", tc.account, tc.handle, nil, nil)
+			w, err := NewWatcher(vaa.ChainIDAptos, "aptos", "http://localhost", tc.account, tc.handle, nil, nil, nil, nil)
 
 			if tc.expectError == "" {
 				require.NoError(t, err)
@@ -764,7 +765,7 @@ func TestProcessPollingBatch(t *testing.T) {
 			core, logs := observer.New(zapcore.ErrorLevel)
 			logger := zap.New(core)
 			msgC := make(chan *common.MessagePublication, 16)
-			w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", testAptosAccount, testAptosHandle, msgC, nil)
+			w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", testAptosAccount, testAptosHandle, msgC, nil, nil, nil)
 			require.NoError(t, err)
 
 			jsonStr := tc.rawJSON
@@ -773,7 +774,7 @@ func TestProcessPollingBatch(t *testing.T) {
 			}
 
 			nextSeq := tc.initialNextSeq
-			w.processPollingBatch(logger, gjson.Parse(jsonStr), &nextSeq)
+			w.processPollingBatch(context.Background(), logger, gjson.Parse(jsonStr), &nextSeq)
 
 			assertLoggedError(t, logs, tc.expectError)
 			assert.Equal(t, tc.expectedNextSeq, nextSeq)
@@ -853,7 +854,7 @@ func TestProcessReobs(t *testing.T) {
 			core, logs := observer.New(zapcore.ErrorLevel)
 			logger := zap.New(core)
 			msgC := make(chan *common.MessagePublication, 16)
-			w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", testAptosAccount, testAptosHandle, msgC, nil)
+			w, err := NewWatcher(vaa.ChainIDAptos, "aptos-test", "http://localhost", testAptosAccount, testAptosHandle, msgC, nil, nil, nil)
 			require.NoError(t, err)
 
 			jsonStr := tc.rawJSON
@@ -861,7 +862,7 @@ func TestProcessReobs(t *testing.T) {
 				jsonStr = encodeBatch(t, tc.events...)
 			}
 
-			w.processReobservationBatch(logger, gjson.Parse(jsonStr), tc.nativeSeq)
+			w.processReobservationBatch(context.Background(), logger, gjson.Parse(jsonStr), tc.nativeSeq)
 
 			assertLoggedError(t, logs, tc.expectError)
 			assert.Len(t, msgC, tc.expectedMsgCount)
@@ -0,0 +1,130 @@
+package cosmwasm
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/tidwall/gjson"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// reobserveTransaction queries the LCD endpoint at urlLCD for the specified transaction and publishes any Wormhole messages it contains.
+// It returns the number of messages published.
+func (e *Watcher) reobserveTransaction(ctx context.Context, logger *zap.Logger, urlLCD string, txHashBytes []byte) (uint32, error) {
+	// SECURITY: Directly using data for URL path is scary.
+	// Potential for directory traversal attacks to return the incorrect data
+	// This is hex encoded so it's acceptable but be careful changing this logic.
+	tx := hex.EncodeToString(txHashBytes)
+
+	logger.Info("received observation request", zap.String("network", e.networkName), zap.String("tx_hash", tx))
+
+	client := &http.Client{
+		Timeout: time.Second * 5,
+	}
+
+	// Query for tx by hash
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/cosmos/tx/v1beta1/txs/%s", urlLCD, tx), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create tx request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("query tx response error: %w", err)
+	}
+	txBody, err := common.SafeRead(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return 0, fmt.Errorf("query tx response read error: %w", err)
+	}
+
+	txJSON := string(txBody)
+
+	txHashRaw := gjson.Get(txJSON, "tx_response.txhash")
+	if !txHashRaw.Exists() {
+		return 0, fmt.Errorf("tx does not have tx hash: %s", txJSON)
+	}
+	txHash := txHashRaw.String()
+
+	events := gjson.Get(txJSON, "tx_response.events")
+	if !events.Exists() {
+		return 0, fmt.Errorf("tx has no events: %s", txJSON)
+	}
+
+	msgs := EventsToMessagePublications(e.contract, txHash, events.Array(), logger, e.chainID, e.contractAddressLogKey, e.b64Encoded)
+	for _, msg := range msgs {
+		msg.IsReobservation = true
+		e.msgC <- msg // Note on channel capacity: The channel to the processor is buffered and shared across chains, if it backs up we should stop processing new observations
+		messagesConfirmed.WithLabelValues(e.networkName).Inc()
+		watchers.ReobservationsByChain.WithLabelValues(e.networkName, "std").Inc()
+	}
+
+	return uint32(len(msgs)), nil // #nosec G115 -- The number of messages in a transaction is small
+}
+
+// getNetwork queries the LCD endpoint at urlLCD for the cosmos chain id of the node, such as "phoenix-1".
+func getNetwork(ctx context.Context, urlLCD string) (string, error) {
+	client := &http.Client{
+		Timeout: time.Second * 5,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/cosmos/base/tendermint/v1beta1/node_info", urlLCD), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create node info request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("query node info response error: %w", err)
+	}
+	body, err := common.SafeRead(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("query node info response read error: %w", err)
+	}
+
+	network := gjson.GetBytes(body, "default_node_info.network")
+	if !network.Exists() {
+		return "", fmt.Errorf("node info does not have a network: %s", string(body))
+	}
+	return network.String(), nil
+}
+
+// verifyNetwork checks that the LCD endpoint at urlLCD is on the same cosmos chain as the configured one, so that
+// messages are never reobserved from another network.
+func (e *Watcher) verifyNetwork(ctx context.Context, urlLCD string) error {
+	expected, err := getNetwork(ctx, e.urlLCD)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain id of the configured endpoint: %w", err)
+	}
+	actual, err := getNetwork(ctx, urlLCD)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain id of the endpoint: %w", err)
+	}
+
+	e.logger.Info("queried cosmos chain id", zap.String("network", e.networkName), zap.String("expected", expected), zap.String("actual", actual))
+	if actual != expected {
+		return fmt.Errorf("%s chain id mismatch, expected %s, received %s", e.networkName, expected, actual)
+	}
+	return nil
+}
+
+// Reobserve is the interface for reobserving using a custom URL. It checks that the URL is on the same cosmos chain as
+// the configured LCD endpoint and does the reobservation against it instead of the configured one.
+func (e *Watcher) Reobserve(ctx context.Context, chainID vaa.ChainID, txID []byte, customEndpoint string) (uint32, error) {
+	e.logger.Info("received a request to reobserve using a custom endpoint", zap.Stringer("chainID", chainID), zap.Any("txID", txID), zap.String("url", customEndpoint))
+
+	if chainID != e.chainID {
+		return 0, fmt.Errorf("unexpected chain id: %v", chainID)
+	}
+
+	if err := e.verifyNetwork(ctx, customEndpoint); err != nil {
+		return 0, err
+	}
+
+	return e.reobserveTransaction(ctx, e.logger, customEndpoint, txID)
+}
@@ -0,0 +1,143 @@
+package cosmwasm
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	testContract = "terra14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9ssrc8au"
+	testSender   = "0000000000000000000000000000000000000000000000000000000000000004"
+)
+
+var testTxHash = strings.Repeat("ab", 32)
+
+// testTxResponse builds an LCD tx response containing a single Wormhole message emitted by the specified contract.
+func testTxResponse(t *testing.T, contract string) string {
+	attrs := map[string]string{
+		"_contract_address":  contract,
+		"message.message":    "deadbeef",
+		"message.sender":     testSender,
+		"message.chain_id":   "18",
+		"message.nonce":      "42",
+		"message.sequence":   "1234",
+		"message.block_time": "1700000000",
+	}
+	attributes := make([]map[string]string, 0, len(attrs))
+	for k, v := range attrs {
+		attributes = append(attributes, map[string]string{"key": k, "value": v})
+	}
+	resp := map[string]any{
+		"tx_response": map[string]any{
+			"txhash": strings.ToUpper(testTxHash),
+			"events": []any{map[string]any{"type": "wasm", "attributes": attributes}},
+		},
+	}
+	b, err := json.Marshal(resp)
+	require.NoError(t, err)
+	return string(b)
+}
+
+// newFakeLCD serves the node info of the specified cosmos chain and the tx lookup endpoint of the LCD API, returning the
+// specified body for every known transaction.
+func newFakeLCD(t *testing.T, network string, txs map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cosmos/base/tendermint/v1beta1/node_info" {
+			_, _ = fmt.Fprintf(w, `{"default_node_info":{"network":%q}}`, network)
+			return
+		}
+		body, exists := txs[strings.TrimPrefix(r.URL.Path, "/cosmos/tx/v1beta1/txs/")]
+		if !exists {
+			http.Error(w, `{"code":5,"message":"tx not found"}`, http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestReobserveWatcher creates a watcher whose configured LCD endpoint is a fake node on Terra2 mainnet.
+func newTestReobserveWatcher(t *testing.T) (*Watcher, chan *common.MessagePublication) {
+	msgC := make(chan *common.MessagePublication, 10)
+	configured := newFakeLCD(t, "phoenix-1", nil)
+	w := NewWatcher("ws://unused", configured.URL, testContract, msgC, nil, vaa.ChainIDTerra2, common.MainNet)
+	w.logger = zap.NewNop()
+	return w, msgC
+}
+
+func TestReobserveWithEndpoint(t *testing.T) {
+	server := newFakeLCD(t, "phoenix-1", map[string]string{testTxHash: testTxResponse(t, testContract)})
+	w, msgC := newTestReobserveWatcher(t)
+
+	txID, err := hex.DecodeString(testTxHash)
+	require.NoError(t, err)
+
+	numObs, err := w.Reobserve(context.Background(), vaa.ChainIDTerra2, txID, server.URL)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), numObs)
+	require.Len(t, msgC, 1)
+
+	msg := <-msgC
+	require.True(t, msg.IsReobservation)
+	require.Equal(t, txID, msg.TxID)
+	require.Equal(t, vaa.ChainIDTerra2, msg.EmitterChain)
+	require.Equal(t, uint64(1234), msg.Sequence)
+	require.Equal(t, uint32(42), msg.Nonce)
+	require.Equal(t, testSender, msg.EmitterAddress.String())
+	require.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, msg.Payload)
+}
+
+func TestReobserveWithEndpointNoMessage(t *testing.T) {
+	server := newFakeLCD(t, "phoenix-1", map[string]string{testTxHash: testTxResponse(t, "terra1someothercontract")})
+	w, msgC := newTestReobserveWatcher(t)
+
+	txID, err := hex.DecodeString(testTxHash)
+	require.NoError(t, err)
+
+	numObs, err := w.Reobserve(context.Background(), vaa.ChainIDTerra2, txID, server.URL)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), numObs)
+	require.Empty(t, msgC)
+}
+
+func TestReobserveWithEndpointErrors(t *testing.T) {
+	server := newFakeLCD(t, "phoenix-1", map[string]string{testTxHash: testTxResponse(t, testContract)})
+	testnet := newFakeLCD(t, "pisco-1", map[string]string{testTxHash: testTxResponse(t, testContract)})
+	txID, err := hex.DecodeString(testTxHash)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		chainID  vaa.ChainID
+		txID     []byte
+		endpoint string
+		errText  string
+	}{
+		{name: "wrong chain", chainID: vaa.ChainIDInjective, txID: txID, endpoint: server.URL, errText: "unexpected chain id"},
+		{name: "unknown transaction", chainID: vaa.ChainIDTerra2, txID: make([]byte, 32), endpoint: server.URL, errText: "tx does not have tx hash"},
+		{name: "unreachable endpoint", chainID: vaa.ChainIDTerra2, txID: txID, endpoint: "http://127.0.0.1:0", errText: "failed to read the chain id of the endpoint"},
+		{name: "wrong network", chainID: vaa.ChainIDTerra2, txID: txID, endpoint: testnet.URL, errText: "terra2 chain id mismatch, expected phoenix-1, received pisco-1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w, msgC := newTestReobserveWatcher(t)
+			numObs, err := w.Reobserve(context.Background(), tc.chainID, tc.txID, tc.endpoint)
+			require.ErrorContains(t, err, tc.errText)
+			require.Equal(t, uint32(0), numObs)
+			require.Empty(t, msgC)
+		})
+	}
+}
@@ -12,7 +12,6 @@ import (
 
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
-	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -65,6 +64,9 @@ type (
 
 		// Human readable chain name
 		networkName string
+
+		// Logger, set when the watcher starts running
+		logger *zap.Logger
 	}
 )
 
@@ -150,6 +152,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 
 	errC := make(chan error)
 	logger := supervisor.Logger(ctx)
+	e.logger = logger
 
 	logger.Info("Starting watcher",
 		zap.String("watcher_name", "cosmwasm"),
@@ -257,54 +260,8 @@ func (e *Watcher) Run(ctx context.Context) error {
 					panic("invalid chain ID")
 				}
 
-				// SECURITY: Directly using data for URL path is scary.
-				// Potential for directory traversal attacks to return the incorrect data
-				// This is hex encoded so it's acceptable but be careful changing this logic.
-				tx := hex.EncodeToString(r.TxHash)
-
-				logger.Info("received observation request", zap.String("network", e.networkName), zap.String("tx_hash", tx))
-
-				client := &http.Client{
-					Timeout: time.Second * 5,
-				}
-
-				// Query for tx by hash
-				resp, err := client.Get(fmt.Sprintf("%s/cosmos/tx/v1beta1/txs/%s", e.urlLCD, tx)) //nolint:noctx // TODO FIXME we should propagate context with Deadline here.
-				if err != nil {
-					logger.Error("query tx response error", zap.String("network", e.networkName), zap.Error(err))
-					continue
-				}
-				txBody, err := common.SafeRead(resp.Body)
-				if err != nil {
-					logger.Error("query tx response read error", zap.String("network", e.networkName), zap.Error(err))
-					resp.Body.Close()
-					continue
-				}
-				resp.Body.Close()
-
-				txJSON := string(txBody)
-
-				txHashRaw := gjson.Get(txJSON, "tx_response.txhash")
-				if !txHashRaw.Exists() {
-					logger.Error("tx does not have tx hash", zap.String("network", e.networkName), zap.String("payload", txJSON))
-					continue
-				}
-				txHash := txHashRaw.String()
-
-				events := gjson.Get(txJSON, "tx_response.events")
-				if !events.Exists() {
-					logger.Error("tx has no events", zap.String("network", e.networkName), zap.String("payload", txJSON))
-					continue
-				}
-
-				contractAddressLogKey := e.contractAddressLogKey
-
-				msgs := EventsToMessagePublications(e.contract, txHash, events.Array(), logger, e.chainID, contractAddressLogKey, e.b64Encoded)
-				for _, msg := range msgs {
-					msg.IsReobservation = true
-					e.msgC <- msg // Note on channel capacity: The channel to the processor is buffered and shared across chains, if it backs up we should stop processing new observations
-					messagesConfirmed.WithLabelValues(e.networkName).Inc()
-					watchers.ReobservationsByChain.WithLabelValues(e.networkName, "std").Inc()
+				if _, err := e.reobserveTransaction(ctx, logger, e.urlLCD, r.TxHash); err != nil {
+					logger.Error("failed to process observation request", zap.String("network", e.networkName), zap.Error(err))
 				}
 			}
 		}
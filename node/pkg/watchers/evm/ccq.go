@@ -6,11 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	eth_common "github.com/ethereum/go-ethereum/common"
@@ -58,6 +60,10 @@ func (w *Watcher) QueryHandler(ctx context.Context, queryRequest *query.PerChain
 		w.ccqHandleEthCallByTimestampQueryRequest(ctx, queryRequest, req)
 	case *query.EthCallWithFinalityQueryRequest:
 		w.ccqHandleEthCallWithFinalityQueryRequest(ctx, queryRequest, req)
+	case *query.EthGetLogsQueryRequest:
+		w.ccqHandleEthGetLogsQueryRequest(ctx, queryRequest, req)
+	case *query.EthGetStorageAtQueryRequest:
+		w.ccqHandleEthGetStorageAtQueryRequest(ctx, queryRequest, req)
 	default:
 		w.ccqLogger.Warn("received unsupported request type",
 			zap.Uint8("payload", uint8(queryRequest.Request.Query.Type())),
@@ -640,6 +646,296 @@ func (w *Watcher) ccqHandleEthCallWithFinalityQueryRequest(ctx context.Context,
 	w.ccqSendQueryResponse(queryRequest, query.QuerySuccess, &resp)
 }
 
+// ccqHandleEthGetLogsQueryRequest is the query handler for an eth_get_logs request.
+func (w *Watcher) ccqHandleEthGetLogsQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.EthGetLogsQueryRequest) {
+	requestId := "eth_get_logs:" + queryRequest.ID()
+	w.ccqLogger.Info("received eth_get_logs query request",
+		zap.String("requestId", requestId),
+		zap.String("fromBlock", req.FromBlock),
+		zap.String("toBlock", req.ToBlock),
+		zap.Int("numAddresses", len(req.Addresses)),
+		zap.Int("numTopicPositions", len(req.Topics)),
+	)
+
+	fromBlock, toBlock, err := req.BlockRange()
+	if err != nil {
+		w.ccqLogger.Info("invalid block range in eth_get_logs query request",
+			zap.String("requestId", requestId),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryFatalError, nil)
+		return
+	}
+
+	// Read the logs and the last block in the range in a single batch.
+	var logs []types.Log
+	var blockResult connectors.BlockMarshaller
+	batch := []rpc.BatchElem{
+		{
+			Method: "eth_getLogs",
+			Args:   []interface{}{ccqBuildLogFilter(req, fromBlock, toBlock)},
+			Result: &logs,
+		},
+		{
+			Method: "eth_getBlockByNumber",
+			Args: []interface{}{
+				eth_hexutil.EncodeUint64(toBlock),
+				false, // no full transaction details
+			},
+			Result: &blockResult,
+		},
+	}
+
+	start := time.Now()
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err = w.ethConn.RawBatchCallContext(timeout, batch)
+	if err == nil {
+		err = errors.Join(batch[0].Error, batch[1].Error)
+	}
+	if err != nil {
+		w.ccqLogger.Info("failed to process eth_get_logs query request",
+			zap.String("requestId", requestId),
+			zap.Uint64("fromBlock", fromBlock),
+			zap.Uint64("toBlock", toBlock),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+		return
+	}
+
+	// Verify that the block read was successful. This also makes sure the end of the range has actually been reached.
+	if verifyErr := w.ccqVerifyBlockResult(nil, blockResult); verifyErr != nil {
+		w.ccqLogger.Debug("failed to verify block for eth_get_logs query",
+			zap.String("requestId", requestId),
+			zap.Uint64("toBlock", toBlock),
+			zap.Error(verifyErr),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+		return
+	}
+
+	results, status, err := ccqConvertLogs(logs, fromBlock, toBlock, blockResult.Hash)
+	if err != nil {
+		w.ccqLogger.Info("failed to process eth_get_logs query results",
+			zap.String("requestId", requestId),
+			zap.Uint64("fromBlock", fromBlock),
+			zap.Uint64("toBlock", toBlock),
+			zap.Int("numLogs", len(logs)),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, status, nil)
+		return
+	}
+
+	w.ccqLogger.Info("query complete for eth_get_logs",
+		zap.String("requestId", requestId),
+		zap.Uint64("fromBlock", fromBlock),
+		zap.Uint64("toBlock", toBlock),
+		zap.String("toBlockHash", blockResult.Hash.Hex()),
+		zap.Int("numLogs", len(results)),
+		zap.Int64("duration", time.Since(start).Milliseconds()),
+	)
+
+	resp := query.EthGetLogsQueryResponse{
+		FromBlockNumber: fromBlock,
+		ToBlockNumber:   toBlock,
+		ToBlockHash:     blockResult.Hash,
+		ToBlockTime:     time.Unix(int64(blockResult.Time), 0), // #nosec G115 -- This conversion is safe indefinitely
+		Logs:            results,
+	}
+
+	w.ccqSendQueryResponse(queryRequest, query.QuerySuccess, &resp)
+}
+
+// ccqBuildLogFilter builds the filter object passed to eth_getLogs. An empty topic position is passed as null, which matches anything.
+func ccqBuildLogFilter(req *query.EthGetLogsQueryRequest, fromBlock uint64, toBlock uint64) map[string]interface{} {
+	addresses := make([]eth_common.Address, 0, len(req.Addresses))
+	for _, addr := range req.Addresses {
+		addresses = append(addresses, eth_common.BytesToAddress(addr))
+	}
+
+	topics := make([]interface{}, 0, len(req.Topics))
+	for _, position := range req.Topics {
+		if len(position) == 0 {
+			topics = append(topics, nil)
+		} else {
+			topics = append(topics, position)
+		}
+	}
+
+	return map[string]interface{}{
+		"fromBlock": eth_hexutil.EncodeUint64(fromBlock),
+		"toBlock":   eth_hexutil.EncodeUint64(toBlock),
+		"address":   addresses,
+		"topics":    topics,
+	}
+}
+
+// ccqConvertLogs verifies the logs returned by eth_getLogs and converts them to the query response format. Logs that are inconsistent with
+// the block range or the hash of the last block indicate that the node is still catching up or there was a rollback, so a retry is requested.
+// Exceeding the response size caps will not change on a retry, so that is a fatal error.
+func ccqConvertLogs(logs []types.Log, fromBlock uint64, toBlock uint64, toBlockHash eth_common.Hash) ([]query.EthLog, query.QueryStatus, error) {
+	if len(logs) > query.EthGetLogsMaxLogs {
+		return nil, query.QueryFatalError, fmt.Errorf("too many logs returned: %d, may not be more than %d", len(logs), query.EthGetLogsMaxLogs)
+	}
+
+	results := make([]query.EthLog, 0, len(logs))
+	dataSize := 0
+	for idx, log := range logs {
+		if log.Removed {
+			return nil, query.QueryRetryNeeded, fmt.Errorf("log %d has been removed", idx)
+		}
+		if log.BlockNumber < fromBlock || log.BlockNumber > toBlock {
+			return nil, query.QueryRetryNeeded, fmt.Errorf("log %d is in block %d, which is outside of the requested range", idx, log.BlockNumber)
+		}
+		if log.BlockNumber == toBlock && log.BlockHash != toBlockHash {
+			return nil, query.QueryRetryNeeded, fmt.Errorf("log %d has block hash %s, expected %s", idx, log.BlockHash.Hex(), toBlockHash.Hex())
+		}
+		if log.Index > math.MaxUint32 {
+			return nil, query.QueryFatalError, fmt.Errorf("log %d has an invalid index %d", idx, log.Index)
+		}
+
+		dataSize += len(log.Data)
+		if dataSize > query.EthGetLogsMaxDataSize {
+			return nil, query.QueryFatalError, fmt.Errorf("log data too large, may not be more than %d bytes", query.EthGetLogsMaxDataSize)
+		}
+
+		results = append(results, query.EthLog{
+			BlockNumber: log.BlockNumber,
+			TxHash:      log.TxHash,
+			LogIndex:    uint32(log.Index), // #nosec G115 -- This is checked above
+			Address:     log.Address,
+			Topics:      log.Topics,
+			Data:        log.Data,
+		})
+	}
+
+	return results, query.QuerySuccess, nil
+}
+
+// ccqHandleEthGetStorageAtQueryRequest is the query handler for an eth_get_storage_at request.
+func (w *Watcher) ccqHandleEthGetStorageAtQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.EthGetStorageAtQueryRequest) {
+	requestId := "eth_get_storage_at:" + queryRequest.ID()
+	block := req.BlockId
+	w.ccqLogger.Info("received eth_get_storage_at query request",
+		zap.String("requestId", requestId),
+		zap.String("block", block),
+		zap.Int("numSlots", len(req.Slots)),
+	)
+
+	// Create the block query args.
+	blockMethod, callBlockArg, err := ccqCreateBlockRequest(block)
+	if err != nil {
+		w.ccqLogger.Info("invalid block id in eth_get_storage_at query request",
+			zap.String("requestId", requestId),
+			zap.String("block", block),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryFatalError, nil)
+		return
+	}
+
+	// Add each slot to the batch, followed by the block query.
+	batch := make([]rpc.BatchElem, 0, len(req.Slots)+1)
+	slotResults := make([]eth_hexutil.Bytes, len(req.Slots))
+	for idx, slot := range req.Slots {
+		batch = append(batch, rpc.BatchElem{
+			Method: "eth_getStorageAt",
+			Args: []interface{}{
+				eth_common.BytesToAddress(slot.Address),
+				slot.Slot,
+				callBlockArg,
+			},
+			Result: &slotResults[idx],
+		})
+	}
+
+	var blockResult connectors.BlockMarshaller
+	batch = append(batch, rpc.BatchElem{
+		Method: blockMethod,
+		Args: []interface{}{
+			block,
+			false, // no full transaction details
+		},
+		Result: &blockResult,
+	})
+
+	start := time.Now()
+	timeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	err = w.ethConn.RawBatchCallContext(timeout, batch)
+	if err != nil {
+		w.ccqLogger.Info("failed to process eth_get_storage_at query request",
+			zap.String("requestId", requestId),
+			zap.String("block", block),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+		return
+	}
+
+	// Verify that the block read was successful.
+	if verifyErr := w.ccqVerifyBlockResult(batch[len(batch)-1].Error, blockResult); verifyErr != nil {
+		w.ccqLogger.Debug("failed to verify block for eth_get_storage_at query",
+			zap.String("requestId", requestId),
+			zap.String("block", block),
+			zap.Error(verifyErr),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+		return
+	}
+
+	results, err := ccqExtractStorageResults(batch[:len(req.Slots)], slotResults)
+	if err != nil {
+		w.ccqLogger.Info("failed to process eth_get_storage_at query results",
+			zap.String("requestId", requestId),
+			zap.String("block", block),
+			zap.Error(err),
+		)
+		w.ccqSendQueryResponse(queryRequest, query.QueryRetryNeeded, nil)
+		return
+	}
+
+	w.ccqLogger.Info("query complete for eth_get_storage_at",
+		zap.String("requestId", requestId),
+		zap.String("block", block),
+		zap.String("blockNumber", blockResult.Number.String()),
+		zap.String("blockHash", blockResult.Hash.Hex()),
+		zap.String("blockTime", blockResult.Time.String()),
+		zap.Int64("duration", time.Since(start).Milliseconds()),
+	)
+
+	resp := query.EthGetStorageAtQueryResponse{
+		BlockNumber: blockResult.Number.ToInt().Uint64(),
+		Hash:        blockResult.Hash,
+		Time:        time.Unix(int64(blockResult.Time), 0), // #nosec G115 -- This conversion is safe indefinitely
+		Results:     results,
+	}
+
+	w.ccqSendQueryResponse(queryRequest, query.QuerySuccess, &resp)
+}
+
+// ccqExtractStorageResults verifies the results of the eth_getStorageAt calls and converts them to 32 byte words.
+// Some nodes return storage values without leading zeros, so shorter values are left padded.
+func ccqExtractStorageResults(batch []rpc.BatchElem, slotResults []eth_hexutil.Bytes) ([]eth_common.Hash, error) {
+	results := make([]eth_common.Hash, 0, len(slotResults))
+	for idx, result := range slotResults {
+		if batch[idx].Error != nil {
+			return nil, fmt.Errorf("slot %d failed: %w", idx, batch[idx].Error)
+		}
+		if len(result) == 0 {
+			return nil, fmt.Errorf("slot %d failed: result is empty", idx)
+		}
+		if len(result) > eth_common.HashLength {
+			return nil, fmt.Errorf("slot %d failed: result is too long", idx)
+		}
+		results = append(results, eth_common.BytesToHash(result))
+	}
+
+	return results, nil
+}
+
 // ccqCreateBlockRequest creates a block query. It parses the block string, allowing for both a block number or a block hash. Note that for now, strings like "latest", "finalized" or "safe"
 // are not supported, and the block must be a hex string starting with 0x. The determination of whether it is a block number or a block hash is based on the overall length of the string,
 // since a hash is 32 bytes (64 hex digits).
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"testing"
 
+	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -182,3 +184,136 @@ func TestCcqVerifyAndExtractQueryResults_Status(t *testing.T) {
 		})
 	}
 }
+
+func TestCcqConvertLogs(t *testing.T) {
+	toBlockHash := ethCommon.HexToHash("0x1234")
+	makeLog := func(blockNumber uint64, blockHash ethCommon.Hash, data []byte) types.Log {
+		return types.Log{
+			Address:     ethCommon.HexToAddress("0x2791bca1f2de4661ed88a30c99a7a9449aa84174"),
+			Topics:      []ethCommon.Hash{ethCommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")},
+			Data:        data,
+			BlockNumber: blockNumber,
+			TxHash:      ethCommon.HexToHash("0xabcd"),
+			BlockHash:   blockHash,
+			Index:       7,
+		}
+	}
+
+	tooManyLogs := make([]types.Log, query.EthGetLogsMaxLogs+1)
+	for idx := range tooManyLogs {
+		tooManyLogs[idx] = makeLog(100, ethCommon.HexToHash("0x01"), nil)
+	}
+
+	removedLog := makeLog(100, ethCommon.HexToHash("0x01"), nil)
+	removedLog.Removed = true
+
+	tests := []struct {
+		name   string
+		logs   []types.Log
+		status query.QueryStatus
+	}{
+		{name: "no logs", logs: []types.Log{}, status: query.QuerySuccess},
+		{name: "success", logs: []types.Log{makeLog(100, ethCommon.HexToHash("0x01"), []byte{0x01}), makeLog(110, toBlockHash, nil)}, status: query.QuerySuccess},
+		{name: "retry on log before range", logs: []types.Log{makeLog(99, ethCommon.HexToHash("0x01"), nil)}, status: query.QueryRetryNeeded},
+		{name: "retry on log after range", logs: []types.Log{makeLog(111, ethCommon.HexToHash("0x01"), nil)}, status: query.QueryRetryNeeded},
+		{name: "retry on block hash mismatch", logs: []types.Log{makeLog(110, ethCommon.HexToHash("0x01"), nil)}, status: query.QueryRetryNeeded},
+		{name: "retry on removed log", logs: []types.Log{removedLog}, status: query.QueryRetryNeeded},
+		{name: "fatal on too many logs", logs: tooManyLogs, status: query.QueryFatalError},
+		{name: "fatal on too much data", logs: []types.Log{makeLog(100, ethCommon.HexToHash("0x01"), make([]byte, query.EthGetLogsMaxDataSize+1))}, status: query.QueryFatalError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			results, status, err := ccqConvertLogs(tc.logs, 100, 110, toBlockHash)
+			assert.Equal(t, tc.status, status)
+			if tc.status != query.QuerySuccess {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, results, len(tc.logs))
+			for idx, log := range tc.logs {
+				assert.Equal(t, log.BlockNumber, results[idx].BlockNumber)
+				assert.Equal(t, log.TxHash, results[idx].TxHash)
+				assert.Equal(t, uint32(log.Index), results[idx].LogIndex) // #nosec G115 -- Test values are small
+				assert.Equal(t, log.Address, results[idx].Address)
+				assert.Equal(t, log.Topics, results[idx].Topics)
+				assert.Equal(t, log.Data, results[idx].Data)
+			}
+		})
+	}
+}
+
+func TestCcqBuildLogFilter(t *testing.T) {
+	req := &query.EthGetLogsQueryRequest{
+		FromBlock: "0x64",
+		ToBlock:   "0x6e",
+		Addresses: [][]byte{ethCommon.FromHex("0x2791bca1f2de4661ed88a30c99a7a9449aa84174")},
+		Topics: [][]ethCommon.Hash{
+			{ethCommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")},
+			{},
+			{ethCommon.HexToHash("0x01"), ethCommon.HexToHash("0x02")},
+		},
+	}
+
+	filter, err := json.Marshal(ccqBuildLogFilter(req, 100, 110))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"fromBlock": "0x64",
+		"toBlock": "0x6e",
+		"address": ["0x2791bca1f2de4661ed88a30c99a7a9449aa84174"],
+		"topics": [
+			["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+			null,
+			["0x0000000000000000000000000000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000000000000000000000000000002"]
+		]
+	}`, string(filter))
+}
+
+func TestCcqExtractStorageResults(t *testing.T) {
+	tests := []struct {
+		name     string
+		batch    []rpc.BatchElem
+		results  []hexutil.Bytes
+		expected []ethCommon.Hash
+		errMsg   string
+	}{
+		{
+			name:     "success",
+			batch:    []rpc.BatchElem{{}, {}},
+			results:  []hexutil.Bytes{ethCommon.HexToHash("0x2a").Bytes(), {0x01, 0x02}},
+			expected: []ethCommon.Hash{ethCommon.HexToHash("0x2a"), ethCommon.HexToHash("0x0102")},
+		},
+		{
+			name:    "call failed",
+			batch:   []rpc.BatchElem{{Error: errors.New("header not found")}},
+			results: []hexutil.Bytes{nil},
+			errMsg:  "slot 0 failed: header not found",
+		},
+		{
+			name:    "empty result",
+			batch:   []rpc.BatchElem{{}},
+			results: []hexutil.Bytes{{}},
+			errMsg:  "slot 0 failed: result is empty",
+		},
+		{
+			name:    "result too long",
+			batch:   []rpc.BatchElem{{}},
+			results: []hexutil.Bytes{make([]byte, 33)},
+			errMsg:  "slot 0 failed: result is too long",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			results, err := ccqExtractStorageResults(tc.batch, tc.results)
+			if tc.errMsg != "" {
+				require.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, results)
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package evm
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
@@ -16,6 +20,8 @@ type WatcherConfig struct {
 	NetworkID                  watchers.NetworkID // human readable name
 	ChainID                    vaa.ChainID        // ChainID
 	Rpc                        string             // RPC URL
+	QuorumRpcs                 []string           // Additional RPC URLs that must agree with Rpc, only used if RpcQuorum is greater than one
+	RpcQuorum                  int                // Number of RPC endpoints that must agree on every result
 	Contract                   string             // hex representation of the contract address
 	GuardianSetUpdateChain     bool               // if `true`, we will retrieve the GuardianSet from this chain and watch this chain for GuardianSet updates
 	DelegatedGuardiansContract string             // hex representation of the delegated guardians contract address
@@ -74,5 +80,66 @@ func (wc *WatcherConfig) Create(
 		wc.CcqBackfillCache,
 		wc.TxVerifierEnabled,
 	)
+	watcher.quorumUrls = wc.QuorumRpcs
+	watcher.rpcQuorum = wc.RpcQuorum
 	return watcher.Run, watcher, nil
 }
+
+// ApplyRpcQuorum enables the RPC quorum on the EVM watcher configs. Both quorums and rpcs are lists of "<network>=<value>" entries,
+// where the value is the number of endpoints that must agree for quorums and an additional RPC URL for rpcs. The configured Rpc
+// of the watcher counts as one of the endpoints. The quorum must be a strict majority of the endpoints.
+func ApplyRpcQuorum(configs []watchers.WatcherConfig, quorums []string, rpcs []string) error {
+	evmConfigs := map[watchers.NetworkID]*WatcherConfig{}
+	for _, c := range configs {
+		if wc, ok := c.(*WatcherConfig); ok {
+			evmConfigs[wc.NetworkID] = wc
+		}
+	}
+
+	lookup := func(entry string) (*WatcherConfig, string, error) {
+		network, value, found := strings.Cut(entry, "=")
+		if !found || network == "" || value == "" {
+			return nil, "", fmt.Errorf(`invalid entry "%s", expected <network>=<value>`, entry)
+		}
+		wc, exists := evmConfigs[watchers.NetworkID(network)]
+		if !exists {
+			return nil, "", fmt.Errorf(`network "%s" is not an enabled EVM network`, network)
+		}
+		return wc, value, nil
+	}
+
+	for _, entry := range quorums {
+		wc, value, err := lookup(entry)
+		if err != nil {
+			return err
+		}
+		if wc.RpcQuorum != 0 {
+			return fmt.Errorf(`quorum for network "%s" is specified more than once`, wc.NetworkID)
+		}
+		quorum, err := strconv.Atoi(value)
+		if err != nil || quorum < 1 {
+			return fmt.Errorf(`invalid quorum "%s" for network "%s"`, value, wc.NetworkID)
+		}
+		wc.RpcQuorum = quorum
+	}
+
+	for _, entry := range rpcs {
+		wc, value, err := lookup(entry)
+		if err != nil {
+			return err
+		}
+		wc.QuorumRpcs = append(wc.QuorumRpcs, value)
+	}
+
+	for _, wc := range evmConfigs {
+		numEndpoints := 1 + len(wc.QuorumRpcs)
+		if len(wc.QuorumRpcs) != 0 && wc.RpcQuorum == 0 {
+			return fmt.Errorf(`additional RPCs are specified for network "%s" but the quorum is not`, wc.NetworkID)
+		}
+		if wc.RpcQuorum != 0 && (wc.RpcQuorum > numEndpoints || 2*wc.RpcQuorum <= numEndpoints) {
+			return fmt.Errorf(`quorum %d for network "%s" is not a strict majority of its %d RPC endpoints`, wc.RpcQuorum, wc.NetworkID, numEndpoints)
+		}
+	}
+
+	return nil
+}
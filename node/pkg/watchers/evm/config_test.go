@@ -0,0 +1,50 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/watchers"
+	"github.com/certusone/wormhole/node/pkg/watchers/solana"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyRpcQuorum(t *testing.T) {
+	tests := []struct {
+		name           string
+		quorums        []string
+		rpcs           []string
+		errText        string
+		expectedQuorum int
+		expectedRpcs   []string
+	}{
+		{name: "not configured"},
+		{name: "two of three", quorums: []string{"eth=2"}, rpcs: []string{"eth=wss://b", "eth=wss://c"}, expectedQuorum: 2, expectedRpcs: []string{"wss://b", "wss://c"}},
+		{name: "quorum of one", quorums: []string{"eth=1"}, expectedQuorum: 1},
+		{name: "not a majority", quorums: []string{"eth=2"}, rpcs: []string{"eth=wss://b", "eth=wss://c", "eth=wss://d"}, errText: "not a strict majority"},
+		{name: "more than the endpoints", quorums: []string{"eth=3"}, rpcs: []string{"eth=wss://b"}, errText: "not a strict majority"},
+		{name: "rpcs without quorum", rpcs: []string{"eth=wss://b"}, errText: "the quorum is not"},
+		{name: "duplicate quorum", quorums: []string{"eth=2", "eth=2"}, rpcs: []string{"eth=wss://b"}, errText: "more than once"},
+		{name: "invalid quorum", quorums: []string{"eth=two"}, errText: "invalid quorum"},
+		{name: "zero quorum", quorums: []string{"eth=0"}, errText: "invalid quorum"},
+		{name: "missing value", quorums: []string{"eth"}, errText: "invalid entry"},
+		{name: "unknown network", quorums: []string{"bsc=2"}, errText: "not an enabled EVM network"},
+		{name: "non-EVM network", quorums: []string{"solana=2"}, errText: "not an enabled EVM network"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eth := &WatcherConfig{NetworkID: "eth", Rpc: "wss://a"}
+			configs := []watchers.WatcherConfig{eth, &solana.WatcherConfig{NetworkID: "solana"}}
+
+			err := ApplyRpcQuorum(configs, tc.quorums, tc.rpcs)
+			if tc.errText != "" {
+				require.ErrorContains(t, err, tc.errText)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedQuorum, eth.RpcQuorum)
+			assert.Equal(t, tc.expectedRpcs, eth.QuorumRpcs)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package connectors
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ContractCaller lets abigen bindings read contracts through a Connector rather than through its Client(), so that the
+// reads of a QuorumConnector are cross-checked like the rest of its requests.
+type ContractCaller struct {
+	conn Connector
+}
+
+func NewContractCaller(conn Connector) *ContractCaller {
+	return &ContractCaller{conn: conn}
+}
+
+func (c *ContractCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	var code hexutil.Bytes
+	err := c.conn.RawCallContext(ctx, &code, "eth_getCode", contract, toBlockNumArg(blockNumber))
+	return code, err
+}
+
+func (c *ContractCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var result hexutil.Bytes
+	err := c.conn.RawCallContext(ctx, &result, "eth_call", toCallArg(call), toBlockNumArg(blockNumber))
+	return result, err
+}
+
+// toBlockNumArg and toCallArg encode the arguments the same way as ethclient.
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	if number.Cmp(big.NewInt(-1)) == 0 {
+		return "pending"
+	}
+	return hexutil.EncodeBig(number)
+}
+
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	return arg
+}
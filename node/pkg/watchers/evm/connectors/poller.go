@@ -3,7 +3,6 @@ package connectors
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
@@ -12,6 +11,7 @@ import (
 
 	ethereum "github.com/ethereum/go-ethereum"
 	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethHexutil "github.com/ethereum/go-ethereum/common/hexutil"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	ethEvent "github.com/ethereum/go-ethereum/event"
@@ -198,18 +198,19 @@ func (p *PollConnector) watchLogMessagePublishedFrom(ctx context.Context, errC c
 						toBlock = fromBlock + p.MaxLogScanBlocks - 1
 					}
 
-					from := new(big.Int).SetUint64(fromBlock)
-					to := new(big.Int).SetUint64(toBlock)
-					// FilterLogs is a blocking RPC call; bound it with a per-iteration
+					// eth_getLogs is a blocking RPC call; bound it with a per-iteration
 					// timeout (matching GetBlock) so a stalled node can't wedge the
 					// poll loop. cancel() is called inline rather than deferred to
-					// avoid accumulating cancels across loop iterations.
+					// avoid accumulating cancels across loop iterations. The raw call
+					// is used rather than Client().FilterLogs so that the request goes
+					// through the underlying connector (e.g. a QuorumConnector).
 					timeout, cancel := context.WithTimeout(ctx, filterLogsTimeout)
-					logs, err := p.Client().FilterLogs(timeout, ethereum.FilterQuery{
-						FromBlock: from,
-						ToBlock:   to,
-						Addresses: []ethCommon.Address{p.ContractAddress()},
-						Topics:    [][]ethCommon.Hash{{logMessagePublishedTopic}},
+					var logs []ethTypes.Log
+					err := p.RawCallContext(timeout, &logs, "eth_getLogs", map[string]interface{}{
+						"fromBlock": ethHexutil.EncodeUint64(fromBlock),
+						"toBlock":   ethHexutil.EncodeUint64(toBlock),
+						"address":   []ethCommon.Address{p.ContractAddress()},
+						"topics":    [][]ethCommon.Hash{{logMessagePublishedTopic}},
 					})
 					cancel()
 					if err != nil {
@@ -0,0 +1,782 @@
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	dgAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/delegated_guardians"
+	ethAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethHexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	ethClient "github.com/ethereum/go-ethereum/ethclient"
+	ethEvent "github.com/ethereum/go-ethereum/event"
+	ethRpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"go.uber.org/zap"
+)
+
+// QuorumStragglerTimeout is how long a quorum request waits for the remaining endpoints once enough of them have
+// responded successfully to possibly reach quorum. Responses that arrive later are ignored.
+const QuorumStragglerTimeout = 500 * time.Millisecond
+
+// quorumVoteRetention is how long a subscription item that has been reported by at least one endpoint is remembered.
+const quorumVoteRetention = 10 * time.Minute
+
+// ErrNoQuorum is returned when not enough endpoints agree on a result.
+var ErrNoQuorum = errors.New("rpc endpoints did not reach quorum")
+
+var (
+	quorumEndpointLatency = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "wormhole_eth_quorum_endpoint_latency",
+			Help: "Latency histogram for RPC calls to each endpoint of a quorum connector",
+		}, []string{"eth_network", "endpoint", "method"})
+	quorumEndpointDisagreements = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_quorum_endpoint_disagreements_total",
+			Help: "Total number of results from an endpoint of a quorum connector that did not match the quorum result",
+		}, []string{"eth_network", "endpoint", "method"})
+	quorumEndpointErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_quorum_endpoint_errors_total",
+			Help: "Total number of failed RPC calls to an endpoint of a quorum connector",
+		}, []string{"eth_network", "endpoint", "method"})
+	quorumFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_quorum_failures_total",
+			Help: "Total number of requests for which the endpoints of a quorum connector did not reach quorum",
+		}, []string{"eth_network", "method"})
+)
+
+// primaryOnlyMethods are raw RPC methods whose results legitimately differ between endpoints. They are only sent to the primary endpoint.
+var primaryOnlyMethods = map[string]struct{}{
+	"web3_clientVersion": {},
+}
+
+// QuorumEndpoint is a single RPC endpoint used by a QuorumConnector.
+type QuorumEndpoint struct {
+	// Name identifies the endpoint in logs and metrics. It must not contain credentials.
+	Name      string
+	Connector Connector
+}
+
+// QuorumConnector fans out requests to several RPC endpoints for the same network and only returns results that at least
+// quorum of them agree on, so that a single lying or lagging provider cannot decide what the guardian observes. It is used
+// in place of an EthereumBaseConnector beneath PollConnector, BatchPollConnector and InstantFinalityConnector.
+//
+// Requests for the "latest", "safe" and "finalized" block tags resolve to the highest block number that at least quorum
+// endpoints have reached, and then require quorum agreement on that block. Logs and new heads from subscriptions are only
+// forwarded once quorum endpoints have reported them.
+//
+// Client() and ParseLogMessagePublished() are served by the first (primary) endpoint without any cross-checking.
+type QuorumConnector struct {
+	networkName string
+	logger      *zap.Logger
+	endpoints   []QuorumEndpoint
+	quorum      int
+}
+
+func NewQuorumConnector(networkName string, endpoints []QuorumEndpoint, quorum int, logger *zap.Logger) (*QuorumConnector, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one endpoint is required")
+	}
+
+	// SECURITY: Requiring a strict majority guarantees that at most one result can reach quorum.
+	if quorum < 1 || quorum > len(endpoints) || 2*quorum <= len(endpoints) {
+		return nil, fmt.Errorf("invalid quorum %d for %d endpoints, it must be a strict majority", quorum, len(endpoints))
+	}
+
+	names := make(map[string]struct{}, len(endpoints))
+	for _, ep := range endpoints {
+		if ep.Connector == nil {
+			return nil, fmt.Errorf("endpoint %s does not have a connector", ep.Name)
+		}
+		if _, exists := names[ep.Name]; exists {
+			return nil, fmt.Errorf("duplicate endpoint name %s", ep.Name)
+		}
+		names[ep.Name] = struct{}{}
+		if ep.Connector.ContractAddress() != endpoints[0].Connector.ContractAddress() {
+			return nil, fmt.Errorf("endpoint %s is configured with a different contract address", ep.Name)
+		}
+	}
+
+	return &QuorumConnector{
+		networkName: networkName,
+		logger:      logger,
+		endpoints:   endpoints,
+		quorum:      quorum,
+	}, nil
+}
+
+// NewEthereumQuorumConnector dials an EthereumBaseConnector for each of the specified URLs and creates a QuorumConnector from them.
+// The first URL is used as the primary endpoint.
+func NewEthereumQuorumConnector(ctx context.Context, networkName string, rawUrls []string, quorum int, address ethCommon.Address, delegatedGuardiansAddr *ethCommon.Address, logger *zap.Logger) (*QuorumConnector, error) {
+	endpoints := make([]QuorumEndpoint, 0, len(rawUrls))
+	closeAll := func() {
+		for _, ep := range endpoints {
+			ep.Connector.Close()
+		}
+	}
+
+	for idx, rawUrl := range rawUrls {
+		name := QuorumEndpointName(idx, rawUrl)
+		conn, err := NewEthereumBaseConnector(ctx, networkName, rawUrl, address, delegatedGuardiansAddr, logger.With(zap.String("endpoint", name)))
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("failed to dial endpoint %s: %w", name, err)
+		}
+		endpoints = append(endpoints, QuorumEndpoint{Name: name, Connector: conn})
+	}
+
+	q, err := NewQuorumConnector(networkName, endpoints, quorum, logger)
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	return q, nil
+}
+
+// QuorumEndpointName returns a name for an endpoint that can be used in logs and metrics. Only the host is used
+// because the rest of an RPC URL often contains an API key.
+func QuorumEndpointName(idx int, rawUrl string) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Hostname() == "" {
+		return strconv.Itoa(idx)
+	}
+	return fmt.Sprintf("%d:%s", idx, u.Hostname())
+}
+
+func (q *QuorumConnector) primary() Connector {
+	return q.endpoints[0].Connector
+}
+
+func (q *QuorumConnector) NetworkName() string {
+	return q.networkName
+}
+
+func (q *QuorumConnector) ContractAddress() ethCommon.Address {
+	return q.primary().ContractAddress()
+}
+
+func (q *QuorumConnector) GetCurrentGuardianSetIndex(ctx context.Context) (uint32, error) {
+	responses := quorumFanOut(ctx, q, "get_current_guardian_set_index", func(ctx context.Context, conn Connector) (uint32, error) {
+		return conn.GetCurrentGuardianSetIndex(ctx)
+	})
+	return quorumSelect(q, "get_current_guardian_set_index", responses, func(idx uint32) (string, error) {
+		return strconv.FormatUint(uint64(idx), 10), nil
+	})
+}
+
+func (q *QuorumConnector) GetGuardianSet(ctx context.Context, index uint32) (ethAbi.StructsGuardianSet, error) {
+	responses := quorumFanOut(ctx, q, "get_guardian_set", func(ctx context.Context, conn Connector) (ethAbi.StructsGuardianSet, error) {
+		return conn.GetGuardianSet(ctx, index)
+	})
+	return quorumSelect(q, "get_guardian_set", responses, func(gs ethAbi.StructsGuardianSet) (string, error) {
+		return fmt.Sprintf("%v", gs), nil
+	})
+}
+
+func (q *QuorumConnector) GetDelegatedGuardianConfig(ctx context.Context) ([]dgAbi.WormholeDelegatedGuardiansDelegatedGuardianSet, error) {
+	responses := quorumFanOut(ctx, q, "get_delegated_guardian_config", func(ctx context.Context, conn Connector) ([]dgAbi.WormholeDelegatedGuardiansDelegatedGuardianSet, error) {
+		return conn.GetDelegatedGuardianConfig(ctx)
+	})
+	return quorumSelect(q, "get_delegated_guardian_config", responses, func(cfg []dgAbi.WormholeDelegatedGuardiansDelegatedGuardianSet) (string, error) {
+		return fmt.Sprintf("%v", cfg), nil
+	})
+}
+
+// WatchLogMessagePublished subscribes to the logs on every endpoint and only forwards a log once quorum endpoints have reported it.
+func (q *QuorumConnector) WatchLogMessagePublished(ctx context.Context, errC chan error, sink chan<- *ethAbi.AbiLogMessagePublished) (ethEvent.Subscription, error) {
+	sinks := make([]chan *ethAbi.AbiLogMessagePublished, len(q.endpoints))
+	subs := make([]ethereum.Subscription, 0, len(q.endpoints))
+	for idx, ep := range q.endpoints {
+		sinks[idx] = make(chan *ethAbi.AbiLogMessagePublished, 2)
+		sub, err := ep.Connector.WatchLogMessagePublished(ctx, errC, sinks[idx])
+		if err != nil {
+			for _, s := range subs {
+				s.Unsubscribe()
+			}
+			return nil, fmt.Errorf("failed to watch logs on endpoint %s: %w", ep.Name, err)
+		}
+		subs = append(subs, sub)
+	}
+
+	return ethEvent.NewSubscription(func(quit <-chan struct{}) error {
+		return runQuorumSubscription(q, "watch_log_message_published", quit, sinks, subs, logMessagePublishedKey, func(ev *ethAbi.AbiLogMessagePublished) {
+			sink <- ev // Note on channel capacity: This channel is buffered, if it backs up, we will just stop reading until it clears
+		})
+	}), nil
+}
+
+func (q *QuorumConnector) TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*ethTypes.Receipt, error) {
+	responses := quorumFanOut(ctx, q, "transaction_receipt", func(ctx context.Context, conn Connector) (*ethTypes.Receipt, error) {
+		return conn.TransactionReceipt(ctx, txHash)
+	})
+	return quorumSelect(q, "transaction_receipt", responses, func(r *ethTypes.Receipt) (string, error) {
+		return receiptKey(r), nil
+	})
+}
+
+func (q *QuorumConnector) TimeOfBlockByHash(ctx context.Context, hash ethCommon.Hash) (uint64, error) {
+	responses := quorumFanOut(ctx, q, "time_of_block_by_hash", func(ctx context.Context, conn Connector) (uint64, error) {
+		return conn.TimeOfBlockByHash(ctx, hash)
+	})
+	return quorumSelect(q, "time_of_block_by_hash", responses, func(t uint64) (string, error) {
+		return strconv.FormatUint(t, 10), nil
+	})
+}
+
+func (q *QuorumConnector) ParseLogMessagePublished(log ethTypes.Log) (*ethAbi.AbiLogMessagePublished, error) {
+	return q.primary().ParseLogMessagePublished(log)
+}
+
+func (q *QuorumConnector) SubscribeForBlocks(ctx context.Context, errC chan error, sink chan<- *NewBlock) (ethereum.Subscription, error) {
+	panic("not implemented")
+}
+
+func (q *QuorumConnector) GetLatest(ctx context.Context) (latest, finalized, safe uint64, err error) {
+	panic("not implemented")
+}
+
+func (q *QuorumConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if _, exists := primaryOnlyMethods[method]; exists {
+		return q.primary().RawCallContext(ctx, result, method, args...)
+	}
+
+	responses := quorumFanOut(ctx, q, method, func(ctx context.Context, conn Connector) (json.RawMessage, error) {
+		var raw json.RawMessage
+		err := conn.RawCallContext(ctx, &raw, method, args...)
+		return raw, err
+	})
+
+	var raw json.RawMessage
+	var err error
+	if isBlockTagQuery(method, args) {
+		raw, err = q.resolveBlockTag(ctx, args, responses)
+	} else {
+		raw, err = quorumSelect(q, method, responses, rawResultKey(method))
+	}
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, result)
+}
+
+// RawBatchCallContext sends the whole batch to every endpoint and then checks each element for quorum individually.
+// It only returns an error if fewer than quorum endpoints responded to the batch. Per element failures, including
+// a lack of quorum, are reported in the Error field of that element.
+func (q *QuorumConnector) RawBatchCallContext(ctx context.Context, b []ethRpc.BatchElem) error {
+	responses := quorumFanOut(ctx, q, "batch", func(ctx context.Context, conn Connector) ([]ethRpc.BatchElem, error) {
+		batch := make([]ethRpc.BatchElem, len(b))
+		for idx := range b {
+			batch[idx] = ethRpc.BatchElem{
+				Method: b[idx].Method,
+				Args:   b[idx].Args,
+				Result: new(json.RawMessage),
+			}
+		}
+		err := conn.RawBatchCallContext(ctx, batch)
+		return batch, err
+	})
+
+	numSuccesses := 0
+	for _, r := range responses {
+		if r.err == nil {
+			numSuccesses++
+		}
+	}
+	if numSuccesses < q.quorum {
+		quorumFailures.WithLabelValues(q.networkName, "batch").Inc()
+		return fmt.Errorf("%w: only %d of %d endpoints responded to the batch, need %d: %w", ErrNoQuorum, numSuccesses, len(q.endpoints), q.quorum, firstError(responses))
+	}
+
+	for elemIdx := range b {
+		elemResponses := make([]quorumResponse[json.RawMessage], 0, len(responses))
+		for _, r := range responses {
+			if r.err != nil {
+				continue
+			}
+			elem := r.value[elemIdx]
+			elemResponses = append(elemResponses, quorumResponse[json.RawMessage]{
+				idx:   r.idx,
+				value: *elem.Result.(*json.RawMessage),
+				err:   elem.Error,
+			})
+		}
+
+		var raw json.RawMessage
+		var err error
+		if isBlockTagQuery(b[elemIdx].Method, b[elemIdx].Args) {
+			raw, err = q.resolveBlockTag(ctx, b[elemIdx].Args, elemResponses)
+		} else {
+			raw, err = quorumSelect(q, b[elemIdx].Method, elemResponses, rawResultKey(b[elemIdx].Method))
+		}
+		if err != nil {
+			b[elemIdx].Error = err
+			continue
+		}
+
+		if b[elemIdx].Result != nil {
+			if err := json.Unmarshal(raw, b[elemIdx].Result); err != nil {
+				b[elemIdx].Error = err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Client returns the client of the primary endpoint. Calls made through it are not checked against the other endpoints.
+func (q *QuorumConnector) Client() *ethClient.Client {
+	return q.primary().Client()
+}
+
+// SubscribeNewHead subscribes to new heads on every endpoint and only forwards a header once quorum endpoints have reported it.
+func (q *QuorumConnector) SubscribeNewHead(ctx context.Context, ch chan<- *ethTypes.Header) (ethereum.Subscription, error) {
+	sinks := make([]chan *ethTypes.Header, len(q.endpoints))
+	subs := make([]ethereum.Subscription, 0, len(q.endpoints))
+	for idx, ep := range q.endpoints {
+		sinks[idx] = make(chan *ethTypes.Header, 2)
+		sub, err := ep.Connector.SubscribeNewHead(ctx, sinks[idx])
+		if err != nil {
+			for _, s := range subs {
+				s.Unsubscribe()
+			}
+			return nil, fmt.Errorf("failed to subscribe to new heads on endpoint %s: %w", ep.Name, err)
+		}
+		subs = append(subs, sub)
+	}
+
+	return ethEvent.NewSubscription(func(quit <-chan struct{}) error {
+		return runQuorumSubscription(q, "subscribe_new_head", quit, sinks, subs, headerKey, func(h *ethTypes.Header) {
+			ch <- h // Note on channel capacity: This channel is buffered, if it backs up, we will just stop reading until it clears
+		})
+	}), nil
+}
+
+// Close releases all of the endpoints. Safe to call multiple times.
+func (q *QuorumConnector) Close() {
+	for _, ep := range q.endpoints {
+		ep.Connector.Close()
+	}
+}
+
+// resolveBlockTag takes the responses to an eth_getBlockByNumber request for a block tag (such as "finalized") and returns the
+// block at the highest number that at least quorum endpoints have reached. If the endpoints at that number do not already agree on
+// the block, it is requested by number from all endpoints.
+func (q *QuorumConnector) resolveBlockTag(ctx context.Context, args []interface{}, responses []quorumResponse[json.RawMessage]) (json.RawMessage, error) {
+	type blockResponse struct {
+		idx   int
+		raw   json.RawMessage
+		block *BlockMarshaller
+	}
+
+	blocks := make([]blockResponse, 0, len(responses))
+	for _, r := range responses {
+		if r.err != nil {
+			continue
+		}
+		var m *BlockMarshaller
+		if err := json.Unmarshal(r.value, &m); err != nil || m == nil || m.Number == nil {
+			q.logger.Warn("endpoint returned an invalid block", zap.String("endpoint", q.endpoints[r.idx].Name), zap.Any("tag", args[0]), zap.Error(err))
+			quorumEndpointErrors.WithLabelValues(q.networkName, q.endpoints[r.idx].Name, "eth_getBlockByNumber").Inc()
+			continue
+		}
+		blocks = append(blocks, blockResponse{idx: r.idx, raw: r.value, block: m})
+	}
+
+	if len(blocks) < q.quorum {
+		quorumFailures.WithLabelValues(q.networkName, "eth_getBlockByNumber").Inc()
+		return nil, fmt.Errorf("%w: only %d of %d endpoints returned a block for %v, need %d: %w", ErrNoQuorum, len(blocks), len(q.endpoints), args[0], q.quorum, firstError(responses))
+	}
+
+	heights := make([]uint64, len(blocks))
+	for idx, b := range blocks {
+		heights[idx] = b.block.Number.ToInt().Uint64()
+	}
+	slices.Sort(heights)
+	slices.Reverse(heights)
+	height := heights[q.quorum-1]
+
+	// Fast path: enough endpoints are at exactly that height and agree on the block.
+	atHeight := make([]quorumResponse[json.RawMessage], 0, len(blocks))
+	votes := map[string]int{}
+	for _, b := range blocks {
+		if b.block.Number.ToInt().Uint64() == height {
+			atHeight = append(atHeight, quorumResponse[json.RawMessage]{idx: b.idx, value: b.raw})
+			votes[blockKey(b.block)]++
+		}
+	}
+	for _, n := range votes {
+		if n >= q.quorum {
+			return quorumSelect(q, "eth_getBlockByNumber", atHeight, rawResultKey("eth_getBlockByNumber"))
+		}
+	}
+
+	// Otherwise ask everyone for the block at that height.
+	byNumberArgs := append([]interface{}{ethHexutil.EncodeUint64(height)}, args[1:]...)
+	byNumber := quorumFanOut(ctx, q, "eth_getBlockByNumber", func(ctx context.Context, conn Connector) (json.RawMessage, error) {
+		var raw json.RawMessage
+		err := conn.RawCallContext(ctx, &raw, "eth_getBlockByNumber", byNumberArgs...)
+		return raw, err
+	})
+	return quorumSelect(q, "eth_getBlockByNumber", byNumber, rawResultKey("eth_getBlockByNumber"))
+}
+
+// quorumResponse is the response of a single endpoint, identified by its index.
+type quorumResponse[T any] struct {
+	idx   int
+	value T
+	err   error
+}
+
+// quorumFanOut calls fn on every endpoint in parallel. It returns once every endpoint has responded, or once at least quorum endpoints
+// have responded successfully and QuorumStragglerTimeout has passed, or once the context is done, whichever is first.
+func quorumFanOut[T any](ctx context.Context, q *QuorumConnector, method string, fn func(ctx context.Context, conn Connector) (T, error)) []quorumResponse[T] {
+	respC := make(chan quorumResponse[T], len(q.endpoints))
+	for idx, ep := range q.endpoints {
+		go func() {
+			start := time.Now()
+			value, err := fn(ctx, ep.Connector)
+			if err == nil {
+				quorumEndpointLatency.WithLabelValues(q.networkName, ep.Name, method).Observe(time.Since(start).Seconds())
+			} else if !errors.Is(err, context.Canceled) {
+				quorumEndpointErrors.WithLabelValues(q.networkName, ep.Name, method).Inc()
+				q.logger.Debug("quorum endpoint request failed", zap.String("endpoint", ep.Name), zap.String("method", method), zap.Error(err))
+			}
+			respC <- quorumResponse[T]{idx: idx, value: value, err: err} // Note on channel capacity: Buffered for every endpoint, so this never blocks.
+		}()
+	}
+
+	responses := make([]quorumResponse[T], 0, len(q.endpoints))
+	numSuccesses := 0
+	var stragglerC <-chan time.Time
+	for len(responses) < len(q.endpoints) {
+		select {
+		case <-ctx.Done():
+			return responses
+		case <-stragglerC:
+			return responses
+		case r := <-respC:
+			responses = append(responses, r)
+			if r.err == nil {
+				numSuccesses++
+				if numSuccesses == q.quorum && len(responses) < len(q.endpoints) {
+					timer := time.NewTimer(QuorumStragglerTimeout)
+					defer timer.Stop()
+					stragglerC = timer.C
+				}
+			}
+		}
+	}
+	return responses
+}
+
+// quorumSelect returns the value that at least quorum endpoints agree on, where two values agree if they have the same key.
+// If quorum endpoints agree that the item does not exist, it returns ethereum.NotFound.
+func quorumSelect[T any](q *QuorumConnector, method string, responses []quorumResponse[T], key func(T) (string, error)) (T, error) {
+	var zero T
+	keys := make(map[int]string, len(responses))
+	votes := map[string]int{}
+	numNotFound := 0
+	for _, r := range responses {
+		if r.err != nil {
+			if errors.Is(r.err, ethereum.NotFound) {
+				numNotFound++
+			}
+			continue
+		}
+		k, err := key(r.value)
+		if err != nil {
+			q.logger.Warn("failed to interpret endpoint response", zap.String("endpoint", q.endpoints[r.idx].Name), zap.String("method", method), zap.Error(err))
+			quorumEndpointErrors.WithLabelValues(q.networkName, q.endpoints[r.idx].Name, method).Inc()
+			continue
+		}
+		keys[r.idx] = k
+		votes[k]++
+	}
+
+	if numNotFound >= q.quorum {
+		return zero, ethereum.NotFound
+	}
+
+	for _, r := range responses {
+		k, exists := keys[r.idx]
+		if !exists || votes[k] < q.quorum {
+			continue
+		}
+
+		for idx, other := range keys {
+			if other != k {
+				q.logger.Warn("endpoint disagrees with quorum", zap.String("endpoint", q.endpoints[idx].Name), zap.String("method", method))
+				quorumEndpointDisagreements.WithLabelValues(q.networkName, q.endpoints[idx].Name, method).Inc()
+			}
+		}
+		return r.value, nil
+	}
+
+	quorumFailures.WithLabelValues(q.networkName, method).Inc()
+	largest := 0
+	for _, n := range votes {
+		largest = max(largest, n)
+	}
+	err := fmt.Errorf("%w: %s: %d of %d endpoints responded and at most %d agree, need %d", ErrNoQuorum, method, len(keys), len(q.endpoints), largest, q.quorum)
+	if firstErr := firstError(responses); firstErr != nil {
+		err = fmt.Errorf("%w: %w", err, firstErr)
+	}
+	return zero, err
+}
+
+// firstError returns the first error in a set of responses, if any.
+func firstError[T any](responses []quorumResponse[T]) error {
+	for _, r := range responses {
+		if r.err != nil {
+			return r.err
+		}
+	}
+	return nil
+}
+
+// quorumVote tracks which endpoints have reported a subscription item.
+type quorumVote struct {
+	voters    map[int]struct{}
+	firstSeen time.Time
+	forwarded bool
+}
+
+// runQuorumSubscription merges the items delivered on the per endpoint channels and calls forward for each item the first time quorum
+// endpoints have reported it. It returns an error when fewer than quorum endpoint subscriptions remain alive.
+func runQuorumSubscription[T any](
+	q *QuorumConnector,
+	method string,
+	quit <-chan struct{},
+	sinks []chan T,
+	subs []ethereum.Subscription,
+	key func(T) ethCommon.Hash,
+	forward func(T),
+) error {
+	type endpointItem struct {
+		idx  int
+		item T
+	}
+	type endpointErr struct {
+		idx int
+		err error
+	}
+
+	done := make(chan struct{})
+	defer func() {
+		close(done)
+		for _, sub := range subs {
+			sub.Unsubscribe()
+		}
+	}()
+
+	itemC := make(chan endpointItem)
+	errC := make(chan endpointErr)
+	for idx := range subs {
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case item := <-sinks[idx]:
+					select {
+					case itemC <- endpointItem{idx: idx, item: item}:
+					case <-done:
+						return
+					}
+				case err := <-subs[idx].Err():
+					select {
+					case errC <- endpointErr{idx: idx, err: err}:
+					case <-done:
+					}
+					return
+				}
+			}
+		}()
+	}
+
+	votes := map[ethCommon.Hash]*quorumVote{}
+	numAlive := len(subs)
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-quit:
+			return nil
+		case e := <-errC:
+			numAlive--
+			q.logger.Error("quorum endpoint subscription failed", zap.String("endpoint", q.endpoints[e.idx].Name), zap.String("method", method), zap.Int("numAlive", numAlive), zap.Error(e.err))
+			quorumEndpointErrors.WithLabelValues(q.networkName, q.endpoints[e.idx].Name, method).Inc()
+			if numAlive < q.quorum {
+				return fmt.Errorf("%w: only %d endpoint subscriptions remain, need %d: %w", ErrNoQuorum, numAlive, q.quorum, e.err)
+			}
+		case ei := <-itemC:
+			k := key(ei.item)
+			vote, exists := votes[k]
+			if !exists {
+				vote = &quorumVote{voters: map[int]struct{}{}, firstSeen: time.Now()}
+				votes[k] = vote
+			}
+			vote.voters[ei.idx] = struct{}{}
+			if !vote.forwarded && len(vote.voters) >= q.quorum {
+				vote.forwarded = true
+				quorumEndpointLatency.WithLabelValues(q.networkName, q.endpoints[ei.idx].Name, method).Observe(time.Since(vote.firstSeen).Seconds())
+				forward(ei.item)
+			}
+		case <-ticker.C:
+			pruneQuorumVotes(q, method, votes, time.Now().Add(-quorumVoteRetention))
+		}
+	}
+}
+
+// pruneQuorumVotes drops the votes first seen before the cutoff. Endpoints that reported an item that never reached quorum, or that never
+// reported an item that did, are counted as disagreeing.
+func pruneQuorumVotes(q *QuorumConnector, method string, votes map[ethCommon.Hash]*quorumVote, cutoff time.Time) {
+	for k, vote := range votes {
+		if !vote.firstSeen.Before(cutoff) {
+			continue
+		}
+		for idx, ep := range q.endpoints {
+			_, voted := vote.voters[idx]
+			if voted != vote.forwarded {
+				quorumEndpointDisagreements.WithLabelValues(q.networkName, ep.Name, method).Inc()
+			}
+		}
+		delete(votes, k)
+	}
+}
+
+// isBlockTagQuery returns true if this is an eth_getBlockByNumber request for a block tag rather than a block number.
+func isBlockTagQuery(method string, args []interface{}) bool {
+	if method != "eth_getBlockByNumber" || len(args) == 0 {
+		return false
+	}
+	tag, ok := args[0].(string)
+	return ok && (tag == "latest" || tag == "safe" || tag == "finalized")
+}
+
+// rawResultKey returns a function that computes the key used to compare raw results of the specified method. Results are decoded
+// first where possible so that formatting differences and fields not relevant to the watcher do not cause disagreements.
+func rawResultKey(method string) func(json.RawMessage) (string, error) {
+	return func(raw json.RawMessage) (string, error) {
+		switch method {
+		case "eth_getBlockByNumber", "eth_getBlockByHash":
+			var m *BlockMarshaller
+			if err := json.Unmarshal(raw, &m); err != nil {
+				return "", err
+			}
+			return blockKey(m), nil
+		case "eth_getTransactionReceipt":
+			var r *ethTypes.Receipt
+			if err := json.Unmarshal(raw, &r); err != nil {
+				return "", err
+			}
+			return receiptKey(r), nil
+		case "eth_getLogs":
+			var logs []ethTypes.Log
+			if err := json.Unmarshal(raw, &logs); err != nil {
+				return "", err
+			}
+			return logsKey(logs), nil
+		default:
+			var v interface{}
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return "", err
+			}
+			// Marshaling the decoded value sorts object keys, making the key independent of field order.
+			b, err := json.Marshal(v)
+			return string(b), err
+		}
+	}
+}
+
+// blockKey identifies a block by the fields used by the watcher.
+func blockKey(m *BlockMarshaller) string {
+	if m == nil {
+		return "null"
+	}
+	return fmt.Sprintf("%v:%s:%d:%v", m.Number, m.Hash.Hex(), m.Time, m.L1BlockNumber)
+}
+
+// receiptKey is a hash over the fields of a receipt used by the watcher.
+func receiptKey(r *ethTypes.Receipt) string {
+	if r == nil {
+		return "null"
+	}
+	data := make([]byte, 0, 256)
+	data = append(data, r.TxHash.Bytes()...)
+	data = append(data, r.BlockHash.Bytes()...)
+	if r.BlockNumber != nil {
+		data = append(data, r.BlockNumber.Bytes()...)
+	}
+	data = strconv.AppendUint(data, r.Status, 10)
+	return ethCrypto.Keccak256Hash(data, []byte(logsKey(derefLogs(r.Logs)))).Hex()
+}
+
+// logsKey is a hash over the fields of a list of logs.
+func logsKey(logs []ethTypes.Log) string {
+	data := make([]byte, 0, 256*len(logs))
+	for _, l := range logs {
+		data = append(data, logKey(&l).Bytes()...)
+	}
+	return ethCrypto.Keccak256Hash(data).Hex()
+}
+
+// logKey is a hash over the fields of a log that identify it and its contents.
+func logKey(l *ethTypes.Log) ethCommon.Hash {
+	data := make([]byte, 0, 256+len(l.Data))
+	data = append(data, l.Address.Bytes()...)
+	for _, topic := range l.Topics {
+		data = append(data, topic.Bytes()...)
+	}
+	data = append(data, l.TxHash.Bytes()...)
+	data = append(data, l.BlockHash.Bytes()...)
+	data = strconv.AppendUint(data, l.BlockNumber, 10)
+	data = strconv.AppendUint(data, uint64(l.Index), 10)
+	data = strconv.AppendBool(data, l.Removed)
+	return ethCrypto.Keccak256Hash(data, ethCrypto.Keccak256(l.Data))
+}
+
+func derefLogs(logs []*ethTypes.Log) []ethTypes.Log {
+	ret := make([]ethTypes.Log, 0, len(logs))
+	for _, l := range logs {
+		if l != nil {
+			ret = append(ret, *l)
+		}
+	}
+	return ret
+}
+
+func logMessagePublishedKey(ev *ethAbi.AbiLogMessagePublished) ethCommon.Hash {
+	if ev == nil {
+		return ethCommon.Hash{}
+	}
+	return logKey(&ev.Raw)
+}
+
+func headerKey(h *ethTypes.Header) ethCommon.Hash {
+	if h == nil {
+		return ethCommon.Hash{}
+	}
+	return h.Hash()
+}
@@ -0,0 +1,429 @@
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	ethAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethHexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	ethEvent "github.com/ethereum/go-ethereum/event"
+	ethRpc "github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// mockQuorumEndpoint serves blocks for the block tags and numbers it knows about. Methods of Connector that are not overridden panic.
+type mockQuorumEndpoint struct {
+	Connector
+	mutex    sync.Mutex
+	address  ethCommon.Address
+	err      error
+	tags     map[string]uint64         // block tag to block number
+	hashes   map[uint64]ethCommon.Hash // block number to hash, defaults to a hash derived from the number
+	receipt  *ethTypes.Receipt
+	logs     chan *ethAbi.AbiLogMessagePublished
+	call     string // result of eth_call
+	numCalls int
+}
+
+func newMockQuorumEndpoint(latest, safe, finalized uint64) *mockQuorumEndpoint {
+	return &mockQuorumEndpoint{
+		tags:   map[string]uint64{"latest": latest, "safe": safe, "finalized": finalized},
+		hashes: map[uint64]ethCommon.Hash{},
+	}
+}
+
+func mockBlockHash(number uint64) ethCommon.Hash {
+	return ethCommon.BigToHash(new(big.Int).SetUint64(number + 0x1000))
+}
+
+func (m *mockQuorumEndpoint) ContractAddress() ethCommon.Address { return m.address }
+func (m *mockQuorumEndpoint) Close()                             {}
+
+func (m *mockQuorumEndpoint) block(arg interface{}) (json.RawMessage, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.numCalls++
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	str, ok := arg.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected arg type %T", arg)
+	}
+	number, exists := m.tags[str]
+	if !exists {
+		n, err := ethHexutil.DecodeUint64(str)
+		if err != nil {
+			return nil, err
+		}
+		number = n
+	}
+	hash, exists := m.hashes[number]
+	if !exists {
+		hash = mockBlockHash(number)
+	}
+	return json.RawMessage(fmt.Sprintf(`{"number":"0x%x","hash":"%s","timestamp":"0x%x","extra":"%p"}`, number, hash.Hex(), number*10, m)), nil
+}
+
+func (m *mockQuorumEndpoint) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if method == "eth_call" {
+		return json.Unmarshal([]byte(fmt.Sprintf("%q", m.call)), result)
+	}
+	if method != "eth_getBlockByNumber" {
+		return fmt.Errorf("unexpected method: %s", method)
+	}
+	raw, err := m.block(args[0])
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, result)
+}
+
+func (m *mockQuorumEndpoint) RawBatchCallContext(ctx context.Context, b []ethRpc.BatchElem) error {
+	for idx := range b {
+		if b[idx].Method != "eth_getBlockByNumber" {
+			b[idx].Error = fmt.Errorf("unexpected method: %s", b[idx].Method)
+			continue
+		}
+		raw, err := m.block(b[idx].Args[0])
+		if err != nil {
+			if errors.Is(err, errMockTransport) {
+				return err
+			}
+			b[idx].Error = err
+			continue
+		}
+		if err := json.Unmarshal(raw, b[idx].Result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockQuorumEndpoint) TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*ethTypes.Receipt, error) {
+	if m.receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return m.receipt, nil
+}
+
+func (m *mockQuorumEndpoint) WatchLogMessagePublished(ctx context.Context, errC chan error, sink chan<- *ethAbi.AbiLogMessagePublished) (ethEvent.Subscription, error) {
+	return ethEvent.NewSubscription(func(quit <-chan struct{}) error {
+		for {
+			select {
+			case <-quit:
+				return nil
+			case ev := <-m.logs:
+				sink <- ev
+			}
+		}
+	}), nil
+}
+
+var errMockTransport = errors.New("mock transport failure")
+
+func newTestQuorumConnector(t *testing.T, quorum int, endpoints ...*mockQuorumEndpoint) *QuorumConnector {
+	t.Helper()
+	eps := make([]QuorumEndpoint, len(endpoints))
+	for idx, ep := range endpoints {
+		eps[idx] = QuorumEndpoint{Name: fmt.Sprintf("ep%d", idx), Connector: ep}
+	}
+	q, err := NewQuorumConnector("mockQuorum", eps, quorum, zap.NewNop())
+	require.NoError(t, err)
+	return q
+}
+
+func TestNewQuorumConnector(t *testing.T) {
+	ep := func() QuorumEndpoint { return QuorumEndpoint{Connector: newMockQuorumEndpoint(0, 0, 0)} }
+	named := func(name string) QuorumEndpoint { e := ep(); e.Name = name; return e }
+
+	tests := []struct {
+		name      string
+		endpoints []QuorumEndpoint
+		quorum    int
+		errText   string
+	}{
+		{name: "single endpoint", endpoints: []QuorumEndpoint{named("a")}, quorum: 1},
+		{name: "two of three", endpoints: []QuorumEndpoint{named("a"), named("b"), named("c")}, quorum: 2},
+		{name: "three of four", endpoints: []QuorumEndpoint{named("a"), named("b"), named("c"), named("d")}, quorum: 3},
+		{name: "no endpoints", endpoints: nil, quorum: 1, errText: "at least one endpoint"},
+		{name: "zero quorum", endpoints: []QuorumEndpoint{named("a")}, quorum: 0, errText: "invalid quorum"},
+		{name: "quorum too large", endpoints: []QuorumEndpoint{named("a"), named("b")}, quorum: 3, errText: "invalid quorum"},
+		{name: "not a majority", endpoints: []QuorumEndpoint{named("a"), named("b"), named("c"), named("d")}, quorum: 2, errText: "invalid quorum"},
+		{name: "duplicate names", endpoints: []QuorumEndpoint{named("a"), named("a"), named("b")}, quorum: 2, errText: "duplicate endpoint name"},
+		{name: "missing connector", endpoints: []QuorumEndpoint{named("a"), {Name: "b"}, named("c")}, quorum: 2, errText: "does not have a connector"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewQuorumConnector("mockQuorum", tc.endpoints, tc.quorum, zap.NewNop())
+			if tc.errText == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errText)
+			}
+		})
+	}
+
+	other := newMockQuorumEndpoint(0, 0, 0)
+	other.address = ethCommon.HexToAddress("0x01")
+	_, err := NewQuorumConnector("mockQuorum", []QuorumEndpoint{named("a"), named("b"), {Name: "c", Connector: other}}, 2, zap.NewNop())
+	require.ErrorContains(t, err, "different contract address")
+}
+
+func TestQuorumEndpointName(t *testing.T) {
+	assert.Equal(t, "0:rpc.example.com", QuorumEndpointName(0, "https://rpc.example.com/v2/secretApiKey"))
+	assert.Equal(t, "1:localhost", QuorumEndpointName(1, "ws://localhost:8545"))
+	assert.Equal(t, "2", QuorumEndpointName(2, "not a url"))
+}
+
+func TestQuorumConnectorBlockTag(t *testing.T) {
+	tests := []struct {
+		name       string
+		finalized  []uint64
+		quorum     int
+		expected   uint64
+		errText    string
+		lieAtBlock bool // The last endpoint returns a different hash for every block.
+	}{
+		{name: "all agree", finalized: []uint64{100, 100, 100}, quorum: 2, expected: 100},
+		{name: "one ahead", finalized: []uint64{100, 100, 500}, quorum: 2, expected: 100},
+		{name: "one behind", finalized: []uint64{100, 99, 100}, quorum: 2, expected: 100},
+		{name: "all different", finalized: []uint64{100, 98, 99}, quorum: 2, expected: 99},
+		{name: "strict quorum", finalized: []uint64{100, 98, 99}, quorum: 3, expected: 98},
+		{name: "liar is outvoted", finalized: []uint64{100, 100, 100}, quorum: 2, expected: 100, lieAtBlock: true},
+		{name: "liar prevents unanimity", finalized: []uint64{100, 100, 100}, quorum: 3, errText: "did not reach quorum", lieAtBlock: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			endpoints := make([]*mockQuorumEndpoint, len(tc.finalized))
+			for idx, f := range tc.finalized {
+				endpoints[idx] = newMockQuorumEndpoint(f+10, f+5, f)
+			}
+			if tc.lieAtBlock {
+				liar := endpoints[len(endpoints)-1]
+				for n := uint64(0); n < 1000; n++ {
+					liar.hashes[n] = ethCommon.HexToHash("0xbad")
+				}
+			}
+			q := newTestQuorumConnector(t, tc.quorum, endpoints...)
+
+			block, err := GetBlockByFinality(context.Background(), q, Finalized)
+			if tc.errText != "" {
+				require.ErrorContains(t, err, tc.errText)
+				require.ErrorIs(t, err, ErrNoQuorum)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, block.Number.Uint64())
+			assert.Equal(t, mockBlockHash(tc.expected), block.Hash)
+			assert.Equal(t, tc.expected*10, block.Time)
+		})
+	}
+}
+
+func TestQuorumConnectorBlockTagEndpointErrors(t *testing.T) {
+	ep1 := newMockQuorumEndpoint(110, 105, 100)
+	ep2 := newMockQuorumEndpoint(110, 105, 100)
+	ep3 := newMockQuorumEndpoint(110, 105, 100)
+	q := newTestQuorumConnector(t, 2, ep1, ep2, ep3)
+
+	// One endpoint down is tolerated.
+	ep3.err = errors.New("endpoint down")
+	block, err := GetBlockByFinality(context.Background(), q, Finalized)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), block.Number.Uint64())
+
+	// Two endpoints down is not.
+	ep2.err = errors.New("endpoint down")
+	_, err = GetBlockByFinality(context.Background(), q, Finalized)
+	require.ErrorIs(t, err, ErrNoQuorum)
+}
+
+func TestQuorumConnectorBatch(t *testing.T) {
+	ep1 := newMockQuorumEndpoint(110, 105, 100)
+	ep2 := newMockQuorumEndpoint(109, 104, 99)
+	ep3 := newMockQuorumEndpoint(500, 500, 500)
+	ep3.hashes[50] = ethCommon.HexToHash("0xbad")
+	q := newTestQuorumConnector(t, 2, ep1, ep2, ep3)
+
+	results := make([]BlockMarshaller, 4)
+	batch := []ethRpc.BatchElem{
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"finalized", false}, Result: &results[0]},
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"safe", false}, Result: &results[1]},
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"latest", false}, Result: &results[2]},
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"0x32", false}, Result: &results[3]},
+	}
+	require.NoError(t, q.RawBatchCallContext(context.Background(), batch))
+
+	for idx, expected := range []uint64{100, 105, 110, 50} {
+		require.NoError(t, batch[idx].Error)
+		assert.Equal(t, expected, results[idx].Number.ToInt().Uint64())
+		assert.Equal(t, mockBlockHash(expected), results[idx].Hash)
+	}
+
+	// A block number that only one endpoint agrees with fails just that element.
+	ep2.hashes[60] = ethCommon.HexToHash("0xbad2")
+	ep3.hashes[60] = ethCommon.HexToHash("0xbad3")
+	batch = []ethRpc.BatchElem{
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"finalized", false}, Result: &results[0]},
+		{Method: "eth_getBlockByNumber", Args: []interface{}{"0x3c", false}, Result: &results[1]},
+	}
+	require.NoError(t, q.RawBatchCallContext(context.Background(), batch))
+	require.NoError(t, batch[0].Error)
+	require.ErrorIs(t, batch[1].Error, ErrNoQuorum)
+
+	// The batch fails if fewer than quorum endpoints respond at all.
+	ep1.err = errMockTransport
+	ep2.err = errMockTransport
+	err := q.RawBatchCallContext(context.Background(), batch)
+	require.ErrorIs(t, err, ErrNoQuorum)
+}
+
+func TestQuorumConnectorTransactionReceipt(t *testing.T) {
+	receipt := &ethTypes.Receipt{
+		Status:      ethTypes.ReceiptStatusSuccessful,
+		TxHash:      ethCommon.HexToHash("0x01"),
+		BlockHash:   ethCommon.HexToHash("0x02"),
+		BlockNumber: big.NewInt(100),
+		Logs:        []*ethTypes.Log{{Address: ethCommon.HexToAddress("0x03"), Data: []byte{1, 2, 3}}},
+	}
+	forged := &ethTypes.Receipt{
+		Status:      ethTypes.ReceiptStatusSuccessful,
+		TxHash:      ethCommon.HexToHash("0x01"),
+		BlockHash:   ethCommon.HexToHash("0x02"),
+		BlockNumber: big.NewInt(100),
+		Logs:        []*ethTypes.Log{{Address: ethCommon.HexToAddress("0x03"), Data: []byte{1, 2, 4}}},
+	}
+
+	ep1 := newMockQuorumEndpoint(0, 0, 0)
+	ep2 := newMockQuorumEndpoint(0, 0, 0)
+	ep3 := newMockQuorumEndpoint(0, 0, 0)
+	q := newTestQuorumConnector(t, 2, ep1, ep2, ep3)
+
+	// Quorum agrees that the transaction does not exist.
+	_, err := q.TransactionReceipt(context.Background(), receipt.TxHash)
+	require.ErrorIs(t, err, ethereum.NotFound)
+
+	// A single endpoint cannot forge a receipt.
+	ep3.receipt = forged
+	_, err = q.TransactionReceipt(context.Background(), receipt.TxHash)
+	require.ErrorIs(t, err, ethereum.NotFound)
+
+	ep1.receipt = receipt
+	_, err = q.TransactionReceipt(context.Background(), receipt.TxHash)
+	require.ErrorIs(t, err, ErrNoQuorum)
+
+	ep2.receipt = receipt
+	r, err := q.TransactionReceipt(context.Background(), receipt.TxHash)
+	require.NoError(t, err)
+	assert.Equal(t, receipt, r)
+}
+
+func TestQuorumConnectorContractCaller(t *testing.T) {
+	ep0 := newMockQuorumEndpoint(0, 0, 0)
+	ep1 := newMockQuorumEndpoint(0, 0, 0)
+	ep2 := newMockQuorumEndpoint(0, 0, 0)
+	ep0.call, ep1.call, ep2.call = "0x01", "0x02", "0x02"
+	caller := NewContractCaller(newTestQuorumConnector(t, 2, ep0, ep1, ep2))
+
+	// The primary endpoint is outvoted.
+	contract := ethCommon.HexToAddress("0x01")
+	result, err := caller.CallContract(context.Background(), ethereum.CallMsg{To: &contract}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02}, result)
+
+	ep1.call = "0x03"
+	_, err = caller.CallContract(context.Background(), ethereum.CallMsg{To: &contract}, nil)
+	require.ErrorIs(t, err, ErrNoQuorum)
+}
+
+func TestQuorumConnectorWatchLogMessagePublished(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	endpoints := make([]*mockQuorumEndpoint, 3)
+	for idx := range endpoints {
+		endpoints[idx] = newMockQuorumEndpoint(0, 0, 0)
+		endpoints[idx].logs = make(chan *ethAbi.AbiLogMessagePublished)
+	}
+	q := newTestQuorumConnector(t, 2, endpoints...)
+
+	sink := make(chan *ethAbi.AbiLogMessagePublished, 10)
+	sub, err := q.WatchLogMessagePublished(ctx, make(chan error, 1), sink)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	ev := func(seq uint64, data byte) *ethAbi.AbiLogMessagePublished {
+		return &ethAbi.AbiLogMessagePublished{
+			Sequence: seq,
+			Raw:      ethTypes.Log{TxHash: ethCommon.HexToHash("0x01"), Index: uint(seq), Data: []byte{data}},
+		}
+	}
+
+	// A log seen by a single endpoint is not forwarded.
+	endpoints[0].logs <- ev(1, 0xaa)
+	expectNoLog(t, sink)
+
+	// A different version of it from another endpoint does not count either.
+	endpoints[1].logs <- ev(1, 0xbb)
+	expectNoLog(t, sink)
+
+	// Once a quorum has reported the same log, it is forwarded exactly once.
+	endpoints[2].logs <- ev(1, 0xaa)
+	select {
+	case got := <-sink:
+		assert.Equal(t, uint64(1), got.Sequence)
+		assert.Equal(t, []byte{0xaa}, got.Raw.Data)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for log")
+	}
+
+	endpoints[1].logs <- ev(1, 0xaa)
+	expectNoLog(t, sink)
+}
+
+func expectNoLog(t *testing.T, sink chan *ethAbi.AbiLogMessagePublished) {
+	t.Helper()
+	select {
+	case got := <-sink:
+		t.Fatalf("unexpected log forwarded: %v", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestQuorumConnectorBeneathBatchPollConnector(t *testing.T) {
+	ep1 := newMockQuorumEndpoint(110, 105, 100)
+	ep2 := newMockQuorumEndpoint(112, 106, 101)
+	ep3 := newMockQuorumEndpoint(9999, 9999, 9999)
+	q := newTestQuorumConnector(t, 2, ep1, ep2, ep3)
+
+	poller := NewBatchPollConnector(context.Background(), zap.NewNop(), q, true, time.Second)
+	latest, finalized, safe, err := poller.GetLatest(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(112), latest)
+	assert.Equal(t, uint64(101), finalized)
+	assert.Equal(t, uint64(106), safe)
+
+	blocks, err := poller.getBlocks(context.Background(), zap.NewNop())
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	assert.Equal(t, uint64(101), blocks[0].Number.Uint64())
+	assert.Equal(t, uint64(106), blocks[1].Number.Uint64())
+}
@@ -25,11 +25,11 @@ import (
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 
+	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	cclAbi "github.com/certusone/wormhole/node/pkg/watchers/evm/custom_consistency_level_abi"
 
 	ethBind "github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethCommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 )
 
 // CCLRequestType used to represent the custom handling type.
@@ -123,7 +123,7 @@ func (w *Watcher) cclEnable(ctx context.Context) error {
 
 	// Do a test read on the contract to confirm it exists. This should not return anything, but it shouldn't fail!
 	// We use the free function here so we don't add the zero emitter to the cache.
-	_, err = CCLReadContract(ctx, w.ethConn.Client(), w.cclAddr, ethCommon.Address{})
+	_, err = CCLReadContract(ctx, connectors.NewContractCaller(w.ethConn), w.cclAddr, ethCommon.Address{})
 	if err != nil {
 		w.cclLogger.Error("failed to do test read on contract, disabling custom consistency level handling", zap.Stringer("contractAddr", w.cclAddr), zap.Error(err))
 		return nil
@@ -222,7 +222,7 @@ func (w *Watcher) cclReadContract(ctx context.Context, emitterAddr ethCommon.Add
 		return data, nil
 	}
 
-	data, err := CCLReadContract(ctx, w.ethConn.Client(), w.cclAddr, emitterAddr)
+	data, err := CCLReadContract(ctx, connectors.NewContractCaller(w.ethConn), w.cclAddr, emitterAddr)
 	if err != nil {
 		return cclEmptyData, err
 	}
@@ -234,7 +234,7 @@ func (w *Watcher) cclReadContract(ctx context.Context, emitterAddr ethCommon.Add
 
 // CCLReadContract calls into the contract to read the configuration for a given emitter.
 // This is a free function so it can be called by the config verification tool.
-func CCLReadContract(ctx context.Context, ethClient *ethclient.Client, cclAddr ethCommon.Address, emitterAddr ethCommon.Address) ([32]byte, error) {
+func CCLReadContract(ctx context.Context, ethClient ethBind.ContractCaller, cclAddr ethCommon.Address, emitterAddr ethCommon.Address) ([32]byte, error) {
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 
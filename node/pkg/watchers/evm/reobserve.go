@@ -153,8 +153,9 @@ func (w *Watcher) Reobserve(ctx context.Context, chainID vaa.ChainID, txID []byt
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 
-	// Connect to the node using the appropriate type of connector and the custom endpoint.
-	ethConn, _, _, err := w.createConnector(timeout, customEndpoint)
+	// Connect to the node using the appropriate type of connector and the custom endpoint. The endpoint is used on its
+	// own, since the point of reobserving with it is to not depend on the configured endpoints.
+	ethConn, _, _, err := w.createConnector(timeout, customEndpoint, false)
 	if err != nil {
 		return 0, fmt.Errorf(`failed to connect to endpoint "%v": %w`, customEndpoint, err)
 	}
@@ -89,6 +89,10 @@ type (
 	Watcher struct {
 		// EVM RPC url.
 		url string
+		// Additional EVM RPC urls that must agree with url. Only used if rpcQuorum is greater than one.
+		quorumUrls []string
+		// Number of RPC endpoints (url and quorumUrls) that must agree on every result.
+		rpcQuorum int
 		// Address of the EVM contract
 		contract eth_common.Address
 		// Human-readable name of the EVM network, for logging and monitoring.
@@ -286,15 +290,17 @@ func (w *Watcher) Run(parentCtx context.Context) error {
 	})
 
 	// Verify that we are connecting to the correct chain.
-	if verifyErr := w.verifyEvmChainID(ctx, logger, w.url); verifyErr != nil {
-		return fmt.Errorf("failed to verify evm chain id: %w", verifyErr)
+	for _, url := range w.rpcUrls() {
+		if verifyErr := w.verifyEvmChainID(ctx, logger, url); verifyErr != nil {
+			return fmt.Errorf("failed to verify evm chain id: %w", verifyErr)
+		}
 	}
 
 	// Connect to the node using the appropriate type of connector.
 	{
 		var finalizedPollingSupported, safePollingSupported bool
 		timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
-		w.ethConn, finalizedPollingSupported, safePollingSupported, err = w.createConnector(timeout, w.url)
+		w.ethConn, finalizedPollingSupported, safePollingSupported, err = w.createConnector(timeout, w.url, true)
 		cancel()
 		if err != nil {
 			ethConnectionErrors.WithLabelValues(w.networkName, "dial_error").Inc()
@@ -1244,18 +1250,36 @@ func msgIdFromLogEvent(chainID vaa.ChainID, ev *ethabi.AbiLogMessagePublished) s
 	return fmt.Sprintf("%v/%v/%v", uint16(chainID), PadAddress(ev.Sender), ev.Sequence)
 }
 
-// createConnector determines the type of connector needed for a chain and creates the appropriate one.
-func (w *Watcher) createConnector(ctx context.Context, url string) (ethConn connectors.Connector, finalizedPollingSupported, safePollingSupported bool, err error) {
+// createConnector determines the type of connector needed for a chain and creates the appropriate one. If useQuorum is
+// set and quorum is enabled, the url is combined with the additional quorum urls.
+func (w *Watcher) createConnector(ctx context.Context, url string, useQuorum bool) (ethConn connectors.Connector, finalizedPollingSupported, safePollingSupported bool, err error) {
 	finalizedPollingSupported, safePollingSupported, err = w.getFinality(ctx)
 	if err != nil {
 		err = fmt.Errorf("failed to determine finality: %w", err)
 		return
 	}
 
-	baseConnector, err := connectors.NewEthereumBaseConnector(ctx, w.networkName, url, w.contract, w.dgContractAddr, w.logger)
-	if err != nil {
-		err = fmt.Errorf("dialing eth client failed: %w", err)
-		return
+	var baseConnector connectors.Connector
+	if useQuorum && w.rpcQuorum > 1 {
+		urls := append([]string{url}, w.quorumUrls...)
+		for _, u := range urls {
+			if isHttpUrl(u) != isHttpUrl(url) {
+				err = fmt.Errorf("quorum endpoints must all use HTTP or all use WebSockets")
+				return
+			}
+		}
+		baseConnector, err = connectors.NewEthereumQuorumConnector(ctx, w.networkName, urls, w.rpcQuorum, w.contract, w.dgContractAddr, w.logger)
+		if err != nil {
+			err = fmt.Errorf("creating quorum connector failed: %w", err)
+			return
+		}
+		w.logger.Info("using quorum connector", zap.Int("numEndpoints", len(urls)), zap.Int("quorum", w.rpcQuorum))
+	} else {
+		baseConnector, err = connectors.NewEthereumBaseConnector(ctx, w.networkName, url, w.contract, w.dgContractAddr, w.logger)
+		if err != nil {
+			err = fmt.Errorf("dialing eth client failed: %w", err)
+			return
+		}
 	}
 
 	// We support three types of connectors:
@@ -1263,7 +1287,7 @@ func (w *Watcher) createConnector(ctx context.Context, url string) (ethConn conn
 	// - BatchPollConnector: for WebSocket RPCs, subscribes for latest heads, polls for finalized/safe.
 	// - InstantFinalityConnector: for chains with instant finality, subscribes for latest heads.
 	if finalizedPollingSupported {
-		if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+		if isHttpUrl(url) {
 			ethConn = connectors.NewPollConnector(ctx, w.logger, baseConnector, safePollingSupported, 1000*time.Millisecond, connectors.DefaultMaxLogScanBlocks)
 		} else {
 			ethConn = connectors.NewBatchPollConnector(ctx, w.logger, baseConnector, safePollingSupported, 1000*time.Millisecond)
@@ -1274,6 +1298,19 @@ func (w *Watcher) createConnector(ctx context.Context, url string) (ethConn conn
 	return
 }
 
+// rpcUrls returns the RPC urls used by the watcher. The additional quorum urls are only included if quorum is enabled.
+func (w *Watcher) rpcUrls() []string {
+	if w.rpcQuorum > 1 {
+		return append([]string{w.url}, w.quorumUrls...)
+	}
+	return []string{w.url}
+}
+
+// isHttpUrl returns true if the url uses HTTP rather than WebSockets.
+func isHttpUrl(url string) bool {
+	return strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://")
+}
+
 // consistencyLevelMatches returns true if the consistency level of this block "matches" the requested consistency level of an observation.
 // It matches if either the actual values match, or if this block is finalized and the requested value is not immediate (latest) or safe.
 // This extra check is necessary because the requested consistency level is assumed to be finalized unless they specifically ask for immediate or safe.
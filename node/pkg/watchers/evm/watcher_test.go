@@ -979,3 +979,24 @@ func TestConsistencyLevelMatches(t *testing.T) {
 	assert.False(t, consistencyLevelMatches(vaa.ConsistencyLevelPublishImmediately, 0))
 	assert.False(t, consistencyLevelMatches(vaa.ConsistencyLevelSafe, 0))
 }
+
+func TestCreateConnectorWithoutQuorum(t *testing.T) {
+	w := &Watcher{
+		env:        common.MainNet,
+		chainID:    vaa.ChainIDKlaytn,
+		url:        "ws://127.0.0.1:1",
+		rpcQuorum:  2,
+		quorumUrls: []string{"ws://127.0.0.1:2", "ws://127.0.0.1:3"},
+		contract:   eth_common.HexToAddress("0x0C21603c4f3a6387e241c0091A7EA39E43E90bb7"),
+	}
+
+	// A custom endpoint is not combined with the quorum urls, so an HTTP one can be used with WebSocket quorum urls.
+	_, _, _, err := w.createConnector(context.Background(), "http://127.0.0.1:4", true)
+	require.ErrorContains(t, err, "quorum endpoints must all use HTTP or all use WebSockets")
+
+	ethConn, _, _, err := w.createConnector(context.Background(), "http://127.0.0.1:4", false)
+	require.NoError(t, err)
+	defer ethConn.Close()
+	_, isQuorum := ethConn.(*connectors.QuorumConnector)
+	assert.False(t, isQuorum)
+}
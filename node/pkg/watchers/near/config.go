@@ -35,5 +35,6 @@ func (wc *WatcherConfig) Create(
 	env common.Environment,
 ) (supervisor.Runnable, interfaces.Reobserver, error) {
 	var mainnet = (env == common.MainNet)
-	return NewWatcher(wc.Rpc, wc.Contract, msgC, obsvReqC, mainnet).Run, nil, nil
+	watcher := NewWatcher(wc.Rpc, wc.Contract, msgC, obsvReqC, mainnet)
+	return watcher.Run, watcher, nil
 }
@@ -128,9 +128,9 @@ func (s *ForwardingCachingServer) ServeHTTP(w http.ResponseWriter, req *http.Req
 		return
 	}
 
-	// Mock the status request to return a version
+	// Mock the status request to return a version and the mainnet chain ID
 	if bytes.Contains(origReqBody, []byte("\"method\": \"status\"")) {
-		_, _ = w.Write([]byte(`{"id": "dontcare", "jsonrpc": "2.0", "result": {"version": "1.0.0"}}`))
+		_, _ = w.Write([]byte(`{"id": "dontcare", "jsonrpc": "2.0", "result": {"version": "1.0.0", "chain_id": "mainnet"}}`))
 		return
 	}
 
@@ -43,6 +43,7 @@ type (
 		GetChunk(ctx context.Context, chunkHeader ChunkHeader) (Chunk, error)
 		GetTxStatus(ctx context.Context, txHash string, senderAccountId string) ([]byte, error)
 		GetVersion(ctx context.Context) (string, error)
+		GetChainID(ctx context.Context) (string, error)
 	}
 	NearApiImpl struct {
 		nearRPC NearRpc
@@ -193,6 +194,17 @@ func (n NearApiImpl) GetVersion(ctx context.Context) (string, error) {
 	return version, nil
 }
 
+// GetChainID returns the chain ID of the network the node is on, such as "mainnet" or "testnet" (https://docs.near.org/api/rpc/network#node-status)
+func (n NearApiImpl) GetChainID(ctx context.Context) (string, error) {
+	s := `{"id": "dontcare", "jsonrpc": "2.0", "method": "status"}`
+	statusBytes, err := n.nearRPC.Query(ctx, s)
+	if err != nil {
+		return "", err
+	}
+
+	return ChainIDFromBytes(statusBytes)
+}
+
 func IsWellFormedHash(hash string) error {
 	hashBytes, err := base58.Decode(hash)
 	if err != nil {
@@ -98,6 +98,22 @@ func VersionFromBytes(bytes []byte) (string, error) {
 	return version, nil
 }
 
+func ChainIDFromBytes(bytes []byte) (string, error) {
+	if !gjson.ValidBytes(bytes) {
+		return "", errors.New("invalid json")
+	}
+
+	json := gjson.ParseBytes(bytes)
+
+	chainID := jsonGetString(json, "result.chain_id")
+
+	if chainID == "" {
+		return "", errors.New("invalid json")
+	}
+
+	return chainID, nil
+}
+
 func (b Block) Timestamp() uint64 {
 	ts_nanosec := jsonGetUint(b.json, "result.header.timestamp")
 	return ts_nanosec / 1000000000
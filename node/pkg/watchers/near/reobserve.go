@@ -0,0 +1,60 @@
+package near
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/watchers/near/nearapi"
+	"github.com/mr-tron/base58"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// verifyChainID checks that the NEAR API is on the same network as the configured endpoint, so that messages are never
+// reobserved from another network.
+func (e *Watcher) verifyChainID(ctx context.Context, nearAPI nearapi.NearApi) error {
+	expected, err := nearapi.NewNearApiImpl(nearapi.NewHttpNearRpc(e.nearRPC)).GetChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain id of the configured endpoint: %w", err)
+	}
+	actual, err := nearAPI.GetChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain id of the endpoint: %w", err)
+	}
+
+	e.logger.Info("queried near chain id", zap.String("expected", expected), zap.String("actual", actual))
+	if actual != expected {
+		return fmt.Errorf("near chain id mismatch, expected %s, received %s", expected, actual)
+	}
+	return nil
+}
+
+// Reobserve is the interface for reobserving using a custom URL. It creates a NEAR API client and finalizer for that URL,
+// checks that it is on the same network as the configured endpoint and processes the transaction once, rather than
+// queuing it for retries like a regular reobservation request.
+func (e *Watcher) Reobserve(ctx context.Context, chainID vaa.ChainID, txID []byte, customEndpoint string) (uint32, error) {
+	e.logger.Info("received a request to reobserve using a custom endpoint", zap.Stringer("chainID", chainID), zap.Any("txID", txID), zap.String("url", customEndpoint))
+
+	if chainID != vaa.ChainIDNear {
+		return 0, fmt.Errorf("unexpected chain id: %v", chainID)
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	nearAPI := nearapi.NewNearApiImpl(nearapi.NewHttpNearRpc(customEndpoint))
+	if err := e.verifyChainID(timeout, nearAPI); err != nil {
+		return 0, err
+	}
+	finalizer := newFinalizer(e.eventChan, nearAPI, e.mainnet)
+
+	// TODO The sender account id has the same problem as in runObsvReqProcessor, see the comment there.
+	job := newTransactionProcessingJob(base58.Encode(txID), e.wormholeAccount, true)
+
+	if err := e.processTx(e.logger, timeout, nearAPI, finalizer, job); err != nil {
+		return job.numObservations, fmt.Errorf("failed to process transaction: %w", err)
+	}
+
+	return job.numObservations, nil
+}
@@ -0,0 +1,83 @@
+package near
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	mockserver "github.com/certusone/wormhole/node/pkg/watchers/near/nearapi/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap/zaptest"
+)
+
+// newTestReobserveWatcher creates a watcher that has not been started, along with a mock NEAR RPC node serving the cached "success" data.
+// The mock node is also the configured endpoint of the watcher.
+func newTestReobserveWatcher(t *testing.T) (*Watcher, chan *common.MessagePublication, string) {
+	logger := zaptest.NewLogger(t)
+
+	mockServer := mockserver.NewForwardingCachingServer(logger, "", "nearapi/mock/success/", []string{"FdJXkyscWxFk8zrZHgahTGCBEcpo4huJNNnuxQ9hgFbW"})
+	mockHttpServer := httptest.NewServer(mockServer)
+	t.Cleanup(mockHttpServer.Close)
+
+	msgC := make(chan *common.MessagePublication, 10)
+	w := NewWatcher(mockHttpServer.URL, WORMHOLE_CONTRACT, msgC, nil, true)
+	w.logger = logger
+	return w, msgC, mockHttpServer.URL
+}
+
+func TestReobserveWithEndpoint(t *testing.T) {
+	w, msgC, endpoint := newTestReobserveWatcher(t)
+
+	pl, _ := hex.DecodeString("0100000000000000000000000000000000000000000000000000000000000f42400000000000000000000000000000000000000000000000000000000000000000000f0108bc32f7de18a5f6e1e7d6ee7aff9f5fc858d0d87ac0da94dd8d2a5d267d6b00160000000000000000000000000000000000000000000000000000000000000000")
+	txHashBytes, _ := hex.DecodeString("88029cf0e7432cec04c266a3e72903ee6650b4624c7f9c8e22b04d78e18e87f8")
+
+	numObs, err := w.Reobserve(context.Background(), vaa.ChainIDNear, txHashBytes, endpoint)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), numObs)
+	require.Len(t, msgC, 1)
+
+	require.Equal(t, &common.MessagePublication{
+		TxID:             txHashBytes,
+		EmitterAddress:   portalEmitterAddress(),
+		ConsistencyLevel: 0,
+		EmitterChain:     vaa.ChainIDNear,
+		Nonce:            76538233,
+		Payload:          pl,
+		Sequence:         261,
+		Timestamp:        time.Unix(int64(1666142886047190991)/1_000_000_000, 0),
+		IsReobservation:  true,
+		Unreliable:       false,
+	}, <-msgC)
+}
+
+func TestReobserveWithEndpointWrongChain(t *testing.T) {
+	w, msgC, endpoint := newTestReobserveWatcher(t)
+
+	txHashBytes, _ := hex.DecodeString("88029cf0e7432cec04c266a3e72903ee6650b4624c7f9c8e22b04d78e18e87f8")
+
+	numObs, err := w.Reobserve(context.Background(), vaa.ChainIDSolana, txHashBytes, endpoint)
+	require.Error(t, err)
+	require.Equal(t, uint32(0), numObs)
+	require.Empty(t, msgC)
+}
+
+func TestReobserveWithEndpointWrongNetwork(t *testing.T) {
+	w, msgC, _ := newTestReobserveWatcher(t)
+
+	testnet := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		_, _ = rw.Write([]byte(`{"id": "dontcare", "jsonrpc": "2.0", "result": {"version": "1.0.0", "chain_id": "testnet"}}`))
+	}))
+	t.Cleanup(testnet.Close)
+
+	txHashBytes, _ := hex.DecodeString("88029cf0e7432cec04c266a3e72903ee6650b4624c7f9c8e22b04d78e18e87f8")
+
+	numObs, err := w.Reobserve(context.Background(), vaa.ChainIDNear, txHashBytes, testnet.URL)
+	require.ErrorContains(t, err, "near chain id mismatch, expected mainnet, received testnet")
+	require.Equal(t, uint32(0), numObs)
+	require.Empty(t, msgC)
+}
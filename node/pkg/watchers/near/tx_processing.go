@@ -35,10 +35,11 @@ type NearWormholePublishEvent struct {
 // processTx fetches a transaction's receipt_outcomes and looks for wormhole messages in it.
 // we go through all receipt outcomes (result.receipts_outcome) and look for log emissions from the Wormhole core contract.
 // sender_account_id is required to help determine which shard to query.
-func (e *Watcher) processTx(logger *zap.Logger, ctx context.Context, job *transactionProcessingJob) error {
+// The NEAR API and finalizer are passed in so that reobservation requests can be served by a different RPC node.
+func (e *Watcher) processTx(logger *zap.Logger, ctx context.Context, nearAPI nearapi.NearApi, finalizer Finalizer, job *transactionProcessingJob) error {
 	logger.Debug("processTx", zap.String("log_msg_type", "info_process_tx"), zap.String("tx_hash", job.txHash))
 
-	tx_receipts, err := e.nearAPI.GetTxStatus(ctx, job.txHash, job.senderAccountId)
+	tx_receipts, err := nearAPI.GetTxStatus(ctx, job.txHash, job.senderAccountId)
 
 	if err != nil {
 		return err
@@ -53,7 +54,7 @@ func (e *Watcher) processTx(logger *zap.Logger, ctx context.Context, job *transa
 	}
 
 	for _, receiptOutcome := range receiptOutcomes.Array() {
-		err = e.processOutcome(logger, ctx, job, receiptOutcome)
+		err = e.processOutcome(logger, ctx, finalizer, job, receiptOutcome)
 		if err != nil {
 			logger.Debug("ProcessOutcome error: ", zap.Error(err))
 			return err
@@ -62,7 +63,7 @@ func (e *Watcher) processTx(logger *zap.Logger, ctx context.Context, job *transa
 	return nil
 }
 
-func (e *Watcher) processOutcome(logger *zap.Logger, ctx context.Context, job *transactionProcessingJob, receiptOutcome gjson.Result) error {
+func (e *Watcher) processOutcome(logger *zap.Logger, ctx context.Context, finalizer Finalizer, job *transactionProcessingJob, receiptOutcome gjson.Result) error {
 	outcome := receiptOutcome.Get("outcome")
 	if !outcome.Exists() {
 		logger.Warn("NEAR RPC malformed response: receipts_outcome.outcome does not exist", zap.String("error_type", "nearapi_inconsistent"), zap.String("json", receiptOutcome.Str))
@@ -98,7 +99,7 @@ func (e *Watcher) processOutcome(logger *zap.Logger, ctx context.Context, job *t
 	}
 
 	// SECURITY CRITICAL: Check that block has been finalized.
-	outcomeBlockHeader, isFinalized := e.finalizer.isFinalized(logger, ctx, outcomeBlockHash.String())
+	outcomeBlockHeader, isFinalized := finalizer.isFinalized(logger, ctx, outcomeBlockHash.String())
 	if !isFinalized {
 		// If it has not, we return an error such that this transaction can be put back into the queue.
 		return fmt.Errorf("block %s not finalized yet", outcomeBlockHash.String())
@@ -255,6 +256,7 @@ func (e *Watcher) processWormholeLog(logger *zap.Logger, _ context.Context, job
 
 	// tell everyone about it
 	job.hasWormholeMsg = true
+	job.numObservations++
 
 	e.eventChan <- EVENT_NEAR_MESSAGE_CONFIRMED // Note on channel capacity: Only pauses this watcher
 
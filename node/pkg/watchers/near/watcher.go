@@ -60,7 +60,8 @@ type (
 		isReobservation bool
 
 		// set during processing
-		hasWormholeMsg bool // set during processing; whether this transaction emitted a Wormhole message
+		hasWormholeMsg  bool   // set during processing; whether this transaction emitted a Wormhole message
+		numObservations uint32 // set during processing; the number of Wormhole messages published for this transaction
 	}
 
 	Watcher struct {
@@ -72,6 +73,7 @@ type (
 		msgC          chan<- *common.MessagePublication   // validated (SECURITY: and only validated!) observations go into this channel
 		obsvReqC      <-chan *gossipv1.ObservationRequest // observation requests are coming from this channel
 		readinessSync readiness.Component
+		logger        *zap.Logger
 
 		// internal queues
 		transactionProcessingQueueCounter atomic.Int64
@@ -122,6 +124,7 @@ func newTransactionProcessingJob(txHash string, senderAccountId string, isReobse
 		initialTxProcDelay,
 		isReobservation,
 		false,
+		0,
 	}
 }
 
@@ -234,7 +237,7 @@ func (e *Watcher) runTxProcessor(ctx context.Context) error {
 			return ctx.Err()
 
 		case job := <-e.transactionProcessingQueue:
-			processErr := e.processTx(logger, ctx, job)
+			processErr := e.processTx(logger, ctx, e.nearAPI, e.finalizer, job)
 			if processErr != nil {
 				// transaction processing unsuccessful. Retry if retry_counter not exceeded.
 				if job.retryCounter < txProcRetry {
@@ -275,6 +278,7 @@ func (e *Watcher) runTxProcessor(ctx context.Context) error {
 
 func (e *Watcher) Run(ctx context.Context) error {
 	logger := supervisor.Logger(ctx)
+	e.logger = logger
 
 	logger.Info("Starting watcher",
 		zap.String("watcher_name", "near"),
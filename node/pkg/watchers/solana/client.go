@@ -20,6 +20,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/txverifier"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/coder/websocket"
 	"github.com/gagliardetto/solana-go"
@@ -95,6 +96,17 @@ type (
 		// wormhole transaction to be observed. It is stored in the Watcher so it will survive a
 		// watcher restart, allowing us to continue on without gaps.
 		pollPrevWormholeSignature solana.Signature
+
+		// txVerifier checks token bridge transfers against the transaction that posted them. Nil if disabled.
+		txVerifier *txverifier.SolanaTransferVerifier
+
+		// geyserEndpoint is the URL of the Yellowstone Geyser gRPC endpoint to stream transactions from. Blocks are
+		// polled instead if it is empty.
+		geyserEndpoint string
+		geyserToken    string
+
+		// geyserLastSlotUpdate is the time of the last slot update received from the Geyser stream, in Unix nanoseconds.
+		geyserLastSlotUpdate int64
 	}
 
 	EventSubscriptionError struct {
@@ -240,6 +252,16 @@ var (
 			Name: "wormhole_solana_query_latency",
 			Help: "Latency histogram for Solana RPC calls",
 		}, []string{"solana_network", "operation", "commitment"})
+	solanaTransferVerifierFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_solana_txverifier_failures",
+			Help: "Total number of messages that failed transfer verification",
+		}, []string{"solana_network"})
+	solanaGeyserGapSlots = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_solana_geyser_gap_slots_total",
+			Help: "Total number of slots missed by the Geyser stream and fetched by polling",
+		}, []string{"solana_network", "commitment"})
 )
 
 const rpcTimeout = time.Second * 5
@@ -473,6 +495,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 		zap.String("shimContract", s.shimContractStr),
 		zap.Duration("pollInterval", pollInterval),
 		zap.Bool("pollForTx", s.pollForTx),
+		zap.String("geyserEndpoint", s.geyserEndpoint),
 	)
 
 	s.shimSetup()
@@ -493,13 +516,22 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 		}
 	}
 
+	useGeyser := false
+	if s.geyserEndpoint != "" {
+		if s.pollForTx || useWs {
+			// This would definitely be a program bug, so panic is appropriate.
+			panic(fmt.Sprintf("invalid config in %s watcher, cannot use the Geyser stream with useWS or pollForTx", s.chainID.String()))
+		}
+		useGeyser = true
+	}
+
 	// Get the node version for troubleshooting
 	s.logVersion(ctx, logger)
 
 	common.RunWithScissors(ctx, s.errC, "SolanaWatcher", func(ctx context.Context) error {
 		timer := time.NewTicker(pollInterval)
 		defer timer.Stop()
-		useStdPolling := (!s.pollForTx) && (!useWs)
+		useStdPolling := (!s.pollForTx) && (!useWs) && (!useGeyser)
 
 		for {
 			select {
@@ -564,23 +596,26 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 					logger.Debug("fetched current Solana height", zap.Uint64("slot", slot))
 				}
 
+				if useGeyser {
+					// The stream claims the slots it handles. Poll the ones it missed if it is down or stalled.
+					if !s.geyserStreaming() {
+						if lastSlot, claimed := s.claimSlots(slot); claimed {
+							if lastSlot == 0 {
+								lastSlot = slot - 1
+							}
+							s.fetchSlots(ctx, logger, lastSlot+1, slot)
+						}
+					}
+					continue
+				}
+
 				if useStdPolling {
 					lastSlot := atomic.LoadUint64(&s.lastSlot)
 					if lastSlot == 0 {
 						lastSlot = slot - 1
 					}
 
-					rangeStart := lastSlot + 1
-					rangeEnd := slot
-
-					// Requesting each slot
-					for slotIdx := rangeStart; slotIdx <= rangeEnd; slotIdx++ {
-						_slot := slotIdx
-						common.RunWithScissors(ctx, s.errC, "SolanaWatcherSlotFetcher", func(ctx context.Context) error {
-							s.retryFetchBlock(ctx, logger, _slot, 0, false)
-							return nil
-						})
-					}
+					s.fetchSlots(ctx, logger, lastSlot+1, slot)
 				}
 
 				atomic.StoreUint64(&s.lastSlot, slot)
@@ -592,6 +627,10 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 		common.RunWithScissors(ctx, s.errC, "SolanaTxProcessor", s.transactionProcessor)
 	}
 
+	if useGeyser {
+		common.RunWithScissors(ctx, s.errC, "SolanaGeyserProcessor", s.geyserProcessor)
+	}
+
 	if s.commitment == rpc.CommitmentType("finalized") && s.ccqConfig.QueriesSupported() {
 		s.ccqStart(ctx)
 	}
@@ -604,6 +643,17 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 	}
 }
 
+// fetchSlots fetches the blocks of a range of slots in parallel.
+func (s *SolanaWatcher) fetchSlots(ctx context.Context, logger *zap.Logger, first uint64, last uint64) {
+	for slotIdx := first; slotIdx <= last; slotIdx++ {
+		_slot := slotIdx
+		common.RunWithScissors(ctx, s.errC, "SolanaWatcherSlotFetcher", func(ctx context.Context) error {
+			s.retryFetchBlock(ctx, logger, _slot, 0, false)
+			return nil
+		})
+	}
+}
+
 func (s *SolanaWatcher) retryFetchBlock(ctx context.Context, logger *zap.Logger, slot uint64, retry uint, isReobservation bool) {
 	ok := s.fetchBlock(ctx, logger, slot, 0, isReobservation)
 
@@ -830,7 +880,7 @@ func (s *SolanaWatcher) processTransaction(ctx context.Context, rpcClient *rpc.C
 			// these during reobservation — the normal transaction stream must NOT
 			// generate new observations as message accounts are garbage-collected,
 			// because the original message was already observed when it was posted.
-			found, err := s.processClosePostedMessageEvent(s.logger, programIndex, tx, meta.InnerInstructions, i, inst, alreadyProcessed, signature)
+			found, err := s.processClosePostedMessageEvent(ctx, s.logger, programIndex, tx, meta.InnerInstructions, i, inst, alreadyProcessed, signature)
 			if err != nil {
 				s.logger.Error("malformed close_posted_message event",
 					zap.Error(err),
@@ -849,7 +899,7 @@ func (s *SolanaWatcher) processTransaction(ctx context.Context, rpcClient *rpc.C
 				}
 			}
 		} else {
-			found, err := s.processInstruction(ctx, rpcClient, slot, inst, programIndex, tx, signature, i, isReobservation)
+			found, err := s.processInstruction(ctx, rpcClient, slot, inst, programIndex, tx, meta, signature, i, isReobservation)
 			if err != nil {
 				s.logger.Error("malformed Wormhole instruction",
 					zap.Error(err),
@@ -899,7 +949,7 @@ func (s *SolanaWatcher) processTransaction(ctx context.Context, rpcClient *rpc.C
 					// program. Same reobservation-only policy as the top-level
 					// path: we never generate observations from the normal
 					// transaction stream for close events.
-					found, err := s.processInnerClosePostedMessageEvent(s.logger, programIndex, tx, inner.Instructions, outerIdx, innerIdx, inst, alreadyProcessed, signature)
+					found, err := s.processInnerClosePostedMessageEvent(ctx, s.logger, programIndex, tx, inner.Instructions, outerIdx, innerIdx, inst, alreadyProcessed, signature)
 					if err != nil {
 						s.logger.Error("malformed inner close_posted_message event",
 							zap.Error(err),
@@ -920,7 +970,7 @@ func (s *SolanaWatcher) processTransaction(ctx context.Context, rpcClient *rpc.C
 						}
 					}
 				} else {
-					found, err := s.processInstruction(ctx, rpcClient, slot, inst, programIndex, tx, signature, innerIdx, isReobservation)
+					found, err := s.processInstruction(ctx, rpcClient, slot, inst, programIndex, tx, meta, signature, innerIdx, isReobservation)
 					if err != nil {
 						s.logger.Error("malformed Wormhole instruction",
 							zap.Error(err),
@@ -948,7 +998,7 @@ func (s *SolanaWatcher) processTransaction(ctx context.Context, rpcClient *rpc.C
 	return
 }
 
-func (s *SolanaWatcher) processInstruction(ctx context.Context, rpcClient *rpc.Client, slot uint64, inst solana.CompiledInstruction, programIndex uint16, tx *solana.Transaction, signature solana.Signature, idx int, isReobservation bool) (bool, error) {
+func (s *SolanaWatcher) processInstruction(ctx context.Context, rpcClient *rpc.Client, slot uint64, inst solana.CompiledInstruction, programIndex uint16, tx *solana.Transaction, meta *rpc.TransactionMeta, signature solana.Signature, idx int, isReobservation bool) (bool, error) {
 	if inst.ProgramIDIndex != programIndex {
 		return false, nil
 	}
@@ -1002,15 +1052,15 @@ func (s *SolanaWatcher) processInstruction(ctx context.Context, rpcClient *rpc.C
 	}
 
 	common.RunWithScissors(ctx, s.errC, "retryFetchMessageAccount", func(ctx context.Context) error {
-		s.retryFetchMessageAccount(ctx, rpcClient, acc, slot, 0, isReobservation, signature)
+		s.retryFetchMessageAccount(ctx, rpcClient, acc, slot, 0, isReobservation, tx, meta, signature)
 		return nil
 	})
 
 	return true, nil
 }
 
-func (s *SolanaWatcher) retryFetchMessageAccount(ctx context.Context, rpcClient *rpc.Client, acc solana.PublicKey, slot uint64, retry uint, isReobservation bool, signature solana.Signature) {
-	_, retryable := s.fetchMessageAccount(ctx, rpcClient, acc, slot, isReobservation, signature)
+func (s *SolanaWatcher) retryFetchMessageAccount(ctx context.Context, rpcClient *rpc.Client, acc solana.PublicKey, slot uint64, retry uint, isReobservation bool, tx *solana.Transaction, meta *rpc.TransactionMeta, signature solana.Signature) {
+	_, retryable := s.fetchMessageAccount(ctx, rpcClient, acc, slot, isReobservation, tx, meta, signature)
 
 	if retryable {
 		if retry >= maxRetries {
@@ -1029,13 +1079,13 @@ func (s *SolanaWatcher) retryFetchMessageAccount(ctx context.Context, rpcClient
 			zap.Uint("retry", retry))
 
 		common.RunWithScissors(ctx, s.errC, "retryFetchMessageAccount", func(ctx context.Context) error {
-			s.retryFetchMessageAccount(ctx, rpcClient, acc, slot, retry+1, isReobservation, signature)
+			s.retryFetchMessageAccount(ctx, rpcClient, acc, slot, retry+1, isReobservation, tx, meta, signature)
 			return nil
 		})
 	}
 }
 
-func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, rpcClient *rpc.Client, acc solana.PublicKey, slot uint64, isReobservation bool, signature solana.Signature) (numObservations uint32, retryable bool) {
+func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, rpcClient *rpc.Client, acc solana.PublicKey, slot uint64, isReobservation bool, tx *solana.Transaction, meta *rpc.TransactionMeta, signature solana.Signature) (numObservations uint32, retryable bool) {
 	// Fetching account
 	rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
 	defer cancel()
@@ -1095,13 +1145,13 @@ func (s *SolanaWatcher) fetchMessageAccount(ctx context.Context, rpcClient *rpc.
 			zap.String("data", messageAccountData.String()))
 	}
 
-	return s.processMessageAccount(s.logger, messageAccountData, acc, isReobservation, signature, false), false
+	return s.processMessageAccount(ctx, s.logger, messageAccountData, acc, isReobservation, tx, meta, signature, false), false
 }
 
 // processAccountSubscriptionData processes the data received from the account subscription.
 // This function is primarily used for Pythnet as its caller relies on a WebSocket subscription to receive data,
 // and this is allow-listed only for Pythnet's ChainID.
-func (s *SolanaWatcher) processAccountSubscriptionData(_ context.Context, data []byte, isReobservation bool) error {
+func (s *SolanaWatcher) processAccountSubscriptionData(ctx context.Context, data []byte, isReobservation bool) error {
 	// SECURITY: json.Unmarshal returns an error for empty inputs, but nil if the input is `{}` (valid-but-empty JSON).
 	// Both cases need to be handled before we try to parse data.
 	// The structs into which we unmarshal the data use a mix of pointers and non-pointers, so be mindful of error-handling
@@ -1169,13 +1219,13 @@ func (s *SolanaWatcher) processAccountSubscriptionData(_ context.Context, data [
 	acc := solana.PublicKeyFromBytes([]byte(value.Pubkey))
 	// NOTE: We don't care about the number of observations here so the return value is ignored.
 	// The called function will still publish observations if it is successful.
-	s.processMessageAccount(s.logger, messageAccountData, acc, isReobservation, solana.Signature{}, false)
+	s.processMessageAccount(ctx, s.logger, messageAccountData, acc, isReobservation, nil, nil, solana.Signature{}, false)
 
 	return nil
 }
 
 // SECURITY: Ownership check on account key must be done BEFORE this function is called.
-func (s *SolanaWatcher) processMessageAccount(logger *zap.Logger, messageAccountData MessageAccountData, acc solana.PublicKey, isReobservation bool, signature solana.Signature, useSignatureAsTxID bool) (numObservations uint32) {
+func (s *SolanaWatcher) processMessageAccount(ctx context.Context, logger *zap.Logger, messageAccountData MessageAccountData, acc solana.PublicKey, isReobservation bool, tx *solana.Transaction, meta *rpc.TransactionMeta, signature solana.Signature, useSignatureAsTxID bool) (numObservations uint32) {
 	proposal, err := ParseMessagePublicationAccount(messageAccountData)
 	if err != nil {
 		solanaAccountSkips.WithLabelValues(s.networkName, "parse_transfer_out").Inc()
@@ -1248,6 +1298,25 @@ func (s *SolanaWatcher) processMessageAccount(logger *zap.Logger, messageAccount
 		return
 	}
 
+	if s.txVerifier != nil {
+		// A close event is observed in the transaction that closed the message account, not the one that posted it.
+		postTx, postMeta := tx, meta
+		if useSignatureAsTxID {
+			postTx, postMeta = nil, nil
+		}
+
+		verifiedMsg, err := s.verify(observation, postTx, postMeta, acc, logger)
+		if err != nil {
+			solanaTransferVerifierFailures.WithLabelValues(s.networkName).Inc()
+			logger.Error("failed to verify message",
+				zap.Stringer("account", acc),
+				zap.Stringer("signature", signature),
+				zap.Error(err))
+			return
+		}
+		observation = &verifiedMsg
+	}
+
 	solanaMessagesConfirmed.WithLabelValues(s.networkName).Inc()
 	if isReobservation {
 		watchers.ReobservationsByChain.WithLabelValues(s.chainID.String(), "std").Inc()
@@ -2,6 +2,7 @@ package solana
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 
@@ -71,6 +72,7 @@ func resolveCloseEventMessageAccount(
 // processClosePostedMessageEvent handles a top-level close_posted_message
 // instruction by scanning its inner instructions for the CPI event.
 func (s *SolanaWatcher) processClosePostedMessageEvent(
+	ctx context.Context,
 	logger *zap.Logger,
 	programIndex uint16,
 	tx *solana.Transaction,
@@ -96,7 +98,7 @@ func (s *SolanaWatcher) processClosePostedMessageEvent(
 					return false, err
 				}
 				alreadyProcessed.add(outerIdx, innerIdx)
-				return s.processMessageAccount(logger, accountData, messageAccount, true, signature, true) > 0, nil
+				return s.processMessageAccount(ctx, logger, accountData, messageAccount, true, nil, nil, signature, true) > 0, nil
 			}
 		}
 	}
@@ -109,6 +111,7 @@ func (s *SolanaWatcher) processClosePostedMessageEvent(
 // the same inner instruction set. Both are marked in alreadyProcessed so the
 // outer loop does not re-process them (same pattern as the shim).
 func (s *SolanaWatcher) processInnerClosePostedMessageEvent(
+	ctx context.Context,
 	logger *zap.Logger,
 	programIndex uint16,
 	tx *solana.Transaction,
@@ -134,7 +137,7 @@ func (s *SolanaWatcher) processInnerClosePostedMessageEvent(
 				return false, err
 			}
 			alreadyProcessed.add(outerIdx, i)
-			return s.processMessageAccount(logger, accountData, messageAccount, true, signature, true) > 0, nil
+			return s.processMessageAccount(ctx, logger, accountData, messageAccount, true, nil, nil, signature, true) > 0, nil
 		}
 	}
 	return false, nil
@@ -215,6 +215,7 @@ func TestClosePostedMessageDirect(t *testing.T) {
 	logger := zap.NewNop()
 	alreadyProcessed := ShimAlreadyProcessed{}
 	found, err := s.processClosePostedMessageEvent(
+		context.Background(),
 		logger,
 		programIndex,
 		tx,
@@ -1,10 +1,13 @@
 package solana
 
 import (
+	"fmt"
+
 	"github.com/certusone/wormhole/node/pkg/common"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/certusone/wormhole/node/pkg/txverifier"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/gagliardetto/solana-go"
@@ -23,6 +26,13 @@ type WatcherConfig struct {
 	Contract      string             // hex representation of the contract address
 	ShimContract  string             // Address of the shim contract (empty string if disabled)
 	Commitment    solana_rpc.CommitmentType
+	// TxVerifierEnabled enables the transfer verifier for token bridge messages.
+	TxVerifierEnabled bool
+	// GeyserEndpoint is the URL of a Yellowstone Geyser gRPC endpoint to stream transactions from, instead of polling
+	// every block. Blocks are still polled while the stream is down.
+	GeyserEndpoint string
+	// GeyserToken is the access token of the Geyser endpoint, if any.
+	GeyserToken string
 }
 
 func (wc *WatcherConfig) GetNetworkID() watchers.NetworkID {
@@ -39,7 +49,7 @@ func (wc *WatcherConfig) Create(
 	queryReqC <-chan *query.PerChainQueryInternal,
 	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 	_ chan<- *common.GuardianSet,
-	_ common.Environment,
+	env common.Environment,
 ) (supervisor.Runnable, interfaces.Reobserver, error) {
 	solAddress, err := solana_types.PublicKeyFromBase58(wc.Contract)
 	if err != nil {
@@ -58,8 +68,32 @@ func (wc *WatcherConfig) Create(
 		obsvReqC = nil
 	}
 
+	if wc.GeyserEndpoint != "" && (wc.PollForTx || wc.Websocket != "") {
+		return nil, nil, fmt.Errorf("the Geyser stream of the %s watcher cannot be used with polling for transactions or a websocket", wc.NetworkID)
+	}
+
 	watcher := NewSolanaWatcher(wc.Rpc, wc.Websocket, solAddress, wc.Contract, msgC, obsvReqC, wc.Commitment, wc.ChainID, queryReqC, queryResponseC, wc.ShimContract, shimContractAddr, wc.PollForTx)
 
+	watcher.geyserEndpoint = wc.GeyserEndpoint
+	watcher.geyserToken = wc.GeyserToken
+
+	if wc.TxVerifierEnabled {
+		tokenBridgeStr, exists := txverifier.SolanaTokenBridgeProgramIds[env]
+		if !exists {
+			return nil, nil, fmt.Errorf("no Solana token bridge program ID known for environment %s", env)
+		}
+
+		tokenBridge, err := solana_types.PublicKeyFromBase58(tokenBridgeStr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		watcher.txVerifier, err = txverifier.NewSolanaTransferVerifier(solAddress, tokenBridge, watcher.rpcClient)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create Solana transfer verifier: %w", err)
+		}
+	}
+
 	var reobserver interfaces.Reobserver
 	if wc.Commitment == solana_rpc.CommitmentFinalized {
 		reobserver = watcher
@@ -0,0 +1,432 @@
+package solana
+
+// This code streams Wormhole transactions from a Yellowstone Geyser gRPC endpoint, instead of fetching every block.
+// The watcher subscribes to the successful, non-vote transactions that reference the core or shim contract, along
+// with the slot updates at its commitment level. The transactions are handled by the standard transaction processing
+// code, as if they had been read from a block.
+//
+// The stream is not trusted to be gapless. Each slot update claims the slots since the last handled one, and any
+// slot before it that was not streamed (for example after a reconnection) is fetched with the standard block
+// polling. If the stream goes down or stops sending slot updates, the main watcher loop falls back to polling every
+// slot until the stream recovers.
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/proto/geyser"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	// geyserStaleTimeout is how long the stream may go without a slot update before the watcher polls blocks instead.
+	geyserStaleTimeout = 15 * time.Second
+
+	// geyserRetryDelay is the delay before resubscribing after the stream fails.
+	geyserRetryDelay = 5 * time.Second
+
+	// geyserFilterName is the name of the subscription filters, which the server echoes in its updates.
+	geyserFilterName = "wormhole"
+)
+
+// geyserCommitmentLevel returns the Geyser commitment level matching a Solana commitment.
+func geyserCommitmentLevel(commitment rpc.CommitmentType) (geyser.CommitmentLevel, error) {
+	switch commitment {
+	case rpc.CommitmentConfirmed:
+		return geyser.CommitmentLevel_CONFIRMED, nil
+	case rpc.CommitmentFinalized:
+		return geyser.CommitmentLevel_FINALIZED, nil
+	default:
+		return 0, fmt.Errorf("unsupported commitment for the Geyser stream: %s", commitment)
+	}
+}
+
+// geyserToken sends the access token of the endpoint as metadata of every call.
+type geyserToken string
+
+func (t geyserToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"x-token": string(t)}, nil
+}
+
+func (geyserToken) RequireTransportSecurity() bool {
+	// Plain HTTP endpoints are only expected on the local network, so the token is allowed on them.
+	return false
+}
+
+// dialGeyser creates the client connection to a Geyser endpoint. The endpoint is an https:// or http:// URL, and the
+// port defaults to the standard one of the scheme.
+func dialGeyser(endpoint string, token string) (*grpc.ClientConn, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Geyser endpoint: %w", err)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid Geyser endpoint %q: missing host", endpoint)
+	}
+
+	var creds credentials.TransportCredentials
+	port := u.Port()
+	switch u.Scheme {
+	case "https":
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+		if port == "" {
+			port = "443"
+		}
+	case "http":
+		creds = insecure.NewCredentials()
+		if port == "" {
+			port = "80"
+		}
+	default:
+		return nil, fmt.Errorf("invalid Geyser endpoint %q: the scheme must be https or http", endpoint)
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(geyserToken(token)))
+	}
+
+	return grpc.NewClient(net.JoinHostPort(u.Hostname(), port), opts...)
+}
+
+// geyserSubscribeRequest returns the subscription to the slots and Wormhole transactions at the commitment of the
+// watcher.
+func (s *SolanaWatcher) geyserSubscribeRequest() (*geyser.SubscribeRequest, error) {
+	commitment, err := geyserCommitmentLevel(s.commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := []string{s.contract.String()}
+	if s.shimEnabled {
+		accounts = append(accounts, s.shimContractAddr.String())
+	}
+
+	vote := false
+	failed := false
+	return &geyser.SubscribeRequest{
+		Slots: map[string]*geyser.SubscribeRequestFilterSlots{
+			geyserFilterName: {FilterByCommitment: true},
+		},
+		Transactions: map[string]*geyser.SubscribeRequestFilterTransactions{
+			geyserFilterName: {
+				Vote:           &vote,
+				Failed:         &failed,
+				AccountInclude: accounts,
+			},
+		},
+		Commitment: commitment,
+	}, nil
+}
+
+// geyserProcessor is the entry point of the runnable that streams transactions from the Geyser endpoint. Stream
+// failures don't stop the watcher, since the main loop polls blocks while the stream is down.
+func (s *SolanaWatcher) geyserProcessor(ctx context.Context) error {
+	conn, err := dialGeyser(s.geyserEndpoint, s.geyserToken)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := geyser.NewGeyserClient(conn)
+	for {
+		err := s.geyserSubscribe(ctx, client)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
+		solanaConnectionErrors.WithLabelValues(s.networkName, string(s.commitment), "geyser_stream_error").Inc()
+		s.logger.Warn("Geyser stream failed, polling blocks until it is resubscribed", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(geyserRetryDelay):
+		}
+	}
+}
+
+// geyserSubscribe subscribes to the Geyser stream and handles its updates until it fails.
+func (s *SolanaWatcher) geyserSubscribe(ctx context.Context, client geyser.GeyserClient) error {
+	req, err := s.geyserSubscribeRequest()
+	if err != nil {
+		return err
+	}
+
+	// The handlers use the watcher context, so that the blocks they fetch are not abandoned when the stream ends.
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.Subscribe(streamCtx)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe: %w", err)
+	}
+	if err := stream.Send(req); err != nil {
+		return fmt.Errorf("failed to send the subscription: %w", err)
+	}
+
+	s.logger.Info("subscribed to the Geyser stream", zap.String("endpoint", s.geyserEndpoint))
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch u := update.UpdateOneof.(type) {
+		case *geyser.SubscribeUpdate_Slot:
+			s.handleGeyserSlot(ctx, u.Slot)
+		case *geyser.SubscribeUpdate_Transaction:
+			s.handleGeyserTransaction(ctx, u.Transaction)
+		}
+	}
+}
+
+// handleGeyserSlot claims the slots up to a slot update, and fetches the blocks of the ones that were not streamed.
+func (s *SolanaWatcher) handleGeyserSlot(ctx context.Context, update *geyser.SubscribeUpdateSlot) {
+	if update == nil || update.Slot == 0 {
+		return
+	}
+
+	// The subscription only asks for the slots at the commitment of the watcher, but don't rely on it.
+	if commitment, err := geyserCommitmentLevel(s.commitment); err != nil || update.Status != commitment {
+		return
+	}
+
+	atomic.StoreInt64(&s.geyserLastSlotUpdate, time.Now().UnixNano())
+	s.updateLatestBlock(update.Slot)
+
+	prev, claimed := s.claimSlots(update.Slot)
+	if !claimed {
+		return
+	}
+
+	first, last, gap := geyserGap(prev, update.Slot, update.Parent)
+	if !gap {
+		return
+	}
+
+	s.logger.Warn("Geyser stream skipped slots, fetching their blocks",
+		zap.Uint64("first", first),
+		zap.Uint64("last", last),
+	)
+	solanaGeyserGapSlots.WithLabelValues(s.networkName, string(s.commitment)).Add(float64(last - first + 1))
+	s.fetchSlots(ctx, s.logger, first, last)
+}
+
+// geyserGap returns the range of slots that must be polled when the stream moves from the previous slot to a new
+// one. The slots between the parent of the new slot and the new slot were skipped by the leaders, so they are not
+// polled. Nothing is polled for the first slot of the watcher.
+func geyserGap(prev uint64, slot uint64, parent *uint64) (first uint64, last uint64, gap bool) {
+	if prev == 0 {
+		return 0, 0, false
+	}
+
+	first = prev + 1
+	last = slot - 1
+	if parent != nil && *parent < last {
+		last = *parent
+	}
+
+	return first, last, first <= last
+}
+
+// claimSlots marks every slot up to the given one as handled, and returns the previously handled slot. It returns
+// false if the slot was already handled, either by the stream or by polling.
+func (s *SolanaWatcher) claimSlots(slot uint64) (prev uint64, claimed bool) {
+	for {
+		prev = atomic.LoadUint64(&s.lastSlot)
+		if slot <= prev {
+			return prev, false
+		}
+		if atomic.CompareAndSwapUint64(&s.lastSlot, prev, slot) {
+			return prev, true
+		}
+	}
+}
+
+// geyserStreaming returns true if the stream sent a slot update recently.
+func (s *SolanaWatcher) geyserStreaming() bool {
+	lastUpdate := atomic.LoadInt64(&s.geyserLastSlotUpdate)
+	return lastUpdate != 0 && time.Since(time.Unix(0, lastUpdate)) < geyserStaleTimeout
+}
+
+// handleGeyserTransaction processes a streamed transaction.
+func (s *SolanaWatcher) handleGeyserTransaction(ctx context.Context, update *geyser.SubscribeUpdateTransaction) {
+	if update == nil {
+		return
+	}
+
+	tx, meta, err := geyserTransactionToRpc(update.Transaction)
+	if err != nil {
+		s.logger.Error("failed to convert streamed transaction",
+			zap.Uint64("slot", update.Slot),
+			zap.Error(err),
+		)
+		return
+	}
+
+	// SECURITY: Validate transaction metadata before accessing fields
+	if metaErr := validateTransactionMeta(meta); metaErr != nil {
+		if s.logger.Level().Enabled(zapcore.DebugLevel) {
+			s.logger.Debug("skipping streamed transaction",
+				zap.Uint64("slot", update.Slot),
+				zap.Stringer("signature", tx.Signatures[0]),
+				zap.String("reason", metaErr.Error()),
+			)
+		}
+		return
+	}
+
+	if !isPossibleWormholeMessage(s.whLogPrefix, meta.LogMessages) {
+		return
+	}
+
+	s.processTransaction(ctx, s.rpcClient, tx, meta, update.Slot, false)
+}
+
+// geyserTransactionToRpc converts a streamed transaction to the types returned by the RPC, which are expected by
+// processTransaction.
+func geyserTransactionToRpc(info *geyser.SubscribeUpdateTransactionInfo) (*solana.Transaction, *rpc.TransactionMeta, error) {
+	if info == nil || info.Transaction == nil || info.Transaction.Message == nil {
+		return nil, nil, errors.New("transaction is missing")
+	}
+	if info.Meta == nil {
+		return nil, nil, errors.New("transaction metadata is missing")
+	}
+
+	msg := info.Transaction.Message
+	if len(info.Transaction.Signatures) == 0 {
+		return nil, nil, errors.New("transaction has no signatures")
+	}
+
+	tx := &solana.Transaction{}
+	for _, sig := range info.Transaction.Signatures {
+		if len(sig) != SolanaSignatureLen {
+			return nil, nil, fmt.Errorf("invalid signature length %d", len(sig))
+		}
+		tx.Signatures = append(tx.Signatures, solana.SignatureFromBytes(sig))
+	}
+
+	var err error
+	if tx.Message.AccountKeys, err = geyserPublicKeys(msg.AccountKeys); err != nil {
+		return nil, nil, fmt.Errorf("invalid account keys: %w", err)
+	}
+
+	if len(msg.RecentBlockhash) != len(solana.Hash{}) {
+		return nil, nil, fmt.Errorf("invalid recent blockhash length %d", len(msg.RecentBlockhash))
+	}
+	tx.Message.RecentBlockhash = solana.HashFromBytes(msg.RecentBlockhash)
+
+	if header := msg.Header; header != nil {
+		if header.NumRequiredSignatures > math.MaxUint8 || header.NumReadonlySignedAccounts > math.MaxUint8 || header.NumReadonlyUnsignedAccounts > math.MaxUint8 {
+			return nil, nil, errors.New("invalid message header")
+		}
+		tx.Message.Header = solana.MessageHeader{
+			NumRequiredSignatures:       uint8(header.NumRequiredSignatures),       // #nosec G115 -- Checked above
+			NumReadonlySignedAccounts:   uint8(header.NumReadonlySignedAccounts),   // #nosec G115 -- Checked above
+			NumReadonlyUnsignedAccounts: uint8(header.NumReadonlyUnsignedAccounts), // #nosec G115 -- Checked above
+		}
+	}
+
+	for _, inst := range msg.Instructions {
+		compiled, err := geyserCompiledInstruction(inst.ProgramIdIndex, inst.Accounts, inst.Data)
+		if err != nil {
+			return nil, nil, err
+		}
+		tx.Message.Instructions = append(tx.Message.Instructions, compiled)
+	}
+
+	if msg.Versioned {
+		tx.Message.SetVersion(solana.MessageVersionV0)
+		lookups := make([]solana.MessageAddressTableLookup, 0, len(msg.AddressTableLookups))
+		for _, lookup := range msg.AddressTableLookups {
+			if len(lookup.AccountKey) != solana.PublicKeyLength {
+				return nil, nil, fmt.Errorf("invalid lookup table length %d", len(lookup.AccountKey))
+			}
+			lookups = append(lookups, solana.MessageAddressTableLookup{
+				AccountKey:      solana.PublicKeyFromBytes(lookup.AccountKey),
+				WritableIndexes: lookup.WritableIndexes,
+				ReadonlyIndexes: lookup.ReadonlyIndexes,
+			})
+		}
+		tx.Message.SetAddressTableLookups(lookups)
+	}
+
+	meta := &rpc.TransactionMeta{}
+	if info.Meta.Err != nil {
+		// The error is bincode encoded. Only its presence matters to the watcher.
+		meta.Err = fmt.Sprintf("%x", info.Meta.Err.Err)
+	}
+	if !info.Meta.LogMessagesNone {
+		meta.LogMessages = info.Meta.LogMessages
+	}
+	if !info.Meta.InnerInstructionsNone {
+		for _, inner := range info.Meta.InnerInstructions {
+			if inner.Index > math.MaxUint16 {
+				return nil, nil, fmt.Errorf("invalid inner instructions index %d", inner.Index)
+			}
+			innerInstructions := rpc.InnerInstruction{Index: uint16(inner.Index)} // #nosec G115 -- Checked above
+			for _, inst := range inner.Instructions {
+				compiled, err := geyserCompiledInstruction(inst.ProgramIdIndex, inst.Accounts, inst.Data)
+				if err != nil {
+					return nil, nil, err
+				}
+				innerInstructions.Instructions = append(innerInstructions.Instructions, compiled)
+			}
+			meta.InnerInstructions = append(meta.InnerInstructions, innerInstructions)
+		}
+	}
+	if meta.LoadedAddresses.Writable, err = geyserPublicKeys(info.Meta.LoadedWritableAddresses); err != nil {
+		return nil, nil, fmt.Errorf("invalid loaded writable addresses: %w", err)
+	}
+	if meta.LoadedAddresses.ReadOnly, err = geyserPublicKeys(info.Meta.LoadedReadonlyAddresses); err != nil {
+		return nil, nil, fmt.Errorf("invalid loaded readonly addresses: %w", err)
+	}
+
+	return tx, meta, nil
+}
+
+// geyserPublicKeys converts a list of raw public keys.
+func geyserPublicKeys(keys [][]byte) (solana.PublicKeySlice, error) {
+	out := make(solana.PublicKeySlice, 0, len(keys))
+	for _, key := range keys {
+		if len(key) != solana.PublicKeyLength {
+			return nil, fmt.Errorf("invalid public key length %d", len(key))
+		}
+		out = append(out, solana.PublicKeyFromBytes(key))
+	}
+	return out, nil
+}
+
+// geyserCompiledInstruction converts an instruction, whose account indexes are encoded as one byte each.
+func geyserCompiledInstruction(programIDIndex uint32, accounts []byte, data []byte) (solana.CompiledInstruction, error) {
+	if programIDIndex > math.MaxUint8 {
+		return solana.CompiledInstruction{}, fmt.Errorf("invalid program index %d", programIDIndex)
+	}
+
+	compiled := solana.CompiledInstruction{
+		ProgramIDIndex: uint16(programIDIndex), // #nosec G115 -- Checked above
+		Accounts:       make([]uint16, len(accounts)),
+		Data:           solana.Base58(data),
+	}
+	for i, account := range accounts {
+		compiled.Accounts[i] = uint16(account)
+	}
+	return compiled, nil
+}
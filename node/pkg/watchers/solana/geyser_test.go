@@ -0,0 +1,323 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/proto/geyser"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeGeyserServer records the subscription and streams the configured updates, then waits for the client to leave.
+type fakeGeyserServer struct {
+	geyser.UnimplementedGeyserServer
+	updates  []*geyser.SubscribeUpdate
+	requests chan *geyser.SubscribeRequest
+	tokens   chan string
+}
+
+func (f *fakeGeyserServer) Subscribe(stream geyser.Geyser_SubscribeServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	f.tokens <- strings.Join(md.Get("x-token"), ",")
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	f.requests <- req
+
+	for _, update := range f.updates {
+		if err := stream.Send(update); err != nil {
+			return err
+		}
+	}
+
+	<-stream.Context().Done()
+	return nil
+}
+
+func newFakeGeyserServer(t *testing.T, updates []*geyser.SubscribeUpdate) (*fakeGeyserServer, string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	f := &fakeGeyserServer{
+		updates:  updates,
+		requests: make(chan *geyser.SubscribeRequest, 10),
+		tokens:   make(chan string, 10),
+	}
+	server := grpc.NewServer()
+	geyser.RegisterGeyserServer(server, f)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return f, "http://" + lis.Addr().String()
+}
+
+func geyserSlotUpdate(slot uint64, parent uint64) *geyser.SubscribeUpdate {
+	return &geyser.SubscribeUpdate{
+		Filters: []string{geyserFilterName},
+		UpdateOneof: &geyser.SubscribeUpdate_Slot{Slot: &geyser.SubscribeUpdateSlot{
+			Slot:   slot,
+			Parent: &parent,
+			Status: geyser.CommitmentLevel_FINALIZED,
+		}},
+	}
+}
+
+func TestGeyserStream(t *testing.T) {
+	contract := solana.PublicKeyFromBytes(bytes.Repeat([]byte{0xAA}, solana.PublicKeyLength))
+	messageAccount := solana.PublicKeyFromBytes(bytes.Repeat([]byte{0xBB}, solana.PublicKeyLength))
+	otherKey := solana.PublicKeyFromBytes(bytes.Repeat([]byte{0xCC}, solana.PublicKeyLength))
+	shimContract := solana.PublicKeyFromBytes(bytes.Repeat([]byte{0xDD}, solana.PublicKeyLength))
+
+	m := newMockRPCServer(t)
+	defer m.Close()
+	proposal := testMessagePublicationAccount([]byte("hello"), 32)
+	m.SetAccount(messageAccount, contract.String(), encodeMessagePublicationAccount(t, accountPrefixReliable, proposal))
+
+	postMsgData := encodePostMessageData(t, 7, []byte("hello"), consistencyLevelFinalized)
+	wormholeTx := &geyser.SubscribeUpdate{
+		UpdateOneof: &geyser.SubscribeUpdate_Transaction{Transaction: &geyser.SubscribeUpdateTransaction{
+			Slot: 100,
+			Transaction: &geyser.SubscribeUpdateTransactionInfo{
+				Transaction: &geyser.Transaction{
+					Signatures: [][]byte{bytes.Repeat([]byte{0x01}, SolanaSignatureLen)},
+					Message: &geyser.Message{
+						Header:          &geyser.MessageHeader{NumRequiredSignatures: 1},
+						AccountKeys:     [][]byte{otherKey.Bytes(), contract.Bytes(), messageAccount.Bytes()},
+						RecentBlockhash: make([]byte, 32),
+						Instructions: []*geyser.CompiledInstruction{{
+							ProgramIdIndex: 1,
+							Accounts:       []byte{0, 2, 0, 0, 0, 0, 0, 0},
+							Data:           append([]byte{postMessageInstructionID}, postMsgData...),
+						}},
+					},
+				},
+				Meta: &geyser.TransactionStatusMeta{
+					LogMessages: []string{"Program " + contract.String() + " invoke [1]", "Program log: Sequence: 99"},
+				},
+			},
+		}},
+	}
+
+	// The same transaction without the core contract logs is skipped without being parsed.
+	noLogsTx := &geyser.SubscribeUpdate{
+		UpdateOneof: &geyser.SubscribeUpdate_Transaction{Transaction: &geyser.SubscribeUpdateTransaction{
+			Slot: 101,
+			Transaction: &geyser.SubscribeUpdateTransactionInfo{
+				Transaction: wormholeTx.GetTransaction().Transaction.Transaction,
+				Meta:        &geyser.TransactionStatusMeta{LogMessagesNone: true},
+			},
+		}},
+	}
+
+	f, endpoint := newFakeGeyserServer(t, []*geyser.SubscribeUpdate{
+		geyserSlotUpdate(99, 98),
+		wormholeTx,
+		geyserSlotUpdate(100, 99),
+		noLogsTx,
+		geyserSlotUpdate(101, 100),
+	})
+
+	msgC := make(chan *common.MessagePublication, 10)
+	s := newTestWatcher(t, vaa.ChainIDSolana, rpc.CommitmentFinalized, msgC)
+	s.errC = make(chan error, 10)
+	s.contract = contract
+	s.whLogPrefix = "Program " + contract.String()
+	s.rpcClient = rpc.New(m.URL)
+	s.shimContractAddr = shimContract
+	s.shimContractStr = shimContract.String()
+	s.shimSetup()
+	s.geyserEndpoint = endpoint
+	s.geyserToken = "secret"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = s.geyserProcessor(ctx) }()
+
+	select {
+	case token := <-f.tokens:
+		assert.Equal(t, "secret", token)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the subscription")
+	}
+
+	req := <-f.requests
+	assert.Equal(t, geyser.CommitmentLevel_FINALIZED, req.Commitment)
+	require.Contains(t, req.Slots, geyserFilterName)
+	assert.True(t, req.Slots[geyserFilterName].FilterByCommitment)
+	require.Contains(t, req.Transactions, geyserFilterName)
+	txFilter := req.Transactions[geyserFilterName]
+	assert.Equal(t, []string{contract.String(), shimContract.String()}, txFilter.AccountInclude)
+	require.NotNil(t, txFilter.Vote)
+	assert.False(t, *txFilter.Vote)
+	require.NotNil(t, txFilter.Failed)
+	assert.False(t, *txFilter.Failed)
+
+	select {
+	case msg := <-msgC:
+		require.NotNil(t, msg)
+		assert.Equal(t, uint64(99), msg.Sequence)
+		assert.Equal(t, messageAccount.Bytes(), msg.TxID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for message publication")
+	}
+
+	require.Eventually(t, func() bool { return atomic.LoadUint64(&s.lastSlot) == 101 }, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, s.geyserStreaming())
+	assert.Equal(t, uint64(101), s.getLatestFinalizedBlockNumber())
+	assert.Empty(t, msgC)
+}
+
+func TestGeyserGap(t *testing.T) {
+	parent := func(p uint64) *uint64 { return &p }
+
+	tests := []struct {
+		name      string
+		prev      uint64
+		slot      uint64
+		parent    *uint64
+		wantFirst uint64
+		wantLast  uint64
+		wantGap   bool
+	}{
+		{name: "first_slot", prev: 0, slot: 100, parent: parent(90)},
+		{name: "next_slot", prev: 99, slot: 100, parent: parent(99)},
+		{name: "skipped_by_leader", prev: 97, slot: 100, parent: parent(97)},
+		{name: "missed_slots", prev: 95, slot: 100, parent: parent(99), wantFirst: 96, wantLast: 99, wantGap: true},
+		{name: "missed_and_skipped_slots", prev: 95, slot: 100, parent: parent(97), wantFirst: 96, wantLast: 97, wantGap: true},
+		{name: "unknown_parent", prev: 95, slot: 100, wantFirst: 96, wantLast: 99, wantGap: true},
+		{name: "unknown_parent_next_slot", prev: 99, slot: 100},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			first, last, gap := geyserGap(tc.prev, tc.slot, tc.parent)
+			assert.Equal(t, tc.wantGap, gap)
+			if tc.wantGap {
+				assert.Equal(t, tc.wantFirst, first)
+				assert.Equal(t, tc.wantLast, last)
+			}
+		})
+	}
+}
+
+func TestClaimSlots(t *testing.T) {
+	s := &SolanaWatcher{}
+
+	prev, claimed := s.claimSlots(100)
+	assert.True(t, claimed)
+	assert.Equal(t, uint64(0), prev)
+
+	// A slot that was already handled, for example by polling, is not claimed again.
+	prev, claimed = s.claimSlots(100)
+	assert.False(t, claimed)
+	assert.Equal(t, uint64(100), prev)
+
+	_, claimed = s.claimSlots(90)
+	assert.False(t, claimed)
+
+	prev, claimed = s.claimSlots(105)
+	assert.True(t, claimed)
+	assert.Equal(t, uint64(100), prev)
+	assert.Equal(t, uint64(105), atomic.LoadUint64(&s.lastSlot))
+}
+
+func TestGeyserTransactionToRpc(t *testing.T) {
+	key := func(b byte) []byte { return bytes.Repeat([]byte{b}, solana.PublicKeyLength) }
+	stackHeight := uint32(2)
+
+	info := &geyser.SubscribeUpdateTransactionInfo{
+		Transaction: &geyser.Transaction{
+			Signatures: [][]byte{bytes.Repeat([]byte{0x01}, SolanaSignatureLen)},
+			Message: &geyser.Message{
+				Header:          &geyser.MessageHeader{NumRequiredSignatures: 1, NumReadonlyUnsignedAccounts: 1},
+				AccountKeys:     [][]byte{key(0xAA), key(0xBB)},
+				RecentBlockhash: key(0x02),
+				Instructions:    []*geyser.CompiledInstruction{{ProgramIdIndex: 1, Accounts: []byte{0, 2}, Data: []byte{0x01, 0x02}}},
+				Versioned:       true,
+				AddressTableLookups: []*geyser.MessageAddressTableLookup{{
+					AccountKey:      key(0xCC),
+					WritableIndexes: []byte{0},
+					ReadonlyIndexes: []byte{1, 2},
+				}},
+			},
+		},
+		Meta: &geyser.TransactionStatusMeta{
+			InnerInstructions: []*geyser.InnerInstructions{{
+				Index:        0,
+				Instructions: []*geyser.InnerInstruction{{ProgramIdIndex: 2, Accounts: []byte{1}, Data: []byte{0x03}, StackHeight: &stackHeight}},
+			}},
+			LogMessages:             []string{"Program log: hello"},
+			LoadedWritableAddresses: [][]byte{key(0xDD)},
+			LoadedReadonlyAddresses: [][]byte{key(0xEE), key(0xFF)},
+		},
+	}
+
+	tx, meta, err := geyserTransactionToRpc(info)
+	require.NoError(t, err)
+
+	assert.Equal(t, solana.SignatureFromBytes(bytes.Repeat([]byte{0x01}, SolanaSignatureLen)), tx.Signatures[0])
+	assert.Equal(t, []solana.PublicKey{solana.PublicKeyFromBytes(key(0xAA)), solana.PublicKeyFromBytes(key(0xBB))}, tx.Message.AccountKeys)
+	assert.Equal(t, solana.HashFromBytes(key(0x02)), tx.Message.RecentBlockhash)
+	assert.Equal(t, solana.MessageHeader{NumRequiredSignatures: 1, NumReadonlyUnsignedAccounts: 1}, tx.Message.Header)
+	assert.Equal(t, []solana.CompiledInstruction{{ProgramIDIndex: 1, Accounts: []uint16{0, 2}, Data: solana.Base58{0x01, 0x02}}}, tx.Message.Instructions)
+	assert.True(t, tx.Message.IsVersioned())
+	require.Len(t, tx.Message.AddressTableLookups, 1)
+	assert.Equal(t, solana.PublicKeyFromBytes(key(0xCC)), tx.Message.AddressTableLookups[0].AccountKey)
+	assert.Equal(t, solana.Uint8SliceAsNum{1, 2}, tx.Message.AddressTableLookups[0].ReadonlyIndexes)
+
+	assert.Nil(t, meta.Err)
+	assert.NoError(t, validateTransactionMeta(meta))
+	assert.Equal(t, []string{"Program log: hello"}, meta.LogMessages)
+	assert.Equal(t, []rpc.InnerInstruction{{
+		Index:        0,
+		Instructions: []solana.CompiledInstruction{{ProgramIDIndex: 2, Accounts: []uint16{1}, Data: solana.Base58{0x03}}},
+	}}, meta.InnerInstructions)
+	assert.Equal(t, solana.PublicKeySlice{solana.PublicKeyFromBytes(key(0xDD))}, meta.LoadedAddresses.Writable)
+	assert.Len(t, meta.LoadedAddresses.ReadOnly, 2)
+
+	// A failed transaction is rejected by the metadata validation.
+	info.Meta.Err = &geyser.TransactionError{Err: []byte{0x01}}
+	_, meta, err = geyserTransactionToRpc(info)
+	require.NoError(t, err)
+	assert.Error(t, validateTransactionMeta(meta))
+
+	// Malformed transactions are rejected.
+	info.Transaction.Message.AccountKeys = [][]byte{{0x01}}
+	_, _, err = geyserTransactionToRpc(info)
+	assert.Error(t, err)
+
+	info.Transaction.Signatures = nil
+	_, _, err = geyserTransactionToRpc(info)
+	assert.Error(t, err)
+
+	_, _, err = geyserTransactionToRpc(&geyser.SubscribeUpdateTransactionInfo{Transaction: info.Transaction})
+	assert.Error(t, err)
+}
+
+func TestDialGeyser(t *testing.T) {
+	for _, endpoint := range []string{"https://geyser.example.com", "http://localhost:10000"} {
+		conn, err := dialGeyser(endpoint, "token")
+		require.NoError(t, err, endpoint)
+		require.NoError(t, conn.Close())
+	}
+
+	for _, endpoint := range []string{"geyser.example.com:443", "ws://geyser.example.com", "https://"} {
+		_, err := dialGeyser(endpoint, "")
+		assert.Error(t, err, endpoint)
+	}
+}
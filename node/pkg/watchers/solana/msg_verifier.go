@@ -0,0 +1,66 @@
+package solana
+
+import (
+	"fmt"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// verify evaluates a MessagePublication using the Solana transfer verifier and returns a copy of it with its
+// verification state set. `tx` and `meta` are the already fetched transaction that posted the message to
+// `messageAccount`. They are nil when that transaction is not known, in which case token bridge transfers are marked
+// as CouldNotVerify.
+func (s *SolanaWatcher) verify(
+	msg *common.MessagePublication,
+	tx *solana.Transaction,
+	meta *rpc.TransactionMeta,
+	messageAccount solana.PublicKey,
+	logger *zap.Logger,
+) (common.MessagePublication, error) {
+
+	if msg == nil {
+		return common.MessagePublication{}, fmt.Errorf("MessagePublication is nil")
+	}
+
+	if msg.VerificationState() != common.NotVerified {
+		return common.MessagePublication{}, fmt.Errorf("MessagePublication already has a non-default verification state")
+	}
+
+	if s.txVerifier == nil {
+		return common.MessagePublication{}, fmt.Errorf("transfer verifier is nil")
+	}
+
+	localMsg := *msg
+
+	var verificationState common.VerificationState
+
+	// If the payload does not represent a transfer, or if the emitter address of the message does
+	// not match the token bridge emitter, mark the message's verification state as NotApplicable.
+	if !vaa.IsTransfer(msg.Payload) || localMsg.EmitterAddress != vaa.Address(s.txVerifier.GetTokenBridgeEmitter()) {
+		verificationState = common.NotApplicable
+	} else if tx == nil {
+		logger.Debug("cannot verify transfer without the transaction that posted it", zap.Stringer("account", messageAccount))
+		verificationState = common.CouldNotVerify
+	} else {
+		// Unlike the Sui verifier, the Solana verifier distinguishes between invariant violations, which are
+		// Rejected, and transfers whose message does not match the instruction that posted it, which are Anomalous.
+		state, err := s.txVerifier.ProcessTransaction(tx, meta, messageAccount, logger)
+		if err != nil {
+			logger.Error("an internal Solana tx verifier error occurred", zap.Stringer("account", messageAccount), zap.Error(err))
+		}
+		verificationState = state
+	}
+
+	// Update the state of the message.
+	updateErr := localMsg.SetVerificationState(verificationState)
+	if updateErr != nil {
+		errMsg := fmt.Sprintf("could not set verification state for message with txID %s", localMsg.TxIDString())
+		return common.MessagePublication{}, fmt.Errorf("%s %w", errMsg, updateErr)
+	}
+
+	return localMsg, nil
+}
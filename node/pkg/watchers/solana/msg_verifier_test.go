@@ -0,0 +1,113 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/txverifier"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// countingSolanaClient is a txverifier.SolanaClient that cannot fetch any transaction and counts the attempts.
+type countingSolanaClient struct {
+	calls int
+}
+
+func (c *countingSolanaClient) GetTransaction(context.Context, solana.Signature, *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error) {
+	c.calls++
+	return nil, errors.New("rpc unavailable")
+}
+
+func TestVerify(t *testing.T) {
+	tokenBridge := solana.MustPublicKeyFromBase58(txverifier.SolanaTokenBridgeProgramIds[common.MainNet])
+	coreBridge := solana.MustPublicKeyFromBase58("worm2ZoG2kUd4vFXhvjh93UUH596ayRfgQ2MgjNMTth")
+	client := &countingSolanaClient{}
+	verifier, err := txverifier.NewSolanaTransferVerifier(coreBridge, tokenBridge, client)
+	require.NoError(t, err)
+
+	s := newTestWatcher(t, vaa.ChainIDSolana, rpc.CommitmentFinalized, nil)
+	s.txVerifier = verifier
+
+	transferPayload := make([]byte, 133)
+	transferPayload[0] = 1
+	tokenBridgeEmitter := vaa.Address(verifier.GetTokenBridgeEmitter())
+	tx := &solana.Transaction{Signatures: []solana.Signature{{0x01}}}
+
+	tests := []struct {
+		name          string
+		emitter       vaa.Address
+		payload       []byte
+		tx            *solana.Transaction
+		meta          *rpc.TransactionMeta
+		expectedState common.VerificationState
+	}{
+		{
+			name:          "not from the token bridge",
+			emitter:       vaa.Address{0x01},
+			payload:       transferPayload,
+			tx:            tx,
+			meta:          &rpc.TransactionMeta{},
+			expectedState: common.NotApplicable,
+		},
+		{
+			name:          "not a transfer",
+			emitter:       tokenBridgeEmitter,
+			payload:       []byte{0x02, 0x00},
+			tx:            tx,
+			meta:          &rpc.TransactionMeta{},
+			expectedState: common.NotApplicable,
+		},
+		{
+			name:          "posting transaction unknown",
+			emitter:       tokenBridgeEmitter,
+			payload:       transferPayload,
+			expectedState: common.CouldNotVerify,
+		},
+		{
+			name:          "posting transaction has no metadata",
+			emitter:       tokenBridgeEmitter,
+			payload:       transferPayload,
+			tx:            tx,
+			expectedState: common.CouldNotVerify,
+		},
+		{
+			name:          "posting transaction failed",
+			emitter:       tokenBridgeEmitter,
+			payload:       transferPayload,
+			tx:            tx,
+			meta:          &rpc.TransactionMeta{Err: "InstructionError"},
+			expectedState: common.CouldNotVerify,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &common.MessagePublication{
+				EmitterChain:   vaa.ChainIDSolana,
+				EmitterAddress: tc.emitter,
+				Payload:        tc.payload,
+			}
+
+			verified, err := s.verify(msg, tc.tx, tc.meta, solana.PublicKey{0x02}, zap.NewNop())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedState, verified.VerificationState())
+			// The original message is left untouched.
+			require.Equal(t, common.NotVerified, msg.VerificationState())
+		})
+	}
+
+	// The transaction the watcher already holds is used, so the verifier never fetches it again.
+	require.Zero(t, client.calls)
+
+	// A message that has already been verified is an error.
+	msg := &common.MessagePublication{EmitterAddress: tokenBridgeEmitter, Payload: transferPayload}
+	require.NoError(t, msg.SetVerificationState(common.Valid))
+	_, err = s.verify(msg, tx, &rpc.TransactionMeta{}, solana.PublicKey{0x02}, zap.NewNop())
+	require.Error(t, err)
+}
@@ -0,0 +1,464 @@
+package sui
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/certusone/wormhole/node/pkg/suiclient"
+	"github.com/mr-tron/base58"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// ccqRpcTimeout is the timeout applied to each RPC made while handling a query.
+	ccqRpcTimeout = 10 * time.Second
+
+	// ccqMaxObjectVersionHops is the maximum number of prior versions we will walk back through when looking
+	// for the version of an object that was live as of the requested checkpoint. Objects that change more often
+	// than this between the requested checkpoint and now cannot be queried at that checkpoint.
+	ccqMaxObjectVersionHops = 16
+)
+
+// errCcqCheckpointNotReached is returned when our node has not reached the checkpoint of a query yet.
+var errCcqCheckpointNotReached = errors.New("requested checkpoint has not been reached yet")
+
+// ccqObjectFields are the object fields needed to build a sui_object query response.
+var ccqObjectFields = []string{
+	suiclient.ObjectFieldObjectID,
+	suiclient.ObjectFieldVersion,
+	suiclient.ObjectFieldDigest,
+	suiclient.ObjectFieldObjectType,
+	suiclient.ObjectFieldContents,
+	suiclient.ObjectFieldPreviousTransaction,
+}
+
+// ccqHandler implements the query.Watcher interface for Sui. It binds the watcher to the Sui client
+// created for the current invocation of Run, so the client does not need to be stored on the Watcher.
+type ccqHandler struct {
+	w      *Watcher
+	client suiclient.SuiClient
+	logger *zap.Logger
+}
+
+// ccqStart starts up CCQ query processing.
+func (e *Watcher) ccqStart(ctx context.Context, logger *zap.Logger, errC chan error, client suiclient.SuiClient) {
+	h := &ccqHandler{
+		w:      e,
+		client: client,
+		logger: logger.With(zap.String("component", "ccqsui")),
+	}
+	query.StartWorkers(ctx, h.logger, errC, h, e.queryReqC, e.ccqConfig, vaa.ChainIDSui.String())
+}
+
+// ccqSendQueryResponse sends a response back to the query handler.
+func (h *ccqHandler) ccqSendQueryResponse(queryResponse *query.PerChainQueryResponseInternal) {
+	select {
+	case h.w.queryResponseC <- queryResponse:
+		h.logger.Debug("published query response to handler")
+	default:
+		h.logger.Error("failed to published query response error to handler")
+	}
+}
+
+// ccqSendErrorResponse creates an error query response and sends it back to the query handler. It sets the response field to nil.
+func (h *ccqHandler) ccqSendErrorResponse(req *query.PerChainQueryInternal, status query.QueryStatus) {
+	queryResponse := query.CreatePerChainQueryResponseInternal(req.RequestID, req.RequestIdx, req.Request.ChainId, status, nil)
+	h.ccqSendQueryResponse(queryResponse)
+}
+
+// QueryHandler is the top-level query handler. It breaks out the requests based on the type and calls the appropriate handler.
+func (h *ccqHandler) QueryHandler(ctx context.Context, queryRequest *query.PerChainQueryInternal) {
+	// This can't happen unless there is a programming error - the caller
+	// is expected to send us only requests for our chainID.
+	if queryRequest.Request.ChainId != vaa.ChainIDSui {
+		panic("ccqsui: invalid chain ID")
+	}
+
+	start := time.Now()
+
+	switch req := queryRequest.Request.Query.(type) {
+	case *query.SuiObjectQueryRequest:
+		h.ccqHandleSuiObjectQueryRequest(ctx, queryRequest, req)
+	case *query.SuiMoveViewQueryRequest:
+		h.ccqHandleSuiMoveViewQueryRequest(ctx, queryRequest, req)
+	default:
+		h.logger.Warn("received unsupported request type",
+			zap.Uint8("payload", uint8(queryRequest.Request.Query.Type())),
+		)
+		h.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+	}
+
+	query.TotalWatcherTime.WithLabelValues(vaa.ChainIDSui.String()).Observe(float64(time.Since(start).Milliseconds()))
+}
+
+// ccqGetLatestCheckpoint returns the sequence number of the latest checkpoint known to our node.
+func (h *ccqHandler) ccqGetLatestCheckpoint(ctx context.Context) (uint64, error) {
+	checkpoint, err := h.client.GetLatestCheckpoint(ctx, []string{suiclient.CheckpointFieldSequenceNumber})
+	if err != nil {
+		return 0, err
+	}
+	if checkpoint.SequenceNumber == nil {
+		return 0, fmt.Errorf("latest checkpoint response missing sequence number")
+	}
+	return *checkpoint.SequenceNumber, nil
+}
+
+// ccqCheckpoint holds the fields of a checkpoint that are returned in query responses.
+type ccqCheckpoint struct {
+	digest [query.SuiDigestLength]byte
+	time   time.Time
+}
+
+// ccqReadCheckpoint returns the digest and timestamp of the requested checkpoint. If our node has not reached the checkpoint yet,
+// it fails with a status asking the query handler to retry later. On failure, it returns the status that should be reported to the query handler.
+func (h *ccqHandler) ccqReadCheckpoint(ctx context.Context, sequenceNumber uint64) (ccqCheckpoint, query.QueryStatus, error) {
+	latest, err := h.ccqGetLatestCheckpoint(ctx)
+	if err != nil {
+		return ccqCheckpoint{}, query.QueryRetryNeeded, fmt.Errorf("failed to read latest checkpoint: %w", err)
+	}
+
+	if latest < sequenceNumber {
+		return ccqCheckpoint{}, query.QueryRetryNeeded, fmt.Errorf("%w, latest checkpoint is %d", errCcqCheckpointNotReached, latest)
+	}
+
+	checkpoint, err := h.client.GetCheckpoint(ctx, sequenceNumber, []string{
+		suiclient.CheckpointFieldSequenceNumber,
+		suiclient.CheckpointFieldDigest,
+		suiclient.CheckpointFieldTimestamp,
+	})
+	if err != nil {
+		return ccqCheckpoint{}, query.QueryRetryNeeded, err
+	}
+
+	if checkpoint.SequenceNumber == nil || checkpoint.Digest == nil || checkpoint.Timestamp == nil {
+		return ccqCheckpoint{}, query.QueryRetryNeeded, fmt.Errorf("checkpoint is missing required fields")
+	}
+
+	if *checkpoint.SequenceNumber != sequenceNumber {
+		return ccqCheckpoint{}, query.QueryRetryNeeded, fmt.Errorf("read wrong checkpoint %d", *checkpoint.SequenceNumber)
+	}
+
+	digest, err := ccqDecodeDigest(*checkpoint.Digest)
+	if err != nil {
+		return ccqCheckpoint{}, query.QueryFatalError, fmt.Errorf("failed to decode checkpoint digest %s: %w", *checkpoint.Digest, err)
+	}
+
+	return ccqCheckpoint{digest: digest, time: time.UnixMicro(checkpoint.Timestamp.UnixMicro())}, query.QuerySuccess, nil
+}
+
+// ccqHandleSuiObjectQueryRequest is the query handler for a sui_object request. It reads each object as of the requested checkpoint.
+func (h *ccqHandler) ccqHandleSuiObjectQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.SuiObjectQueryRequest) {
+	requestId := "sui_object:" + queryRequest.ID()
+	h.logger.Info("received a sui_object query",
+		zap.String("requestId", requestId),
+		zap.Uint64("checkpoint", req.Checkpoint),
+		zap.Int("numObjects", len(req.ObjectIDs)),
+	)
+
+	rCtx, cancel := context.WithTimeout(ctx, ccqRpcTimeout)
+	defer cancel()
+
+	checkpoint, status, err := h.ccqReadCheckpoint(rCtx, req.Checkpoint)
+	if errors.Is(err, errCcqCheckpointNotReached) {
+		h.logger.Info("requested checkpoint has not been reached yet for sui_object query request, will retry",
+			zap.String("requestId", requestId),
+			zap.Uint64("checkpoint", req.Checkpoint),
+			zap.Error(err),
+		)
+		h.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to read checkpoint for sui_object query request",
+			zap.String("requestId", requestId),
+			zap.Uint64("checkpoint", req.Checkpoint),
+			zap.Error(err),
+		)
+		h.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+
+	results := make([]query.SuiObjectResult, 0, len(req.ObjectIDs))
+	for _, objectID := range req.ObjectIDs {
+		objectIDStr := "0x" + hex.EncodeToString(objectID[:])
+		result, status, err := h.ccqReadObjectAsOfCheckpoint(rCtx, objectIDStr, req.Checkpoint)
+		if err != nil {
+			h.logger.Error("failed to read object for sui_object query request",
+				zap.String("requestId", requestId),
+				zap.String("objectId", objectIDStr),
+				zap.Uint64("checkpoint", req.Checkpoint),
+				zap.Error(err),
+			)
+			h.ccqSendErrorResponse(queryRequest, status)
+			return
+		}
+		results = append(results, result)
+	}
+
+	resp := query.SuiObjectQueryResponse{
+		CheckpointSequenceNumber: req.Checkpoint,
+		CheckpointDigest:         checkpoint.digest,
+		CheckpointTime:           checkpoint.time,
+		Results:                  results,
+	}
+
+	h.logger.Info("sui_object query complete",
+		zap.String("requestId", requestId),
+		zap.Uint64("checkpoint", req.Checkpoint),
+		zap.String("checkpointDigest", base58.Encode(checkpoint.digest[:])),
+		zap.Int("numResults", len(resp.Results)),
+	)
+
+	queryResponse := query.CreatePerChainQueryResponseInternal(queryRequest.RequestID, queryRequest.RequestIdx, queryRequest.Request.ChainId, query.QuerySuccess, &resp)
+	h.ccqSendQueryResponse(queryResponse)
+}
+
+// ccqReadObjectAsOfCheckpoint returns the version of an object that was live as of the specified checkpoint. It starts with the latest
+// version and, while that version was created by a transaction after the checkpoint, steps back to the version that transaction consumed.
+// On failure, it returns the status that should be reported to the query handler.
+func (h *ccqHandler) ccqReadObjectAsOfCheckpoint(ctx context.Context, objectID string, checkpoint uint64) (query.SuiObjectResult, query.QueryStatus, error) {
+	var version *uint64
+	for hop := 0; hop < ccqMaxObjectVersionHops; hop++ {
+		obj, err := h.client.GetObjectAtVersion(ctx, objectID, version, ccqObjectFields)
+		if err != nil {
+			return query.SuiObjectResult{}, query.QueryRetryNeeded, err
+		}
+
+		if obj.Version == nil || obj.Digest == nil || obj.ObjectType == nil || obj.PreviousTransaction == nil {
+			return query.SuiObjectResult{}, query.QueryRetryNeeded, fmt.Errorf("object response is missing required fields")
+		}
+
+		tx, err := h.client.GetTransaction(ctx, *obj.PreviousTransaction, []string{
+			suiclient.TransactionFieldCheckpoint,
+			suiclient.TransactionFieldChangedObjects,
+		})
+		if err != nil {
+			return query.SuiObjectResult{}, query.QueryRetryNeeded, fmt.Errorf("failed to read previous transaction %s: %w", *obj.PreviousTransaction, err)
+		}
+
+		if tx.Checkpoint == nil {
+			return query.SuiObjectResult{}, query.QueryRetryNeeded, fmt.Errorf("previous transaction %s is missing its checkpoint", *obj.PreviousTransaction)
+		}
+
+		if *tx.Checkpoint <= checkpoint {
+			digest, err := ccqDecodeDigest(*obj.Digest)
+			if err != nil {
+				return query.SuiObjectResult{}, query.QueryFatalError, fmt.Errorf("failed to decode object digest: %w", err)
+			}
+
+			idBytes, err := hex.DecodeString(strings.TrimPrefix(objectID, "0x"))
+			if err != nil || len(idBytes) != query.SuiObjectIDLength {
+				return query.SuiObjectResult{}, query.QueryFatalError, fmt.Errorf("invalid object id: %s", objectID)
+			}
+
+			result := query.SuiObjectResult{
+				Version:    *obj.Version,
+				Digest:     digest,
+				ObjectType: *obj.ObjectType,
+				Data:       obj.ContentsBytes,
+			}
+			copy(result.ObjectID[:], idBytes)
+			return result, query.QuerySuccess, nil
+		}
+
+		// This version was written after the checkpoint, so find the version the transaction consumed.
+		version = nil
+		for _, change := range tx.ObjectChanges {
+			if change.ObjectID != nil && strings.EqualFold(*change.ObjectID, objectID) {
+				version = change.InputVersion
+				break
+			}
+		}
+
+		if version == nil {
+			// The object was created after the checkpoint, so it did not exist yet. Retrying will not help.
+			return query.SuiObjectResult{}, query.QueryFatalError, fmt.Errorf("object did not exist as of checkpoint %d", checkpoint)
+		}
+	}
+
+	return query.SuiObjectResult{}, query.QueryFatalError, fmt.Errorf("object changed more than %d times since checkpoint %d", ccqMaxObjectVersionHops, checkpoint)
+}
+
+// ccqHandleSuiMoveViewQueryRequest is the query handler for a sui_move_view request. It evaluates the calls in a single simulated transaction.
+// Sui nodes can only simulate against their latest state, so the calls are pinned to the requested checkpoint by requiring that none of
+// their object arguments changed after it, both before and after the simulation.
+func (h *ccqHandler) ccqHandleSuiMoveViewQueryRequest(ctx context.Context, queryRequest *query.PerChainQueryInternal, req *query.SuiMoveViewQueryRequest) {
+	requestId := "sui_move_view:" + queryRequest.ID()
+	h.logger.Info("received a sui_move_view query",
+		zap.String("requestId", requestId),
+		zap.Uint64("checkpoint", req.Checkpoint),
+		zap.Int("numCalls", len(req.CallData)),
+	)
+
+	rCtx, cancel := context.WithTimeout(ctx, ccqRpcTimeout)
+	defer cancel()
+
+	checkpoint, status, err := h.ccqReadCheckpoint(rCtx, req.Checkpoint)
+	if errors.Is(err, errCcqCheckpointNotReached) {
+		h.logger.Info("requested checkpoint has not been reached yet for sui_move_view query request, will retry",
+			zap.String("requestId", requestId),
+			zap.Uint64("checkpoint", req.Checkpoint),
+			zap.Error(err),
+		)
+		h.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+	if err != nil {
+		h.logger.Error("failed to read checkpoint for sui_move_view query request",
+			zap.String("requestId", requestId),
+			zap.Uint64("checkpoint", req.Checkpoint),
+			zap.Error(err),
+		)
+		h.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+
+	var objectIDs []string
+	calls := make([]suiclient.SuiMoveCall, 0, len(req.CallData))
+	for _, cd := range req.CallData {
+		call := suiclient.SuiMoveCall{
+			Package:       "0x" + hex.EncodeToString(cd.Package[:]),
+			Module:        cd.Module,
+			Function:      cd.Function,
+			TypeArguments: cd.TypeArguments,
+		}
+		for _, arg := range cd.Arguments {
+			switch arg.Kind {
+			case query.SuiMoveCallArgumentPure:
+				call.Arguments = append(call.Arguments, suiclient.SuiMoveCallInput{Pure: arg.Pure})
+			case query.SuiMoveCallArgumentObject:
+				objectID := "0x" + hex.EncodeToString(arg.ObjectID[:])
+				call.Arguments = append(call.Arguments, suiclient.SuiMoveCallInput{ObjectID: &objectID})
+				objectIDs = append(objectIDs, objectID)
+			default:
+				// This should have been caught by Validate.
+				h.logger.Error("invalid argument kind in sui_move_view query request",
+					zap.String("requestId", requestId),
+					zap.Uint8("kind", uint8(arg.Kind)),
+				)
+				h.ccqSendErrorResponse(queryRequest, query.QueryFatalError)
+				return
+			}
+		}
+		calls = append(calls, call)
+	}
+
+	versions, status, err := h.ccqReadObjectVersionsUnchangedSince(rCtx, objectIDs, req.Checkpoint)
+	if err != nil {
+		h.logger.Error("failed to pin object arguments to checkpoint for sui_move_view query request",
+			zap.String("requestId", requestId),
+			zap.Uint64("checkpoint", req.Checkpoint),
+			zap.Error(err),
+		)
+		h.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+
+	callResults, err := h.client.SimulateMoveCalls(rCtx, calls)
+	if err != nil {
+		h.logger.Error("failed to simulate calls for sui_move_view query request",
+			zap.String("requestId", requestId),
+			zap.Error(err),
+		)
+		h.ccqSendErrorResponse(queryRequest, query.QueryRetryNeeded)
+		return
+	}
+
+	// An object may have changed while the calls were being simulated, in which case the results may not reflect the checkpoint.
+	versionsAfter, status, err := h.ccqReadObjectVersionsUnchangedSince(rCtx, objectIDs, req.Checkpoint)
+	if err == nil {
+		for objectID, version := range versions {
+			if versionsAfter[objectID] != version {
+				status, err = query.QueryFatalError, fmt.Errorf("object %s changed while the calls were being simulated", objectID)
+				break
+			}
+		}
+	}
+	if err != nil {
+		h.logger.Error("failed to pin object arguments to checkpoint for sui_move_view query request",
+			zap.String("requestId", requestId),
+			zap.Uint64("checkpoint", req.Checkpoint),
+			zap.Error(err),
+		)
+		h.ccqSendErrorResponse(queryRequest, status)
+		return
+	}
+
+	resp := query.SuiMoveViewQueryResponse{
+		CheckpointSequenceNumber: req.Checkpoint,
+		CheckpointDigest:         checkpoint.digest,
+		CheckpointTime:           checkpoint.time,
+	}
+	for _, cr := range callResults {
+		resp.Results = append(resp.Results, query.SuiMoveViewResult{ReturnValues: cr.ReturnValues})
+	}
+
+	h.logger.Info("sui_move_view query complete",
+		zap.String("requestId", requestId),
+		zap.Uint64("checkpoint", req.Checkpoint),
+		zap.Int("numResults", len(resp.Results)),
+	)
+
+	queryResponse := query.CreatePerChainQueryResponseInternal(queryRequest.RequestID, queryRequest.RequestIdx, queryRequest.Request.ChainId, query.QuerySuccess, &resp)
+	h.ccqSendQueryResponse(queryResponse)
+}
+
+// ccqReadObjectVersionsUnchangedSince returns the latest version of each object, and fails if any of them was written after the checkpoint.
+// On failure, it returns the status that should be reported to the query handler.
+func (h *ccqHandler) ccqReadObjectVersionsUnchangedSince(ctx context.Context, objectIDs []string, checkpoint uint64) (map[string]uint64, query.QueryStatus, error) {
+	versions := make(map[string]uint64, len(objectIDs))
+	for _, objectID := range objectIDs {
+		if _, exists := versions[objectID]; exists {
+			continue
+		}
+
+		obj, err := h.client.GetObjectAtVersion(ctx, objectID, nil, []string{
+			suiclient.ObjectFieldObjectID,
+			suiclient.ObjectFieldVersion,
+			suiclient.ObjectFieldPreviousTransaction,
+		})
+		if err != nil {
+			return nil, query.QueryRetryNeeded, fmt.Errorf("failed to read object %s: %w", objectID, err)
+		}
+		if obj.Version == nil || obj.PreviousTransaction == nil {
+			return nil, query.QueryRetryNeeded, fmt.Errorf("object %s response is missing required fields", objectID)
+		}
+
+		tx, err := h.client.GetTransaction(ctx, *obj.PreviousTransaction, []string{suiclient.TransactionFieldCheckpoint})
+		if err != nil {
+			return nil, query.QueryRetryNeeded, fmt.Errorf("failed to read previous transaction %s: %w", *obj.PreviousTransaction, err)
+		}
+		if tx.Checkpoint == nil {
+			return nil, query.QueryRetryNeeded, fmt.Errorf("previous transaction %s is missing its checkpoint", *obj.PreviousTransaction)
+		}
+
+		if *tx.Checkpoint > checkpoint {
+			// Our node has moved past the checkpoint, so retrying will not help. The query must be made at a later checkpoint.
+			return nil, query.QueryFatalError, fmt.Errorf("object %s changed at checkpoint %d, after checkpoint %d", objectID, *tx.Checkpoint, checkpoint)
+		}
+
+		versions[objectID] = *obj.Version
+	}
+
+	return versions, query.QuerySuccess, nil
+}
+
+// ccqDecodeDigest converts a base58 encoded Sui digest to its binary form.
+func ccqDecodeDigest(digest string) ([query.SuiDigestLength]byte, error) {
+	var out [query.SuiDigestLength]byte
+	b, err := base58.Decode(digest)
+	if err != nil {
+		return out, err
+	}
+	if len(b) != query.SuiDigestLength {
+		return out, fmt.Errorf("digest has invalid length %d", len(b))
+	}
+	copy(out[:], b)
+	return out, nil
+}
@@ -0,0 +1,333 @@
+package sui
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/query"
+	"github.com/certusone/wormhole/node/pkg/suiclient"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// ccqMockObjectVersion describes one version of an object served by ccqMockSuiClient.
+type ccqMockObjectVersion struct {
+	version      uint64
+	inputVersion *uint64 // The version consumed by the transaction that wrote this one. Nil if it was created.
+	checkpoint   uint64  // The checkpoint of the transaction that wrote this version.
+	contents     []byte
+}
+
+// ccqMockSuiClient serves a version history for a single object, along with checkpoints and simulation results.
+type ccqMockSuiClient struct {
+	mockSuiClient
+	latestCheckpoint uint64
+	objectID         string
+	versions         []ccqMockObjectVersion // Ordered oldest first.
+	simulateResults  []suiclient.SuiMoveCallResult
+	simulateCalls    []suiclient.SuiMoveCall
+	onSimulate       func(m *ccqMockSuiClient) // Called after the calls are recorded, to change the state during the simulation.
+}
+
+func ccqTestDigest(seed byte) string {
+	b := make([]byte, query.SuiDigestLength)
+	for i := range b {
+		b[i] = seed
+	}
+	return base58.Encode(b)
+}
+
+func ccqTestTxDigest(version uint64) string {
+	return ccqTestDigest(byte(version)) // #nosec G115 -- Test versions are small
+}
+
+func (m *ccqMockSuiClient) GetLatestCheckpoint(ctx context.Context, fields []string) (suiclient.SuiCheckpoint, error) {
+	sn := m.latestCheckpoint
+	return suiclient.SuiCheckpoint{SequenceNumber: &sn}, nil
+}
+
+func (m *ccqMockSuiClient) GetCheckpoint(ctx context.Context, sequenceNumber uint64, fields []string) (suiclient.SuiCheckpoint, error) {
+	digest := ccqTestDigest(0xcc)
+	ts := time.UnixMilli(1_700_000_000_000)
+	return suiclient.SuiCheckpoint{SequenceNumber: &sequenceNumber, Digest: &digest, Timestamp: &ts}, nil
+}
+
+func (m *ccqMockSuiClient) GetObjectAtVersion(ctx context.Context, objectID string, version *uint64, fields []string) (suiclient.SuiObject, error) {
+	if objectID != m.objectID || len(m.versions) == 0 {
+		return suiclient.SuiObject{}, fmt.Errorf("object %s not found", objectID)
+	}
+	ov := m.versions[len(m.versions)-1]
+	if version != nil {
+		found := false
+		for _, v := range m.versions {
+			if v.version == *version {
+				ov, found = v, true
+				break
+			}
+		}
+		if !found {
+			return suiclient.SuiObject{}, fmt.Errorf("object %s@%d not found", objectID, *version)
+		}
+	}
+	digest := ccqTestDigest(0xdd)
+	objectType := "0x2::coin::Coin<0x2::sui::SUI>"
+	prevTx := ccqTestTxDigest(ov.version)
+	v := ov.version
+	return suiclient.SuiObject{
+		ObjectID:            &m.objectID,
+		Version:             &v,
+		Digest:              &digest,
+		ObjectType:          &objectType,
+		ContentsBytes:       ov.contents,
+		PreviousTransaction: &prevTx,
+	}, nil
+}
+
+func (m *ccqMockSuiClient) GetTransaction(ctx context.Context, digest string, fields []string) (suiclient.SuiTransaction, error) {
+	for _, ov := range m.versions {
+		if ccqTestTxDigest(ov.version) == digest {
+			cp := ov.checkpoint
+			v := ov.version
+			return suiclient.SuiTransaction{
+				Checkpoint: &cp,
+				ObjectChanges: []suiclient.SuiObjectChange{
+					{ObjectID: &m.objectID, InputVersion: ov.inputVersion, OutputVersion: &v},
+				},
+			}, nil
+		}
+	}
+	return suiclient.SuiTransaction{}, fmt.Errorf("transaction not found: %s", digest)
+}
+
+func (m *ccqMockSuiClient) SimulateMoveCalls(ctx context.Context, calls []suiclient.SuiMoveCall) ([]suiclient.SuiMoveCallResult, error) {
+	m.simulateCalls = calls
+	if m.onSimulate != nil {
+		m.onSimulate(m)
+	}
+	return m.simulateResults, nil
+}
+
+func newCcqTestHandler(client suiclient.SuiClient) (*ccqHandler, chan *query.PerChainQueryResponseInternal) {
+	queryResponseC := make(chan *query.PerChainQueryResponseInternal, 10)
+	w := &Watcher{queryResponseC: queryResponseC}
+	return &ccqHandler{w: w, client: client, logger: zap.NewNop()}, queryResponseC
+}
+
+func newCcqTestRequest(q query.ChainSpecificQuery) *query.PerChainQueryInternal {
+	return &query.PerChainQueryInternal{
+		RequestID:  "123456",
+		RequestIdx: 0,
+		Request:    &query.PerChainQueryRequest{ChainId: vaa.ChainIDSui, Query: q},
+	}
+}
+
+func ccqTestObjectHistory() []ccqMockObjectVersion {
+	v1, v2 := uint64(1), uint64(2)
+	return []ccqMockObjectVersion{
+		{version: 1, inputVersion: nil, checkpoint: 100, contents: []byte("created")},
+		{version: 2, inputVersion: &v1, checkpoint: 200, contents: []byte("first update")},
+		{version: 3, inputVersion: &v2, checkpoint: 300, contents: []byte("second update")},
+	}
+}
+
+func TestCcqSuiObjectQueryReadsVersionAsOfCheckpoint(t *testing.T) {
+	var objectID [query.SuiObjectIDLength]byte
+	objectID[31] = 0x42
+	objectIDStr := "0x" + hex.EncodeToString(objectID[:])
+
+	tests := []struct {
+		name            string
+		checkpoint      uint64
+		expectedStatus  query.QueryStatus
+		expectedVersion uint64
+		expectedData    []byte
+	}{
+		{name: "latest version", checkpoint: 350, expectedStatus: query.QuerySuccess, expectedVersion: 3, expectedData: []byte("second update")},
+		{name: "exactly at checkpoint", checkpoint: 300, expectedStatus: query.QuerySuccess, expectedVersion: 3, expectedData: []byte("second update")},
+		{name: "one version back", checkpoint: 250, expectedStatus: query.QuerySuccess, expectedVersion: 2, expectedData: []byte("first update")},
+		{name: "two versions back", checkpoint: 150, expectedStatus: query.QuerySuccess, expectedVersion: 1, expectedData: []byte("created")},
+		{name: "before creation", checkpoint: 50, expectedStatus: query.QueryFatalError},
+		{name: "checkpoint not reached", checkpoint: 500, expectedStatus: query.QueryRetryNeeded},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &ccqMockSuiClient{
+				latestCheckpoint: 400,
+				objectID:         objectIDStr,
+				versions:         ccqTestObjectHistory(),
+			}
+			h, queryResponseC := newCcqTestHandler(client)
+
+			req := &query.SuiObjectQueryRequest{Checkpoint: tc.checkpoint, ObjectIDs: [][query.SuiObjectIDLength]byte{objectID}}
+			h.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+			require.Len(t, queryResponseC, 1)
+			resp := <-queryResponseC
+			require.Equal(t, tc.expectedStatus, resp.Status)
+			if tc.expectedStatus != query.QuerySuccess {
+				require.Nil(t, resp.Response)
+				return
+			}
+
+			objResp, ok := resp.Response.(*query.SuiObjectQueryResponse)
+			require.True(t, ok)
+			require.Equal(t, tc.checkpoint, objResp.CheckpointSequenceNumber)
+			require.Len(t, objResp.Results, 1)
+			require.Equal(t, objectID, objResp.Results[0].ObjectID)
+			require.Equal(t, tc.expectedVersion, objResp.Results[0].Version)
+			require.Equal(t, tc.expectedData, objResp.Results[0].Data)
+
+			// The response must be marshalable.
+			_, err := objResp.Marshal()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCcqSuiObjectQueryTooManyVersions(t *testing.T) {
+	var objectID [query.SuiObjectIDLength]byte
+	objectID[31] = 0x42
+	objectIDStr := "0x" + hex.EncodeToString(objectID[:])
+
+	// Build a history where every version was written after the requested checkpoint.
+	versions := []ccqMockObjectVersion{{version: 1, checkpoint: 100}}
+	for v := uint64(2); v <= ccqMaxObjectVersionHops+2; v++ {
+		prev := v - 1
+		versions = append(versions, ccqMockObjectVersion{version: v, inputVersion: &prev, checkpoint: 1000 + v})
+	}
+
+	client := &ccqMockSuiClient{latestCheckpoint: 2000, objectID: objectIDStr, versions: versions}
+	h, queryResponseC := newCcqTestHandler(client)
+
+	req := &query.SuiObjectQueryRequest{Checkpoint: 500, ObjectIDs: [][query.SuiObjectIDLength]byte{objectID}}
+	h.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+	require.Len(t, queryResponseC, 1)
+	resp := <-queryResponseC
+	require.Equal(t, query.QueryFatalError, resp.Status)
+}
+
+func TestCcqSuiMoveViewQuery(t *testing.T) {
+	var pkg, objectID [query.SuiObjectIDLength]byte
+	pkg[31] = 0x02
+	objectID[31] = 0x06
+
+	client := &ccqMockSuiClient{
+		latestCheckpoint: 400,
+		objectID:         "0x" + hex.EncodeToString(objectID[:]),
+		versions:         ccqTestObjectHistory(),
+		simulateResults: []suiclient.SuiMoveCallResult{
+			{ReturnValues: [][]byte{{0x01, 0x02, 0x03}}},
+		},
+	}
+	h, queryResponseC := newCcqTestHandler(client)
+
+	req := &query.SuiMoveViewQueryRequest{
+		Checkpoint: 350,
+		CallData: []*query.SuiMoveCallData{
+			{
+				Package:       pkg,
+				Module:        "clock",
+				Function:      "timestamp_ms",
+				TypeArguments: []string{"0x2::sui::SUI"},
+				Arguments: []query.SuiMoveCallArgument{
+					{Kind: query.SuiMoveCallArgumentObject, ObjectID: objectID},
+					{Kind: query.SuiMoveCallArgumentPure, Pure: []byte{0x2a}},
+				},
+			},
+		},
+	}
+	h.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+	require.Len(t, queryResponseC, 1)
+	resp := <-queryResponseC
+	require.Equal(t, query.QuerySuccess, resp.Status)
+	viewResp, ok := resp.Response.(*query.SuiMoveViewQueryResponse)
+	require.True(t, ok)
+	require.Equal(t, uint64(350), viewResp.CheckpointSequenceNumber)
+	expectedDigest, err := ccqDecodeDigest(ccqTestDigest(0xcc))
+	require.NoError(t, err)
+	require.Equal(t, expectedDigest, viewResp.CheckpointDigest)
+	require.Equal(t, time.UnixMilli(1_700_000_000_000), viewResp.CheckpointTime)
+	require.Equal(t, []query.SuiMoveViewResult{{ReturnValues: [][]byte{{0x01, 0x02, 0x03}}}}, viewResp.Results)
+
+	// The response must be marshalable.
+	_, err = viewResp.Marshal()
+	require.NoError(t, err)
+
+	require.Len(t, client.simulateCalls, 1)
+	call := client.simulateCalls[0]
+	require.True(t, strings.HasSuffix(call.Package, "02"))
+	require.Equal(t, "clock", call.Module)
+	require.Equal(t, "timestamp_ms", call.Function)
+	require.Len(t, call.Arguments, 2)
+	require.NotNil(t, call.Arguments[0].ObjectID)
+	require.Equal(t, "0x"+hex.EncodeToString(objectID[:]), *call.Arguments[0].ObjectID)
+	require.Equal(t, []byte{0x2a}, call.Arguments[1].Pure)
+}
+
+func TestCcqSuiMoveViewQueryIsPinnedToCheckpoint(t *testing.T) {
+	var pkg, objectID [query.SuiObjectIDLength]byte
+	pkg[31] = 0x02
+	objectID[31] = 0x06
+
+	tests := []struct {
+		name           string
+		checkpoint     uint64
+		onSimulate     func(m *ccqMockSuiClient)
+		expectedStatus query.QueryStatus
+	}{
+		{name: "object unchanged since checkpoint", checkpoint: 300, expectedStatus: query.QuerySuccess},
+		{name: "object changed after checkpoint", checkpoint: 250, expectedStatus: query.QueryFatalError},
+		{name: "checkpoint not reached", checkpoint: 500, expectedStatus: query.QueryRetryNeeded},
+		{
+			name:       "object changed during simulation",
+			checkpoint: 350,
+			onSimulate: func(m *ccqMockSuiClient) {
+				prev := m.versions[len(m.versions)-1].version
+				m.versions = append(m.versions, ccqMockObjectVersion{version: prev + 1, inputVersion: &prev, checkpoint: 350})
+			},
+			expectedStatus: query.QueryFatalError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &ccqMockSuiClient{
+				latestCheckpoint: 400,
+				objectID:         "0x" + hex.EncodeToString(objectID[:]),
+				versions:         ccqTestObjectHistory(),
+				simulateResults:  []suiclient.SuiMoveCallResult{{ReturnValues: [][]byte{{0x01}}}},
+				onSimulate:       tc.onSimulate,
+			}
+			h, queryResponseC := newCcqTestHandler(client)
+
+			req := &query.SuiMoveViewQueryRequest{
+				Checkpoint: tc.checkpoint,
+				CallData: []*query.SuiMoveCallData{
+					{
+						Package:   pkg,
+						Module:    "clock",
+						Function:  "timestamp_ms",
+						Arguments: []query.SuiMoveCallArgument{{Kind: query.SuiMoveCallArgumentObject, ObjectID: objectID}},
+					},
+				},
+			}
+			h.QueryHandler(context.Background(), newCcqTestRequest(req))
+
+			require.Len(t, queryResponseC, 1)
+			resp := <-queryResponseC
+			require.Equal(t, tc.expectedStatus, resp.Status)
+			if tc.expectedStatus != query.QuerySuccess {
+				require.Nil(t, resp.Response)
+			}
+		})
+	}
+}
@@ -26,12 +26,11 @@ func (wc *WatcherConfig) GetChainID() vaa.ChainID {
 	return wc.ChainID
 }
 
-//nolint:unparam // error is always nil here but the return type is required to satisfy the interface.
 func (wc *WatcherConfig) Create(
 	msgC chan<- *common.MessagePublication,
 	obsvReqC <-chan *gossipv1.ObservationRequest,
-	_ <-chan *query.PerChainQueryInternal,
-	_ chan<- *query.PerChainQueryResponseInternal,
+	queryReqC <-chan *query.PerChainQueryInternal,
+	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 	_ chan<- *common.GuardianSet,
 	env common.Environment,
 ) (supervisor.Runnable, interfaces.Reobserver, error) {
@@ -43,9 +42,14 @@ func (wc *WatcherConfig) Create(
 		devMode,
 		msgC,
 		obsvReqC,
+		queryReqC,
+		queryResponseC,
 		env,
 		wc.TxVerifierEnabled,
 	)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return watcher.Run, nil, err
+	return watcher.Run, watcher, nil
 }
@@ -0,0 +1,101 @@
+package sui
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/suiclient"
+	"github.com/mr-tron/base58"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// reobserveTransaction fetches a transaction using the specified client and re-processes each
+// of its Wormhole message events. It returns the number of messages published.
+func (e *Watcher) reobserveTransaction(ctx context.Context, logger *zap.Logger, client suiclient.SuiClient, txHash []byte) (uint32, error) {
+	tx58 := base58.Encode(txHash)
+
+	txn, err := client.GetTransaction(ctx, tx58, []string{
+		suiclient.TransactionFieldDigest,
+		suiclient.TransactionFieldEvents,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get transaction %s: %w", tx58, err)
+	}
+
+	numObservations := uint32(0)
+	for i, event := range txn.Events {
+		// Events returned by GetTransaction do not carry their transaction digest, so it is
+		// supplied explicitly here.
+		published, err := e.processEvent(ctx, logger, event, tx58, true)
+		if err != nil {
+			logger.Info("sui_fetch_obvs_req skipping event data in result", zap.String("txhash", tx58), zap.Int("index", i), zap.Error(err))
+			continue
+		}
+		if published {
+			numObservations++
+		}
+	}
+
+	return numObservations, nil
+}
+
+// verifyChainIdentifier checks that the client is connected to the same chain as the configured endpoint, so that
+// messages are never reobserved from another network.
+func (e *Watcher) verifyChainIdentifier(ctx context.Context, client suiclient.SuiClient) error {
+	configuredClient, err := suiclient.NewSuiGrpcClient(e.suiRPC, e.logger, suiGrpcDialOpts(e.unsafeDevMode)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the configured endpoint: %w", err)
+	}
+	defer func() {
+		if cerr := configuredClient.Close(); cerr != nil {
+			e.logger.Error("failed to close Sui gRPC client", zap.Error(cerr))
+		}
+	}()
+
+	expected, err := configuredClient.GetChainIdentifier(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain identifier of the configured endpoint: %w", err)
+	}
+	actual, err := client.GetChainIdentifier(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read the chain identifier of the endpoint: %w", err)
+	}
+
+	e.logger.Info("queried sui chain identifier", zap.String("expected", expected), zap.String("actual", actual))
+	if actual != expected {
+		return fmt.Errorf("sui chain identifier mismatch, expected %s, received %s", expected, actual)
+	}
+	return nil
+}
+
+// Reobserve is the interface for reobserving using a custom URL. It creates a gRPC client for that URL,
+// checks that it is on the same chain as the configured endpoint, does the reobservation on it and then
+// closes the client.
+func (e *Watcher) Reobserve(ctx context.Context, chainID vaa.ChainID, txID []byte, customEndpoint string) (uint32, error) {
+	e.logger.Info("received a request to reobserve using a custom endpoint", zap.Stringer("chainID", chainID), zap.Any("txID", txID), zap.String("url", customEndpoint))
+
+	if chainID != vaa.ChainIDSui {
+		return 0, fmt.Errorf("unexpected chain id: %v", chainID)
+	}
+
+	client, err := suiclient.NewSuiGrpcClient(customEndpoint, e.logger, suiGrpcDialOpts(e.unsafeDevMode)...)
+	if err != nil {
+		return 0, fmt.Errorf(`failed to connect to endpoint "%v": %w`, customEndpoint, err)
+	}
+	defer func() {
+		if cerr := client.Close(); cerr != nil {
+			e.logger.Error("failed to close Sui gRPC client", zap.Error(cerr))
+		}
+	}()
+
+	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+
+	if err := e.verifyChainIdentifier(timeout, client); err != nil {
+		return 0, err
+	}
+
+	return e.reobserveTransaction(timeout, e.logger, client, txID)
+}
@@ -0,0 +1,149 @@
+package sui
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pb "github.com/block-vision/sui-go-sdk/pb/sui/rpc/v2"
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testReobserveEventType = "0x5306f64e312b581766351c07af79c72fcb1cd25147157fdc2f8ad76de9a3fb6a::publish_message::WormholeMessage"
+
+const testChainIdentifier = "4btiuiMPvEENsttpZC7CZ53DruC3MAgfznDbASZ7DR6S"
+
+// fakeLedgerServer serves GetTransaction from a fixed set of transactions, keyed by digest, and the chain identifier.
+type fakeLedgerServer struct {
+	pb.UnimplementedLedgerServiceServer
+	chainID      string
+	transactions map[string]*pb.ExecutedTransaction
+}
+
+func (f *fakeLedgerServer) GetServiceInfo(_ context.Context, _ *pb.GetServiceInfoRequest) (*pb.GetServiceInfoResponse, error) {
+	return &pb.GetServiceInfoResponse{ChainId: strPtr(f.chainID)}, nil
+}
+
+func (f *fakeLedgerServer) GetTransaction(_ context.Context, req *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	tx, ok := f.transactions[req.GetDigest()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "transaction %s not found", req.GetDigest())
+	}
+	return &pb.GetTransactionResponse{Transaction: tx}, nil
+}
+
+// startFakeSuiNode starts a plaintext gRPC server serving the ledger service and returns its address.
+func startFakeSuiNode(t *testing.T, ledger *fakeLedgerServer) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	pb.RegisterLedgerServiceServer(server, ledger)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func newTestWormholeEvent(eventType string) *pb.Event {
+	return &pb.Event{
+		PackageId: strPtr("0x5306f64e312b581766351c07af79c72fcb1cd25147157fdc2f8ad76de9a3fb6a"),
+		Module:    strPtr("publish_message"),
+		Sender:    strPtr("0x" + sampleSenderHex),
+		EventType: strPtr(eventType),
+		Contents:  &pb.Bcs{Name: strPtr(eventType), Value: sampleWormholeMessageBcs},
+	}
+}
+
+func newTestExecutedTransaction(success bool, events ...*pb.Event) *pb.ExecutedTransaction {
+	return &pb.ExecutedTransaction{
+		Digest:  strPtr(sampleTxDigest),
+		Effects: &pb.TransactionEffects{Status: &pb.ExecutionStatus{Success: &success}},
+		Events:  &pb.TransactionEvents{Events: events},
+	}
+}
+
+// newTestReobserveWatcher creates a watcher whose configured endpoint is a fake node on the test chain.
+func newTestReobserveWatcher(t *testing.T) (*Watcher, chan *common.MessagePublication) {
+	msgC := make(chan *common.MessagePublication, 10)
+	return &Watcher{
+		suiRPC:           startFakeSuiNode(t, &fakeLedgerServer{chainID: testChainIdentifier}),
+		msgChan:          msgC,
+		suiMoveEventType: testReobserveEventType,
+		unsafeDevMode:    true,
+		logger:           zap.NewNop(),
+	}, msgC
+}
+
+func TestReobserveWithEndpoint(t *testing.T) {
+	endpoint := startFakeSuiNode(t, &fakeLedgerServer{
+		chainID: testChainIdentifier,
+		transactions: map[string]*pb.ExecutedTransaction{
+			sampleTxDigest: newTestExecutedTransaction(true,
+				newTestWormholeEvent(testReobserveEventType),
+				newTestWormholeEvent("0xabc::some_module::SomeOtherEvent"),
+			),
+		},
+	})
+
+	w, msgC := newTestReobserveWatcher(t)
+	txHash, err := base58.Decode(sampleTxDigest)
+	require.NoError(t, err)
+
+	numObs, err := w.Reobserve(context.Background(), vaa.ChainIDSui, txHash, endpoint)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), numObs)
+	require.Len(t, msgC, 1)
+
+	msg := <-msgC
+	require.Equal(t, txHash, msg.TxID)
+	require.Equal(t, sampleSequence, msg.Sequence)
+	require.True(t, msg.IsReobservation)
+}
+
+func TestReobserveWithEndpointErrors(t *testing.T) {
+	endpoint := startFakeSuiNode(t, &fakeLedgerServer{
+		chainID: testChainIdentifier,
+		transactions: map[string]*pb.ExecutedTransaction{
+			sampleTxDigest: newTestExecutedTransaction(false, newTestWormholeEvent(testReobserveEventType)),
+		},
+	})
+	otherNetwork := startFakeSuiNode(t, &fakeLedgerServer{
+		chainID: "69WiPg3DAQiwdxfncX6wYQ2siKwAe6L9BZthQea3JNMD",
+		transactions: map[string]*pb.ExecutedTransaction{
+			sampleTxDigest: newTestExecutedTransaction(true, newTestWormholeEvent(testReobserveEventType)),
+		},
+	})
+
+	txHash, err := base58.Decode(sampleTxDigest)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		chainID  vaa.ChainID
+		txHash   []byte
+		endpoint string
+	}{
+		{name: "wrong chain", chainID: vaa.ChainIDSolana, txHash: txHash, endpoint: endpoint},
+		{name: "wrong network", chainID: vaa.ChainIDSui, txHash: txHash, endpoint: otherNetwork},
+		{name: "failed transaction", chainID: vaa.ChainIDSui, txHash: txHash, endpoint: endpoint},
+		{name: "unknown transaction", chainID: vaa.ChainIDSui, txHash: make([]byte, 32), endpoint: endpoint},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w, msgC := newTestReobserveWatcher(t)
+			numObs, err := w.Reobserve(context.Background(), tc.chainID, tc.txHash, tc.endpoint)
+			require.Error(t, err)
+			require.Equal(t, uint32(0), numObs)
+			require.Empty(t, msgC)
+		})
+	}
+}
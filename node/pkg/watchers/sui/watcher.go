@@ -13,6 +13,7 @@ import (
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/readiness"
 	"github.com/certusone/wormhole/node/pkg/suiclient"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
@@ -53,6 +54,11 @@ type (
 		msgChan       chan<- *common.MessagePublication
 		obsvReqC      <-chan *gossipv1.ObservationRequest
 		readinessSync readiness.Component
+		logger        *zap.Logger
+
+		queryReqC      <-chan *query.PerChainQueryInternal
+		queryResponseC chan<- *query.PerChainQueryResponseInternal
+		ccqConfig      query.PerChainConfig
 
 		// Note: suiclient.SuiClient is an interface. A `nil` check is therefore fine for checking initialization.
 		suiClient suiclient.SuiClient
@@ -88,6 +94,8 @@ func NewWatcher(
 	unsafeDevMode bool,
 	messageEvents chan<- *common.MessagePublication,
 	obsvReqC <-chan *gossipv1.ObservationRequest,
+	queryReqC <-chan *query.PerChainQueryInternal,
+	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 	env common.Environment,
 	txVerifierEnabled bool,
 ) (*Watcher, error) {
@@ -150,6 +158,9 @@ func NewWatcher(
 		msgChan:           messageEvents,
 		obsvReqC:          obsvReqC,
 		readinessSync:     common.MustConvertChainIdToReadinessSyncing(vaa.ChainIDSui),
+		queryReqC:         queryReqC,
+		queryResponseC:    queryResponseC,
+		ccqConfig:         query.GetPerChainConfig(vaa.ChainIDSui),
 		suiTxVerifier:     suiTxVerifier,
 		txVerifierEnabled: txVerifierEnabled,
 	}, nil
@@ -158,23 +169,24 @@ func NewWatcher(
 // processEvent decodes a single Sui gRPC event into a Wormhole MessagePublication and,
 // after optional transfer verification, publishes it to the message channel. `txDigest`
 // is the base58-encoded Sui transaction digest the event belongs to; it is supplied
-// explicitly because gRPC events fetched via GetTransaction do not carry it.
-func (e *Watcher) processEvent(ctx context.Context, logger *zap.Logger, event suiclient.SuiEvent, txDigest string, isReobservation bool) error {
+// explicitly because gRPC events fetched via GetTransaction do not carry it. It returns
+// true if a message was published.
+func (e *Watcher) processEvent(ctx context.Context, logger *zap.Logger, event suiclient.SuiEvent, txDigest string, isReobservation bool) (bool, error) {
 	// The subscription is already filtered by event type, but reobservation returns every
 	// event in the transaction, so re-check the type here before decoding.
 	if event.EventType != e.suiMoveEventType {
-		return nil
+		return false, nil
 	}
 
 	msg, err := suiclient.DecodeBcs[txverifier.WormholeMessage](event.BcsBytes)
 	if err != nil {
 		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
-		return fmt.Errorf("processEvent failed to decode WormholeMessage BCS bytes: %w", err)
+		return false, fmt.Errorf("processEvent failed to decode WormholeMessage BCS bytes: %w", err)
 	}
 
 	txHashBytes, err := base58.Decode(txDigest)
 	if err != nil {
-		return fmt.Errorf("processEvent failed to base58-decode txDigest %s: %w", txDigest, err)
+		return false, fmt.Errorf("processEvent failed to base58-decode txDigest %s: %w", txDigest, err)
 	}
 
 	if len(txHashBytes) != 32 {
@@ -184,7 +196,7 @@ func (e *Watcher) processEvent(ctx context.Context, logger *zap.Logger, event su
 			zap.String("log_msg_type", "tx_processing_error"),
 			zap.String("txHash", txDigest),
 		)
-		return errors.New("transaction hash is not 32 bytes")
+		return false, errors.New("transaction hash is not 32 bytes")
 	}
 
 	txHashEthFormat := eth_common.BytesToHash(txHashBytes)
@@ -209,9 +221,10 @@ func (e *Watcher) processEvent(ctx context.Context, logger *zap.Logger, event su
 		logger.Error("Message publication error",
 			zap.String("TxDigest", txDigest),
 			zap.Error(err))
+		return false, nil
 	}
 
-	return nil
+	return true, nil
 }
 
 func (e *Watcher) verifyAndPublish(
@@ -258,24 +271,9 @@ func (e *Watcher) handleReobservation(ctx context.Context, logger *zap.Logger, c
 		panic("invalid chain ID")
 	}
 
-	tx58 := base58.Encode(r.TxHash)
-
-	txn, err := client.GetTransaction(ctx, tx58, []string{
-		suiclient.TransactionFieldDigest,
-		suiclient.TransactionFieldEvents,
-	})
-	if err != nil {
-		logger.Error("sui_fetch_obvs_req failed", zap.String("txhash", tx58), zap.Error(err))
+	if _, err := e.reobserveTransaction(ctx, logger, client, r.TxHash); err != nil {
+		logger.Error("sui_fetch_obvs_req failed", zap.Error(err))
 		p2p.DefaultRegistry.AddErrorCount(vaa.ChainIDSui, 1)
-		return
-	}
-
-	for i, event := range txn.Events {
-		// Events returned by GetTransaction do not carry their transaction digest, so it is
-		// supplied explicitly here.
-		if err := e.processEvent(ctx, logger, event, tx58, true); err != nil {
-			logger.Info("sui_fetch_obvs_req skipping event data in result", zap.String("txhash", tx58), zap.Int("index", i), zap.Error(err))
-		}
 	}
 }
 
@@ -285,6 +283,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 	})
 
 	logger := supervisor.Logger(ctx)
+	e.logger = logger
 
 	logger.Info("Starting watcher",
 		zap.String("watcher_name", "sui"),
@@ -353,7 +352,7 @@ func (e *Watcher) Run(ctx context.Context) error {
 					continue
 				}
 
-				if err := e.processEvent(ctx, logger, txEvent.Event, txEvent.TxDigest, false); err != nil {
+				if _, err := e.processEvent(ctx, logger, txEvent.Event, txEvent.TxDigest, false); err != nil {
 					logger.Error("sui_data_pump processEvent error", zap.Error(err))
 				}
 			}
@@ -401,6 +400,10 @@ func (e *Watcher) Run(ctx context.Context) error {
 		}
 	})
 
+	if e.ccqConfig.QueriesSupported() {
+		e.ccqStart(ctx, logger, errC, client)
+	}
+
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
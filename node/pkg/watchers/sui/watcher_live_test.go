@@ -69,7 +69,7 @@ func TestLiveSuiWatcher(t *testing.T) {
 	msgChan := make(chan *common.MessagePublication, 100)
 	obsvReqC := make(chan *gossipv1.ObservationRequest, 10)
 
-	watcher, err := NewWatcher(rpc, eventType, false, msgChan, obsvReqC, common.MainNet, txVerifierEnabled)
+	watcher, err := NewWatcher(rpc, eventType, false, msgChan, obsvReqC, nil, nil, common.MainNet, txVerifierEnabled)
 	require.NoError(t, err)
 
 	rootCtx, cancel := context.WithTimeout(context.Background(), time.Duration(seconds)*time.Second)
@@ -87,6 +87,18 @@ func (m *mockSuiClient) GetTransaction(ctx context.Context, digest string, field
 	return txn, nil
 }
 
+func (m *mockSuiClient) GetCheckpoint(ctx context.Context, sequenceNumber uint64, fields []string) (suiclient.SuiCheckpoint, error) {
+	return suiclient.SuiCheckpoint{}, nil
+}
+
+func (m *mockSuiClient) GetChainIdentifier(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (m *mockSuiClient) SimulateMoveCalls(ctx context.Context, calls []suiclient.SuiMoveCall) ([]suiclient.SuiMoveCallResult, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockSuiClient) SubscribeToTransactionEvent(ctx context.Context, eventType string, eventWriteChannel chan<- suiclient.SuiTransactionEvent) (suiclient.SuiSubscription, error) {
 	return suiclient.SuiSubscription{}, nil
 }
@@ -142,8 +154,9 @@ func Test_processEvent(t *testing.T) {
 		BcsBytes:  sampleWormholeMessageBcs,
 	}
 
-	err := watcher.processEvent(context.TODO(), zap.NewNop(), event, sampleTxDigest, false)
+	ok, err := watcher.processEvent(context.TODO(), zap.NewNop(), event, sampleTxDigest, false)
 	require.NoError(t, err)
+	require.True(t, ok)
 
 	published := <-msgChan
 	expectedTxID, err := base58.Decode(sampleTxDigest)
@@ -175,8 +188,9 @@ func Test_processEvent_IgnoresOtherEventTypes(t *testing.T) {
 		BcsBytes:  sampleWormholeMessageBcs,
 	}
 
-	err := watcher.processEvent(context.TODO(), zap.NewNop(), event, sampleTxDigest, false)
+	ok, err := watcher.processEvent(context.TODO(), zap.NewNop(), event, sampleTxDigest, false)
 	require.NoError(t, err)
+	require.False(t, ok)
 	require.Empty(t, msgChan)
 }
 
@@ -28,6 +28,13 @@ type WatcherConfig interface {
 	) (supervisor.Runnable, interfaces.Reobserver, error)
 }
 
+// ReloadResult lists the watchers that changed when the watcher configuration was reloaded.
+type ReloadResult struct {
+	Started []NetworkID
+	Stopped []NetworkID
+	Updated []NetworkID
+}
+
 var (
 	ReobservationsByChain = promauto.NewCounterVec(
 		prometheus.CounterOpts{
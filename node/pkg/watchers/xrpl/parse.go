@@ -19,6 +19,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/ntt"
 	"github.com/certusone/wormhole/node/pkg/watchers/xrpl/currencycodec"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -31,10 +32,6 @@ const nttMemoFormat = "6170706C69636174696F6E2F782D6E74742D7472616E73666572"
 // coreMemoFormat is the hex-encoded MemoFormat for generic Wormhole messages: "application/x-wormhole-publish"
 const coreMemoFormat = "6170706C69636174696F6E2F782D776F726D686F6C652D7075626C697368"
 
-// Prefixes for NTT payloads
-var transceiverPrefix = [4]byte{0x99, 0x45, 0xFF, 0x10}
-var nttPrefix = [4]byte{0x99, 0x4E, 0x54, 0x54}
-
 // xtcfPrefix is the 4-byte prefix for XRPL ticket refill confirmation payloads
 var xtcfPrefix = [4]byte{'X', 'T', 'C', 'F'}
 
@@ -136,6 +133,8 @@ type GenericTx struct {
 	MetaTransactionIndex  uint64
 	MetaTransactionResult string
 	MetaAffectedNodes     []transaction.AffectedNode
+	// MetaPartialDeliveredAmount is the DeliveredAmount metadata field, which is recorded for partial payments.
+	MetaPartialDeliveredAmount any
 }
 
 // NewParser creates a new Parser with the given core account, managed accounts, and MPT asset scale fetcher.
@@ -169,14 +168,15 @@ func (p *Parser) ParseTransactionStream(tx *streamtypes.TransactionStream) (*com
 	}
 
 	return p.parseTransaction(GenericTx{
-		Transaction:           tx.Transaction,
-		Hash:                  string(tx.Hash),
-		LedgerIndex:           tx.LedgerIndex,
-		MetaDeliveredAmount:   tx.Meta.DeliveredAmount,
-		MetaTransactionIndex:  tx.Meta.TransactionIndex,
-		MetaTransactionResult: tx.Meta.TransactionResult,
-		MetaAffectedNodes:     tx.Meta.AffectedNodes,
-		Timestamp:             timestamp,
+		Transaction:                tx.Transaction,
+		Hash:                       string(tx.Hash),
+		LedgerIndex:                tx.LedgerIndex,
+		MetaDeliveredAmount:        tx.Meta.DeliveredAmount,
+		MetaPartialDeliveredAmount: tx.Meta.PartialDeliveredAmount,
+		MetaTransactionIndex:       tx.Meta.TransactionIndex,
+		MetaTransactionResult:      tx.Meta.TransactionResult,
+		MetaAffectedNodes:          tx.Meta.AffectedNodes,
+		Timestamp:                  timestamp,
 	})
 }
 
@@ -191,18 +191,20 @@ func (p *Parser) ParseTxResponse(tx *txResponseV2) (*common.MessagePublication,
 	}
 
 	return p.parseTransaction(GenericTx{
-		Transaction:           tx.TxJSON,
-		Hash:                  tx.Hash.String(),
-		LedgerIndex:           tx.LedgerIndex,
-		MetaDeliveredAmount:   tx.Meta.DeliveredAmount,
-		MetaTransactionIndex:  tx.Meta.TransactionIndex,
-		MetaTransactionResult: tx.Meta.TransactionResult,
-		MetaAffectedNodes:     tx.Meta.AffectedNodes,
-		Timestamp:             timestamp,
+		Transaction:                tx.TxJSON,
+		Hash:                       tx.Hash.String(),
+		LedgerIndex:                tx.LedgerIndex,
+		MetaDeliveredAmount:        tx.Meta.DeliveredAmount,
+		MetaPartialDeliveredAmount: tx.Meta.PartialDeliveredAmount,
+		MetaTransactionIndex:       tx.Meta.TransactionIndex,
+		MetaTransactionResult:      tx.Meta.TransactionResult,
+		MetaAffectedNodes:          tx.Meta.AffectedNodes,
+		Timestamp:                  timestamp,
 	})
 }
 
 // parseNttTransaction contains the shared logic for parsing both TransactionStream and TxResponse.
+// Besides Payments, it handles the other ways funds reach a managed account (see extractNttDelivery).
 // Returns (nil, nil) if no NTT memo is found, if the payment is sent to the core account,
 // or if the destination is not one of the managed NTT custody accounts.
 //
@@ -225,22 +227,12 @@ func (p *Parser) parseNttTransaction(
 		return nil, err
 	}
 
-	// Validate transaction type is Payment
-	if err = validateTransactionType(tx.Transaction); err != nil {
-		return nil, err
-	}
-
-	// Extract sender address
-	sender, err := p.extractSender(tx.Transaction)
-	if err != nil {
-		return nil, err
-	}
-
-	// Extract destination address (the NTT manager on XRPL)
-	destination, err := p.extractDestination(tx.Transaction)
+	// Extract the sender, the destination (the NTT manager on XRPL) and the amount it received
+	delivery, err := p.extractNttDelivery(tx)
 	if err != nil {
 		return nil, err
 	}
+	sender, destination := delivery.sender, delivery.destination
 
 	// Skip payments to the core account — those are not NTT transfers
 	if p.coreAccount != "" && destination == p.coreAccount {
@@ -258,9 +250,13 @@ func (p *Parser) parseNttTransaction(
 		return nil, nil
 	}
 
+	if delivery.amount == nil {
+		return nil, fmt.Errorf("transaction has no delivered amount")
+	}
+
 	// Parse delivered amount to get token info
 	// This also validates: non-zero amount, memo.fromDecimals matches token type
-	tokenInfo, err := p.parseDeliveredAmount(tx.MetaDeliveredAmount, memo)
+	tokenInfo, err := p.parseDeliveredAmount(delivery.amount, memo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse delivered amount: %w", err)
 	}
@@ -325,13 +321,14 @@ func (p *Parser) parseNttTransaction(
 //     (Release Payments, failed TicketCreate, Burn/AccountSet) produce an XACK.
 //  3. parseCoreTransaction — payments to the core account with a Wormhole core
 //     memo produce a generic Wormhole message.
-//  4. parseNttTransaction — payments to a managed account with an NTT memo
-//     produce an NTT transfer message.
+//  4. parseNttTransaction — payments, cashed checks, finished escrows and AMM
+//     withdrawals to a managed account with an NTT memo produce an NTT transfer
+//     message.
 //
 // The order is load-bearing: TicketCreate must run before XACK (so successful
 // TicketCreates are claimed as XTCF rather than XACK), and the Core/NTT parsers
-// come last because they require a Destination field that TicketCreate
-// transactions do not have.
+// come last because they expect a transfer of funds, which TicketCreate
+// transactions are not.
 // Returns (nil, nil) if none matched.
 func (p *Parser) parseTransaction(tx GenericTx) (*common.MessagePublication, error) {
 	msg, err := p.parseTicketCreateTransaction(tx)
@@ -359,6 +356,12 @@ func (p *Parser) parseCoreTransaction(tx GenericTx) (*common.MessagePublication,
 		return nil, nil
 	}
 
+	// Only payments carry core messages. Other transactions without a Destination, such as a
+	// CheckCash by a managed account, are left to parseNttTransaction.
+	if _, ok := tx.Transaction["Destination"]; !ok && tx.Transaction["TransactionType"] != "Payment" {
+		return nil, nil
+	}
+
 	// Check destination is the core account
 	destination, err := p.extractDestination(tx.Transaction)
 	if err != nil {
@@ -887,7 +890,7 @@ func (p *Parser) parseMemoData(tx transaction.FlatTransaction) (*memoData, error
 	}
 
 	// Verify NTT prefix
-	if !bytes.Equal(data[:4], nttPrefix[:]) {
+	if !bytes.Equal(data[:4], ntt.TransferPrefix) {
 		return nil, nil
 	}
 
@@ -1344,7 +1347,7 @@ func (p *Parser) buildNTTPayload(
 	offset := 0
 
 	// TransceiverMessage prefix (4 bytes)
-	copy(payload[offset:], transceiverPrefix[:])
+	copy(payload[offset:], ntt.TransceiverPrefix)
 	offset += 4
 
 	// source_ntt_manager_address (32 bytes)
@@ -1374,7 +1377,7 @@ func (p *Parser) buildNTTPayload(
 	offset += 2
 
 	// NTT prefix (4 bytes)
-	copy(payload[offset:], nttPrefix[:])
+	copy(payload[offset:], ntt.TransferPrefix)
 	offset += 4
 
 	// decimals (1 byte)
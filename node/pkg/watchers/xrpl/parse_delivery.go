@@ -0,0 +1,502 @@
+package xrpl
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/certusone/wormhole/node/pkg/watchers/xrpl/currencycodec"
+)
+
+// deliveredAmountUnavailable is the delivered_amount of transactions in ledgers before DeliveredAmount was recorded.
+const deliveredAmountUnavailable = "unavailable"
+
+// nttDelivery is a transfer of funds to an account, along with the account that sent them.
+type nttDelivery struct {
+	sender      [32]byte
+	destination string
+	// amount is the amount received by the destination, in the JSON format of a currency amount.
+	amount any
+}
+
+// =============================================================================
+// Delivery extraction
+// =============================================================================
+
+// extractNttDelivery extracts the sender, the destination and the amount received by the destination of an
+// NTT transfer. Besides Payments, funds can reach a managed account by:
+//   - CheckCash: the managed account cashes a check written to it. The sender is the creator of the check.
+//   - EscrowFinish: an escrow to the managed account is finished by its owner or by the managed account.
+//     The sender is the owner of the escrow.
+//   - AMMWithdraw: the managed account withdraws a single asset from an AMM. The sender is the managed account.
+//
+// Any other transaction type must be a Payment.
+func (p *Parser) extractNttDelivery(tx GenericTx) (*nttDelivery, error) {
+	txType, _ := tx.Transaction["TransactionType"].(string)
+	switch txType {
+	case "CheckCash":
+		return p.extractCheckCashDelivery(tx)
+	case "EscrowFinish":
+		return p.extractEscrowFinishDelivery(tx)
+	case "AMMWithdraw":
+		return p.extractAMMWithdrawDelivery(tx)
+	}
+
+	if err := validateTransactionType(tx.Transaction); err != nil {
+		return nil, err
+	}
+
+	sender, err := p.extractSender(tx.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := p.extractDestination(tx.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nttDelivery{
+		sender:      sender,
+		destination: destination,
+		amount:      deliveredAmount(tx),
+	}, nil
+}
+
+// extractCheckCashDelivery extracts the delivery of a CheckCash transaction. The check entry deleted by the
+// transaction identifies its creator.
+func (p *Parser) extractCheckCashDelivery(tx GenericTx) (*nttDelivery, error) {
+	account, err := stringField(tx.Transaction, "Account")
+	if err != nil {
+		return nil, err
+	}
+
+	check, err := deletedLedgerEntry(tx, "Check")
+	if err != nil {
+		return nil, err
+	}
+	creator, err := stringField(check, "Account")
+	if err != nil {
+		return nil, fmt.Errorf("invalid Check entry: %w", err)
+	}
+	destination, err := stringField(check, "Destination")
+	if err != nil {
+		return nil, fmt.Errorf("invalid Check entry: %w", err)
+	}
+
+	// Only the destination of a check can cash it.
+	if destination != account {
+		return nil, fmt.Errorf("check to %s was cashed by %s", destination, account)
+	}
+
+	sender, err := p.addressToEmitter(creator)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert check creator address: %w", err)
+	}
+
+	// With DeliverMin, a check delivers anything between DeliverMin and SendMax, like a partial payment.
+	return &nttDelivery{
+		sender:      sender,
+		destination: destination,
+		amount:      deliveredAmount(tx),
+	}, nil
+}
+
+// extractEscrowFinishDelivery extracts the delivery of an EscrowFinish transaction. Its metadata has no delivered
+// amount, since an escrow always delivers the Amount of the deleted escrow entry.
+func (p *Parser) extractEscrowFinishDelivery(tx GenericTx) (*nttDelivery, error) {
+	account, err := stringField(tx.Transaction, "Account")
+	if err != nil {
+		return nil, err
+	}
+
+	escrow, err := deletedLedgerEntry(tx, "Escrow")
+	if err != nil {
+		return nil, err
+	}
+	owner, err := stringField(escrow, "Account")
+	if err != nil {
+		return nil, fmt.Errorf("invalid Escrow entry: %w", err)
+	}
+	destination, err := stringField(escrow, "Destination")
+	if err != nil {
+		return nil, fmt.Errorf("invalid Escrow entry: %w", err)
+	}
+	amount, ok := escrow["Amount"]
+	if !ok {
+		return nil, fmt.Errorf("invalid Escrow entry: no Amount field")
+	}
+
+	// SECURITY: Anyone can finish an escrow once it is due, but the memo of the EscrowFinish picks the recipient
+	// on the destination chain. It is only trusted from the owner of the funds or the managed account receiving them.
+	if account != owner && account != destination {
+		return nil, fmt.Errorf("escrow of %s to %s was finished by %s", owner, destination, account)
+	}
+
+	sender, err := p.addressToEmitter(owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert escrow owner address: %w", err)
+	}
+
+	return &nttDelivery{
+		sender:      sender,
+		destination: destination,
+		amount:      amount,
+	}, nil
+}
+
+// extractAMMWithdrawDelivery extracts the delivery of an AMMWithdraw transaction, which sends the withdrawn assets
+// to the account that submitted it. An NTT transfer has a single token, so the withdrawal must be of the single
+// asset given by Amount. Its metadata has no delivered amount, so the amount is the increase of that asset in the
+// balance of the account.
+func (p *Parser) extractAMMWithdrawDelivery(tx GenericTx) (*nttDelivery, error) {
+	account, err := stringField(tx.Transaction, "Account")
+	if err != nil {
+		return nil, err
+	}
+
+	asset, ok := tx.Transaction["Amount"]
+	if !ok {
+		return nil, fmt.Errorf("AMMWithdraw must withdraw a single asset: no Amount field")
+	}
+	if _, ok := tx.Transaction["Amount2"]; ok {
+		return nil, fmt.Errorf("AMMWithdraw must withdraw a single asset: Amount2 is set")
+	}
+
+	amount, err := balanceIncrease(tx, account, asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute withdrawn amount: %w", err)
+	}
+
+	sender, err := p.addressToEmitter(account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert sender address: %w", err)
+	}
+
+	return &nttDelivery{
+		sender:      sender,
+		destination: account,
+		amount:      amount,
+	}, nil
+}
+
+// deliveredAmount returns the amount actually delivered by a Payment or a CheckCash. The delivered_amount field
+// added by the server is used, or the DeliveredAmount metadata field where delivered_amount is unavailable.
+// The Amount field of the transaction must never be used: for a partial payment, it is only an upper bound.
+func deliveredAmount(tx GenericTx) any {
+	if tx.MetaDeliveredAmount != nil && tx.MetaDeliveredAmount != deliveredAmountUnavailable {
+		return tx.MetaDeliveredAmount
+	}
+	return tx.MetaPartialDeliveredAmount
+}
+
+// =============================================================================
+// Ledger entry helpers
+// =============================================================================
+
+// deletedLedgerEntry returns the final fields of the ledger entry of the given type deleted by the transaction.
+// It returns an error unless there is exactly one.
+func deletedLedgerEntry(tx GenericTx, entryType ledger.EntryType) (ledger.FlatLedgerObject, error) {
+	var fields ledger.FlatLedgerObject
+	for _, node := range tx.MetaAffectedNodes {
+		if node.DeletedNode == nil || node.DeletedNode.LedgerEntryType != entryType {
+			continue
+		}
+		if fields != nil {
+			return nil, fmt.Errorf("transaction deleted more than one %s entry", entryType)
+		}
+		fields = node.DeletedNode.FinalFields
+	}
+
+	if fields == nil {
+		return nil, fmt.Errorf("transaction deleted no %s entry", entryType)
+	}
+	return fields, nil
+}
+
+// stringField returns a string field of a transaction or ledger entry.
+func stringField(fields map[string]any, name string) (string, error) {
+	raw, ok := fields[name]
+	if !ok {
+		return "", fmt.Errorf("no %s field", name)
+	}
+	value, ok := raw.(string)
+	if !ok {
+		return "", fmt.Errorf("%s field is not a string", name)
+	}
+	return value, nil
+}
+
+// previousLedgerFields returns the fields of a modified ledger entry before the transaction. The metadata only
+// records the previous value of the fields that changed, so the others are taken from the final fields.
+func previousLedgerFields(final, previous ledger.FlatLedgerObject) ledger.FlatLedgerObject {
+	fields := make(ledger.FlatLedgerObject, len(final))
+	for key, value := range final {
+		fields[key] = value
+	}
+	for key, value := range previous {
+		fields[key] = value
+	}
+	return fields
+}
+
+// =============================================================================
+// Balance changes
+// =============================================================================
+
+// balanceIncrease returns the increase of the balance of an account in the given asset, in the JSON format of a
+// currency amount. The asset is a currency amount whose value is ignored.
+func balanceIncrease(tx GenericTx, account string, asset any) (any, error) {
+	data, err := json.Marshal(asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal asset: %w", err)
+	}
+	amount, err := types.UnmarshalCurrencyAmount(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal asset: %w", err)
+	}
+
+	switch v := amount.(type) {
+	case types.XRPCurrencyAmount:
+		return xrpBalanceIncrease(tx, account)
+	case types.IssuedCurrencyAmount:
+		value, err := trustLineBalanceIncrease(tx, account, v.Currency, string(v.Issuer))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"currency": v.Currency, "issuer": string(v.Issuer), "value": value}, nil
+	case types.MPTCurrencyAmount:
+		value, err := mptBalanceIncrease(tx, account, v.MPTIssuanceID)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"mpt_issuance_id": v.MPTIssuanceID, "value": value}, nil
+	default:
+		return nil, fmt.Errorf("unexpected currency amount type: %T", amount)
+	}
+}
+
+// xrpBalanceIncrease returns the XRP received by the account that submitted the transaction, in drops. The
+// transaction fee was taken from the same balance, so it is added back.
+func xrpBalanceIncrease(tx GenericTx, account string) (string, error) {
+	feeStr, err := stringField(tx.Transaction, "Fee")
+	if err != nil {
+		return "", err
+	}
+	fee, err := strconv.ParseUint(feeStr, decimalBase, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid Fee: %w", err)
+	}
+
+	for _, node := range tx.MetaAffectedNodes {
+		if node.ModifiedNode == nil || node.ModifiedNode.LedgerEntryType != "AccountRoot" {
+			continue
+		}
+		if owner, _ := node.ModifiedNode.FinalFields["Account"].(string); owner != account {
+			continue
+		}
+
+		previousFields := previousLedgerFields(node.ModifiedNode.FinalFields, node.ModifiedNode.PreviousFields)
+		final, err := dropsField(node.ModifiedNode.FinalFields, "Balance")
+		if err != nil {
+			return "", err
+		}
+		previous, err := dropsField(previousFields, "Balance")
+		if err != nil {
+			return "", err
+		}
+
+		// No XRP supply can exceed 10^17 drops, so the sum can't overflow.
+		if final+fee <= previous {
+			return "", fmt.Errorf("no XRP was received by %s", account)
+		}
+		return strconv.FormatUint(final+fee-previous, decimalBase), nil
+	}
+
+	return "", fmt.Errorf("transaction did not modify the AccountRoot of %s", account)
+}
+
+// dropsField parses an XRP balance field, in drops.
+func dropsField(fields ledger.FlatLedgerObject, name string) (uint64, error) {
+	value, err := stringField(fields, name)
+	if err != nil {
+		return 0, err
+	}
+	drops, err := strconv.ParseUint(value, decimalBase, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	return drops, nil
+}
+
+// trustLineBalanceIncrease returns the increase of the balance of an account in a trust line token, as a
+// decimal string.
+//
+// A RippleState entry holds the balance of a trust line from the point of view of its low account, so the
+// balance of the high account is its opposite.
+func trustLineBalanceIncrease(tx GenericTx, account, currency, issuer string) (string, error) {
+	wantCurrency, err := currencycodec.Decode(currency)
+	if err != nil {
+		return "", err
+	}
+
+	for _, node := range tx.MetaAffectedNodes {
+		var fields, previousFields ledger.FlatLedgerObject
+		switch {
+		case node.CreatedNode != nil && node.CreatedNode.LedgerEntryType == "RippleState":
+			fields = node.CreatedNode.NewFields
+		case node.ModifiedNode != nil && node.ModifiedNode.LedgerEntryType == "RippleState":
+			fields = node.ModifiedNode.FinalFields
+			previousFields = previousLedgerFields(fields, node.ModifiedNode.PreviousFields)
+		default:
+			continue
+		}
+
+		low, _ := amountField(fields, "LowLimit")["issuer"].(string)
+		high, _ := amountField(fields, "HighLimit")["issuer"].(string)
+		var negate bool
+		switch {
+		case low == account && high == issuer:
+		case high == account && low == issuer:
+			negate = true
+		default:
+			continue
+		}
+
+		balance := amountField(fields, "Balance")
+		balanceCurrency, _ := balance["currency"].(string)
+		gotCurrency, err := currencycodec.Decode(balanceCurrency)
+		if err != nil || gotCurrency != wantCurrency {
+			continue
+		}
+
+		final, _ := balance["value"].(string)
+		previous := "0"
+		if previousFields != nil {
+			previous, _ = amountField(previousFields, "Balance")["value"].(string)
+		}
+
+		increase, err := decimalDifference(final, previous, negate)
+		if err != nil {
+			return "", fmt.Errorf("invalid trust line balance: %w", err)
+		}
+		if strings.HasPrefix(increase, "-") || strings.Trim(increase, "0.") == "" {
+			return "", fmt.Errorf("no %s was received by %s", currency, account)
+		}
+		return increase, nil
+	}
+
+	return "", fmt.Errorf("transaction did not modify the %s trust line of %s", currency, account)
+}
+
+// amountField returns a currency amount object field of a ledger entry, or nil if it is missing.
+func amountField(fields ledger.FlatLedgerObject, name string) map[string]any {
+	value, _ := fields[name].(map[string]any)
+	return value
+}
+
+// decimalDifference returns final - previous, or previous - final if negate is set, as an exact decimal string.
+func decimalDifference(final, previous string, negate bool) (string, error) {
+	finalRat, finalDigits, err := parseExactDecimal(final)
+	if err != nil {
+		return "", err
+	}
+	previousRat, previousDigits, err := parseExactDecimal(previous)
+	if err != nil {
+		return "", err
+	}
+
+	difference := new(big.Rat).Sub(finalRat, previousRat)
+	if negate {
+		difference.Neg(difference)
+	}
+
+	// The difference has no more fractional digits than either operand, so it is printed exactly.
+	return difference.FloatString(max(finalDigits, previousDigits)), nil
+}
+
+// parseExactDecimal parses a decimal string, possibly in scientific notation, and returns it along with its number
+// of fractional digits.
+func parseExactDecimal(s string) (*big.Rat, int, error) {
+	plain, negative, err := normalizeDecimal(s)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	digits := 0
+	if dot := strings.IndexByte(plain, '.'); dot >= 0 {
+		digits = len(plain) - dot - 1
+	}
+	if negative {
+		plain = "-" + plain
+	}
+
+	value, ok := new(big.Rat).SetString(plain)
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid decimal %q", s)
+	}
+	return value, digits, nil
+}
+
+// mptBalanceIncrease returns the increase of the balance of an account in a Multi-Purpose Token. The MPToken entry
+// omits MPTAmount when the balance is zero.
+func mptBalanceIncrease(tx GenericTx, account, mptIssuanceID string) (string, error) {
+	for _, node := range tx.MetaAffectedNodes {
+		var fields, previousFields ledger.FlatLedgerObject
+		switch {
+		case node.CreatedNode != nil && node.CreatedNode.LedgerEntryType == "MPToken":
+			fields = node.CreatedNode.NewFields
+			previousFields = ledger.FlatLedgerObject{}
+		case node.ModifiedNode != nil && node.ModifiedNode.LedgerEntryType == "MPToken":
+			fields = node.ModifiedNode.FinalFields
+			previousFields = previousLedgerFields(fields, node.ModifiedNode.PreviousFields)
+		default:
+			continue
+		}
+
+		owner, _ := fields["Account"].(string)
+		id, _ := fields["MPTokenIssuanceID"].(string)
+		if owner != account || !strings.EqualFold(id, mptIssuanceID) {
+			continue
+		}
+
+		final, err := mptAmountField(fields)
+		if err != nil {
+			return "", err
+		}
+		previous, err := mptAmountField(previousFields)
+		if err != nil {
+			return "", err
+		}
+		if final <= previous {
+			return "", fmt.Errorf("no MPT %s was received by %s", mptIssuanceID, account)
+		}
+		return strconv.FormatUint(final-previous, decimalBase), nil
+	}
+
+	return "", fmt.Errorf("transaction did not modify the MPToken %s of %s", mptIssuanceID, account)
+}
+
+// mptAmountField parses the MPTAmount field of an MPToken entry.
+func mptAmountField(fields ledger.FlatLedgerObject) (uint64, error) {
+	raw, ok := fields["MPTAmount"]
+	if !ok {
+		return 0, nil
+	}
+	if value, ok := raw.(string); ok {
+		amount, err := strconv.ParseUint(value, decimalBase, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid MPTAmount: %w", err)
+		}
+		return amount, nil
+	}
+	amount, err := jsonNumberToUint64(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid MPTAmount: %w", err)
+	}
+	return amount, nil
+}
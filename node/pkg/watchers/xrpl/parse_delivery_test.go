@@ -0,0 +1,346 @@
+package xrpl
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// loadTxFixture loads a recorded response to a tx request from testdata.
+func loadTxFixture(t *testing.T, name string) *txResponseV2 {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	require.NoError(t, err)
+
+	var resp websocket.ClientResponse
+	require.NoError(t, json.Unmarshal(data, &resp))
+
+	txResp, err := decodeTxResponse(&resp)
+	require.NoError(t, err)
+	require.True(t, txResp.Validated)
+	return txResp
+}
+
+// =============================================================================
+// Recorded ledger tests
+// =============================================================================
+
+func TestParseTxResponse_Deliveries(t *testing.T) {
+	rlusd := map[string]any{
+		"currency": "524C555344000000000000000000000000000000",
+		"issuer":   "rMxCKbEDwqr76QuheSUMdEGf4B9xJ8m5De",
+		"value":    "1",
+	}
+
+	testCases := []struct {
+		name string
+		// sender is the account expected in the sender field of the NTT payload.
+		sender string
+		// token identifies the delivered token; its value is ignored.
+		token  any
+		amount uint64
+	}{
+		{
+			name:   "check_cash_xrp",
+			sender: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			token:  "1",
+			amount: 2500000,
+		},
+		{
+			// Cashed with DeliverMin: 97.5 of the 100 RLUSD allowed by SendMax were delivered.
+			name:   "check_cash_deliver_min",
+			sender: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			token:  rlusd,
+			amount: 97500000,
+		},
+		{
+			name:   "escrow_finish_xrp",
+			sender: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			token:  "1",
+			amount: 75000000,
+		},
+		{
+			// 5 XRP withdrawn; the balance of the managed account only grew by 5 XRP minus the fee.
+			name:   "amm_withdraw_xrp",
+			sender: testNttCustodyAccount,
+			token:  "1",
+			amount: 5000000,
+		},
+		{
+			// The trust line balance went from 1e2 to 349.75.
+			name:   "amm_withdraw_rlusd",
+			sender: testNttCustodyAccount,
+			token:  rlusd,
+			amount: 249750000,
+		},
+		{
+			// A partial payment with an Amount of 10 XRP that delivered 1.234567 XRP.
+			name:   "payment_partial",
+			sender: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			token:  "1",
+			amount: 1234567,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewParser(testCoreAccount, []string{testNttCustodyAccount}, nil)
+			txResp := loadTxFixture(t, tc.name)
+
+			msg, err := p.ParseTxResponse(txResp)
+			require.NoError(t, err)
+			require.NotNil(t, msg)
+
+			memo, err := p.parseMemoData(txResp.TxJSON)
+			require.NoError(t, err)
+			tokenInfo, err := p.parseDeliveredAmount(tc.token, memo)
+			require.NoError(t, err)
+			sourceNTTManager, err := p.addressToEmitter(testNttCustodyAccount)
+			require.NoError(t, err)
+			sender, err := p.addressToEmitter(tc.sender)
+			require.NoError(t, err)
+
+			expectedSequence := (uint64(txResp.LedgerIndex) << 32) | uint64(txResp.Meta.TransactionIndex)
+			assert.Equal(t, expectedSequence, msg.Sequence)
+			assert.Equal(t, vaa.ChainIDXRPL, msg.EmitterChain)
+			assert.Equal(t, p.calculateEmitterAddress(sourceNTTManager, tokenInfo.sourceToken), msg.EmitterAddress)
+			assert.Equal(t, p.buildNTTPayload(
+				sourceNTTManager,
+				memo.recipientNTTManager,
+				expectedSequence,
+				sender,
+				6,
+				tc.amount,
+				tokenInfo.sourceToken,
+				memo.recipientAddress,
+				memo.recipientChain,
+			), msg.Payload)
+			assert.Equal(t, tc.amount, binary.BigEndian.Uint64(msg.Payload[141:149]))
+		})
+	}
+}
+
+func TestParseTxResponse_EscrowFinishedByThirdParty(t *testing.T) {
+	p := NewParser(testCoreAccount, []string{testNttCustodyAccount}, nil)
+
+	msg, err := p.ParseTxResponse(loadTxFixture(t, "escrow_finish_third_party"))
+	require.Error(t, err)
+	assert.Nil(t, msg)
+	assert.Contains(t, err.Error(), "was finished by rf8sHGZFeZStG1Z8WLxsVqpjWjwYm4eX4e")
+}
+
+// =============================================================================
+// Delivery extraction tests
+// =============================================================================
+
+func TestDeliveredAmount(t *testing.T) {
+	assert.Equal(t, "100", deliveredAmount(GenericTx{MetaDeliveredAmount: "100", MetaPartialDeliveredAmount: "90"}))
+	assert.Equal(t, "90", deliveredAmount(GenericTx{MetaDeliveredAmount: "unavailable", MetaPartialDeliveredAmount: "90"}))
+	assert.Equal(t, "90", deliveredAmount(GenericTx{MetaPartialDeliveredAmount: "90"}))
+	assert.Nil(t, deliveredAmount(GenericTx{MetaDeliveredAmount: "unavailable"}))
+}
+
+func TestParseNttTransaction_DeliveredAmountUnavailable(t *testing.T) {
+	p := NewParser(testCoreAccount, []string{testNttCustodyAccount}, nil)
+	tx := GenericTx{
+		Transaction:           createValidNTTTransaction(),
+		Hash:                  "ABCDEF1234567890ABCDEF1234567890ABCDEF1234567890ABCDEF1234567890",
+		LedgerIndex:           1000,
+		MetaTransactionResult: "tesSUCCESS",
+		MetaDeliveredAmount:   "unavailable",
+	}
+
+	// The Amount of the transaction must not be used in place of a delivered amount.
+	tx.Transaction["Amount"] = "1000000"
+	msg, err := p.parseTransaction(tx)
+	require.Error(t, err)
+	assert.Nil(t, msg)
+	assert.Contains(t, err.Error(), "transaction has no delivered amount")
+
+	tx.MetaPartialDeliveredAmount = "500000"
+	msg, err = p.parseTransaction(tx)
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	assert.Equal(t, uint64(500000), binary.BigEndian.Uint64(msg.Payload[141:149]))
+}
+
+func TestExtractCheckCashDelivery_NotDestination(t *testing.T) {
+	p := NewParser(testCoreAccount, []string{testNttCustodyAccount}, nil)
+	tx := GenericTx{
+		Transaction: transaction.FlatTransaction{
+			"TransactionType": "CheckCash",
+			"Account":         "rf8sHGZFeZStG1Z8WLxsVqpjWjwYm4eX4e",
+		},
+		MetaAffectedNodes: []transaction.AffectedNode{
+			{
+				DeletedNode: &transaction.DeletedNode{
+					LedgerEntryType: ledger.CheckEntry,
+					FinalFields: ledger.FlatLedgerObject{
+						"Account":     "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+						"Destination": testNttCustodyAccount,
+					},
+				},
+			},
+		},
+	}
+
+	_, err := p.extractNttDelivery(tx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "was cashed by rf8sHGZFeZStG1Z8WLxsVqpjWjwYm4eX4e")
+}
+
+func TestExtractEscrowFinishDelivery_NotManaged(t *testing.T) {
+	p := NewParser(testCoreAccount, []string{testNttCustodyAccount}, nil)
+	tx := GenericTx{
+		Transaction: transaction.FlatTransaction{
+			"TransactionType": "EscrowFinish",
+			"Account":         "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+			"Memos": []any{
+				map[string]any{
+					"Memo": map[string]any{
+						"MemoFormat": testNTTMemoFormat,
+						"MemoData":   sampleNTTMemoData,
+					},
+				},
+			},
+		},
+		MetaTransactionResult: "tesSUCCESS",
+		MetaAffectedNodes: []transaction.AffectedNode{
+			{
+				DeletedNode: &transaction.DeletedNode{
+					LedgerEntryType: ledger.EscrowEntry,
+					FinalFields: ledger.FlatLedgerObject{
+						"Account":     "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh",
+						"Destination": "rf8sHGZFeZStG1Z8WLxsVqpjWjwYm4eX4e",
+						"Amount":      "1000000",
+					},
+				},
+			},
+		},
+	}
+
+	msg, err := p.parseTransaction(tx)
+	require.NoError(t, err)
+	assert.Nil(t, msg, "Should skip escrows to accounts that are not managed")
+}
+
+func TestExtractAMMWithdrawDelivery_TwoAssets(t *testing.T) {
+	p := NewParser(testCoreAccount, []string{testNttCustodyAccount}, nil)
+	tx := GenericTx{
+		Transaction: transaction.FlatTransaction{
+			"TransactionType": "AMMWithdraw",
+			"Account":         testNttCustodyAccount,
+			"Amount":          "1000000",
+			"Amount2": map[string]any{
+				"currency": "524C555344000000000000000000000000000000",
+				"issuer":   "rMxCKbEDwqr76QuheSUMdEGf4B9xJ8m5De",
+				"value":    "10",
+			},
+		},
+	}
+
+	_, err := p.extractNttDelivery(tx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AMMWithdraw must withdraw a single asset")
+
+	delete(tx.Transaction, "Amount")
+	delete(tx.Transaction, "Amount2")
+	_, err = p.extractNttDelivery(tx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AMMWithdraw must withdraw a single asset")
+}
+
+// =============================================================================
+// Balance change tests
+// =============================================================================
+
+func TestBalanceIncrease_MPT(t *testing.T) {
+	const mptID = "0000012FFD9EE5DA93AC614B4DB94D7E0FCE415CA51BED47"
+	asset := map[string]any{"mpt_issuance_id": mptID, "value": "0"}
+
+	modified := GenericTx{
+		MetaAffectedNodes: []transaction.AffectedNode{
+			{
+				ModifiedNode: &transaction.ModifiedNode{
+					LedgerEntryType: ledger.MPTokenEntry,
+					FinalFields: ledger.FlatLedgerObject{
+						"Account":           testNttCustodyAccount,
+						"MPTokenIssuanceID": mptID,
+						"MPTAmount":         "1500",
+					},
+					PreviousFields: ledger.FlatLedgerObject{
+						"MPTAmount": "250",
+					},
+				},
+			},
+		},
+	}
+	amount, err := balanceIncrease(modified, testNttCustodyAccount, asset)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"mpt_issuance_id": mptID, "value": "1250"}, amount)
+
+	// The MPToken of an account that held none omits MPTAmount.
+	created := GenericTx{
+		MetaAffectedNodes: []transaction.AffectedNode{
+			{
+				CreatedNode: &transaction.CreatedNode{
+					LedgerEntryType: ledger.MPTokenEntry,
+					NewFields: ledger.FlatLedgerObject{
+						"Account":           testNttCustodyAccount,
+						"MPTokenIssuanceID": mptID,
+						"MPTAmount":         json.Number("42"),
+					},
+				},
+			},
+		},
+	}
+	amount, err = balanceIncrease(created, testNttCustodyAccount, asset)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"mpt_issuance_id": mptID, "value": "42"}, amount)
+
+	_, err = balanceIncrease(modified, "rf8sHGZFeZStG1Z8WLxsVqpjWjwYm4eX4e", asset)
+	require.Error(t, err)
+}
+
+func TestTrustLineBalanceIncrease_HighAccount(t *testing.T) {
+	const currency = "524C555344000000000000000000000000000000"
+	const issuer = "rN7n3473SaZBCG4dFL83w7a1RXtXtbk2D9"
+	const account = "rMxCKbEDwqr76QuheSUMdEGf4B9xJ8m5De"
+
+	tx := GenericTx{
+		MetaAffectedNodes: []transaction.AffectedNode{
+			{
+				ModifiedNode: &transaction.ModifiedNode{
+					LedgerEntryType: ledger.RippleStateEntry,
+					FinalFields: ledger.FlatLedgerObject{
+						"Balance":   map[string]any{"currency": currency, "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "-12.5"},
+						"LowLimit":  map[string]any{"currency": currency, "issuer": issuer, "value": "0"},
+						"HighLimit": map[string]any{"currency": currency, "issuer": account, "value": "100"},
+					},
+					PreviousFields: ledger.FlatLedgerObject{
+						"Balance": map[string]any{"currency": currency, "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "-0.25"},
+					},
+				},
+			},
+		},
+	}
+
+	// The balance of the high account is the opposite of the RippleState balance.
+	increase, err := trustLineBalanceIncrease(tx, account, currency, issuer)
+	require.NoError(t, err)
+	assert.Equal(t, "12.25", increase)
+
+	// The low account (the issuer here) lost the same amount.
+	_, err = trustLineBalanceIncrease(tx, issuer, currency, account)
+	require.Error(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
 	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/certusone/wormhole/node/pkg/ntt"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -70,7 +71,7 @@ func createValidNTTTransaction() transaction.FlatTransaction {
 // createSampleMemoData creates a 72-byte NTT memo with specified parameters
 func createSampleMemoData(recipientChain uint16, fromDecimals, toDecimals uint8) string {
 	data := make([]byte, 72)
-	copy(data[0:4], nttPrefix[:])
+	copy(data[0:4], ntt.TransferPrefix)
 	// recipientNTTManager at 4-35 (32 bytes) - use sample address
 	data[35] = 0x01 // non-zero byte in recipient NTT manager
 	// recipientAddress at 36-67 (32 bytes) - use sample address
@@ -891,7 +892,7 @@ func TestBuildNTTPayload_Structure(t *testing.T) {
 	assert.Equal(t, 217, len(payload))
 
 	// Verify transceiver prefix
-	assert.Equal(t, transceiverPrefix[:], payload[0:4])
+	assert.Equal(t, ntt.TransceiverPrefix, payload[0:4])
 
 	// Verify source NTT manager
 	assert.Equal(t, sourceNTTManager[:], payload[4:36])
@@ -908,7 +909,7 @@ func TestBuildNTTPayload_Structure(t *testing.T) {
 	assert.Equal(t, uint16(79), internalPayloadLen)
 
 	// Verify NTT prefix in manager payload
-	assert.Equal(t, nttPrefix[:], payload[136:140])
+	assert.Equal(t, ntt.TransferPrefix, payload[136:140])
 
 	// Verify decimals
 	assert.Equal(t, uint8(6), payload[140])
@@ -1604,7 +1605,7 @@ func TestParseTransactionStream_XRPPayment_FullFlow(t *testing.T) {
 	assert.Equal(t, 217, len(msg.Payload))
 
 	// Verify transceiver prefix in payload
-	assert.Equal(t, transceiverPrefix[:], msg.Payload[0:4])
+	assert.Equal(t, ntt.TransceiverPrefix, msg.Payload[0:4])
 
 	// Verify decimals in payload (at offset 140)
 	// For XRP: min(min(8, 6), 8) = 6
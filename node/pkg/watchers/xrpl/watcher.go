@@ -371,6 +371,22 @@ func (w *Watcher) fetchAndParseTransaction(txHash []byte) (*common.MessagePublic
 		return nil, fmt.Errorf("failed to fetch transaction: %w", err)
 	}
 
+	txResp, err := decodeTxResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only process validated transactions
+	if !txResp.Validated {
+		return nil, fmt.Errorf("transaction not yet validated")
+	}
+
+	// Parse the transaction
+	return w.parser.ParseTxResponse(txResp)
+}
+
+// decodeTxResponse decodes the response to a tx request.
+func decodeTxResponse(resp *websocket.ClientResponse) (*txResponseV2, error) {
 	// Decode the TxResponse fields and close_time_iso separately.
 	// GetResult uses mapstructure (not encoding/json), so embedded struct
 	// squashing is not supported — we decode into each struct independently.
@@ -385,16 +401,10 @@ func (w *Watcher) fetchAndParseTransaction(txHash []byte) (*common.MessagePublic
 		return nil, fmt.Errorf("failed to decode close_time_iso: %w", err)
 	}
 
-	// Only process validated transactions
-	if !txResp.Validated {
-		return nil, fmt.Errorf("transaction not yet validated")
-	}
-
-	// Parse the transaction
-	return w.parser.ParseTxResponse(&txResponseV2{
+	return &txResponseV2{
 		TxResponse:   txResp,
 		CloseTimeISO: v2Fields.CloseTimeISO,
-	})
+	}, nil
 }
 
 // getValidatedLedgerIndex returns the current validated ledger index.
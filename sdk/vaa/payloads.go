@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 
@@ -234,6 +235,9 @@ type (
 		Height uint64
 	}
 
+	// BodyGatewayCancelUpgrade is a governance message to cancel a scheduled upgrade on Gateway. It has no payload.
+	BodyGatewayCancelUpgrade struct{}
+
 	// BodyGatewayIbcComposabilityMwContract is a governance message to set a specific contract (i.e. IBC Translator) for the ibc composability middleware to use
 	BodyGatewayIbcComposabilityMwContract struct {
 		ContractAddr [32]byte
@@ -400,6 +404,15 @@ func (b BodyContractUpgrade) Serialize() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (b *BodyContractUpgrade) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(b.NewContract[:], bz)
+	return nil
+}
+
 //nolint:unparam // TODO: The error is always nil here. This function should not return an error.
 func (b BodyGuardianSetUpdate) Serialize() ([]byte, error) {
 	buf := new(bytes.Buffer)
@@ -420,6 +433,29 @@ func (b BodyGuardianSetUpdate) Serialize() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (b *BodyGuardianSetUpdate) Deserialize(bz []byte) error {
+	// Minimum length: 4 (NewIndex) + 1 (number of keys) = 5 bytes
+	if len(bz) < 5 {
+		return fmt.Errorf("incorrect payload length, should be at least 5 bytes, is %d", len(bz))
+	}
+
+	numKeys := int(bz[4])
+	expectedLen := 5 + numKeys*ethcommon.AddressLength
+	if len(bz) != expectedLen {
+		return fmt.Errorf("incorrect payload length, should be %d for %d keys, is %d", expectedLen, numKeys, len(bz))
+	}
+
+	keys := make([]ethcommon.Address, numKeys)
+	for i := range keys {
+		offset := 5 + i*ethcommon.AddressLength
+		copy(keys[i][:], bz[offset:offset+ethcommon.AddressLength])
+	}
+
+	b.NewIndex = binary.BigEndian.Uint32(bz[0:4])
+	b.Keys = keys
+	return nil
+}
+
 func (r BodyTokenBridgeRegisterChain) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	MustWrite(payload, binary.BigEndian, r.ChainID)
@@ -428,10 +464,29 @@ func (r BodyTokenBridgeRegisterChain) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(r.Module, ActionRegisterChain, 0, payload.Bytes())
 }
 
+func (r *BodyTokenBridgeRegisterChain) Deserialize(bz []byte) error {
+	if len(bz) != 34 {
+		return fmt.Errorf("incorrect payload length, should be 34, is %d", len(bz))
+	}
+
+	r.ChainID = ChainID(binary.BigEndian.Uint16(bz[0:2]))
+	copy(r.EmitterAddress[:], bz[2:34])
+	return nil
+}
+
 func (r BodyTokenBridgeUpgradeContract) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(r.Module, ActionUpgradeTokenBridge, r.TargetChainID, r.NewContract[:])
 }
 
+func (r *BodyTokenBridgeUpgradeContract) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(r.NewContract[:], bz)
+	return nil
+}
+
 func (r BodyTokenBridgeSetPauserAddresses) Serialize() ([]byte, error) {
 	if r.Module != TokenBridgeModuleName {
 		return nil, fmt.Errorf("unknown module %q (expected %q)", r.Module, TokenBridgeModuleName)
@@ -457,6 +512,29 @@ func (r BodyTokenBridgeSetPauserAddresses) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(r.Module, ActionTokenBridgeSetPauserAddresses, r.TargetChainID, payload.Bytes())
 }
 
+func (r *BodyTokenBridgeSetPauserAddresses) Deserialize(bz []byte) error {
+	reader := bytes.NewReader(bz)
+	addresses := make([][]byte, 3)
+	for i, name := range []string{"pauser", "freezer", "unpauser"} {
+		length, err := reader.ReadByte()
+		if err != nil {
+			return fmt.Errorf("failed to read %s length: %w", name, err)
+		}
+		addresses[i] = make([]byte, length)
+		if _, err := io.ReadFull(reader, addresses[i]); err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+	}
+	if reader.Len() != 0 {
+		return fmt.Errorf("payload has %d trailing bytes", reader.Len())
+	}
+
+	r.Pauser = addresses[0]
+	r.Freezer = addresses[1]
+	r.Unpauser = addresses[2]
+	return nil
+}
+
 func (r BodyRecoverChainId) Serialize() ([]byte, error) {
 	// Module
 	buf, err := LeftPadBytes(r.Module, 32)
@@ -478,6 +556,18 @@ func (r BodyRecoverChainId) Serialize() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Deserialize decodes the body that follows the module and action. Unlike other governance messages, chain id
+// recoveries have no target chain in their header.
+func (r *BodyRecoverChainId) Deserialize(bz []byte) error {
+	if len(bz) != 34 {
+		return fmt.Errorf("incorrect payload length, should be 34, is %d", len(bz))
+	}
+
+	r.EvmChainID = new(uint256.Int).SetBytes32(bz[0:32])
+	r.NewChainID = ChainID(binary.BigEndian.Uint16(bz[32:34]))
+	return nil
+}
+
 const AccountantModifyBalanceReasonLength = 32
 
 func (r BodyAccountantModifyBalance) Serialize() ([]byte, error) {
@@ -503,18 +593,62 @@ func (r BodyAccountantModifyBalance) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(r.Module, ActionModifyBalance, r.TargetChainID, payload.Bytes())
 }
 
+// Deserialize keeps the reason as written, including its padding, so that it serializes back to the same bytes.
+func (r *BodyAccountantModifyBalance) Deserialize(bz []byte) error {
+	expectedLen := 8 + 2 + 2 + 32 + 1 + 32 + AccountantModifyBalanceReasonLength
+	if len(bz) != expectedLen {
+		return fmt.Errorf("incorrect payload length, should be %d, is %d", expectedLen, len(bz))
+	}
+
+	r.Sequence = binary.BigEndian.Uint64(bz[0:8])
+	r.ChainId = ChainID(binary.BigEndian.Uint16(bz[8:10]))
+	r.TokenChain = ChainID(binary.BigEndian.Uint16(bz[10:12]))
+	copy(r.TokenAddress[:], bz[12:44])
+	r.Kind = bz[44]
+	r.Amount = new(uint256.Int).SetBytes32(bz[45:77])
+	r.Reason = string(bz[77:])
+	return nil
+}
+
 func (r BodyWormchainStoreCode) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(WasmdModuleStr, ActionStoreCode, ChainIDWormchain, r.WasmHash[:])
 }
 
+func (r *BodyWormchainStoreCode) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(r.WasmHash[:], bz)
+	return nil
+}
+
 func (r BodyWormchainInstantiateContract) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(WasmdModuleStr, ActionInstantiateContract, ChainIDWormchain, r.InstantiationParamsHash[:])
 }
 
+func (r *BodyWormchainInstantiateContract) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(r.InstantiationParamsHash[:], bz)
+	return nil
+}
+
 func (r BodyWormchainMigrateContract) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(WasmdModuleStr, ActionMigrateContract, ChainIDWormchain, r.MigrationParamsHash[:])
 }
 
+func (r *BodyWormchainMigrateContract) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(r.MigrationParamsHash[:], bz)
+	return nil
+}
+
 func (r BodyWormchainWasmAllowlistInstantiate) Serialize(action GovernanceAction) ([]byte, error) {
 	payload := &bytes.Buffer{}
 	payload.Write(r.ContractAddr[:])
@@ -585,17 +719,40 @@ func (r BodyGatewayScheduleUpgrade) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(GatewayModuleStr, ActionScheduleUpgrade, ChainIDWormchain, payload.Bytes())
 }
 
-//nolint:unparam // TODO: The error is always nil here. This function should not return an error.
 func (r *BodyGatewayScheduleUpgrade) Deserialize(bz []byte) error {
+	if len(bz) < 8 {
+		return fmt.Errorf("incorrect payload length, should be at least 8 bytes, is %d", len(bz))
+	}
+
 	r.Name = string(bz[0 : len(bz)-8])
 	r.Height = binary.BigEndian.Uint64(bz[len(bz)-8:])
 	return nil
 }
 
+func (r BodyGatewayCancelUpgrade) Serialize() ([]byte, error) {
+	return EmptyPayloadVaa(GatewayModuleStr, ActionCancelUpgrade, ChainIDWormchain)
+}
+
+func (r *BodyGatewayCancelUpgrade) Deserialize(bz []byte) error {
+	if len(bz) != 0 {
+		return fmt.Errorf("incorrect payload length, should be 0, is %d", len(bz))
+	}
+	return nil
+}
+
 func (r BodyCircleIntegrationUpdateWormholeFinality) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(CircleIntegrationModuleStr, CircleIntegrationActionUpdateWormholeFinality, r.TargetChainID, []byte{r.Finality})
 }
 
+func (r *BodyCircleIntegrationUpdateWormholeFinality) Deserialize(bz []byte) error {
+	if len(bz) != 1 {
+		return fmt.Errorf("incorrect payload length, should be 1, is %d", len(bz))
+	}
+
+	r.Finality = bz[0]
+	return nil
+}
+
 func (r BodyCircleIntegrationRegisterEmitterAndDomain) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	MustWrite(payload, binary.BigEndian, r.ForeignEmitterChainId)
@@ -604,12 +761,32 @@ func (r BodyCircleIntegrationRegisterEmitterAndDomain) Serialize() ([]byte, erro
 	return serializeBridgeGovernanceVaa(CircleIntegrationModuleStr, CircleIntegrationActionRegisterEmitterAndDomain, r.TargetChainID, payload.Bytes())
 }
 
+func (r *BodyCircleIntegrationRegisterEmitterAndDomain) Deserialize(bz []byte) error {
+	if len(bz) != 38 {
+		return fmt.Errorf("incorrect payload length, should be 38, is %d", len(bz))
+	}
+
+	r.ForeignEmitterChainId = ChainID(binary.BigEndian.Uint16(bz[0:2]))
+	copy(r.ForeignEmitterAddress[:], bz[2:34])
+	r.CircleDomain = binary.BigEndian.Uint32(bz[34:38])
+	return nil
+}
+
 func (r BodyCircleIntegrationUpgradeContractImplementation) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	payload.Write(r.NewImplementationAddress[:])
 	return serializeBridgeGovernanceVaa(CircleIntegrationModuleStr, CircleIntegrationActionUpgradeContractImplementation, r.TargetChainID, payload.Bytes())
 }
 
+func (r *BodyCircleIntegrationUpgradeContractImplementation) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(r.NewImplementationAddress[:], bz)
+	return nil
+}
+
 func (r BodyIbcUpdateChannelChain) Serialize(module string) ([]byte, error) {
 	if module != IbcReceiverModuleStr && module != IbcTranslatorModuleStr {
 		return nil, errors.New("module for BodyIbcUpdateChannelChain must be either IbcReceiver or IbcTranslator")
@@ -621,12 +798,31 @@ func (r BodyIbcUpdateChannelChain) Serialize(module string) ([]byte, error) {
 	return serializeBridgeGovernanceVaa(module, IbcReceiverActionUpdateChannelChain, r.TargetChainId, payload.Bytes())
 }
 
+func (r *BodyIbcUpdateChannelChain) Deserialize(bz []byte) error {
+	if len(bz) != 66 {
+		return fmt.Errorf("incorrect payload length, should be 66, is %d", len(bz))
+	}
+
+	copy(r.ChannelId[:], bz[0:64])
+	r.ChainId = ChainID(binary.BigEndian.Uint16(bz[64:66]))
+	return nil
+}
+
 func (r BodyWormholeRelayerSetDefaultDeliveryProvider) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	payload.Write(r.NewDefaultDeliveryProviderAddress[:])
 	return serializeBridgeGovernanceVaa(WormholeRelayerModuleStr, WormholeRelayerSetDefaultDeliveryProvider, r.ChainID, payload.Bytes())
 }
 
+func (r *BodyWormholeRelayerSetDefaultDeliveryProvider) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	copy(r.NewDefaultDeliveryProviderAddress[:], bz)
+	return nil
+}
+
 func (r BodyCoreBridgeSetMessageFee) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	feeBytes := r.MessageFee.Bytes()
@@ -639,6 +835,15 @@ func (r BodyCoreBridgeSetMessageFee) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionCoreSetMessageFee, r.ChainID, payload.Bytes())
 }
 
+func (r *BodyCoreBridgeSetMessageFee) Deserialize(bz []byte) error {
+	if len(bz) != 32 {
+		return fmt.Errorf("incorrect payload length, should be 32, is %d", len(bz))
+	}
+
+	r.MessageFee = new(uint256.Int).SetBytes32(bz)
+	return nil
+}
+
 // CoreBridgeTransferFeesUsesCosmWasmLayout reports whether the core bridge on
 // the given chain expects the TransferFees payload in CosmWasm layout
 // (Recipient || Amount) rather than the spec layout (Amount || Recipient).
@@ -680,6 +885,31 @@ func (r BodyCoreBridgeTransferFees) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(CoreModuleStr, ActionCoreTransferFees, r.ChainID, payload.Bytes())
 }
 
+// Deserialize decodes the payload in the layout used by the core bridge of ChainID, which must be set beforehand.
+func (r *BodyCoreBridgeTransferFees) Deserialize(bz []byte) error {
+	if r.ChainID == ChainIDUnset {
+		return fmt.Errorf("chain id is required")
+	}
+	if len(bz) != 64 {
+		return fmt.Errorf("incorrect payload length, should be 64, is %d", len(bz))
+	}
+
+	amount, recipient := bz[0:32], bz[32:64]
+	if CoreBridgeTransferFeesUsesCosmWasmLayout(r.ChainID) {
+		recipient, amount = bz[0:32], bz[32:64]
+	}
+
+	r.Amount = new(uint256.Int).SetBytes32(amount)
+	copy(r.Recipient[:], recipient)
+	if r.Amount.IsZero() {
+		return fmt.Errorf("amount must be non-zero")
+	}
+	if r.Recipient == (Address{}) {
+		return fmt.Errorf("recipient must be non-zero")
+	}
+	return nil
+}
+
 func (r BodyDelegatedGuardiansSetConfig) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	// Serialize ConfigIndex as 32-byte big-endian uint256
@@ -722,6 +952,50 @@ func (r BodyDelegatedGuardiansSetConfig) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(DelegatedGuardiansModuleStr, DelegatedGuardiansSetConfigAction, 0, payload.Bytes())
 }
 
+func (r *BodyDelegatedGuardiansSetConfig) Deserialize(bz []byte) error {
+	// Minimum length: 32 (ConfigIndex) + 1 (number of chains) = 33 bytes
+	if len(bz) < 33 {
+		return fmt.Errorf("incorrect payload length, should be at least 33 bytes, is %d", len(bz))
+	}
+
+	configIndex := new(uint256.Int).SetBytes32(bz[0:32])
+	numChains := int(bz[32])
+	config := make(map[ChainID]DelegatedGuardianConfig, numChains)
+
+	offset := 33
+	for i := 0; i < numChains; i++ {
+		// Each chain has 2 (ChainID) + 1 (Threshold) + 1 (number of keys) bytes before its keys
+		if len(bz) < offset+4 {
+			return fmt.Errorf("payload too short for config %d", i)
+		}
+		chainId := ChainID(binary.BigEndian.Uint16(bz[offset : offset+2]))
+		threshold := bz[offset+2]
+		numKeys := int(bz[offset+3])
+		offset += 4
+
+		if len(bz) < offset+numKeys*ethcommon.AddressLength {
+			return fmt.Errorf("payload too short for %d keys of chain %d", numKeys, chainId)
+		}
+		if _, exists := config[chainId]; exists {
+			return fmt.Errorf("duplicate config for chain %d", chainId)
+		}
+
+		keys := make([]ethcommon.Address, numKeys)
+		for j := range keys {
+			copy(keys[j][:], bz[offset:offset+ethcommon.AddressLength])
+			offset += ethcommon.AddressLength
+		}
+		config[chainId] = DelegatedGuardianConfig{Threshold: threshold, Keys: keys}
+	}
+	if offset != len(bz) {
+		return fmt.Errorf("payload has %d trailing bytes", len(bz)-offset)
+	}
+
+	r.ConfigIndex = configIndex
+	r.Config = config
+	return nil
+}
+
 func (r BodyGeneralPurposeGovernanceEvm) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	payload.Write(r.GovernanceContract[:])
@@ -736,6 +1010,23 @@ func (r BodyGeneralPurposeGovernanceEvm) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(GeneralPurposeGovernanceModuleStr, GeneralPurposeGovernanceEvmAction, r.ChainID, payload.Bytes())
 }
 
+func (r *BodyGeneralPurposeGovernanceEvm) Deserialize(bz []byte) error {
+	// Minimum length: 20 (GovernanceContract) + 20 (TargetContract) + 2 (payload length) = 42 bytes
+	if len(bz) < 42 {
+		return fmt.Errorf("incorrect payload length, should be at least 42 bytes, is %d", len(bz))
+	}
+
+	payloadLen := int(binary.BigEndian.Uint16(bz[40:42]))
+	if len(bz) != 42+payloadLen {
+		return fmt.Errorf("incorrect payload length, should be %d, is %d", 42+payloadLen, len(bz))
+	}
+
+	copy(r.GovernanceContract[:], bz[0:20])
+	copy(r.TargetContract[:], bz[20:40])
+	r.Payload = bz[42:]
+	return nil
+}
+
 func (r BodyGeneralPurposeGovernanceSolana) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	payload.Write(r.GovernanceContract[:])
@@ -747,6 +1038,16 @@ func (r BodyGeneralPurposeGovernanceSolana) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(GeneralPurposeGovernanceModuleStr, GeneralPurposeGovernanceSolanaAction, r.ChainID, payload.Bytes())
 }
 
+func (r *BodyGeneralPurposeGovernanceSolana) Deserialize(bz []byte) error {
+	if len(bz) < 32 {
+		return fmt.Errorf("incorrect payload length, should be at least 32 bytes, is %d", len(bz))
+	}
+
+	copy(r.GovernanceContract[:], bz[0:32])
+	r.Instruction = bz[32:]
+	return nil
+}
+
 func (r BodyGeneralPurposeGovernanceSui) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	payload.Write(r.GovernanceContract[:])
@@ -756,6 +1057,16 @@ func (r BodyGeneralPurposeGovernanceSui) Serialize() ([]byte, error) {
 	return serializeBridgeGovernanceVaa(GeneralPurposeGovernanceModuleStr, GeneralPurposeGovernanceSuiAction, r.ChainID, payload.Bytes())
 }
 
+func (r *BodyGeneralPurposeGovernanceSui) Deserialize(bz []byte) error {
+	if len(bz) < 32 {
+		return fmt.Errorf("incorrect payload length, should be at least 32 bytes, is %d", len(bz))
+	}
+
+	copy(r.GovernanceContract[:], bz[0:32])
+	r.Payload = bz[32:]
+	return nil
+}
+
 func (r BodyManagerSetUpdate) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	MustWrite(payload, binary.BigEndian, r.ManagerChainID)
@@ -867,6 +1178,183 @@ func serializeBridgeGovernanceVaa(module string, actionId GovernanceAction, chai
 	return buf.Bytes(), nil
 }
 
+// Names of the governance modules whose identifier is chosen by the caller of Serialize rather than fixed.
+const (
+	NFTBridgeModuleName           = "NFTBridge"
+	GlobalAccountantModuleName    = "GlobalAccountant"
+	NTTGlobalAccountantModuleName = "NTTGlobalAccountant"
+)
+
+// GovernanceBody is a governance message decoded by DecodeGovernancePayload.
+type GovernanceBody interface {
+	// Serialize returns the governance payload, including its module, action and chain header.
+	Serialize() ([]byte, error)
+}
+
+// governanceBodyDecoder is a GovernanceBody that can decode the payload following its header.
+type governanceBodyDecoder interface {
+	GovernanceBody
+	Deserialize(bz []byte) error
+}
+
+// BodyWormchainWasmAllowlistInstantiateWithAction is a BodyWormchainWasmAllowlistInstantiate along with its action, which
+// tells whether the contract is added to or deleted from the allowlist.
+type BodyWormchainWasmAllowlistInstantiateWithAction struct {
+	Action GovernanceAction
+	BodyWormchainWasmAllowlistInstantiate
+}
+
+func (r BodyWormchainWasmAllowlistInstantiateWithAction) Serialize() ([]byte, error) {
+	return r.BodyWormchainWasmAllowlistInstantiate.Serialize(r.Action)
+}
+
+// BodyIbcUpdateChannelChainWithModule is a BodyIbcUpdateChannelChain along with the module of the contract it updates,
+// either IbcReceiverModuleStr or IbcTranslatorModuleStr.
+type BodyIbcUpdateChannelChainWithModule struct {
+	Module string
+	BodyIbcUpdateChannelChain
+}
+
+func (r BodyIbcUpdateChannelChainWithModule) Serialize() ([]byte, error) {
+	return r.BodyIbcUpdateChannelChain.Serialize(r.Module)
+}
+
+// DecodeGovernancePayload decodes a governance VAA payload into the body matching its module and action. The returned
+// value is a pointer to one of the Body types of this package, with the fields carried by the header (module and
+// target chain) set. Serializing it returns the original payload.
+func DecodeGovernancePayload(bz []byte) (GovernanceBody, error) {
+	// Module (32 bytes) + Action (1 byte)
+	if len(bz) < 33 {
+		return nil, fmt.Errorf("governance payload too short, should be at least 33 bytes, is %d", len(bz))
+	}
+
+	module := string(bz[0:32])
+	moduleName := string(bytes.TrimLeft(bz[0:32], "\x00"))
+	action := GovernanceAction(bz[32])
+
+	// Chain id recoveries have no target chain in their header.
+	isBridge := moduleName == TokenBridgeModuleName || moduleName == NFTBridgeModuleName
+	if (module == CoreModuleStr && action == ActionCoreRecoverChainId) || (isBridge && action == ActionTokenBridgeRecoverChainId) {
+		body := &BodyRecoverChainId{Module: moduleName}
+		if err := body.Deserialize(bz[33:]); err != nil {
+			return nil, fmt.Errorf("failed to decode %T: %w", body, err)
+		}
+		return body, nil
+	}
+
+	if len(bz) < 35 {
+		return nil, fmt.Errorf("governance payload too short, should be at least 35 bytes, is %d", len(bz))
+	}
+	chainId := ChainID(binary.BigEndian.Uint16(bz[33:35]))
+
+	// requiredChainId is the chain the header must target for actions that are not addressed to a chain of their
+	// choosing, so that the body serializes back to the same bytes.
+	var body governanceBodyDecoder
+	requiredChainId := chainId
+
+	switch {
+	case module == CoreModuleStr:
+		switch action {
+		case ActionContractUpgrade:
+			body = &BodyContractUpgrade{ChainID: chainId}
+		case ActionGuardianSetUpdate:
+			body = &BodyGuardianSetUpdate{}
+			requiredChainId = ChainIDUnset
+		case ActionCoreSetMessageFee:
+			body = &BodyCoreBridgeSetMessageFee{ChainID: chainId}
+		case ActionCoreTransferFees:
+			body = &BodyCoreBridgeTransferFees{ChainID: chainId}
+		}
+	case isBridge:
+		switch action {
+		case ActionRegisterChain:
+			body = &BodyTokenBridgeRegisterChain{Module: moduleName}
+			requiredChainId = ChainIDUnset
+		case ActionUpgradeTokenBridge:
+			body = &BodyTokenBridgeUpgradeContract{Module: moduleName, TargetChainID: chainId}
+		case ActionTokenBridgeSetPauserAddresses:
+			if moduleName == TokenBridgeModuleName {
+				body = &BodyTokenBridgeSetPauserAddresses{Module: moduleName, TargetChainID: chainId}
+			}
+		}
+	case moduleName == GlobalAccountantModuleName || moduleName == NTTGlobalAccountantModuleName:
+		if action == ActionModifyBalance {
+			body = &BodyAccountantModifyBalance{Module: moduleName, TargetChainID: chainId}
+		}
+	case module == WasmdModuleStr:
+		requiredChainId = ChainIDWormchain
+		switch action {
+		case ActionStoreCode:
+			body = &BodyWormchainStoreCode{}
+		case ActionInstantiateContract:
+			body = &BodyWormchainInstantiateContract{}
+		case ActionMigrateContract:
+			body = &BodyWormchainMigrateContract{}
+		case ActionAddWasmInstantiateAllowlist, ActionDeleteWasmInstantiateAllowlist:
+			body = &BodyWormchainWasmAllowlistInstantiateWithAction{Action: action}
+		}
+	case module == GatewayModuleStr:
+		requiredChainId = ChainIDWormchain
+		switch action {
+		case ActionScheduleUpgrade:
+			body = &BodyGatewayScheduleUpgrade{}
+		case ActionCancelUpgrade:
+			body = &BodyGatewayCancelUpgrade{}
+		case ActionSetIbcComposabilityMwContract:
+			body = &BodyGatewayIbcComposabilityMwContract{}
+		case ActionSlashingParamsUpdate:
+			body = &BodyGatewaySlashingParamsUpdate{}
+		}
+	case module == CircleIntegrationModuleStr:
+		switch action {
+		case CircleIntegrationActionUpdateWormholeFinality:
+			body = &BodyCircleIntegrationUpdateWormholeFinality{TargetChainID: chainId}
+		case CircleIntegrationActionRegisterEmitterAndDomain:
+			body = &BodyCircleIntegrationRegisterEmitterAndDomain{TargetChainID: chainId}
+		case CircleIntegrationActionUpgradeContractImplementation:
+			body = &BodyCircleIntegrationUpgradeContractImplementation{TargetChainID: chainId}
+		}
+	case module == IbcReceiverModuleStr || module == IbcTranslatorModuleStr:
+		if action == IbcReceiverActionUpdateChannelChain {
+			body = &BodyIbcUpdateChannelChainWithModule{Module: module, BodyIbcUpdateChannelChain: BodyIbcUpdateChannelChain{TargetChainId: chainId}}
+		}
+	case module == WormholeRelayerModuleStr:
+		if action == WormholeRelayerSetDefaultDeliveryProvider {
+			body = &BodyWormholeRelayerSetDefaultDeliveryProvider{ChainID: chainId}
+		}
+	case module == GeneralPurposeGovernanceModuleStr:
+		switch action {
+		case GeneralPurposeGovernanceEvmAction:
+			body = &BodyGeneralPurposeGovernanceEvm{ChainID: chainId}
+		case GeneralPurposeGovernanceSolanaAction:
+			body = &BodyGeneralPurposeGovernanceSolana{ChainID: chainId}
+		case GeneralPurposeGovernanceSuiAction:
+			body = &BodyGeneralPurposeGovernanceSui{ChainID: chainId}
+		}
+	case module == DelegatedGuardiansModuleStr:
+		if action == DelegatedGuardiansSetConfigAction {
+			body = &BodyDelegatedGuardiansSetConfig{}
+			requiredChainId = ChainIDUnset
+		}
+	case module == DelegatedManagerModuleStr:
+		if action == ActionManagerSetUpdate {
+			body = &BodyManagerSetUpdate{}
+			requiredChainId = ChainIDUnset
+		}
+	}
+
+	if body == nil {
+		return nil, fmt.Errorf("unsupported governance action %d for module %q", action, moduleName)
+	}
+	if chainId != requiredChainId {
+		return nil, fmt.Errorf("unexpected target chain %d for %T, should be %d", chainId, body, requiredChainId)
+	}
+	if err := body.Deserialize(bz[35:]); err != nil {
+		return nil, fmt.Errorf("failed to decode %T: %w", body, err)
+	}
+	return body, nil
+}
+
 func LeftPadIbcChannelId(channelId string) ([64]byte, error) {
 	channelIdBuf, err := LeftPadBytes(channelId, 64)
 	if err != nil {
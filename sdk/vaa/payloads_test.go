@@ -2074,3 +2074,193 @@ func TestDeserializeXRPLBurnTicketPayloadTrailingBytes(t *testing.T) {
 	_, err = DeserializeXRPLBurnTicketPayload(buf)
 	require.ErrorContains(t, err, "trailing bytes")
 }
+
+// governanceBodies returns a body for every governance message supported by DecodeGovernancePayload.
+func governanceBodies() []GovernanceBody {
+	reason := "restore balance after incident 7" // AccountantModifyBalanceReasonLength bytes, so that no padding is added
+	channelId, err := LeftPadIbcChannelId("channel-0")
+	if err != nil {
+		panic(err)
+	}
+	keys := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	}
+
+	return []GovernanceBody{
+		&BodyContractUpgrade{ChainID: ChainIDEthereum, NewContract: addr},
+		&BodyGuardianSetUpdate{Keys: keys, NewIndex: 5},
+		&BodyCoreBridgeSetMessageFee{ChainID: ChainIDSolana, MessageFee: uint256.NewInt(1000)},
+		&BodyCoreBridgeTransferFees{ChainID: ChainIDEthereum, Amount: uint256.NewInt(42), Recipient: addr},
+		&BodyCoreBridgeTransferFees{ChainID: ChainIDTerra2, Amount: uint256.NewInt(42), Recipient: addr},
+		&BodyRecoverChainId{Module: "Core", EvmChainID: uint256.NewInt(1), NewChainID: ChainIDEthereum},
+		&BodyRecoverChainId{Module: TokenBridgeModuleName, EvmChainID: uint256.NewInt(1), NewChainID: ChainIDEthereum},
+		&BodyTokenBridgeRegisterChain{Module: TokenBridgeModuleName, ChainID: ChainIDSui, EmitterAddress: addr},
+		&BodyTokenBridgeRegisterChain{Module: NFTBridgeModuleName, ChainID: ChainIDSui, EmitterAddress: addr},
+		&BodyTokenBridgeUpgradeContract{Module: TokenBridgeModuleName, TargetChainID: ChainIDEthereum, NewContract: addr},
+		&BodyTokenBridgeSetPauserAddresses{
+			Module:        TokenBridgeModuleName,
+			TargetChainID: ChainIDEthereum,
+			Pauser:        bytes.Repeat([]byte{0xaa}, 20),
+			Freezer:       []byte{},
+			Unpauser:      bytes.Repeat([]byte{0xbb}, 20),
+		},
+		&BodyAccountantModifyBalance{
+			Module:        GlobalAccountantModuleName,
+			TargetChainID: ChainIDWormchain,
+			Sequence:      3,
+			ChainId:       ChainIDEthereum,
+			TokenChain:    ChainIDSolana,
+			TokenAddress:  addr,
+			Kind:          1,
+			Amount:        uint256.NewInt(10_000),
+			Reason:        reason,
+		},
+		&BodyWormchainStoreCode{WasmHash: dummyBytes},
+		&BodyWormchainInstantiateContract{InstantiationParamsHash: dummyBytes},
+		&BodyWormchainMigrateContract{MigrationParamsHash: dummyBytes},
+		&BodyWormchainWasmAllowlistInstantiateWithAction{
+			Action:                                ActionAddWasmInstantiateAllowlist,
+			BodyWormchainWasmAllowlistInstantiate: BodyWormchainWasmAllowlistInstantiate{ContractAddr: dummyBytes, CodeId: 7},
+		},
+		&BodyWormchainWasmAllowlistInstantiateWithAction{
+			Action:                                ActionDeleteWasmInstantiateAllowlist,
+			BodyWormchainWasmAllowlistInstantiate: BodyWormchainWasmAllowlistInstantiate{ContractAddr: dummyBytes, CodeId: 7},
+		},
+		&BodyGatewayScheduleUpgrade{Name: "v2.24.0", Height: 1_000_000},
+		&BodyGatewayCancelUpgrade{},
+		&BodyGatewayIbcComposabilityMwContract{ContractAddr: dummyBytes},
+		&BodyGatewaySlashingParamsUpdate{SignedBlocksWindow: 100, MinSignedPerWindow: 500_000_000_000_000_000, DowntimeJailDuration: 600_000_000_000},
+		&BodyCircleIntegrationUpdateWormholeFinality{TargetChainID: ChainIDEthereum, Finality: 200},
+		&BodyCircleIntegrationRegisterEmitterAndDomain{TargetChainID: ChainIDEthereum, ForeignEmitterChainId: ChainIDAvalanche, ForeignEmitterAddress: dummyBytes, CircleDomain: 1},
+		&BodyCircleIntegrationUpgradeContractImplementation{TargetChainID: ChainIDEthereum, NewImplementationAddress: dummyBytes},
+		&BodyIbcUpdateChannelChainWithModule{
+			Module:                    IbcReceiverModuleStr,
+			BodyIbcUpdateChannelChain: BodyIbcUpdateChannelChain{TargetChainId: ChainIDWormchain, ChannelId: channelId, ChainId: ChainIDOsmosis},
+		},
+		&BodyIbcUpdateChannelChainWithModule{
+			Module:                    IbcTranslatorModuleStr,
+			BodyIbcUpdateChannelChain: BodyIbcUpdateChannelChain{TargetChainId: ChainIDWormchain, ChannelId: channelId, ChainId: ChainIDOsmosis},
+		},
+		&BodyWormholeRelayerSetDefaultDeliveryProvider{ChainID: ChainIDEthereum, NewDefaultDeliveryProviderAddress: addr},
+		&BodyGeneralPurposeGovernanceEvm{
+			ChainID:            ChainIDEthereum,
+			GovernanceContract: common.HexToAddress("0x3333333333333333333333333333333333333333"),
+			TargetContract:     common.HexToAddress("0x4444444444444444444444444444444444444444"),
+			Payload:            []byte{0xde, 0xad, 0xbe, 0xef},
+		},
+		&BodyGeneralPurposeGovernanceSolana{ChainID: ChainIDSolana, GovernanceContract: addr, Instruction: []byte{0x01, 0x02}},
+		&BodyGeneralPurposeGovernanceSui{ChainID: ChainIDSui, GovernanceContract: addr, Payload: []byte{0x03, 0x04}},
+		&BodyDelegatedGuardiansSetConfig{
+			ConfigIndex: uint256.NewInt(1),
+			Config: map[ChainID]DelegatedGuardianConfig{
+				ChainIDEthereum: {Threshold: 2, Keys: keys},
+				ChainIDSolana:   {Threshold: 1, Keys: keys[:1]},
+			},
+		},
+		&BodyManagerSetUpdate{ManagerChainID: ChainIDDogecoin, NewManagerSetIndex: 2, NewManagerSet: []byte{0x01, 0x01, 0x01}},
+	}
+}
+
+func TestDecodeGovernancePayload(t *testing.T) {
+	for _, body := range governanceBodies() {
+		t.Run(reflect.TypeOf(body).Elem().Name(), func(t *testing.T) {
+			payload, err := body.Serialize()
+			require.NoError(t, err)
+
+			decoded, err := DecodeGovernancePayload(payload)
+			require.NoError(t, err)
+			assert.Equal(t, body, decoded)
+
+			reserialized, err := decoded.Serialize()
+			require.NoError(t, err)
+			assert.Equal(t, payload, reserialized)
+		})
+	}
+}
+
+func TestDecodeGovernancePayloadFailures(t *testing.T) {
+	upgrade, err := BodyContractUpgrade{ChainID: ChainIDEthereum, NewContract: addr}.Serialize()
+	require.NoError(t, err)
+	guardianSetUpdate, err := BodyGuardianSetUpdate{Keys: []common.Address{{0x01}}, NewIndex: 1}.Serialize()
+	require.NoError(t, err)
+	storeCode, err := BodyWormchainStoreCode{WasmHash: dummyBytes}.Serialize()
+	require.NoError(t, err)
+	unknownModule, err := serializeBridgeGovernanceVaa("Unknown", ActionContractUpgrade, ChainIDEthereum, addr[:])
+	require.NoError(t, err)
+	unknownAction, err := serializeBridgeGovernanceVaa(CoreModuleStr, GovernanceAction(9), ChainIDEthereum, addr[:])
+	require.NoError(t, err)
+	nftPauser, err := serializeBridgeGovernanceVaa(NFTBridgeModuleName, ActionTokenBridgeSetPauserAddresses, ChainIDEthereum, []byte{0, 0, 0})
+	require.NoError(t, err)
+	scheduleUpgrade, err := serializeBridgeGovernanceVaa(GatewayModuleStr, ActionScheduleUpgrade, ChainIDWormchain, []byte{0x01})
+	require.NoError(t, err)
+	transferFees, err := serializeBridgeGovernanceVaa(CoreModuleStr, ActionCoreTransferFees, ChainIDEthereum, make([]byte, 64))
+	require.NoError(t, err)
+
+	// The guardian set update is addressed to a specific chain instead of all of them.
+	misaddressed := bytes.Clone(guardianSetUpdate)
+	binary.BigEndian.PutUint16(misaddressed[33:35], uint16(ChainIDEthereum))
+
+	tests := []struct {
+		name    string
+		payload []byte
+		errText string
+	}{
+		{name: "empty", payload: []byte{}, errText: "governance payload too short, should be at least 33 bytes, is 0"},
+		{name: "no chain", payload: upgrade[:34], errText: "governance payload too short, should be at least 35 bytes, is 34"},
+		{name: "unknown module", payload: unknownModule, errText: `unsupported governance action 1 for module "Unknown"`},
+		{name: "unknown action", payload: unknownAction, errText: `unsupported governance action 9 for module "Core"`},
+		{name: "pauser addresses on nft bridge", payload: nftPauser, errText: `unsupported governance action 4 for module "NFTBridge"`},
+		{name: "universal action with target chain", payload: misaddressed, errText: "unexpected target chain 2 for *vaa.BodyGuardianSetUpdate, should be 0"},
+		{name: "wormchain action on other chain", payload: append(storeCode[:33:33], append([]byte{0x00, 0x02}, storeCode[35:]...)...), errText: "unexpected target chain 2 for *vaa.BodyWormchainStoreCode, should be 3104"},
+		{name: "truncated body", payload: upgrade[:len(upgrade)-1], errText: "failed to decode *vaa.BodyContractUpgrade: incorrect payload length, should be 32, is 31"},
+		{name: "trailing bytes", payload: append(bytes.Clone(guardianSetUpdate), 0x00), errText: "failed to decode *vaa.BodyGuardianSetUpdate: incorrect payload length, should be 25 for 1 keys, is 26"},
+		{name: "schedule upgrade without height", payload: scheduleUpgrade, errText: "incorrect payload length, should be at least 8 bytes, is 1"},
+		{name: "transfer fees without amount", payload: transferFees, errText: "amount must be non-zero"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := DecodeGovernancePayload(tc.payload)
+			require.ErrorContains(t, err, tc.errText)
+			assert.Nil(t, body)
+		})
+	}
+}
+
+func TestBodyDelegatedGuardiansSetConfigDeserializeDuplicateChain(t *testing.T) {
+	// ConfigIndex || 2 configs || (chain 2, threshold 1, no keys) twice
+	buf := append(make([]byte, 32), 0x02, 0x00, 0x02, 0x01, 0x00, 0x00, 0x02, 0x01, 0x00)
+
+	var actual BodyDelegatedGuardiansSetConfig
+	err := actual.Deserialize(buf)
+	require.ErrorContains(t, err, "duplicate config for chain 2")
+}
+
+func FuzzDecodeGovernancePayload(f *testing.F) {
+	for _, body := range governanceBodies() {
+		payload, err := body.Serialize()
+		require.NoError(f, err)
+		f.Add(payload)
+	}
+
+	f.Fuzz(func(t *testing.T, payload []byte) {
+		body, err := DecodeGovernancePayload(payload)
+		if err != nil {
+			t.Skip()
+		}
+
+		// Anything that decodes must serialize back to an equal body.
+		reserialized, err := body.Serialize()
+		require.NoError(t, err)
+		decoded, err := DecodeGovernancePayload(reserialized)
+		require.NoError(t, err)
+		assert.Equal(t, body, decoded)
+
+		// Delegated guardian configs are serialized in chain order, whatever order they were decoded in. All other
+		// bodies serialize back to the same bytes.
+		if _, ok := body.(*BodyDelegatedGuardiansSetConfig); !ok {
+			assert.Equal(t, payload, reserialized)
+		}
+	})
+}